@@ -8,3 +8,27 @@
 {{- end }}
 {{- end }}
 {{- end -}}
+
+{{- define "global-network-policies.public-networks" }}
+    - ipBlock:
+        cidr: 0.0.0.0/0
+        except:
+        - 10.0.0.0/8
+        - 172.16.0.0/12
+        - 192.168.0.0/16
+        - 100.64.0.0/10
+{{- range $i, $address := .Values.blockedAddresses }}
+{{- if not (contains ":" (toString $address)) }}
+        - {{ $address }}
+{{- end }}
+{{- end }}
+    - ipBlock:
+        cidr: ::/0
+        except:
+        - fc00::/7
+{{- range $i, $address := .Values.blockedAddresses }}
+{{- if contains ":" (toString $address) }}
+        - {{ $address }}
+{{- end }}
+{{- end }}
+{{- end -}}
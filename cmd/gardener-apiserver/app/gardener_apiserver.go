@@ -36,7 +36,11 @@ import (
 
 	"github.com/gardener/gardener/plugin/pkg/global/deletionconfirmation"
 	"github.com/gardener/gardener/plugin/pkg/global/resourcereferencemanager"
+	"github.com/gardener/gardener/plugin/pkg/global/seedrestriction"
+	projectdeletionprotection "github.com/gardener/gardener/plugin/pkg/project/deletionprotection"
+	shootcloning "github.com/gardener/gardener/plugin/pkg/shoot/cloning"
 	shootdns "github.com/gardener/gardener/plugin/pkg/shoot/dns"
+	shootprojectpolicy "github.com/gardener/gardener/plugin/pkg/shoot/projectpolicy"
 	shootquotavalidator "github.com/gardener/gardener/plugin/pkg/shoot/quotavalidator"
 	shootvalidator "github.com/gardener/gardener/plugin/pkg/shoot/validator"
 
@@ -126,20 +130,28 @@ func (o *Options) complete() error {
 	// Admission plugin registration
 	resourcereferencemanager.Register(o.Recommended.Admission.Plugins)
 	deletionconfirmation.Register(o.Recommended.Admission.Plugins)
+	projectdeletionprotection.Register(o.Recommended.Admission.Plugins)
 	shootquotavalidator.Register(o.Recommended.Admission.Plugins)
+	shootprojectpolicy.Register(o.Recommended.Admission.Plugins)
+	shootcloning.Register(o.Recommended.Admission.Plugins)
 	shootdns.Register(o.Recommended.Admission.Plugins)
 	shootvalidator.Register(o.Recommended.Admission.Plugins)
 	controllerregistrationresources.Register(o.Recommended.Admission.Plugins)
 	plantvalidator.Register(o.Recommended.Admission.Plugins)
+	seedrestriction.Register(o.Recommended.Admission.Plugins)
 
 	allOrderedPlugins := []string{
+		seedrestriction.PluginName,
+		shootcloning.PluginName,
 		resourcereferencemanager.PluginName,
 		shootdns.PluginName,
 		shootquotavalidator.PluginName,
+		shootprojectpolicy.PluginName,
 		shootvalidator.PluginName,
 		controllerregistrationresources.PluginName,
 		plantvalidator.PluginName,
 		deletionconfirmation.PluginName,
+		projectdeletionprotection.PluginName,
 	}
 
 	o.Recommended.Admission.RecommendedPluginOrder = append(o.Recommended.Admission.RecommendedPluginOrder, allOrderedPlugins...)
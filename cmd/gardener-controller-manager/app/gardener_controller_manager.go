@@ -22,7 +22,6 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
-	"strings"
 	"time"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
@@ -31,15 +30,14 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config/validation"
 	"github.com/gardener/gardener/pkg/controllermanager/controller"
 	"github.com/gardener/gardener/pkg/controllermanager/features"
 	"github.com/gardener/gardener/pkg/controllermanager/server/handlers/webhooks"
+	"github.com/gardener/gardener/pkg/controllermanager/sharding"
 	"github.com/gardener/gardener/pkg/logger"
-	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/server"
 	"github.com/gardener/gardener/pkg/server/handlers"
-	gardenerutils "github.com/gardener/gardener/pkg/utils"
-	"github.com/gardener/gardener/pkg/version"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -258,11 +256,22 @@ func NewGardener(cfg *config.ControllerManagerConfiguration) (*Gardener, error)
 		return nil, errors.New("config is required")
 	}
 
+	// validate the configuration
+	if err := validation.ValidateConfiguration(cfg); err != nil {
+		return nil, err
+	}
+
 	// Initialize logger
 	logger := logger.NewLogger(cfg.LogLevel)
 	logger.Info("Starting Gardener controller manager...")
 	logger.Infof("Feature Gates: %s", features.FeatureGate.String())
 
+	// The seed agent configuration is only meant for the gardener-seed-agent.
+	if cfg.SeedAgent != nil {
+		logger.Warn("Ignoring the seedAgent configuration as it is only considered by the gardener-seed-agent.")
+		cfg.SeedAgent = nil
+	}
+
 	if flag := flag.Lookup("v"); flag != nil {
 		if err := flag.Value.Set(fmt.Sprintf("%d", cfg.KubernetesLogLevel)); err != nil {
 			return nil, err
@@ -309,11 +318,21 @@ func NewGardener(cfg *config.ControllerManagerConfiguration) (*Gardener, error)
 		}
 	}
 
-	identity, gardenerNamespace, err := determineGardenerIdentity()
+	identity, gardenerNamespace, err := utils.DetermineGardenerIdentity()
 	if err != nil {
 		return nil, err
 	}
 
+	// The Shoot controllers compare the identity recorded in the Shoot status with the identities of the shard members,
+	// hence, all replicas must use a unique identity for both if sharding is enabled.
+	if cfg.Sharding != nil && cfg.Sharding.Enabled {
+		shardIdentity, err := sharding.DetermineIdentity()
+		if err != nil {
+			return nil, err
+		}
+		identity.ID = shardIdentity
+	}
+
 	return &Gardener{
 		Identity:               identity,
 		GardenerNamespace:      gardenerNamespace,
@@ -341,24 +360,45 @@ func (g *Gardener) Run(ctx context.Context, cancel context.CancelFunc) error {
 
 	// Prepare a reusable run function.
 	run := func(ctx context.Context) {
-		g.startControllers(ctx)
+		g.startControllers(ctx, nil, nil)
 	}
 
 	// Start HTTP server
 	var (
-		backupInfrastructureInformer = g.K8sGardenInformers.Garden().V1beta1().BackupInfrastructures()
-		projectInformer              = g.K8sGardenInformers.Garden().V1beta1().Projects()
-		shootInformer                = g.K8sGardenInformers.Garden().V1beta1().Shoots()
+		projectInformer                = g.K8sGardenInformers.Garden().V1beta1().Projects()
+		secretBindingInformer          = g.K8sGardenInformers.Garden().V1beta1().SecretBindings()
+		shootInformer                  = g.K8sGardenInformers.Garden().V1beta1().Shoots()
+		seedInformer                   = g.K8sGardenInformers.Garden().V1beta1().Seeds()
+		backupInfrastructureInformer   = g.K8sGardenInformers.Garden().V1beta1().BackupInfrastructures()
+		controllerInstallationInformer = g.K8sGardenCoreInformers.Core().V1alpha1().ControllerInstallations()
+		secretInformer                 = g.KubeInformerFactory.Core().V1().Secrets()
+
+		seedAuthorizer = webhooks.NewSeedAuthorizer(seedInformer.Lister(), shootInformer.Lister(), backupInfrastructureInformer.Lister(), secretBindingInformer.Lister(), controllerInstallationInformer.Lister(), secretInformer.Lister())
 
 		httpsHandlers = map[string]func(http.ResponseWriter, *http.Request){
-			"/webhooks/validate-namespace-deletion": webhooks.NewValidateNamespaceDeletionHandler(g.K8sGardenClient, projectInformer.Lister(), backupInfrastructureInformer.Lister(), shootInformer.Lister()),
+			"/webhooks/validate-namespace-deletion": webhooks.NewValidateNamespaceDeletionHandler(g.K8sGardenClient, projectInformer.Lister(), secretBindingInformer.Lister(), shootInformer.Lister(), g.Config.NamespaceDeletionProtection),
+			"/webhooks/authorize-seeds":             webhooks.NewSeedAuthorizerHandler(seedAuthorizer),
 		}
 	)
 
+	// The ControllerInstallations and Secrets are not served by the Garden informer factory started by the HTTPS server,
+	// hence, their informers have to be registered and started explicitly.
+	controllerInstallationInformer.Informer()
+	g.K8sGardenCoreInformers.Start(ctx.Done())
+	secretInformer.Informer()
+	g.KubeInformerFactory.Start(ctx.Done())
+
 	go server.ServeHTTP(ctx, g.Config.Server.HTTP.Port, g.Config.Server.HTTP.BindAddress)
-	go server.ServeHTTPS(ctx, g.K8sGardenInformers, httpsHandlers, g.Config.Server.HTTPS.Port, g.Config.Server.HTTPS.BindAddress, g.Config.Server.HTTPS.TLS.ServerCertPath, g.Config.Server.HTTPS.TLS.ServerKeyPath, shootInformer.Informer(), projectInformer.Informer(), backupInfrastructureInformer.Informer())
+	go server.ServeHTTPS(ctx, g.K8sGardenInformers, httpsHandlers, g.Config.Server.HTTPS.Port, g.Config.Server.HTTPS.BindAddress, g.Config.Server.HTTPS.TLS.ServerCertPath, g.Config.Server.HTTPS.TLS.ServerKeyPath, shootInformer.Informer(), projectInformer.Informer(), secretBindingInformer.Informer(), seedInformer.Informer(), backupInfrastructureInformer.Informer(), controllerInstallationInformer.Informer(), secretInformer.Informer())
 	handlers.UpdateHealth(true)
 
+	// If sharding is enabled, the Shoot controllers run on all replicas while leader election only
+	// decides about the remaining controllers.
+	if g.Config.Sharding != nil && g.Config.Sharding.Enabled {
+		leaderElectionCancel()
+		return g.runSharded(ctx, cancel)
+	}
+
 	// If leader election is enabled, run via LeaderElector until done and exit.
 	if g.LeaderElection != nil {
 		g.LeaderElection.Callbacks = leaderelection.LeaderCallbacks{
@@ -386,7 +426,38 @@ func (g *Gardener) Run(ctx context.Context, cancel context.CancelFunc) error {
 	return nil
 }
 
-func (g *Gardener) startControllers(ctx context.Context) {
+func (g *Gardener) runSharded(ctx context.Context, cancel context.CancelFunc) error {
+	membership := sharding.NewMembership(g.K8sGardenClient.Kubernetes(), g.Identity.ID, g.Config.Sharding)
+	if err := membership.Start(ctx); err != nil {
+		return fmt.Errorf("couldn't announce shard membership: %v", err)
+	}
+
+	leaderElected := make(chan struct{})
+	if g.LeaderElection != nil {
+		g.LeaderElection.Callbacks = leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(_ context.Context) {
+				g.Logger.Info("Acquired leadership, starting remaining controllers.")
+				close(leaderElected)
+			},
+			OnStoppedLeading: func() {
+				g.Logger.Info("Lost leadership, terminating.")
+				cancel()
+			},
+		}
+		leaderElector, err := leaderelection.NewLeaderElector(*g.LeaderElection)
+		if err != nil {
+			return fmt.Errorf("couldn't create leader elector: %v", err)
+		}
+		go leaderElector.Run(ctx)
+	} else {
+		close(leaderElected)
+	}
+
+	g.startControllers(ctx, membership, leaderElected)
+	return nil
+}
+
+func (g *Gardener) startControllers(ctx context.Context, shard sharding.Interface, leaderElected <-chan struct{}) {
 	controller.NewGardenControllerFactory(
 		g.K8sGardenClient,
 		g.K8sGardenInformers,
@@ -396,47 +467,7 @@ func (g *Gardener) startControllers(ctx context.Context) {
 		g.Identity,
 		g.GardenerNamespace,
 		g.Recorder,
+		shard,
+		leaderElected,
 	).Run(ctx)
 }
-
-// We want to determine the Docker container id of the currently running Gardener controller manager because
-// we need to identify for still ongoing operations whether another Gardener controller manager instance is
-// still operating the respective Shoots. When running locally, we generate a random string because
-// there is no container id.
-func determineGardenerIdentity() (*gardenv1beta1.Gardener, string, error) {
-	var (
-		gardenerID        string
-		gardenerName      string
-		gardenerNamespace = common.GardenNamespace
-		err               error
-	)
-
-	gardenerName, err = os.Hostname()
-	if err != nil {
-		return nil, "", fmt.Errorf("unable to get hostname: %v", err)
-	}
-
-	// If running inside a Kubernetes cluster (as container) we can read the container id from the proc file system.
-	// Otherwise generate a random string for the gardenerID
-	if cgroup, err := ioutil.ReadFile("/proc/self/cgroup"); err == nil {
-		splitByNewline := strings.Split(string(cgroup), "\n")
-		splitBySlash := strings.Split(splitByNewline[0], "/")
-		gardenerID = splitBySlash[len(splitBySlash)-1]
-	} else {
-		gardenerID, err = gardenerutils.GenerateRandomString(64)
-		if err != nil {
-			return nil, "", fmt.Errorf("unable to generate gardenerID: %v", err)
-		}
-	}
-
-	// If running inside a Kubernetes cluster we will have a service account mount.
-	if ns, err := ioutil.ReadFile("/var/run/secrets/kubernetes.io/serviceaccount/namespace"); err == nil {
-		gardenerNamespace = string(ns)
-	}
-
-	return &gardenv1beta1.Gardener{
-		ID:      gardenerID,
-		Name:    gardenerName,
-		Version: version.Get().GitVersion,
-	}, gardenerNamespace, nil
-}
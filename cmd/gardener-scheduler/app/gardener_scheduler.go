@@ -261,12 +261,12 @@ func (g *GardenerScheduler) Run(ctx context.Context) error {
 }
 
 func (g *GardenerScheduler) startScheduler(ctx context.Context) {
-	gardenerScheduler := controller.NewGardenerScheduler(g.K8sGardenClient, g.K8sGardenInformers, g.Config, g.Recorder)
+	gardenerScheduler := controller.NewGardenerScheduler(g.K8sGardenClient, g.K8sGardenInformers, g.K8sGardenCoreInformers, g.Config, g.Recorder)
 
 	// Initialize the Controller metrics collection.
 	gardenmetrics.RegisterControllerMetrics(gardenerScheduler)
 
-	go gardenerScheduler.Run(ctx, g.K8sGardenInformers)
+	go gardenerScheduler.Run(ctx, g.K8sGardenInformers, g.K8sGardenCoreInformers)
 
 	logger.Logger.Infof("Gardener scheduler initialized (with Strategy: %s)", g.Config.Strategy)
 
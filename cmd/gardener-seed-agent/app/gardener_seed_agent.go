@@ -0,0 +1,408 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/gardener/gardener/cmd/utils"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config/validation"
+	"github.com/gardener/gardener/pkg/controllermanager/features"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/seedagent/controller"
+	"github.com/gardener/gardener/pkg/server"
+	"github.com/gardener/gardener/pkg/server/handlers"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/discovery"
+	diskcache "k8s.io/client-go/discovery/cached/disk"
+	"k8s.io/client-go/informers"
+	kubeinformers "k8s.io/client-go/informers"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Options has all the context and parameters needed to run a Gardener seed agent.
+type Options struct {
+	// ConfigFile is the location of the Gardener seed agent's configuration file.
+	ConfigFile string
+	config     *config.ControllerManagerConfiguration
+	scheme     *runtime.Scheme
+	codecs     serializer.CodecFactory
+}
+
+// AddFlags adds flags for a specific Gardener seed agent to the specified FlagSet.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.ConfigFile, "config", o.ConfigFile, "The path to the configuration file.")
+}
+
+// NewOptions returns a new Options object.
+func NewOptions() (*Options, error) {
+	o := &Options{
+		config: new(config.ControllerManagerConfiguration),
+	}
+
+	o.scheme = runtime.NewScheme()
+	o.codecs = serializer.NewCodecFactory(o.scheme)
+
+	if err := config.AddToScheme(o.scheme); err != nil {
+		return nil, err
+	}
+	if err := controllermanagerconfigv1alpha1.AddToScheme(o.scheme); err != nil {
+		return nil, err
+	}
+	if err := gardenv1beta1.AddToScheme(scheme.Scheme); err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
+
+// loadConfigFromFile loads the contents of file and decodes it as a
+// ControllerManagerConfiguration object.
+func (o *Options) loadConfigFromFile(file string) (*config.ControllerManagerConfiguration, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return o.decodeConfig(data)
+}
+
+// decodeConfig decodes data as a ControllerManagerConfiguration object.
+func (o *Options) decodeConfig(data []byte) (*config.ControllerManagerConfiguration, error) {
+	configObj, gvk, err := o.codecs.UniversalDecoder().Decode(data, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	config, ok := configObj.(*config.ControllerManagerConfiguration)
+	if !ok {
+		return nil, fmt.Errorf("got unexpected config type: %v", gvk)
+	}
+	return config, nil
+}
+
+func (o *Options) configFileSpecified() error {
+	if len(o.ConfigFile) == 0 {
+		return fmt.Errorf("missing Gardener seed agent config file")
+	}
+	return nil
+}
+
+// Validate validates all the required options.
+func (o *Options) validate(args []string) error {
+	if len(args) != 0 {
+		return errors.New("arguments are not supported")
+	}
+
+	return nil
+}
+
+func (o *Options) applyDefaults(in *config.ControllerManagerConfiguration) (*config.ControllerManagerConfiguration, error) {
+	external, err := o.scheme.ConvertToVersion(in, controllermanagerconfigv1alpha1.SchemeGroupVersion)
+	if err != nil {
+		return nil, err
+	}
+	o.scheme.Default(external)
+
+	internal, err := o.scheme.ConvertToVersion(external, config.SchemeGroupVersion)
+	if err != nil {
+		return nil, err
+	}
+	out := internal.(*config.ControllerManagerConfiguration)
+
+	return out, nil
+}
+
+func (o *Options) run(ctx context.Context, cancel context.CancelFunc) error {
+	if len(o.ConfigFile) > 0 {
+		c, err := o.loadConfigFromFile(o.ConfigFile)
+		if err != nil {
+			return err
+		}
+		o.config = c
+	}
+
+	if o.config.SeedAgent == nil || len(o.config.SeedAgent.SeedName) == 0 {
+		return errors.New("the name of the Seed must be configured in seedAgent.seedName")
+	}
+
+	// Add feature flags
+	if err := features.FeatureGate.SetFromMap(o.config.FeatureGates); err != nil {
+		return err
+	}
+
+	seedAgent, err := NewSeedAgent(o.config)
+	if err != nil {
+		return err
+	}
+
+	return seedAgent.Run(ctx, cancel)
+}
+
+// NewCommandStartGardenerSeedAgent creates a *cobra.Command object with default parameters
+func NewCommandStartGardenerSeedAgent(ctx context.Context, cancel context.CancelFunc) *cobra.Command {
+	opts, err := NewOptions()
+	if err != nil {
+		panic(err)
+	}
+
+	cmd := &cobra.Command{
+		Use:   "gardener-seed-agent",
+		Short: "Launch the Gardener seed agent",
+		Long: `The Gardener seed agent runs inside a Seed cluster and reconciles the Shoots,
+ControllerInstallations and BackupInfrastructures belonging to this Seed only.
+It authenticates against the Garden cluster with a seed-scoped identity while the
+Gardener controller manager keeps running the garden-level controllers (e.g. for
+projects, quotas, and shoot maintenance).`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := opts.configFileSpecified(); err != nil {
+				panic(err)
+			}
+			if err := opts.validate(args); err != nil {
+				panic(err)
+			}
+			if err := opts.run(ctx, cancel); err != nil {
+				panic(err)
+			}
+		},
+	}
+
+	opts.config, err = opts.applyDefaults(opts.config)
+	if err != nil {
+		panic(err)
+	}
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+// SeedAgent represents all the parameters required to start the
+// Gardener seed agent.
+type SeedAgent struct {
+	Config                 *config.ControllerManagerConfiguration
+	Identity               *gardenv1beta1.Gardener
+	GardenerNamespace      string
+	K8sGardenClient        kubernetes.Interface
+	K8sGardenInformers     gardeninformers.SharedInformerFactory
+	K8sGardenCoreInformers gardencoreinformers.SharedInformerFactory
+	KubeInformerFactory    informers.SharedInformerFactory
+	Logger                 *logrus.Logger
+	Recorder               record.EventRecorder
+	LeaderElection         *leaderelection.LeaderElectionConfig
+}
+
+func discoveryFromControllerManagerConfiguration(cfg *config.ControllerManagerConfiguration) (discovery.CachedDiscoveryInterface, error) {
+	restConfig, err := utils.RESTConfigFromClientConnectionConfiguration(cfg.ClientConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	discoveryCfg := cfg.Discovery
+	var discoveryCacheDir string
+	if discoveryCfg.DiscoveryCacheDir != nil {
+		discoveryCacheDir = *discoveryCfg.DiscoveryCacheDir
+	}
+
+	var httpCacheDir string
+	if discoveryCfg.HTTPCacheDir != nil {
+		httpCacheDir = *discoveryCfg.HTTPCacheDir
+	}
+
+	var ttl time.Duration
+	if discoveryCfg.TTL != nil {
+		ttl = discoveryCfg.TTL.Duration
+	}
+
+	return diskcache.NewCachedDiscoveryClientForConfig(restConfig, discoveryCacheDir, httpCacheDir, ttl)
+}
+
+// NewSeedAgent is the main entry point of instantiating a new Gardener seed agent.
+func NewSeedAgent(cfg *config.ControllerManagerConfiguration) (*SeedAgent, error) {
+	if cfg == nil {
+		return nil, errors.New("config is required")
+	}
+
+	// validate the configuration
+	if err := validation.ValidateConfiguration(cfg); err != nil {
+		return nil, err
+	}
+
+	// Initialize logger
+	logger := logger.NewLogger(cfg.LogLevel)
+	logger.Infof("Starting Gardener seed agent for Seed %q...", cfg.SeedAgent.SeedName)
+	logger.Infof("Feature Gates: %s", features.FeatureGate.String())
+
+	if flag := flag.Lookup("v"); flag != nil {
+		if err := flag.Value.Set(fmt.Sprintf("%d", cfg.KubernetesLogLevel)); err != nil {
+			return nil, err
+		}
+	}
+
+	// Prepare a Kubernetes client object for the Garden cluster which contains all the Clientsets
+	// that can be used to access the Kubernetes API. The kubeconfig is expected to carry the seed-scoped
+	// identity of the agent.
+	if kubeconfig := os.Getenv("KUBECONFIG"); kubeconfig != "" {
+		cfg.ClientConnection.Kubeconfig = kubeconfig
+	}
+
+	restCfg, err := utils.RESTConfigFromClientConnectionConfiguration(cfg.ClientConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	disc, err := discoveryFromControllerManagerConfiguration(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	k8sGardenClient, err := kubernetes.NewForConfig(restCfg, client.Options{
+		Mapper: restmapper.NewDeferredDiscoveryRESTMapper(disc),
+		Scheme: kubernetes.GardenScheme,
+	})
+	if err != nil {
+		return nil, err
+	}
+	k8sGardenClientLeaderElection, err := k8s.NewForConfig(restCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set up leader election if enabled and prepare event recorder. Agents of different Seeds must not
+	// compete for the lock of the Gardener controller manager.
+	var (
+		leaderElectionConfig *leaderelection.LeaderElectionConfig
+		recorder             = utils.CreateRecorder(k8sGardenClient.Kubernetes(), "gardener-seed-agent")
+	)
+	if cfg.LeaderElection.LeaderElect {
+		// The seed authorizer only allows to use the own lease in the garden namespace.
+		if cfg.LeaderElection.ResourceLock != resourcelock.LeasesResourceLock {
+			return nil, fmt.Errorf("the gardener-seed-agent requires the %q resource lock for the leader election", resourcelock.LeasesResourceLock)
+		}
+		if cfg.LeaderElection.LockObjectNamespace != common.GardenNamespace {
+			return nil, fmt.Errorf("the gardener-seed-agent requires the lock object namespace %q for the leader election", common.GardenNamespace)
+		}
+
+		lockObjectName := common.SeedAgentLeaseName(cfg.SeedAgent.SeedName)
+		if name := cfg.LeaderElection.LockObjectName; name != controllermanagerconfigv1alpha1.ControllerManagerDefaultLockObjectName && name != lockObjectName {
+			return nil, fmt.Errorf("the gardener-seed-agent requires the lock object name %q for the leader election", lockObjectName)
+		}
+
+		leaderElectionConfig, err = utils.MakeLeaderElectionConfig(cfg.LeaderElection.LeaderElectionConfiguration, cfg.LeaderElection.LockObjectNamespace, lockObjectName, k8sGardenClientLeaderElection, recorder)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	identity, gardenerNamespace, err := utils.DetermineGardenerIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	// The seed authorizer does not allow to list and watch secrets and configmaps in the garden namespace, hence, only
+	// the namespaces are read via the informers for the Kubernetes resources. The required secrets are read by name.
+	return &SeedAgent{
+		Identity:               identity,
+		GardenerNamespace:      gardenerNamespace,
+		Config:                 cfg,
+		Logger:                 logger,
+		Recorder:               recorder,
+		K8sGardenClient:        k8sGardenClient,
+		K8sGardenInformers:     gardeninformers.NewSharedInformerFactory(k8sGardenClient.Garden(), 0),
+		K8sGardenCoreInformers: gardencoreinformers.NewSharedInformerFactory(k8sGardenClient.GardenCore(), 0),
+		KubeInformerFactory:    kubeinformers.NewSharedInformerFactory(k8sGardenClient.Kubernetes(), 0),
+		LeaderElection:         leaderElectionConfig,
+	}, nil
+}
+
+func (s *SeedAgent) cleanup() {
+	if err := os.RemoveAll(controllermanagerconfigv1alpha1.DefaultDiscoveryDir); err != nil {
+		s.Logger.Errorf("Could not cleanup base discovery cache directory: %v", err)
+	}
+}
+
+// Run runs the SeedAgent. This should never exit.
+func (s *SeedAgent) Run(ctx context.Context, cancel context.CancelFunc) error {
+	defer s.cleanup()
+	leaderElectionCtx, leaderElectionCancel := context.WithCancel(context.Background())
+
+	// Prepare a reusable run function.
+	run := func(ctx context.Context) {
+		s.startControllers(ctx)
+	}
+
+	// Start HTTP server. The seed agent does not serve any webhooks.
+	go server.ServeHTTP(ctx, s.Config.Server.HTTP.Port, s.Config.Server.HTTP.BindAddress)
+	handlers.UpdateHealth(true)
+
+	// If leader election is enabled, run via LeaderElector until done and exit.
+	if s.LeaderElection != nil {
+		s.LeaderElection.Callbacks = leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(_ context.Context) {
+				s.Logger.Info("Acquired leadership, starting controllers.")
+				run(ctx)
+				leaderElectionCancel()
+			},
+			OnStoppedLeading: func() {
+				s.Logger.Info("Lost leadership, terminating.")
+				cancel()
+			},
+		}
+		leaderElector, err := leaderelection.NewLeaderElector(*s.LeaderElection)
+		if err != nil {
+			return fmt.Errorf("couldn't create leader elector: %v", err)
+		}
+		leaderElector.Run(leaderElectionCtx)
+		return nil
+	}
+
+	// Leader election is disabled, thus run directly until done.
+	leaderElectionCancel()
+	run(ctx)
+	return nil
+}
+
+func (s *SeedAgent) startControllers(ctx context.Context) {
+	controller.NewSeedAgentControllerFactory(
+		s.K8sGardenClient,
+		s.K8sGardenInformers,
+		s.K8sGardenCoreInformers,
+		s.KubeInformerFactory,
+		s.Config,
+		s.Identity,
+		s.GardenerNamespace,
+		s.Recorder,
+	).Run(ctx)
+}
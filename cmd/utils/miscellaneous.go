@@ -16,7 +16,17 @@ package utils
 
 import (
 	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
+	gardenerutils "github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/version"
+
 	corev1 "k8s.io/api/core/v1"
 	k8s "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -42,3 +52,45 @@ func ContextFromStopChannel(stopCh <-chan struct{}) context.Context {
 
 	return ctx
 }
+
+// DetermineGardenerIdentity determines the Docker container id of the currently running Gardener component
+// because we need to identify for still ongoing operations whether another Gardener instance is
+// still operating the respective Shoots. When running locally, we generate a random string because
+// there is no container id.
+func DetermineGardenerIdentity() (*gardenv1beta1.Gardener, string, error) {
+	var (
+		gardenerID        string
+		gardenerName      string
+		gardenerNamespace = common.GardenNamespace
+		err               error
+	)
+
+	gardenerName, err = os.Hostname()
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to get hostname: %v", err)
+	}
+
+	// If running inside a Kubernetes cluster (as container) we can read the container id from the proc file system.
+	// Otherwise generate a random string for the gardenerID
+	if cgroup, err := ioutil.ReadFile("/proc/self/cgroup"); err == nil {
+		splitByNewline := strings.Split(string(cgroup), "\n")
+		splitBySlash := strings.Split(splitByNewline[0], "/")
+		gardenerID = splitBySlash[len(splitBySlash)-1]
+	} else {
+		gardenerID, err = gardenerutils.GenerateRandomString(64)
+		if err != nil {
+			return nil, "", fmt.Errorf("unable to generate gardenerID: %v", err)
+		}
+	}
+
+	// If running inside a Kubernetes cluster we will have a service account mount.
+	if ns, err := ioutil.ReadFile("/var/run/secrets/kubernetes.io/serviceaccount/namespace"); err == nil {
+		gardenerNamespace = string(ns)
+	}
+
+	return &gardenv1beta1.Gardener{
+		ID:      gardenerID,
+		Name:    gardenerName,
+		Version: version.Get().GitVersion,
+	}, gardenerNamespace, nil
+}
@@ -274,7 +274,7 @@ spec:
   #   scaleDownDelayAfterFailure: 10m
   #   scaleDownDelayAfterDelete: 10s
   #   scanInterval: 10s
-    version: ${value("spec.kubernetes.version", kubernetesVersion)}<% kubeAPIServer=value("spec.kubernetes.kubeAPIServer", {}) %><% cloudControllerManager=value("spec.kubernetes.cloudControllerManager", {}) %><% kubeControllerManager=value("spec.kubernetes.kubeControllerManager", {}) %><% kubeScheduler=value("spec.kubernetes.kubeScheduler", {}) %><% kubeProxy=value("spec.kubernetes.kubeProxy", {}) %><% kubelet=value("spec.kubernetes.kubelet", {}) %>
+    version: ${value("spec.kubernetes.version", kubernetesVersion)}<% kubeAPIServer=value("spec.kubernetes.kubeAPIServer", {}) %><% cloudControllerManager=value("spec.kubernetes.cloudControllerManager", {}) %><% kubeControllerManager=value("spec.kubernetes.kubeControllerManager", {}) %><% kubeScheduler=value("spec.kubernetes.kubeScheduler", {}) %><% kubeProxy=value("spec.kubernetes.kubeProxy", {}) %><% kubelet=value("spec.kubernetes.kubelet", {}) %><% controlPlane=value("spec.controlPlane", {}) %>
     allowPrivilegedContainers: ${value("spec.kubernetes.allowPrivilegedContainers", "true")} # 'true' means that all authenticated users can use the "gardener.privileged" PodSecurityPolicy, allowing full unrestricted access to Pod features.
     % if kubeAPIServer != {}:
     kubeAPIServer: ${yaml.dump(kubeAPIServer, width=10000, default_flow_style=None)}
@@ -359,6 +359,13 @@ spec:
   #   podPidsLimit: 10
   #   featureGates:
   #     SomeKubernetesFeature: true
+  % endif
+  % if controlPlane != {}:
+  controlPlane: ${yaml.dump(controlPlane, width=10000, default_flow_style=None)}
+  % else:
+# controlPlane:
+#   highAvailability:
+#     enabled: false # Deploys a zone-spread, multi-replica control plane (cannot be changed after creation)
   % endif
   dns:
   # provider: ${value("spec.dns.provider", "aws-route53")}
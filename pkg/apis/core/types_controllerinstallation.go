@@ -51,12 +51,23 @@ type ControllerInstallationSpec struct {
 	RegistrationRef corev1.ObjectReference
 	// SeedRef is used to reference a Seed resources.
 	SeedRef corev1.ObjectReference
+	// Deployment is the deployment configuration of the referenced registration which has been rolled out to the
+	// seed. Installations keep it while a newer version of the registration is being rolled out to other seeds. It
+	// is only set if the registration specifies a rollout strategy.
+	// +optional
+	Deployment *ControllerDeployment
+	// Compatibility contains the version ranges of the referenced registration which have been rolled out to the
+	// seed. It is only considered if the deployment configuration is set.
+	// +optional
+	Compatibility *ControllerCompatibility
 }
 
 // ControllerInstallationStatus is the status of a ControllerInstallation.
 type ControllerInstallationStatus struct {
 	// Conditions represents the latest available observations of a ControllerInstallations's current state.
 	Conditions []Condition
+	// ObservedGeneration is the most recent generation observed for this ControllerInstallation.
+	ObservedGeneration int64
 	// ProviderStatus contains type-specific status.
 	// +optional
 	ProviderStatus *ProviderConfig
@@ -68,4 +79,8 @@ const (
 
 	// ControllerInstallationInstalled is a condition type for indicating whether the controller has been installed.
 	ControllerInstallationInstalled ConditionType = "Installed"
+
+	// ControllerInstallationHealthy is a condition type for indicating whether the workloads of the installed controller
+	// are healthy.
+	ControllerInstallationHealthy ConditionType = "Healthy"
 )
@@ -29,6 +29,8 @@ type ControllerRegistration struct {
 	metav1.ObjectMeta
 	// Spec contains the specification of this registration.
 	Spec ControllerRegistrationSpec
+	// Status contains the status of this registration.
+	Status ControllerRegistrationStatus
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -49,6 +51,19 @@ type ControllerRegistrationSpec struct {
 	Resources []ControllerResource
 	// Deployment contains information for how this controller is deployed.
 	Deployment *ControllerDeployment
+	// Compatibility contains the version ranges supported by the controller.
+	Compatibility *ControllerCompatibility
+}
+
+// ControllerCompatibility contains the version ranges supported by a controller. The ranges are semantic version
+// constraints, e.g. ">= 0.28, < 0.30".
+type ControllerCompatibility struct {
+	// Gardener is the range of supported Gardener versions. The controller is not deployed by incompatible Gardener
+	// versions.
+	Gardener *string
+	// Kubernetes is the range of supported Kubernetes versions of seed clusters. The controller is not deployed to
+	// seeds with incompatible versions.
+	Kubernetes *string
 }
 
 // ControllerResource is a combination of a kind (DNSProvider, Infrastructure, Generic, ...) and the actual type for this
@@ -70,4 +85,83 @@ type ControllerDeployment struct {
 	Type string
 	// ProviderConfig contains type-specific configuration.
 	ProviderConfig *ProviderConfig
+	// Policy controls when the controller is deployed to a seed. It defaults to 'Always'.
+	Policy *ControllerDeploymentPolicy
+	// SeedSelector contains an optional label selector for seeds. The controller is only deployed to seeds whose
+	// labels match the selector.
+	SeedSelector *metav1.LabelSelector
+	// Rollout contains the strategy for rolling out changes of the registration to the seeds. If it is not set then
+	// changes are rolled out to all seeds at once.
+	Rollout *ControllerRolloutStrategy
+}
+
+// ControllerRolloutStrategy contains the strategy for rolling out changes of a registration to the seeds.
+type ControllerRolloutStrategy struct {
+	// MaxParallelSeeds is the maximum number of seeds to which changes are rolled out at the same time. It defaults
+	// to 1.
+	MaxParallelSeeds *int32
+	// WaitForHealthy specifies whether the rollout to a seed is only finished once the controller is healthy on it.
+	// It defaults to true.
+	WaitForHealthy *bool
+	// HaltOnFailure specifies whether the rollout is halted if it failed on a seed. It defaults to true.
+	HaltOnFailure *bool
+}
+
+// ControllerDeploymentPolicy is a string alias.
+type ControllerDeploymentPolicy string
+
+const (
+	// ControllerDeploymentPolicyOnDemand specifies that the controller shall only be deployed to a seed if the seed
+	// or a shoot scheduled to it requires one of the resources of the registration.
+	ControllerDeploymentPolicyOnDemand ControllerDeploymentPolicy = "OnDemand"
+	// ControllerDeploymentPolicyAlways specifies that the controller shall always be deployed to a seed, independent
+	// of whether it is required.
+	ControllerDeploymentPolicyAlways ControllerDeploymentPolicy = "Always"
+)
+
+// ControllerRegistrationStatus is the status of a ControllerRegistration.
+type ControllerRegistrationStatus struct {
+	// ObservedGeneration is the most recent generation observed for this registration.
+	ObservedGeneration int64
+	// Conditions represents the latest available observations of a ControllerRegistration's current state.
+	Conditions []Condition
+	// Seeds contains the rollout progress of the registration per seed.
+	Seeds []ControllerRegistrationSeedStatus
+}
+
+// ControllerRegistrationSeedStatus contains the rollout progress of a registration on a seed.
+type ControllerRegistrationSeedStatus struct {
+	// Name is the name of the seed.
+	Name string
+	// State is the rollout state of the current registration specification on the seed.
+	State ControllerRolloutState
+	// Message contains details about the state.
+	Message string
+	// LastUpdateTime is the time when the state was updated the last time.
+	LastUpdateTime metav1.Time
 }
+
+// ControllerRolloutState is a string alias.
+type ControllerRolloutState string
+
+const (
+	// ControllerRolloutStatePending indicates that the registration has not been rolled out to the seed yet.
+	ControllerRolloutStatePending ControllerRolloutState = "Pending"
+	// ControllerRolloutStateProgressing indicates that the registration is being rolled out to the seed.
+	ControllerRolloutStateProgressing ControllerRolloutState = "Progressing"
+	// ControllerRolloutStateSucceeded indicates that the registration has been rolled out to the seed successfully.
+	ControllerRolloutStateSucceeded ControllerRolloutState = "Succeeded"
+	// ControllerRolloutStateFailed indicates that the rollout of the registration to the seed failed.
+	ControllerRolloutStateFailed ControllerRolloutState = "Failed"
+	// ControllerRolloutStateIncompatible indicates that the controller does not support the seed.
+	ControllerRolloutStateIncompatible ControllerRolloutState = "Incompatible"
+)
+
+const (
+	// ControllerRegistrationCompatible is a condition type for indicating whether the controller supports the
+	// version of Gardener.
+	ControllerRegistrationCompatible ConditionType = "Compatible"
+	// ControllerRegistrationRolledOut is a condition type for indicating whether the registration has been rolled out
+	// to all seeds.
+	ControllerRegistrationRolledOut ConditionType = "RolledOut"
+)
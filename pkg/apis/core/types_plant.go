@@ -49,6 +49,15 @@ const (
 	PlantEveryNodeReady ConditionType = "EveryNodeReady"
 	// PlantAPIServerAvailable is a constant for a condition type indicating that the Plant cluster API server is available.
 	PlantAPIServerAvailable ConditionType = "APIServerAvailable"
+	// PlantSystemComponentsHealthy is a constant for a condition type indicating the health of the system components
+	// (the workloads in the kube-system namespace) of the Plant cluster.
+	PlantSystemComponentsHealthy ConditionType = "SystemComponentsHealthy"
+	// PlantNodeVersionSkewSupported is a constant for a condition type indicating that the Kubernetes versions of all
+	// nodes are supported by the version of the Plant cluster control plane.
+	PlantNodeVersionSkewSupported ConditionType = "NodeVersionSkewSupported"
+	// PlantEndpointsAvailable is a constant for a condition type indicating that all probed endpoints of the Plant
+	// are available.
+	PlantEndpointsAvailable ConditionType = "EndpointsAvailable"
 )
 
 // PlantSpec is the specification of a Plant.
@@ -68,6 +77,17 @@ type Endpoint struct {
 	URL string
 	// Purpose is the purpose of the endpoint
 	Purpose string
+	// Probe configures the probing of the endpoint by the Plant health checks. Endpoints without probe
+	// configuration are not probed. Only endpoints resolving to public addresses are probed.
+	Probe *EndpointProbe
+}
+
+// EndpointProbe configures the probing of a Plant endpoint.
+type EndpointProbe struct {
+	// ExpectedStatusCodes is the list of HTTP status codes which are considered healthy. Defaults to [200].
+	ExpectedStatusCodes []int32
+	// Timeout is the timeout of a single probe. Defaults to 10s.
+	Timeout *metav1.Duration
 }
 
 // PlantStatus is the status of a Plant.
@@ -79,6 +99,57 @@ type PlantStatus struct {
 	ObservedGeneration *int64
 	// ClusterInfo is additional computed information about the newly added cluster (Plant)
 	ClusterInfo *ClusterInfo
+	// Adoption is the report of the latest adoption dry-run of the Plant. It describes how the cluster would be
+	// mapped to a Shoot and which parts of it cannot be adopted.
+	Adoption *PlantAdoptionReport
+}
+
+// PlantAdoptionReport is the result of an adoption dry-run of a Plant.
+type PlantAdoptionReport struct {
+	// LastUpdateTime is the time when the report has been computed.
+	LastUpdateTime metav1.Time
+	// Adoptable indicates whether the Plant can be adopted as Shoot, i.e. whether no issues have been found.
+	Adoptable bool
+	// Infrastructure is the infrastructure configuration derived from the Plant cluster.
+	Infrastructure PlantAdoptionInfrastructure
+	// Workers is the list of worker pools derived from the nodes of the Plant cluster.
+	Workers []PlantAdoptionWorker
+	// Issues is the list of reasons why the Plant cannot be adopted.
+	Issues []string
+}
+
+// PlantAdoptionInfrastructure is the infrastructure configuration derived from a Plant cluster.
+type PlantAdoptionInfrastructure struct {
+	// Provider is the type of the infrastructure provider (e.g. aws, gcp).
+	Provider string
+	// Region is the region of the infrastructure.
+	Region string
+	// Zones is the list of availability zones of the nodes.
+	Zones []string
+	// KubernetesVersion is the Kubernetes version of the control plane.
+	KubernetesVersion string
+}
+
+// PlantAdoptionWorker is a worker pool derived from the nodes of a Plant cluster.
+type PlantAdoptionWorker struct {
+	// Name is the name of the worker pool.
+	Name string
+	// MachineType is the machine type of the nodes of the worker pool.
+	MachineType string
+	// Zones is the list of availability zones of the nodes of the worker pool.
+	Zones []string
+	// Nodes is the number of nodes of the worker pool.
+	Nodes int32
+	// MachineImage is the machine image the nodes of the worker pool are running.
+	MachineImage *PlantAdoptionMachineImage
+}
+
+// PlantAdoptionMachineImage is the machine image derived from the operating system of the nodes of a worker pool.
+type PlantAdoptionMachineImage struct {
+	// Name is the logical name of the machine image.
+	Name string
+	// Version is the version of the machine image.
+	Version string
 }
 
 // ClusterInfo contains information about the Plant cluster
@@ -87,6 +158,16 @@ type ClusterInfo struct {
 	Cloud Cloud
 	// Kubernetes describes kubernetes meta information (e.g., version)
 	Kubernetes Kubernetes
+	// Inventory contains the number of nodes and pods of the Plant cluster.
+	Inventory *ClusterInventory
+}
+
+// ClusterInventory contains the number of resources in the Plant cluster.
+type ClusterInventory struct {
+	// Nodes is the number of nodes registered to the cluster.
+	Nodes int32
+	// Pods is the number of non-terminated pods in the cluster.
+	Pods int32
 }
 
 // Cloud contains information about the cloud
@@ -101,4 +182,6 @@ type Cloud struct {
 type Kubernetes struct {
 	// Version is the semantic Kubernetes version to use for the Plant cluster.
 	Version string
+	// NodeVersions is the list of distinct kubelet versions of the nodes of the Plant cluster.
+	NodeVersions []string
 }
@@ -78,3 +78,14 @@ type K8SNetworks struct {
 	// Services is the CIDR of the service network.
 	Services *CIDR
 }
+
+// DualStackNetworks contains CIDRs of the second IP family for the pod, service and node networks of a
+// dual-stack Kubernetes cluster. Each of them must belong to another IP family than the respective primary network.
+type DualStackNetworks struct {
+	// Nodes is the CIDR of the node network.
+	Nodes *CIDR
+	// Pods is the CIDR of the pod network.
+	Pods *CIDR
+	// Services is the CIDR of the service network.
+	Services *CIDR
+}
@@ -21,3 +21,27 @@ import (
 func addDefaultingFuncs(scheme *runtime.Scheme) error {
 	return RegisterDefaults(scheme)
 }
+
+// SetDefaults_ControllerDeployment sets default values for ControllerDeployment objects.
+func SetDefaults_ControllerDeployment(obj *ControllerDeployment) {
+	if obj.Policy == nil {
+		policy := ControllerDeploymentPolicyAlways
+		obj.Policy = &policy
+	}
+}
+
+// SetDefaults_ControllerRolloutStrategy sets default values for ControllerRolloutStrategy objects.
+func SetDefaults_ControllerRolloutStrategy(obj *ControllerRolloutStrategy) {
+	if obj.MaxParallelSeeds == nil {
+		maxParallelSeeds := int32(1)
+		obj.MaxParallelSeeds = &maxParallelSeeds
+	}
+	if obj.WaitForHealthy == nil {
+		waitForHealthy := true
+		obj.WaitForHealthy = &waitForHealthy
+	}
+	if obj.HaltOnFailure == nil {
+		haltOnFailure := true
+		obj.HaltOnFailure = &haltOnFailure
+	}
+}
@@ -153,6 +153,18 @@ func IsControllerInstallationSuccessful(controllerInstallation gardencorev1alpha
 	return false
 }
 
+// IsControllerInstallationHealthy returns false if a ControllerInstallation has been marked as unhealthy, i.e. its
+// "Healthy" condition is "False". Installations without a "Healthy" condition are considered healthy.
+func IsControllerInstallationHealthy(controllerInstallation gardencorev1alpha1.ControllerInstallation) bool {
+	for _, condition := range controllerInstallation.Status.Conditions {
+		if condition.Type == gardencorev1alpha1.ControllerInstallationHealthy && condition.Status == gardencorev1alpha1.ConditionFalse {
+			return false
+		}
+	}
+
+	return true
+}
+
 // ComputeOperationType checksthe <lastOperation> and determines whether is it is Create operation or reconcile operation
 func ComputeOperationType(meta metav1.ObjectMeta, lastOperation *gardencorev1alpha1.LastOperation) gardencorev1alpha1.LastOperationType {
 	switch {
@@ -232,5 +232,47 @@ var _ = Describe("helper", func() {
 				false,
 			),
 		)
+
+		DescribeTable("#IsControllerInstallationHealthy",
+			func(conditions []gardencorev1alpha1.Condition, expectation bool) {
+				controllerInstallation := gardencorev1alpha1.ControllerInstallation{
+					Status: gardencorev1alpha1.ControllerInstallationStatus{
+						Conditions: conditions,
+					},
+				}
+				Expect(IsControllerInstallationHealthy(controllerInstallation)).To(Equal(expectation))
+			},
+			Entry("expect true",
+				[]gardencorev1alpha1.Condition{
+					{
+						Type:   gardencorev1alpha1.ControllerInstallationHealthy,
+						Status: gardencorev1alpha1.ConditionTrue,
+					},
+				},
+				true,
+			),
+			Entry("expect true if health is unknown",
+				[]gardencorev1alpha1.Condition{
+					{
+						Type:   gardencorev1alpha1.ControllerInstallationHealthy,
+						Status: gardencorev1alpha1.ConditionUnknown,
+					},
+				},
+				true,
+			),
+			Entry("expect true if condition is missing",
+				[]gardencorev1alpha1.Condition{},
+				true,
+			),
+			Entry("expect false",
+				[]gardencorev1alpha1.Condition{
+					{
+						Type:   gardencorev1alpha1.ControllerInstallationHealthy,
+						Status: gardencorev1alpha1.ConditionFalse,
+					},
+				},
+				false,
+			),
+		)
 	})
 })
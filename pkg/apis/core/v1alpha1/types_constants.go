@@ -103,6 +103,13 @@ const (
 	// GardenerOperationReconcile is a constant for the value of the operation annotation describing a reconcile
 	// operation.
 	GardenerOperationReconcile = "reconcile"
+	// GardenerOperationAdoptionDryRun is a constant for the value of the operation annotation on a Plant requesting
+	// an adoption dry-run.
+	GardenerOperationAdoptionDryRun = "adoption-dry-run"
+	// GardenerOperationAdoptionExport is a constant for the value of the operation annotation on a Plant requesting
+	// the export of the extension resources of a Shoot adopting the Plant. It is a dry-run, nothing is created from
+	// the exported resources.
+	GardenerOperationAdoptionExport = "adoption-export"
 
 	// BackupProvider is used to identify the backup provider.
 	BackupProvider = "backup.gardener.cloud/provider"
@@ -52,6 +52,15 @@ type ControllerInstallationSpec struct {
 	RegistrationRef corev1.ObjectReference `json:"registrationRef"`
 	// SeedRef is used to reference a Seed resources.
 	SeedRef corev1.ObjectReference `json:"seedRef"`
+	// Deployment is the deployment configuration of the referenced registration which has been rolled out to the
+	// seed. Installations keep it while a newer version of the registration is being rolled out to other seeds. It
+	// is only set if the registration specifies a rollout strategy.
+	// +optional
+	Deployment *ControllerDeployment `json:"deployment,omitempty"`
+	// Compatibility contains the version ranges of the referenced registration which have been rolled out to the
+	// seed. It is only considered if the deployment configuration is set.
+	// +optional
+	Compatibility *ControllerCompatibility `json:"compatibility,omitempty"`
 }
 
 // ControllerInstallationStatus is the status of a ControllerInstallation.
@@ -59,6 +68,9 @@ type ControllerInstallationStatus struct {
 	// Conditions represents the latest available observations of a ControllerInstallations's current state.
 	// +optional
 	Conditions []Condition `json:"conditions,omitempty"`
+	// ObservedGeneration is the most recent generation observed for this ControllerInstallation.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// ProviderStatus contains type-specific status.
 	// +optional
 	ProviderStatus *ProviderConfig `json:"providerStatus,omitempty"`
@@ -70,4 +82,8 @@ const (
 
 	// ControllerInstallationInstalled is a condition type for indicating whether the controller has been installed.
 	ControllerInstallationInstalled ConditionType = "Installed"
+
+	// ControllerInstallationHealthy is a condition type for indicating whether the workloads of the installed controller
+	// are healthy.
+	ControllerInstallationHealthy ConditionType = "Healthy"
 )
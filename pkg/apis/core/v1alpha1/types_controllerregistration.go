@@ -29,6 +29,9 @@ type ControllerRegistration struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	// Spec contains the specification of this registration.
 	Spec ControllerRegistrationSpec `json:"spec,omitempty"`
+	// Status contains the status of this registration.
+	// +optional
+	Status ControllerRegistrationStatus `json:"status,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -51,6 +54,22 @@ type ControllerRegistrationSpec struct {
 	// Deployment contains information for how this controller is deployed.
 	// +optional
 	Deployment *ControllerDeployment `json:"deployment,omitempty"`
+	// Compatibility contains the version ranges supported by the controller.
+	// +optional
+	Compatibility *ControllerCompatibility `json:"compatibility,omitempty"`
+}
+
+// ControllerCompatibility contains the version ranges supported by a controller. The ranges are semantic version
+// constraints, e.g. ">= 0.28, < 0.30".
+type ControllerCompatibility struct {
+	// Gardener is the range of supported Gardener versions. The controller is not deployed by incompatible Gardener
+	// versions.
+	// +optional
+	Gardener *string `json:"gardener,omitempty"`
+	// Kubernetes is the range of supported Kubernetes versions of seed clusters. The controller is not deployed to
+	// seeds with incompatible versions.
+	// +optional
+	Kubernetes *string `json:"kubernetes,omitempty"`
 }
 
 // ControllerResource is a combination of a kind (DNSProvider, Infrastructure, Generic, ...) and the actual type for this
@@ -75,4 +94,93 @@ type ControllerDeployment struct {
 	// ProviderConfig contains type-specific configuration.
 	// +optional
 	ProviderConfig *ProviderConfig `json:"providerConfig,omitempty"`
+	// Policy controls when the controller is deployed to a seed. It defaults to 'Always'.
+	// +optional
+	Policy *ControllerDeploymentPolicy `json:"policy,omitempty"`
+	// SeedSelector contains an optional label selector for seeds. The controller is only deployed to seeds whose
+	// labels match the selector.
+	// +optional
+	SeedSelector *metav1.LabelSelector `json:"seedSelector,omitempty"`
+	// Rollout contains the strategy for rolling out changes of the registration to the seeds. If it is not set then
+	// changes are rolled out to all seeds at once.
+	// +optional
+	Rollout *ControllerRolloutStrategy `json:"rollout,omitempty"`
+}
+
+// ControllerRolloutStrategy contains the strategy for rolling out changes of a registration to the seeds.
+type ControllerRolloutStrategy struct {
+	// MaxParallelSeeds is the maximum number of seeds to which changes are rolled out at the same time. It defaults
+	// to 1.
+	// +optional
+	MaxParallelSeeds *int32 `json:"maxParallelSeeds,omitempty"`
+	// WaitForHealthy specifies whether the rollout to a seed is only finished once the controller is healthy on it.
+	// It defaults to true.
+	// +optional
+	WaitForHealthy *bool `json:"waitForHealthy,omitempty"`
+	// HaltOnFailure specifies whether the rollout is halted if it failed on a seed. It defaults to true.
+	// +optional
+	HaltOnFailure *bool `json:"haltOnFailure,omitempty"`
+}
+
+// ControllerDeploymentPolicy is a string alias.
+type ControllerDeploymentPolicy string
+
+const (
+	// ControllerDeploymentPolicyOnDemand specifies that the controller shall only be deployed to a seed if the seed
+	// or a shoot scheduled to it requires one of the resources of the registration.
+	ControllerDeploymentPolicyOnDemand ControllerDeploymentPolicy = "OnDemand"
+	// ControllerDeploymentPolicyAlways specifies that the controller shall always be deployed to a seed, independent
+	// of whether it is required.
+	ControllerDeploymentPolicyAlways ControllerDeploymentPolicy = "Always"
+)
+
+// ControllerRegistrationStatus is the status of a ControllerRegistration.
+type ControllerRegistrationStatus struct {
+	// ObservedGeneration is the most recent generation observed for this registration.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions represents the latest available observations of a ControllerRegistration's current state.
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+	// Seeds contains the rollout progress of the registration per seed.
+	// +optional
+	Seeds []ControllerRegistrationSeedStatus `json:"seeds,omitempty"`
 }
+
+// ControllerRegistrationSeedStatus contains the rollout progress of a registration on a seed.
+type ControllerRegistrationSeedStatus struct {
+	// Name is the name of the seed.
+	Name string `json:"name"`
+	// State is the rollout state of the current registration specification on the seed.
+	State ControllerRolloutState `json:"state"`
+	// Message contains details about the state.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// LastUpdateTime is the time when the state was updated the last time.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+}
+
+// ControllerRolloutState is a string alias.
+type ControllerRolloutState string
+
+const (
+	// ControllerRolloutStatePending indicates that the registration has not been rolled out to the seed yet.
+	ControllerRolloutStatePending ControllerRolloutState = "Pending"
+	// ControllerRolloutStateProgressing indicates that the registration is being rolled out to the seed.
+	ControllerRolloutStateProgressing ControllerRolloutState = "Progressing"
+	// ControllerRolloutStateSucceeded indicates that the registration has been rolled out to the seed successfully.
+	ControllerRolloutStateSucceeded ControllerRolloutState = "Succeeded"
+	// ControllerRolloutStateFailed indicates that the rollout of the registration to the seed failed.
+	ControllerRolloutStateFailed ControllerRolloutState = "Failed"
+	// ControllerRolloutStateIncompatible indicates that the controller does not support the seed.
+	ControllerRolloutStateIncompatible ControllerRolloutState = "Incompatible"
+)
+
+const (
+	// ControllerRegistrationCompatible is a condition type for indicating whether the controller supports the
+	// version of Gardener.
+	ControllerRegistrationCompatible ConditionType = "Compatible"
+	// ControllerRegistrationRolledOut is a condition type for indicating whether the registration has been rolled out
+	// to all seeds.
+	ControllerRegistrationRolledOut ConditionType = "RolledOut"
+)
@@ -50,6 +50,15 @@ const (
 	PlantEveryNodeReady ConditionType = "EveryNodeReady"
 	// PlantAPIServerAvailable is a constant for a condition type indicating that the Plant cluster API server is available.
 	PlantAPIServerAvailable ConditionType = "APIServerAvailable"
+	// PlantSystemComponentsHealthy is a constant for a condition type indicating the health of the system components
+	// (the workloads in the kube-system namespace) of the Plant cluster.
+	PlantSystemComponentsHealthy ConditionType = "SystemComponentsHealthy"
+	// PlantNodeVersionSkewSupported is a constant for a condition type indicating that the Kubernetes versions of all
+	// nodes are supported by the version of the Plant cluster control plane.
+	PlantNodeVersionSkewSupported ConditionType = "NodeVersionSkewSupported"
+	// PlantEndpointsAvailable is a constant for a condition type indicating that all probed endpoints of the Plant
+	// are available.
+	PlantEndpointsAvailable ConditionType = "EndpointsAvailable"
 )
 
 // PlantSpec is the specification of a Plant.
@@ -73,6 +82,63 @@ type PlantStatus struct {
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
 	// ClusterInfo is additional computed information about the newly added cluster (Plant)
 	ClusterInfo *ClusterInfo `json:"clusterInfo,omitempty"`
+	// Adoption is the report of the latest adoption dry-run of the Plant. It describes how the cluster would be
+	// mapped to a Shoot and which parts of it cannot be adopted.
+	// +optional
+	Adoption *PlantAdoptionReport `json:"adoption,omitempty"`
+}
+
+// PlantAdoptionReport is the result of an adoption dry-run of a Plant.
+type PlantAdoptionReport struct {
+	// LastUpdateTime is the time when the report has been computed.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+	// Adoptable indicates whether the Plant can be adopted as Shoot, i.e. whether no issues have been found.
+	Adoptable bool `json:"adoptable"`
+	// Infrastructure is the infrastructure configuration derived from the Plant cluster.
+	Infrastructure PlantAdoptionInfrastructure `json:"infrastructure"`
+	// Workers is the list of worker pools derived from the nodes of the Plant cluster.
+	// +optional
+	Workers []PlantAdoptionWorker `json:"workers,omitempty"`
+	// Issues is the list of reasons why the Plant cannot be adopted.
+	// +optional
+	Issues []string `json:"issues,omitempty"`
+}
+
+// PlantAdoptionInfrastructure is the infrastructure configuration derived from a Plant cluster.
+type PlantAdoptionInfrastructure struct {
+	// Provider is the type of the infrastructure provider (e.g. aws, gcp).
+	Provider string `json:"provider"`
+	// Region is the region of the infrastructure.
+	Region string `json:"region"`
+	// Zones is the list of availability zones of the nodes.
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+	// KubernetesVersion is the Kubernetes version of the control plane.
+	KubernetesVersion string `json:"kubernetesVersion"`
+}
+
+// PlantAdoptionWorker is a worker pool derived from the nodes of a Plant cluster.
+type PlantAdoptionWorker struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name"`
+	// MachineType is the machine type of the nodes of the worker pool.
+	MachineType string `json:"machineType"`
+	// Zones is the list of availability zones of the nodes of the worker pool.
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+	// Nodes is the number of nodes of the worker pool.
+	Nodes int32 `json:"nodes"`
+	// MachineImage is the machine image the nodes of the worker pool are running.
+	// +optional
+	MachineImage *PlantAdoptionMachineImage `json:"machineImage,omitempty"`
+}
+
+// PlantAdoptionMachineImage is the machine image derived from the operating system of the nodes of a worker pool.
+type PlantAdoptionMachineImage struct {
+	// Name is the logical name of the machine image.
+	Name string `json:"name"`
+	// Version is the version of the machine image.
+	Version string `json:"version"`
 }
 
 // Endpoint is an endpoint for monitoring, logging and other services around the plant.
@@ -83,6 +149,20 @@ type Endpoint struct {
 	URL string `json:"url"`
 	// Purpose is the purpose of the endpoint
 	Purpose string `json:"purpose"`
+	// Probe configures the probing of the endpoint by the Plant health checks. Endpoints without probe
+	// configuration are not probed. Only endpoints resolving to public addresses are probed.
+	// +optional
+	Probe *EndpointProbe `json:"probe,omitempty"`
+}
+
+// EndpointProbe configures the probing of a Plant endpoint.
+type EndpointProbe struct {
+	// ExpectedStatusCodes is the list of HTTP status codes which are considered healthy. Defaults to [200].
+	// +optional
+	ExpectedStatusCodes []int32 `json:"expectedStatusCodes,omitempty"`
+	// Timeout is the timeout of a single probe. Defaults to 10s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
 }
 
 // ClusterInfo contains information about the Plant cluster
@@ -91,6 +171,17 @@ type ClusterInfo struct {
 	Cloud Cloud `json:"cloud"`
 	// Kubernetes describes kubernetes meta information (e.g., version)
 	Kubernetes Kubernetes `json:"kubernetes"`
+	// Inventory contains the number of nodes and pods of the Plant cluster.
+	// +optional
+	Inventory *ClusterInventory `json:"inventory,omitempty"`
+}
+
+// ClusterInventory contains the number of resources in the Plant cluster.
+type ClusterInventory struct {
+	// Nodes is the number of nodes registered to the cluster.
+	Nodes int32 `json:"nodes"`
+	// Pods is the number of non-terminated pods in the cluster.
+	Pods int32 `json:"pods"`
 }
 
 // Cloud contains information about the cloud
@@ -105,4 +196,7 @@ type Cloud struct {
 type Kubernetes struct {
 	// Version is the semantic Kubernetes version to use for the Plant cluster.
 	Version string `json:"version"`
+	// NodeVersions is the list of distinct kubelet versions of the nodes of the Plant cluster.
+	// +optional
+	NodeVersions []string `json:"nodeVersions,omitempty"`
 }
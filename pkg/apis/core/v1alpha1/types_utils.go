@@ -88,6 +88,20 @@ type K8SNetworks struct {
 	Services *CIDR `json:"services,omitempty"`
 }
 
+// DualStackNetworks contains CIDRs of the second IP family for the pod, service and node networks of a
+// dual-stack Kubernetes cluster. Each of them must belong to another IP family than the respective primary network.
+type DualStackNetworks struct {
+	// Nodes is the CIDR of the node network.
+	// +optional
+	Nodes *CIDR `json:"nodes,omitempty"`
+	// Pods is the CIDR of the pod network.
+	// +optional
+	Pods *CIDR `json:"pods,omitempty"`
+	// Services is the CIDR of the service network.
+	// +optional
+	Services *CIDR `json:"services,omitempty"`
+}
+
 const (
 	// DefaultPodNetworkCIDR is a constant for the default pod network CIDR of a Shoot cluster.
 	DefaultPodNetworkCIDR = CIDR("100.96.0.0/11")
@@ -40,6 +40,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ClusterInventory)(nil), (*core.ClusterInventory)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterInventory_To_core_ClusterInventory(a.(*ClusterInventory), b.(*core.ClusterInventory), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ClusterInventory)(nil), (*ClusterInventory)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ClusterInventory_To_v1alpha1_ClusterInventory(a.(*core.ClusterInventory), b.(*ClusterInventory), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Condition)(nil), (*core.Condition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Condition_To_core_Condition(a.(*Condition), b.(*core.Condition), scope)
 	}); err != nil {
@@ -50,6 +60,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControllerCompatibility)(nil), (*core.ControllerCompatibility)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ControllerCompatibility_To_core_ControllerCompatibility(a.(*ControllerCompatibility), b.(*core.ControllerCompatibility), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControllerCompatibility)(nil), (*ControllerCompatibility)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControllerCompatibility_To_v1alpha1_ControllerCompatibility(a.(*core.ControllerCompatibility), b.(*ControllerCompatibility), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerDeployment)(nil), (*core.ControllerDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControllerDeployment_To_core_ControllerDeployment(a.(*ControllerDeployment), b.(*core.ControllerDeployment), scope)
 	}); err != nil {
@@ -120,6 +140,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControllerRegistrationSeedStatus)(nil), (*core.ControllerRegistrationSeedStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ControllerRegistrationSeedStatus_To_core_ControllerRegistrationSeedStatus(a.(*ControllerRegistrationSeedStatus), b.(*core.ControllerRegistrationSeedStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControllerRegistrationSeedStatus)(nil), (*ControllerRegistrationSeedStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControllerRegistrationSeedStatus_To_v1alpha1_ControllerRegistrationSeedStatus(a.(*core.ControllerRegistrationSeedStatus), b.(*ControllerRegistrationSeedStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerRegistrationSpec)(nil), (*core.ControllerRegistrationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControllerRegistrationSpec_To_core_ControllerRegistrationSpec(a.(*ControllerRegistrationSpec), b.(*core.ControllerRegistrationSpec), scope)
 	}); err != nil {
@@ -130,6 +160,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControllerRegistrationStatus)(nil), (*core.ControllerRegistrationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ControllerRegistrationStatus_To_core_ControllerRegistrationStatus(a.(*ControllerRegistrationStatus), b.(*core.ControllerRegistrationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControllerRegistrationStatus)(nil), (*ControllerRegistrationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControllerRegistrationStatus_To_v1alpha1_ControllerRegistrationStatus(a.(*core.ControllerRegistrationStatus), b.(*ControllerRegistrationStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerResource)(nil), (*core.ControllerResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControllerResource_To_core_ControllerResource(a.(*ControllerResource), b.(*core.ControllerResource), scope)
 	}); err != nil {
@@ -140,6 +180,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControllerRolloutStrategy)(nil), (*core.ControllerRolloutStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ControllerRolloutStrategy_To_core_ControllerRolloutStrategy(a.(*ControllerRolloutStrategy), b.(*core.ControllerRolloutStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControllerRolloutStrategy)(nil), (*ControllerRolloutStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControllerRolloutStrategy_To_v1alpha1_ControllerRolloutStrategy(a.(*core.ControllerRolloutStrategy), b.(*ControllerRolloutStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DualStackNetworks)(nil), (*core.DualStackNetworks)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DualStackNetworks_To_core_DualStackNetworks(a.(*DualStackNetworks), b.(*core.DualStackNetworks), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DualStackNetworks)(nil), (*DualStackNetworks)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DualStackNetworks_To_v1alpha1_DualStackNetworks(a.(*core.DualStackNetworks), b.(*DualStackNetworks), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Endpoint)(nil), (*core.Endpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Endpoint_To_core_Endpoint(a.(*Endpoint), b.(*core.Endpoint), scope)
 	}); err != nil {
@@ -150,6 +210,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EndpointProbe)(nil), (*core.EndpointProbe)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EndpointProbe_To_core_EndpointProbe(a.(*EndpointProbe), b.(*core.EndpointProbe), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.EndpointProbe)(nil), (*EndpointProbe)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_EndpointProbe_To_v1alpha1_EndpointProbe(a.(*core.EndpointProbe), b.(*EndpointProbe), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*K8SNetworks)(nil), (*core.K8SNetworks)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_K8SNetworks_To_core_K8SNetworks(a.(*K8SNetworks), b.(*core.K8SNetworks), scope)
 	}); err != nil {
@@ -200,6 +270,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PlantAdoptionInfrastructure)(nil), (*core.PlantAdoptionInfrastructure)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PlantAdoptionInfrastructure_To_core_PlantAdoptionInfrastructure(a.(*PlantAdoptionInfrastructure), b.(*core.PlantAdoptionInfrastructure), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.PlantAdoptionInfrastructure)(nil), (*PlantAdoptionInfrastructure)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_PlantAdoptionInfrastructure_To_v1alpha1_PlantAdoptionInfrastructure(a.(*core.PlantAdoptionInfrastructure), b.(*PlantAdoptionInfrastructure), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PlantAdoptionMachineImage)(nil), (*core.PlantAdoptionMachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PlantAdoptionMachineImage_To_core_PlantAdoptionMachineImage(a.(*PlantAdoptionMachineImage), b.(*core.PlantAdoptionMachineImage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.PlantAdoptionMachineImage)(nil), (*PlantAdoptionMachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_PlantAdoptionMachineImage_To_v1alpha1_PlantAdoptionMachineImage(a.(*core.PlantAdoptionMachineImage), b.(*PlantAdoptionMachineImage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PlantAdoptionReport)(nil), (*core.PlantAdoptionReport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PlantAdoptionReport_To_core_PlantAdoptionReport(a.(*PlantAdoptionReport), b.(*core.PlantAdoptionReport), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.PlantAdoptionReport)(nil), (*PlantAdoptionReport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_PlantAdoptionReport_To_v1alpha1_PlantAdoptionReport(a.(*core.PlantAdoptionReport), b.(*PlantAdoptionReport), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PlantAdoptionWorker)(nil), (*core.PlantAdoptionWorker)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PlantAdoptionWorker_To_core_PlantAdoptionWorker(a.(*PlantAdoptionWorker), b.(*core.PlantAdoptionWorker), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.PlantAdoptionWorker)(nil), (*PlantAdoptionWorker)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_PlantAdoptionWorker_To_v1alpha1_PlantAdoptionWorker(a.(*core.PlantAdoptionWorker), b.(*PlantAdoptionWorker), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PlantList)(nil), (*core.PlantList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_PlantList_To_core_PlantList(a.(*PlantList), b.(*core.PlantList), scope)
 	}); err != nil {
@@ -272,6 +382,7 @@ func autoConvert_v1alpha1_ClusterInfo_To_core_ClusterInfo(in *ClusterInfo, out *
 	if err := Convert_v1alpha1_Kubernetes_To_core_Kubernetes(&in.Kubernetes, &out.Kubernetes, s); err != nil {
 		return err
 	}
+	out.Inventory = (*core.ClusterInventory)(unsafe.Pointer(in.Inventory))
 	return nil
 }
 
@@ -287,6 +398,7 @@ func autoConvert_core_ClusterInfo_To_v1alpha1_ClusterInfo(in *core.ClusterInfo,
 	if err := Convert_core_Kubernetes_To_v1alpha1_Kubernetes(&in.Kubernetes, &out.Kubernetes, s); err != nil {
 		return err
 	}
+	out.Inventory = (*ClusterInventory)(unsafe.Pointer(in.Inventory))
 	return nil
 }
 
@@ -295,6 +407,28 @@ func Convert_core_ClusterInfo_To_v1alpha1_ClusterInfo(in *core.ClusterInfo, out
 	return autoConvert_core_ClusterInfo_To_v1alpha1_ClusterInfo(in, out, s)
 }
 
+func autoConvert_v1alpha1_ClusterInventory_To_core_ClusterInventory(in *ClusterInventory, out *core.ClusterInventory, s conversion.Scope) error {
+	out.Nodes = in.Nodes
+	out.Pods = in.Pods
+	return nil
+}
+
+// Convert_v1alpha1_ClusterInventory_To_core_ClusterInventory is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterInventory_To_core_ClusterInventory(in *ClusterInventory, out *core.ClusterInventory, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterInventory_To_core_ClusterInventory(in, out, s)
+}
+
+func autoConvert_core_ClusterInventory_To_v1alpha1_ClusterInventory(in *core.ClusterInventory, out *ClusterInventory, s conversion.Scope) error {
+	out.Nodes = in.Nodes
+	out.Pods = in.Pods
+	return nil
+}
+
+// Convert_core_ClusterInventory_To_v1alpha1_ClusterInventory is an autogenerated conversion function.
+func Convert_core_ClusterInventory_To_v1alpha1_ClusterInventory(in *core.ClusterInventory, out *ClusterInventory, s conversion.Scope) error {
+	return autoConvert_core_ClusterInventory_To_v1alpha1_ClusterInventory(in, out, s)
+}
+
 func autoConvert_v1alpha1_Condition_To_core_Condition(in *Condition, out *core.Condition, s conversion.Scope) error {
 	out.Type = core.ConditionType(in.Type)
 	out.Status = core.ConditionStatus(in.Status)
@@ -325,9 +459,34 @@ func Convert_core_Condition_To_v1alpha1_Condition(in *core.Condition, out *Condi
 	return autoConvert_core_Condition_To_v1alpha1_Condition(in, out, s)
 }
 
+func autoConvert_v1alpha1_ControllerCompatibility_To_core_ControllerCompatibility(in *ControllerCompatibility, out *core.ControllerCompatibility, s conversion.Scope) error {
+	out.Gardener = (*string)(unsafe.Pointer(in.Gardener))
+	out.Kubernetes = (*string)(unsafe.Pointer(in.Kubernetes))
+	return nil
+}
+
+// Convert_v1alpha1_ControllerCompatibility_To_core_ControllerCompatibility is an autogenerated conversion function.
+func Convert_v1alpha1_ControllerCompatibility_To_core_ControllerCompatibility(in *ControllerCompatibility, out *core.ControllerCompatibility, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControllerCompatibility_To_core_ControllerCompatibility(in, out, s)
+}
+
+func autoConvert_core_ControllerCompatibility_To_v1alpha1_ControllerCompatibility(in *core.ControllerCompatibility, out *ControllerCompatibility, s conversion.Scope) error {
+	out.Gardener = (*string)(unsafe.Pointer(in.Gardener))
+	out.Kubernetes = (*string)(unsafe.Pointer(in.Kubernetes))
+	return nil
+}
+
+// Convert_core_ControllerCompatibility_To_v1alpha1_ControllerCompatibility is an autogenerated conversion function.
+func Convert_core_ControllerCompatibility_To_v1alpha1_ControllerCompatibility(in *core.ControllerCompatibility, out *ControllerCompatibility, s conversion.Scope) error {
+	return autoConvert_core_ControllerCompatibility_To_v1alpha1_ControllerCompatibility(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControllerDeployment_To_core_ControllerDeployment(in *ControllerDeployment, out *core.ControllerDeployment, s conversion.Scope) error {
 	out.Type = in.Type
 	out.ProviderConfig = (*core.ProviderConfig)(unsafe.Pointer(in.ProviderConfig))
+	out.Policy = (*core.ControllerDeploymentPolicy)(unsafe.Pointer(in.Policy))
+	out.SeedSelector = (*v1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	out.Rollout = (*core.ControllerRolloutStrategy)(unsafe.Pointer(in.Rollout))
 	return nil
 }
 
@@ -339,6 +498,9 @@ func Convert_v1alpha1_ControllerDeployment_To_core_ControllerDeployment(in *Cont
 func autoConvert_core_ControllerDeployment_To_v1alpha1_ControllerDeployment(in *core.ControllerDeployment, out *ControllerDeployment, s conversion.Scope) error {
 	out.Type = in.Type
 	out.ProviderConfig = (*ProviderConfig)(unsafe.Pointer(in.ProviderConfig))
+	out.Policy = (*ControllerDeploymentPolicy)(unsafe.Pointer(in.Policy))
+	out.SeedSelector = (*v1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	out.Rollout = (*ControllerRolloutStrategy)(unsafe.Pointer(in.Rollout))
 	return nil
 }
 
@@ -404,6 +566,8 @@ func Convert_core_ControllerInstallationList_To_v1alpha1_ControllerInstallationL
 func autoConvert_v1alpha1_ControllerInstallationSpec_To_core_ControllerInstallationSpec(in *ControllerInstallationSpec, out *core.ControllerInstallationSpec, s conversion.Scope) error {
 	out.RegistrationRef = in.RegistrationRef
 	out.SeedRef = in.SeedRef
+	out.Deployment = (*core.ControllerDeployment)(unsafe.Pointer(in.Deployment))
+	out.Compatibility = (*core.ControllerCompatibility)(unsafe.Pointer(in.Compatibility))
 	return nil
 }
 
@@ -415,6 +579,8 @@ func Convert_v1alpha1_ControllerInstallationSpec_To_core_ControllerInstallationS
 func autoConvert_core_ControllerInstallationSpec_To_v1alpha1_ControllerInstallationSpec(in *core.ControllerInstallationSpec, out *ControllerInstallationSpec, s conversion.Scope) error {
 	out.RegistrationRef = in.RegistrationRef
 	out.SeedRef = in.SeedRef
+	out.Deployment = (*ControllerDeployment)(unsafe.Pointer(in.Deployment))
+	out.Compatibility = (*ControllerCompatibility)(unsafe.Pointer(in.Compatibility))
 	return nil
 }
 
@@ -425,6 +591,7 @@ func Convert_core_ControllerInstallationSpec_To_v1alpha1_ControllerInstallationS
 
 func autoConvert_v1alpha1_ControllerInstallationStatus_To_core_ControllerInstallationStatus(in *ControllerInstallationStatus, out *core.ControllerInstallationStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
+	out.ObservedGeneration = in.ObservedGeneration
 	out.ProviderStatus = (*core.ProviderConfig)(unsafe.Pointer(in.ProviderStatus))
 	return nil
 }
@@ -436,6 +603,7 @@ func Convert_v1alpha1_ControllerInstallationStatus_To_core_ControllerInstallatio
 
 func autoConvert_core_ControllerInstallationStatus_To_v1alpha1_ControllerInstallationStatus(in *core.ControllerInstallationStatus, out *ControllerInstallationStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]Condition)(unsafe.Pointer(&in.Conditions))
+	out.ObservedGeneration = in.ObservedGeneration
 	out.ProviderStatus = (*ProviderConfig)(unsafe.Pointer(in.ProviderStatus))
 	return nil
 }
@@ -450,6 +618,9 @@ func autoConvert_v1alpha1_ControllerRegistration_To_core_ControllerRegistration(
 	if err := Convert_v1alpha1_ControllerRegistrationSpec_To_core_ControllerRegistrationSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_ControllerRegistrationStatus_To_core_ControllerRegistrationStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -463,6 +634,9 @@ func autoConvert_core_ControllerRegistration_To_v1alpha1_ControllerRegistration(
 	if err := Convert_core_ControllerRegistrationSpec_To_v1alpha1_ControllerRegistrationSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_core_ControllerRegistrationStatus_To_v1alpha1_ControllerRegistrationStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -493,9 +667,36 @@ func Convert_core_ControllerRegistrationList_To_v1alpha1_ControllerRegistrationL
 	return autoConvert_core_ControllerRegistrationList_To_v1alpha1_ControllerRegistrationList(in, out, s)
 }
 
+func autoConvert_v1alpha1_ControllerRegistrationSeedStatus_To_core_ControllerRegistrationSeedStatus(in *ControllerRegistrationSeedStatus, out *core.ControllerRegistrationSeedStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.State = core.ControllerRolloutState(in.State)
+	out.Message = in.Message
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_v1alpha1_ControllerRegistrationSeedStatus_To_core_ControllerRegistrationSeedStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ControllerRegistrationSeedStatus_To_core_ControllerRegistrationSeedStatus(in *ControllerRegistrationSeedStatus, out *core.ControllerRegistrationSeedStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControllerRegistrationSeedStatus_To_core_ControllerRegistrationSeedStatus(in, out, s)
+}
+
+func autoConvert_core_ControllerRegistrationSeedStatus_To_v1alpha1_ControllerRegistrationSeedStatus(in *core.ControllerRegistrationSeedStatus, out *ControllerRegistrationSeedStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.State = ControllerRolloutState(in.State)
+	out.Message = in.Message
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_core_ControllerRegistrationSeedStatus_To_v1alpha1_ControllerRegistrationSeedStatus is an autogenerated conversion function.
+func Convert_core_ControllerRegistrationSeedStatus_To_v1alpha1_ControllerRegistrationSeedStatus(in *core.ControllerRegistrationSeedStatus, out *ControllerRegistrationSeedStatus, s conversion.Scope) error {
+	return autoConvert_core_ControllerRegistrationSeedStatus_To_v1alpha1_ControllerRegistrationSeedStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControllerRegistrationSpec_To_core_ControllerRegistrationSpec(in *ControllerRegistrationSpec, out *core.ControllerRegistrationSpec, s conversion.Scope) error {
 	out.Resources = *(*[]core.ControllerResource)(unsafe.Pointer(&in.Resources))
 	out.Deployment = (*core.ControllerDeployment)(unsafe.Pointer(in.Deployment))
+	out.Compatibility = (*core.ControllerCompatibility)(unsafe.Pointer(in.Compatibility))
 	return nil
 }
 
@@ -507,6 +708,7 @@ func Convert_v1alpha1_ControllerRegistrationSpec_To_core_ControllerRegistrationS
 func autoConvert_core_ControllerRegistrationSpec_To_v1alpha1_ControllerRegistrationSpec(in *core.ControllerRegistrationSpec, out *ControllerRegistrationSpec, s conversion.Scope) error {
 	out.Resources = *(*[]ControllerResource)(unsafe.Pointer(&in.Resources))
 	out.Deployment = (*ControllerDeployment)(unsafe.Pointer(in.Deployment))
+	out.Compatibility = (*ControllerCompatibility)(unsafe.Pointer(in.Compatibility))
 	return nil
 }
 
@@ -515,6 +717,30 @@ func Convert_core_ControllerRegistrationSpec_To_v1alpha1_ControllerRegistrationS
 	return autoConvert_core_ControllerRegistrationSpec_To_v1alpha1_ControllerRegistrationSpec(in, out, s)
 }
 
+func autoConvert_v1alpha1_ControllerRegistrationStatus_To_core_ControllerRegistrationStatus(in *ControllerRegistrationStatus, out *core.ControllerRegistrationStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
+	out.Seeds = *(*[]core.ControllerRegistrationSeedStatus)(unsafe.Pointer(&in.Seeds))
+	return nil
+}
+
+// Convert_v1alpha1_ControllerRegistrationStatus_To_core_ControllerRegistrationStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ControllerRegistrationStatus_To_core_ControllerRegistrationStatus(in *ControllerRegistrationStatus, out *core.ControllerRegistrationStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControllerRegistrationStatus_To_core_ControllerRegistrationStatus(in, out, s)
+}
+
+func autoConvert_core_ControllerRegistrationStatus_To_v1alpha1_ControllerRegistrationStatus(in *core.ControllerRegistrationStatus, out *ControllerRegistrationStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Conditions = *(*[]Condition)(unsafe.Pointer(&in.Conditions))
+	out.Seeds = *(*[]ControllerRegistrationSeedStatus)(unsafe.Pointer(&in.Seeds))
+	return nil
+}
+
+// Convert_core_ControllerRegistrationStatus_To_v1alpha1_ControllerRegistrationStatus is an autogenerated conversion function.
+func Convert_core_ControllerRegistrationStatus_To_v1alpha1_ControllerRegistrationStatus(in *core.ControllerRegistrationStatus, out *ControllerRegistrationStatus, s conversion.Scope) error {
+	return autoConvert_core_ControllerRegistrationStatus_To_v1alpha1_ControllerRegistrationStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControllerResource_To_core_ControllerResource(in *ControllerResource, out *core.ControllerResource, s conversion.Scope) error {
 	out.Kind = in.Kind
 	out.Type = in.Type
@@ -541,10 +767,59 @@ func Convert_core_ControllerResource_To_v1alpha1_ControllerResource(in *core.Con
 	return autoConvert_core_ControllerResource_To_v1alpha1_ControllerResource(in, out, s)
 }
 
+func autoConvert_v1alpha1_ControllerRolloutStrategy_To_core_ControllerRolloutStrategy(in *ControllerRolloutStrategy, out *core.ControllerRolloutStrategy, s conversion.Scope) error {
+	out.MaxParallelSeeds = (*int32)(unsafe.Pointer(in.MaxParallelSeeds))
+	out.WaitForHealthy = (*bool)(unsafe.Pointer(in.WaitForHealthy))
+	out.HaltOnFailure = (*bool)(unsafe.Pointer(in.HaltOnFailure))
+	return nil
+}
+
+// Convert_v1alpha1_ControllerRolloutStrategy_To_core_ControllerRolloutStrategy is an autogenerated conversion function.
+func Convert_v1alpha1_ControllerRolloutStrategy_To_core_ControllerRolloutStrategy(in *ControllerRolloutStrategy, out *core.ControllerRolloutStrategy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControllerRolloutStrategy_To_core_ControllerRolloutStrategy(in, out, s)
+}
+
+func autoConvert_core_ControllerRolloutStrategy_To_v1alpha1_ControllerRolloutStrategy(in *core.ControllerRolloutStrategy, out *ControllerRolloutStrategy, s conversion.Scope) error {
+	out.MaxParallelSeeds = (*int32)(unsafe.Pointer(in.MaxParallelSeeds))
+	out.WaitForHealthy = (*bool)(unsafe.Pointer(in.WaitForHealthy))
+	out.HaltOnFailure = (*bool)(unsafe.Pointer(in.HaltOnFailure))
+	return nil
+}
+
+// Convert_core_ControllerRolloutStrategy_To_v1alpha1_ControllerRolloutStrategy is an autogenerated conversion function.
+func Convert_core_ControllerRolloutStrategy_To_v1alpha1_ControllerRolloutStrategy(in *core.ControllerRolloutStrategy, out *ControllerRolloutStrategy, s conversion.Scope) error {
+	return autoConvert_core_ControllerRolloutStrategy_To_v1alpha1_ControllerRolloutStrategy(in, out, s)
+}
+
+func autoConvert_v1alpha1_DualStackNetworks_To_core_DualStackNetworks(in *DualStackNetworks, out *core.DualStackNetworks, s conversion.Scope) error {
+	out.Nodes = (*core.CIDR)(unsafe.Pointer(in.Nodes))
+	out.Pods = (*core.CIDR)(unsafe.Pointer(in.Pods))
+	out.Services = (*core.CIDR)(unsafe.Pointer(in.Services))
+	return nil
+}
+
+// Convert_v1alpha1_DualStackNetworks_To_core_DualStackNetworks is an autogenerated conversion function.
+func Convert_v1alpha1_DualStackNetworks_To_core_DualStackNetworks(in *DualStackNetworks, out *core.DualStackNetworks, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DualStackNetworks_To_core_DualStackNetworks(in, out, s)
+}
+
+func autoConvert_core_DualStackNetworks_To_v1alpha1_DualStackNetworks(in *core.DualStackNetworks, out *DualStackNetworks, s conversion.Scope) error {
+	out.Nodes = (*CIDR)(unsafe.Pointer(in.Nodes))
+	out.Pods = (*CIDR)(unsafe.Pointer(in.Pods))
+	out.Services = (*CIDR)(unsafe.Pointer(in.Services))
+	return nil
+}
+
+// Convert_core_DualStackNetworks_To_v1alpha1_DualStackNetworks is an autogenerated conversion function.
+func Convert_core_DualStackNetworks_To_v1alpha1_DualStackNetworks(in *core.DualStackNetworks, out *DualStackNetworks, s conversion.Scope) error {
+	return autoConvert_core_DualStackNetworks_To_v1alpha1_DualStackNetworks(in, out, s)
+}
+
 func autoConvert_v1alpha1_Endpoint_To_core_Endpoint(in *Endpoint, out *core.Endpoint, s conversion.Scope) error {
 	out.Name = in.Name
 	out.URL = in.URL
 	out.Purpose = in.Purpose
+	out.Probe = (*core.EndpointProbe)(unsafe.Pointer(in.Probe))
 	return nil
 }
 
@@ -557,6 +832,7 @@ func autoConvert_core_Endpoint_To_v1alpha1_Endpoint(in *core.Endpoint, out *Endp
 	out.Name = in.Name
 	out.URL = in.URL
 	out.Purpose = in.Purpose
+	out.Probe = (*EndpointProbe)(unsafe.Pointer(in.Probe))
 	return nil
 }
 
@@ -565,6 +841,28 @@ func Convert_core_Endpoint_To_v1alpha1_Endpoint(in *core.Endpoint, out *Endpoint
 	return autoConvert_core_Endpoint_To_v1alpha1_Endpoint(in, out, s)
 }
 
+func autoConvert_v1alpha1_EndpointProbe_To_core_EndpointProbe(in *EndpointProbe, out *core.EndpointProbe, s conversion.Scope) error {
+	out.ExpectedStatusCodes = *(*[]int32)(unsafe.Pointer(&in.ExpectedStatusCodes))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1alpha1_EndpointProbe_To_core_EndpointProbe is an autogenerated conversion function.
+func Convert_v1alpha1_EndpointProbe_To_core_EndpointProbe(in *EndpointProbe, out *core.EndpointProbe, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EndpointProbe_To_core_EndpointProbe(in, out, s)
+}
+
+func autoConvert_core_EndpointProbe_To_v1alpha1_EndpointProbe(in *core.EndpointProbe, out *EndpointProbe, s conversion.Scope) error {
+	out.ExpectedStatusCodes = *(*[]int32)(unsafe.Pointer(&in.ExpectedStatusCodes))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_core_EndpointProbe_To_v1alpha1_EndpointProbe is an autogenerated conversion function.
+func Convert_core_EndpointProbe_To_v1alpha1_EndpointProbe(in *core.EndpointProbe, out *EndpointProbe, s conversion.Scope) error {
+	return autoConvert_core_EndpointProbe_To_v1alpha1_EndpointProbe(in, out, s)
+}
+
 func autoConvert_v1alpha1_K8SNetworks_To_core_K8SNetworks(in *K8SNetworks, out *core.K8SNetworks, s conversion.Scope) error {
 	out.Nodes = (*core.CIDR)(unsafe.Pointer(in.Nodes))
 	out.Pods = (*core.CIDR)(unsafe.Pointer(in.Pods))
@@ -591,6 +889,7 @@ func Convert_core_K8SNetworks_To_v1alpha1_K8SNetworks(in *core.K8SNetworks, out
 
 func autoConvert_v1alpha1_Kubernetes_To_core_Kubernetes(in *Kubernetes, out *core.Kubernetes, s conversion.Scope) error {
 	out.Version = in.Version
+	out.NodeVersions = *(*[]string)(unsafe.Pointer(&in.NodeVersions))
 	return nil
 }
 
@@ -601,6 +900,7 @@ func Convert_v1alpha1_Kubernetes_To_core_Kubernetes(in *Kubernetes, out *core.Ku
 
 func autoConvert_core_Kubernetes_To_v1alpha1_Kubernetes(in *core.Kubernetes, out *Kubernetes, s conversion.Scope) error {
 	out.Version = in.Version
+	out.NodeVersions = *(*[]string)(unsafe.Pointer(&in.NodeVersions))
 	return nil
 }
 
@@ -693,6 +993,114 @@ func Convert_core_Plant_To_v1alpha1_Plant(in *core.Plant, out *Plant, s conversi
 	return autoConvert_core_Plant_To_v1alpha1_Plant(in, out, s)
 }
 
+func autoConvert_v1alpha1_PlantAdoptionInfrastructure_To_core_PlantAdoptionInfrastructure(in *PlantAdoptionInfrastructure, out *core.PlantAdoptionInfrastructure, s conversion.Scope) error {
+	out.Provider = in.Provider
+	out.Region = in.Region
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.KubernetesVersion = in.KubernetesVersion
+	return nil
+}
+
+// Convert_v1alpha1_PlantAdoptionInfrastructure_To_core_PlantAdoptionInfrastructure is an autogenerated conversion function.
+func Convert_v1alpha1_PlantAdoptionInfrastructure_To_core_PlantAdoptionInfrastructure(in *PlantAdoptionInfrastructure, out *core.PlantAdoptionInfrastructure, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PlantAdoptionInfrastructure_To_core_PlantAdoptionInfrastructure(in, out, s)
+}
+
+func autoConvert_core_PlantAdoptionInfrastructure_To_v1alpha1_PlantAdoptionInfrastructure(in *core.PlantAdoptionInfrastructure, out *PlantAdoptionInfrastructure, s conversion.Scope) error {
+	out.Provider = in.Provider
+	out.Region = in.Region
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.KubernetesVersion = in.KubernetesVersion
+	return nil
+}
+
+// Convert_core_PlantAdoptionInfrastructure_To_v1alpha1_PlantAdoptionInfrastructure is an autogenerated conversion function.
+func Convert_core_PlantAdoptionInfrastructure_To_v1alpha1_PlantAdoptionInfrastructure(in *core.PlantAdoptionInfrastructure, out *PlantAdoptionInfrastructure, s conversion.Scope) error {
+	return autoConvert_core_PlantAdoptionInfrastructure_To_v1alpha1_PlantAdoptionInfrastructure(in, out, s)
+}
+
+func autoConvert_v1alpha1_PlantAdoptionMachineImage_To_core_PlantAdoptionMachineImage(in *PlantAdoptionMachineImage, out *core.PlantAdoptionMachineImage, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_v1alpha1_PlantAdoptionMachineImage_To_core_PlantAdoptionMachineImage is an autogenerated conversion function.
+func Convert_v1alpha1_PlantAdoptionMachineImage_To_core_PlantAdoptionMachineImage(in *PlantAdoptionMachineImage, out *core.PlantAdoptionMachineImage, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PlantAdoptionMachineImage_To_core_PlantAdoptionMachineImage(in, out, s)
+}
+
+func autoConvert_core_PlantAdoptionMachineImage_To_v1alpha1_PlantAdoptionMachineImage(in *core.PlantAdoptionMachineImage, out *PlantAdoptionMachineImage, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_core_PlantAdoptionMachineImage_To_v1alpha1_PlantAdoptionMachineImage is an autogenerated conversion function.
+func Convert_core_PlantAdoptionMachineImage_To_v1alpha1_PlantAdoptionMachineImage(in *core.PlantAdoptionMachineImage, out *PlantAdoptionMachineImage, s conversion.Scope) error {
+	return autoConvert_core_PlantAdoptionMachineImage_To_v1alpha1_PlantAdoptionMachineImage(in, out, s)
+}
+
+func autoConvert_v1alpha1_PlantAdoptionReport_To_core_PlantAdoptionReport(in *PlantAdoptionReport, out *core.PlantAdoptionReport, s conversion.Scope) error {
+	out.LastUpdateTime = in.LastUpdateTime
+	out.Adoptable = in.Adoptable
+	if err := Convert_v1alpha1_PlantAdoptionInfrastructure_To_core_PlantAdoptionInfrastructure(&in.Infrastructure, &out.Infrastructure, s); err != nil {
+		return err
+	}
+	out.Workers = *(*[]core.PlantAdoptionWorker)(unsafe.Pointer(&in.Workers))
+	out.Issues = *(*[]string)(unsafe.Pointer(&in.Issues))
+	return nil
+}
+
+// Convert_v1alpha1_PlantAdoptionReport_To_core_PlantAdoptionReport is an autogenerated conversion function.
+func Convert_v1alpha1_PlantAdoptionReport_To_core_PlantAdoptionReport(in *PlantAdoptionReport, out *core.PlantAdoptionReport, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PlantAdoptionReport_To_core_PlantAdoptionReport(in, out, s)
+}
+
+func autoConvert_core_PlantAdoptionReport_To_v1alpha1_PlantAdoptionReport(in *core.PlantAdoptionReport, out *PlantAdoptionReport, s conversion.Scope) error {
+	out.LastUpdateTime = in.LastUpdateTime
+	out.Adoptable = in.Adoptable
+	if err := Convert_core_PlantAdoptionInfrastructure_To_v1alpha1_PlantAdoptionInfrastructure(&in.Infrastructure, &out.Infrastructure, s); err != nil {
+		return err
+	}
+	out.Workers = *(*[]PlantAdoptionWorker)(unsafe.Pointer(&in.Workers))
+	out.Issues = *(*[]string)(unsafe.Pointer(&in.Issues))
+	return nil
+}
+
+// Convert_core_PlantAdoptionReport_To_v1alpha1_PlantAdoptionReport is an autogenerated conversion function.
+func Convert_core_PlantAdoptionReport_To_v1alpha1_PlantAdoptionReport(in *core.PlantAdoptionReport, out *PlantAdoptionReport, s conversion.Scope) error {
+	return autoConvert_core_PlantAdoptionReport_To_v1alpha1_PlantAdoptionReport(in, out, s)
+}
+
+func autoConvert_v1alpha1_PlantAdoptionWorker_To_core_PlantAdoptionWorker(in *PlantAdoptionWorker, out *core.PlantAdoptionWorker, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MachineType = in.MachineType
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.Nodes = in.Nodes
+	out.MachineImage = (*core.PlantAdoptionMachineImage)(unsafe.Pointer(in.MachineImage))
+	return nil
+}
+
+// Convert_v1alpha1_PlantAdoptionWorker_To_core_PlantAdoptionWorker is an autogenerated conversion function.
+func Convert_v1alpha1_PlantAdoptionWorker_To_core_PlantAdoptionWorker(in *PlantAdoptionWorker, out *core.PlantAdoptionWorker, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PlantAdoptionWorker_To_core_PlantAdoptionWorker(in, out, s)
+}
+
+func autoConvert_core_PlantAdoptionWorker_To_v1alpha1_PlantAdoptionWorker(in *core.PlantAdoptionWorker, out *PlantAdoptionWorker, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MachineType = in.MachineType
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.Nodes = in.Nodes
+	out.MachineImage = (*PlantAdoptionMachineImage)(unsafe.Pointer(in.MachineImage))
+	return nil
+}
+
+// Convert_core_PlantAdoptionWorker_To_v1alpha1_PlantAdoptionWorker is an autogenerated conversion function.
+func Convert_core_PlantAdoptionWorker_To_v1alpha1_PlantAdoptionWorker(in *core.PlantAdoptionWorker, out *PlantAdoptionWorker, s conversion.Scope) error {
+	return autoConvert_core_PlantAdoptionWorker_To_v1alpha1_PlantAdoptionWorker(in, out, s)
+}
+
 func autoConvert_v1alpha1_PlantList_To_core_PlantList(in *PlantList, out *core.PlantList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]core.Plant)(unsafe.Pointer(&in.Items))
@@ -741,6 +1149,7 @@ func autoConvert_v1alpha1_PlantStatus_To_core_PlantStatus(in *PlantStatus, out *
 	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
 	out.ObservedGeneration = (*int64)(unsafe.Pointer(in.ObservedGeneration))
 	out.ClusterInfo = (*core.ClusterInfo)(unsafe.Pointer(in.ClusterInfo))
+	out.Adoption = (*core.PlantAdoptionReport)(unsafe.Pointer(in.Adoption))
 	return nil
 }
 
@@ -753,6 +1162,7 @@ func autoConvert_core_PlantStatus_To_v1alpha1_PlantStatus(in *core.PlantStatus,
 	out.Conditions = *(*[]Condition)(unsafe.Pointer(&in.Conditions))
 	out.ObservedGeneration = (*int64)(unsafe.Pointer(in.ObservedGeneration))
 	out.ClusterInfo = (*ClusterInfo)(unsafe.Pointer(in.ClusterInfo))
+	out.Adoption = (*PlantAdoptionReport)(unsafe.Pointer(in.Adoption))
 	return nil
 }
 
@@ -45,7 +45,12 @@ func (in *Cloud) DeepCopy() *Cloud {
 func (in *ClusterInfo) DeepCopyInto(out *ClusterInfo) {
 	*out = *in
 	out.Cloud = in.Cloud
-	out.Kubernetes = in.Kubernetes
+	in.Kubernetes.DeepCopyInto(&out.Kubernetes)
+	if in.Inventory != nil {
+		in, out := &in.Inventory, &out.Inventory
+		*out = new(ClusterInventory)
+		**out = **in
+	}
 	return
 }
 
@@ -59,6 +64,22 @@ func (in *ClusterInfo) DeepCopy() *ClusterInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterInventory) DeepCopyInto(out *ClusterInventory) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterInventory.
+func (in *ClusterInventory) DeepCopy() *ClusterInventory {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterInventory)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Condition) DeepCopyInto(out *Condition) {
 	*out = *in
@@ -77,6 +98,32 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerCompatibility) DeepCopyInto(out *ControllerCompatibility) {
+	*out = *in
+	if in.Gardener != nil {
+		in, out := &in.Gardener, &out.Gardener
+		*out = new(string)
+		**out = **in
+	}
+	if in.Kubernetes != nil {
+		in, out := &in.Kubernetes, &out.Kubernetes
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerCompatibility.
+func (in *ControllerCompatibility) DeepCopy() *ControllerCompatibility {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerCompatibility)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerDeployment) DeepCopyInto(out *ControllerDeployment) {
 	*out = *in
@@ -85,6 +132,21 @@ func (in *ControllerDeployment) DeepCopyInto(out *ControllerDeployment) {
 		*out = new(ProviderConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(ControllerDeploymentPolicy)
+		**out = **in
+	}
+	if in.SeedSelector != nil {
+		in, out := &in.SeedSelector, &out.SeedSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(ControllerRolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -103,7 +165,7 @@ func (in *ControllerInstallation) DeepCopyInto(out *ControllerInstallation) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -164,6 +226,16 @@ func (in *ControllerInstallationSpec) DeepCopyInto(out *ControllerInstallationSp
 	*out = *in
 	out.RegistrationRef = in.RegistrationRef
 	out.SeedRef = in.SeedRef
+	if in.Deployment != nil {
+		in, out := &in.Deployment, &out.Deployment
+		*out = new(ControllerDeployment)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Compatibility != nil {
+		in, out := &in.Compatibility, &out.Compatibility
+		*out = new(ControllerCompatibility)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -211,6 +283,7 @@ func (in *ControllerRegistration) DeepCopyInto(out *ControllerRegistration) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -265,6 +338,23 @@ func (in *ControllerRegistrationList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerRegistrationSeedStatus) DeepCopyInto(out *ControllerRegistrationSeedStatus) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerRegistrationSeedStatus.
+func (in *ControllerRegistrationSeedStatus) DeepCopy() *ControllerRegistrationSeedStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerRegistrationSeedStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerRegistrationSpec) DeepCopyInto(out *ControllerRegistrationSpec) {
 	*out = *in
@@ -280,6 +370,11 @@ func (in *ControllerRegistrationSpec) DeepCopyInto(out *ControllerRegistrationSp
 		*out = new(ControllerDeployment)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Compatibility != nil {
+		in, out := &in.Compatibility, &out.Compatibility
+		*out = new(ControllerCompatibility)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -293,6 +388,36 @@ func (in *ControllerRegistrationSpec) DeepCopy() *ControllerRegistrationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerRegistrationStatus) DeepCopyInto(out *ControllerRegistrationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Seeds != nil {
+		in, out := &in.Seeds, &out.Seeds
+		*out = make([]ControllerRegistrationSeedStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerRegistrationStatus.
+func (in *ControllerRegistrationStatus) DeepCopy() *ControllerRegistrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerRegistrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerResource) DeepCopyInto(out *ControllerResource) {
 	*out = *in
@@ -319,9 +444,76 @@ func (in *ControllerResource) DeepCopy() *ControllerResource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerRolloutStrategy) DeepCopyInto(out *ControllerRolloutStrategy) {
+	*out = *in
+	if in.MaxParallelSeeds != nil {
+		in, out := &in.MaxParallelSeeds, &out.MaxParallelSeeds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.WaitForHealthy != nil {
+		in, out := &in.WaitForHealthy, &out.WaitForHealthy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HaltOnFailure != nil {
+		in, out := &in.HaltOnFailure, &out.HaltOnFailure
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerRolloutStrategy.
+func (in *ControllerRolloutStrategy) DeepCopy() *ControllerRolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerRolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DualStackNetworks) DeepCopyInto(out *DualStackNetworks) {
+	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = new(CIDR)
+		**out = **in
+	}
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
+		*out = new(CIDR)
+		**out = **in
+	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = new(CIDR)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DualStackNetworks.
+func (in *DualStackNetworks) DeepCopy() *DualStackNetworks {
+	if in == nil {
+		return nil
+	}
+	out := new(DualStackNetworks)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Endpoint) DeepCopyInto(out *Endpoint) {
 	*out = *in
+	if in.Probe != nil {
+		in, out := &in.Probe, &out.Probe
+		*out = new(EndpointProbe)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -335,6 +527,32 @@ func (in *Endpoint) DeepCopy() *Endpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointProbe) DeepCopyInto(out *EndpointProbe) {
+	*out = *in
+	if in.ExpectedStatusCodes != nil {
+		in, out := &in.ExpectedStatusCodes, &out.ExpectedStatusCodes
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointProbe.
+func (in *EndpointProbe) DeepCopy() *EndpointProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *K8SNetworks) DeepCopyInto(out *K8SNetworks) {
 	*out = *in
@@ -369,6 +587,11 @@ func (in *K8SNetworks) DeepCopy() *K8SNetworks {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Kubernetes) DeepCopyInto(out *Kubernetes) {
 	*out = *in
+	if in.NodeVersions != nil {
+		in, out := &in.NodeVersions, &out.NodeVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -452,6 +675,99 @@ func (in *Plant) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlantAdoptionInfrastructure) DeepCopyInto(out *PlantAdoptionInfrastructure) {
+	*out = *in
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlantAdoptionInfrastructure.
+func (in *PlantAdoptionInfrastructure) DeepCopy() *PlantAdoptionInfrastructure {
+	if in == nil {
+		return nil
+	}
+	out := new(PlantAdoptionInfrastructure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlantAdoptionMachineImage) DeepCopyInto(out *PlantAdoptionMachineImage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlantAdoptionMachineImage.
+func (in *PlantAdoptionMachineImage) DeepCopy() *PlantAdoptionMachineImage {
+	if in == nil {
+		return nil
+	}
+	out := new(PlantAdoptionMachineImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlantAdoptionReport) DeepCopyInto(out *PlantAdoptionReport) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	in.Infrastructure.DeepCopyInto(&out.Infrastructure)
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = make([]PlantAdoptionWorker, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Issues != nil {
+		in, out := &in.Issues, &out.Issues
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlantAdoptionReport.
+func (in *PlantAdoptionReport) DeepCopy() *PlantAdoptionReport {
+	if in == nil {
+		return nil
+	}
+	out := new(PlantAdoptionReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlantAdoptionWorker) DeepCopyInto(out *PlantAdoptionWorker) {
+	*out = *in
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MachineImage != nil {
+		in, out := &in.MachineImage, &out.MachineImage
+		*out = new(PlantAdoptionMachineImage)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlantAdoptionWorker.
+func (in *PlantAdoptionWorker) DeepCopy() *PlantAdoptionWorker {
+	if in == nil {
+		return nil
+	}
+	out := new(PlantAdoptionWorker)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PlantList) DeepCopyInto(out *PlantList) {
 	*out = *in
@@ -492,7 +808,9 @@ func (in *PlantSpec) DeepCopyInto(out *PlantSpec) {
 	if in.Endpoints != nil {
 		in, out := &in.Endpoints, &out.Endpoints
 		*out = make([]Endpoint, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -525,7 +843,12 @@ func (in *PlantStatus) DeepCopyInto(out *PlantStatus) {
 	if in.ClusterInfo != nil {
 		in, out := &in.ClusterInfo, &out.ClusterInfo
 		*out = new(ClusterInfo)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Adoption != nil {
+		in, out := &in.Adoption, &out.Adoption
+		*out = new(PlantAdoptionReport)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
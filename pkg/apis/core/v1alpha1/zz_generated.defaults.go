@@ -12,5 +12,41 @@ import (
 // Public to allow building arbitrary schemes.
 // All generated defaulters are covering - they call all nested defaulters.
 func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&ControllerInstallation{}, func(obj interface{}) { SetObjectDefaults_ControllerInstallation(obj.(*ControllerInstallation)) })
+	scheme.AddTypeDefaultingFunc(&ControllerInstallationList{}, func(obj interface{}) { SetObjectDefaults_ControllerInstallationList(obj.(*ControllerInstallationList)) })
+	scheme.AddTypeDefaultingFunc(&ControllerRegistration{}, func(obj interface{}) { SetObjectDefaults_ControllerRegistration(obj.(*ControllerRegistration)) })
+	scheme.AddTypeDefaultingFunc(&ControllerRegistrationList{}, func(obj interface{}) { SetObjectDefaults_ControllerRegistrationList(obj.(*ControllerRegistrationList)) })
 	return nil
 }
+
+func SetObjectDefaults_ControllerInstallation(in *ControllerInstallation) {
+	if in.Spec.Deployment != nil {
+		SetDefaults_ControllerDeployment(in.Spec.Deployment)
+		if in.Spec.Deployment.Rollout != nil {
+			SetDefaults_ControllerRolloutStrategy(in.Spec.Deployment.Rollout)
+		}
+	}
+}
+
+func SetObjectDefaults_ControllerInstallationList(in *ControllerInstallationList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ControllerInstallation(a)
+	}
+}
+
+func SetObjectDefaults_ControllerRegistration(in *ControllerRegistration) {
+	if in.Spec.Deployment != nil {
+		SetDefaults_ControllerDeployment(in.Spec.Deployment)
+		if in.Spec.Deployment.Rollout != nil {
+			SetDefaults_ControllerRolloutStrategy(in.Spec.Deployment.Rollout)
+		}
+	}
+}
+
+func SetObjectDefaults_ControllerRegistrationList(in *ControllerRegistrationList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ControllerRegistration(a)
+	}
+}
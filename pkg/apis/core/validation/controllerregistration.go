@@ -17,10 +17,13 @@ package validation
 import (
 	"fmt"
 
+	"github.com/Masterminds/semver"
 	"github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -62,6 +65,50 @@ func ValidateControllerRegistrationSpec(spec *core.ControllerRegistrationSpec, f
 		resources[resource.Kind] = resource.Type
 	}
 
+	if deployment := spec.Deployment; deployment != nil {
+		allErrs = append(allErrs, validateControllerDeployment(deployment, fldPath.Child("deployment"))...)
+	}
+
+	if compatibility := spec.Compatibility; compatibility != nil {
+		allErrs = append(allErrs, validateVersionConstraint(compatibility.Gardener, fldPath.Child("compatibility", "gardener"))...)
+		allErrs = append(allErrs, validateVersionConstraint(compatibility.Kubernetes, fldPath.Child("compatibility", "kubernetes"))...)
+	}
+
+	return allErrs
+}
+
+func validateVersionConstraint(constraint *string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if constraint == nil {
+		return allErrs
+	}
+
+	if _, err := semver.NewConstraint(*constraint); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, *constraint, fmt.Sprintf("must be a valid version constraint: %v", err)))
+	}
+
+	return allErrs
+}
+
+var availableControllerDeploymentPolicies = sets.NewString(
+	string(core.ControllerDeploymentPolicyOnDemand),
+	string(core.ControllerDeploymentPolicyAlways),
+)
+
+func validateControllerDeployment(deployment *core.ControllerDeployment, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if policy := deployment.Policy; policy != nil && !availableControllerDeploymentPolicies.Has(string(*policy)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("policy"), *policy, availableControllerDeploymentPolicies.List()))
+	}
+	if deployment.SeedSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(deployment.SeedSelector, fldPath.Child("seedSelector"))...)
+	}
+	if rollout := deployment.Rollout; rollout != nil && rollout.MaxParallelSeeds != nil && *rollout.MaxParallelSeeds < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("rollout", "maxParallelSeeds"), *rollout.MaxParallelSeeds, "must be greater than or equal to 1"))
+	}
+
 	return allErrs
 }
 
@@ -86,3 +133,10 @@ func ValidateControllerRegistrationSpecUpdate(new, old *core.ControllerRegistrat
 
 	return allErrs
 }
+
+// ValidateControllerRegistrationStatusUpdate validates the status field of a ControllerRegistration object.
+func ValidateControllerRegistrationStatusUpdate(newStatus, oldStatus core.ControllerRegistrationStatus) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	return allErrs
+}
@@ -120,6 +120,82 @@ var _ = Describe("validation", func() {
 				"Field": Equal("spec.resources[0].globallyEnabled"),
 			}))))
 		})
+
+		It("should allow a valid deployment policy and seed selector", func() {
+			policy := core.ControllerDeploymentPolicyOnDemand
+			controllerRegistration.Spec.Deployment = &core.ControllerDeployment{
+				Type:         "helm",
+				Policy:       &policy,
+				SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"provider": "aws"}},
+			}
+
+			errorList := ValidateControllerRegistration(controllerRegistration)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid an invalid deployment policy and seed selector", func() {
+			policy := core.ControllerDeploymentPolicy("Sometimes")
+			controllerRegistration.Spec.Deployment = &core.ControllerDeployment{
+				Type:   "helm",
+				Policy: &policy,
+				SeedSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+					Key:      "provider",
+					Operator: metav1.LabelSelectorOpIn,
+				}}},
+			}
+
+			errorList := ValidateControllerRegistration(controllerRegistration)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.deployment.policy"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.deployment.seedSelector.matchExpressions[0].values"),
+			}))))
+		})
+
+		It("should allow valid compatibility constraints and a valid rollout strategy", func() {
+			maxParallelSeeds := int32(2)
+			controllerRegistration.Spec.Compatibility = &core.ControllerCompatibility{
+				Gardener:   test.MakeStrPointer(">= 0.28, < 0.30"),
+				Kubernetes: test.MakeStrPointer("~1.15"),
+			}
+			controllerRegistration.Spec.Deployment = &core.ControllerDeployment{
+				Type:    "helm",
+				Rollout: &core.ControllerRolloutStrategy{MaxParallelSeeds: &maxParallelSeeds},
+			}
+
+			errorList := ValidateControllerRegistration(controllerRegistration)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid invalid compatibility constraints and an invalid rollout strategy", func() {
+			maxParallelSeeds := int32(0)
+			controllerRegistration.Spec.Compatibility = &core.ControllerCompatibility{
+				Gardener:   test.MakeStrPointer("foo"),
+				Kubernetes: test.MakeStrPointer("> bar"),
+			}
+			controllerRegistration.Spec.Deployment = &core.ControllerDeployment{
+				Type:    "helm",
+				Rollout: &core.ControllerRolloutStrategy{MaxParallelSeeds: &maxParallelSeeds},
+			}
+
+			errorList := ValidateControllerRegistration(controllerRegistration)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.compatibility.gardener"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.compatibility.kubernetes"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.deployment.rollout.maxParallelSeeds"),
+			}))))
+		})
 	})
 
 	Describe("#ValidateControllerRegistrationUpdate", func() {
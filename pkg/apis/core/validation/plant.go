@@ -15,6 +15,8 @@
 package validation
 
 import (
+	"net/url"
+
 	"github.com/gardener/gardener/pkg/apis/core"
 
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
@@ -51,6 +53,47 @@ func ValidatePlantSpec(spec *core.PlantSpec, fldPath *field.Path) field.ErrorLis
 		allErrs = append(allErrs, field.Required(registrationRefPath.Child("name"), "field is required"))
 	}
 
+	allErrs = append(allErrs, validatePlantEndpoints(spec.Endpoints, fldPath.Child("endpoints"))...)
+
+	return allErrs
+}
+
+func validatePlantEndpoints(endpoints []core.Endpoint, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = make(map[string]bool, len(endpoints))
+	)
+
+	for i, endpoint := range endpoints {
+		idxPath := fldPath.Index(i)
+
+		if len(endpoint.Name) > 0 {
+			if names[endpoint.Name] {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), endpoint.Name))
+			}
+			names[endpoint.Name] = true
+		}
+
+		if probe := endpoint.Probe; probe != nil {
+			probePath := idxPath.Child("probe")
+
+			if len(endpoint.Name) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name for probed endpoints"))
+			}
+			if u, err := url.Parse(endpoint.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("url"), endpoint.URL, "must be a valid http or https URL for probed endpoints"))
+			}
+			for j, statusCode := range probe.ExpectedStatusCodes {
+				if statusCode < 100 || statusCode > 599 {
+					allErrs = append(allErrs, field.Invalid(probePath.Child("expectedStatusCodes").Index(j), statusCode, "must be a valid HTTP status code"))
+				}
+			}
+			if probe.Timeout != nil && probe.Timeout.Duration <= 0 {
+				allErrs = append(allErrs, field.Invalid(probePath.Child("timeout"), probe.Timeout.Duration.String(), "must be positive"))
+			}
+		}
+	}
+
 	return allErrs
 }
 
@@ -15,6 +15,8 @@
 package validation_test
 
 import (
+	"time"
+
 	"github.com/gardener/gardener/pkg/apis/core"
 	"k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -67,6 +69,59 @@ var _ = Describe("validation", func() {
 
 			Expect(errorList).To(BeEmpty())
 		})
+
+		It("should allow valid endpoint probes", func() {
+			plant.Spec.Endpoints = []core.Endpoint{
+				{Name: "dashboard", URL: "https://dashboard.example.com", Purpose: "dashboard"},
+				{
+					Name:    "prometheus",
+					URL:     "https://prometheus.example.com/-/healthy",
+					Purpose: "monitoring",
+					Probe: &core.EndpointProbe{
+						ExpectedStatusCodes: []int32{200, 204},
+						Timeout:             &metav1.Duration{Duration: 5 * time.Second},
+					},
+				},
+			}
+
+			errorList := ValidatePlant(plant)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid invalid endpoint probes", func() {
+			plant.Spec.Endpoints = []core.Endpoint{
+				{Name: "prometheus", URL: "https://prometheus.example.com"},
+				{
+					Name: "prometheus",
+					URL:  "prometheus.example.com",
+					Probe: &core.EndpointProbe{
+						ExpectedStatusCodes: []int32{42},
+						Timeout:             &metav1.Duration{Duration: -time.Second},
+					},
+				},
+				{URL: "https://foo.example.com", Probe: &core.EndpointProbe{}},
+			}
+
+			errorList := ValidatePlant(plant)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.endpoints[1].name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.endpoints[1].url"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.endpoints[1].probe.expectedStatusCodes[0]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.endpoints[1].probe.timeout"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.endpoints[2].name"),
+			}))))
+		})
 	})
 
 	Describe("#ValidPlantUpdate", func() {
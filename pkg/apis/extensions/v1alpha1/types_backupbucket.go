@@ -21,6 +21,9 @@ import (
 
 var _ Object = (*BackupBucket)(nil)
 
+// BackupBucketResource is a constant for the name of the BackupBucket resource.
+const BackupBucketResource = "BackupBucket"
+
 // +genclient
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -71,4 +74,9 @@ type BackupBucketSpec struct {
 type BackupBucketStatus struct {
 	// DefaultStatus is a structure containing common fields used by all extension resources.
 	DefaultStatus `json:",inline"`
+	// GeneratedSecretRef is a reference to a secret that contains credentials for the bucket generated by the
+	// extension controller. If it is set, these credentials are used to access the bucket instead of the ones referenced
+	// in the spec.
+	// +optional
+	GeneratedSecretRef *corev1.SecretReference `json:"generatedSecretRef,omitempty"`
 }
@@ -179,6 +179,38 @@ func SetDefaults_Project(obj *Project) {
 			obj.Spec.Owner.APIGroup = rbacv1.GroupName
 		}
 	}
+
+	migrateDeprecatedProjectMembers(obj)
+}
+
+// migrateDeprecatedProjectMembers moves the subjects of the deprecated `.spec.members` and `.spec.viewers` fields into
+// the project members list with the "admin" and "viewer" role, respectively, and clears the deprecated fields.
+func migrateDeprecatedProjectMembers(obj *Project) {
+	addRole := func(subject rbacv1.Subject, role string) {
+		for i, member := range obj.Spec.ProjectMembers {
+			if member.Subject != subject {
+				continue
+			}
+			for _, r := range member.Roles {
+				if r == role {
+					return
+				}
+			}
+			obj.Spec.ProjectMembers[i].Roles = append(obj.Spec.ProjectMembers[i].Roles, role)
+			return
+		}
+		obj.Spec.ProjectMembers = append(obj.Spec.ProjectMembers, ProjectMember{Subject: subject, Roles: []string{role}})
+	}
+
+	for _, member := range obj.Spec.Members {
+		addRole(member, ProjectMemberAdmin)
+	}
+	for _, viewer := range obj.Spec.Viewers {
+		addRole(viewer, ProjectMemberViewer)
+	}
+
+	obj.Spec.Members = nil
+	obj.Spec.Viewers = nil
 }
 
 // SetDefaults_KubernetesDashboard sets default values for KubernetesDashboard objects.
@@ -21,6 +21,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	rbacv1 "k8s.io/api/rbac/v1"
 )
 
 var _ = Describe("#SetDefaults_Shoot", func() {
@@ -390,3 +391,55 @@ var _ = Describe("#SetDefaults_Shoot", func() {
 
 	})
 })
+
+var _ = Describe("#SetDefaults_Project", func() {
+	var (
+		project *v1beta1.Project
+
+		alice = rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "alice"}
+		bob   = rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "bob"}
+	)
+
+	BeforeEach(func() {
+		project = &v1beta1.Project{}
+	})
+
+	It("should move the deprecated members and viewers into the project members", func() {
+		project.Spec.Members = []rbacv1.Subject{alice}
+		project.Spec.Viewers = []rbacv1.Subject{bob}
+
+		v1beta1.SetDefaults_Project(project)
+
+		Expect(project.Spec.Members).To(BeNil())
+		Expect(project.Spec.Viewers).To(BeNil())
+		Expect(project.Spec.ProjectMembers).To(Equal([]v1beta1.ProjectMember{
+			{Subject: alice, Roles: []string{v1beta1.ProjectMemberAdmin}},
+			{Subject: bob, Roles: []string{v1beta1.ProjectMemberViewer}},
+		}))
+	})
+
+	It("should merge the roles of subjects which are already project members", func() {
+		project.Spec.Members = []rbacv1.Subject{alice}
+		project.Spec.Viewers = []rbacv1.Subject{alice}
+		project.Spec.ProjectMembers = []v1beta1.ProjectMember{
+			{Subject: alice, Roles: []string{v1beta1.ProjectMemberViewer}},
+		}
+
+		v1beta1.SetDefaults_Project(project)
+
+		Expect(project.Spec.Members).To(BeNil())
+		Expect(project.Spec.Viewers).To(BeNil())
+		Expect(project.Spec.ProjectMembers).To(Equal([]v1beta1.ProjectMember{
+			{Subject: alice, Roles: []string{v1beta1.ProjectMemberViewer, v1beta1.ProjectMemberAdmin}},
+		}))
+	})
+
+	It("should not change projects which only use the project members", func() {
+		members := []v1beta1.ProjectMember{{Subject: bob, Roles: []string{v1beta1.ProjectMemberAdmin}}}
+		project.Spec.ProjectMembers = members
+
+		v1beta1.SetDefaults_Project(project)
+
+		Expect(project.Spec.ProjectMembers).To(Equal(members))
+	})
+})
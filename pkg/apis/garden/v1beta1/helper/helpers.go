@@ -23,6 +23,7 @@ import (
 	"strconv"
 
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
@@ -84,6 +85,12 @@ func IsShootHibernated(shoot *gardenv1beta1.Shoot) bool {
 	return shoot.Spec.Hibernation != nil && shoot.Spec.Hibernation.Enabled
 }
 
+// ShootWantsHighAvailableControlPlane checks if the control plane of the given Shoot shall be highly available.
+func ShootWantsHighAvailableControlPlane(shoot *gardenv1beta1.Shoot) bool {
+	controlPlane := shoot.Spec.ControlPlane
+	return controlPlane != nil && controlPlane.HighAvailability != nil && controlPlane.HighAvailability.Enabled
+}
+
 // ShootWantsClusterAutoscaler checks if the given Shoot needs a cluster autoscaler.
 // This is determined by checking whether one of the Shoot workers has a different
 // AutoScalerMax than AutoScalerMin.
@@ -713,3 +720,47 @@ func SetZoneForShoot(shoot *gardenv1beta1.Shoot, cloudProvider gardenv1beta1.Clo
 		shoot.Spec.Cloud.Packet.Zones = zones
 	}
 }
+
+// GetProjectMemberSubjectsByRole returns a map whose keys are the roles of the given project and whose values are the
+// deduplicated subjects having the respective role. The deprecated `.spec.members` and `.spec.viewers` fields are
+// mapped to the "admin" and "viewer" roles, respectively. The service accounts of the project are only considered if
+// the project namespace is already known.
+func GetProjectMemberSubjectsByRole(project *gardenv1beta1.Project) map[string][]rbacv1.Subject {
+	var (
+		subjectsByRole = map[string][]rbacv1.Subject{}
+		known          = map[string]map[rbacv1.Subject]struct{}{}
+	)
+
+	add := func(role string, subject rbacv1.Subject) {
+		if _, ok := known[role]; !ok {
+			known[role] = map[rbacv1.Subject]struct{}{}
+		}
+		if _, ok := known[role][subject]; ok {
+			return
+		}
+		known[role][subject] = struct{}{}
+		subjectsByRole[role] = append(subjectsByRole[role], subject)
+	}
+
+	for _, member := range project.Spec.Members {
+		add(gardenv1beta1.ProjectMemberAdmin, member)
+	}
+	for _, viewer := range project.Spec.Viewers {
+		add(gardenv1beta1.ProjectMemberViewer, viewer)
+	}
+	for _, member := range project.Spec.ProjectMembers {
+		for _, role := range member.Roles {
+			add(role, member.Subject)
+		}
+	}
+	if namespace := project.Spec.Namespace; namespace != nil {
+		for _, serviceAccount := range project.Spec.ServiceAccounts {
+			subject := rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: serviceAccount.Name, Namespace: *namespace}
+			for _, role := range serviceAccount.Roles {
+				add(role, subject)
+			}
+		}
+	}
+
+	return subjectsByRole
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/gardener/gardener/pkg/operation/common"
 
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	. "github.com/onsi/ginkgo"
@@ -679,4 +680,55 @@ var _ = Describe("helper", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+
+	Describe("#GetProjectMemberSubjectsByRole", func() {
+		var (
+			alice = rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "alice.doe@example.com"}
+			bob   = rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "bob.doe@example.com"}
+		)
+
+		It("should return an empty map for projects without members", func() {
+			Expect(GetProjectMemberSubjectsByRole(&gardenv1beta1.Project{})).To(BeEmpty())
+		})
+
+		It("should map the deprecated fields and the project members to roles", func() {
+			project := &gardenv1beta1.Project{
+				Spec: gardenv1beta1.ProjectSpec{
+					Members: []rbacv1.Subject{alice},
+					Viewers: []rbacv1.Subject{bob},
+					ProjectMembers: []gardenv1beta1.ProjectMember{
+						{Subject: alice, Roles: []string{gardenv1beta1.ProjectMemberAdmin, gardenv1beta1.ProjectMemberSecretManager}},
+						{Subject: bob, Roles: []string{gardenv1beta1.ProjectMemberShootOperator, "extension:foo"}},
+					},
+				},
+			}
+
+			Expect(GetProjectMemberSubjectsByRole(project)).To(Equal(map[string][]rbacv1.Subject{
+				gardenv1beta1.ProjectMemberAdmin:         {alice},
+				gardenv1beta1.ProjectMemberViewer:        {bob},
+				gardenv1beta1.ProjectMemberSecretManager: {alice},
+				gardenv1beta1.ProjectMemberShootOperator: {bob},
+				"extension:foo":                          {bob},
+			}))
+		})
+
+		It("should consider the service accounts if the namespace is known", func() {
+			namespace := "garden-dev"
+			project := &gardenv1beta1.Project{
+				Spec: gardenv1beta1.ProjectSpec{
+					ServiceAccounts: []gardenv1beta1.ProjectServiceAccount{
+						{Name: "ci", Roles: []string{gardenv1beta1.ProjectMemberShootOperator}},
+					},
+				},
+			}
+
+			Expect(GetProjectMemberSubjectsByRole(project)).To(BeEmpty())
+
+			project.Spec.Namespace = &namespace
+
+			Expect(GetProjectMemberSubjectsByRole(project)).To(Equal(map[string][]rbacv1.Subject{
+				gardenv1beta1.ProjectMemberShootOperator: {{Kind: rbacv1.ServiceAccountKind, Name: "ci", Namespace: namespace}},
+			}))
+		})
+	})
 })
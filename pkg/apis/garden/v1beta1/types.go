@@ -379,7 +379,9 @@ type ProjectSpec struct {
 	// +optional
 	Purpose *string `json:"purpose,omitempty"`
 	// Members is a list of subjects representing a user name, an email address, or any other identifier of a user
-	// that should be part of this project with full permissions to manage it.
+	// that should be part of this project with full permissions to manage it. The subjects are moved into
+	// ProjectMembers with the "admin" role by the API server.
+	// Deprecated: Use ProjectMembers instead.
 	// +optional
 	Members []rbacv1.Subject `json:"members,omitempty"`
 	// Namespace is the name of the namespace that has been created for the Project object.
@@ -387,9 +389,70 @@ type ProjectSpec struct {
 	// +optional
 	Namespace *string `json:"namespace,omitempty"`
 	// Viewers is a list of subjects representing a user name, an email address, or any other identifier of a user
-	// that should be part of this project with limited permissions to only view some resources.
+	// that should be part of this project with limited permissions to only view some resources. The subjects are
+	// moved into ProjectMembers with the "viewer" role by the API server.
+	// Deprecated: Use ProjectMembers instead.
 	// +optional
 	Viewers []rbacv1.Subject `json:"viewers,omitempty"`
+	// ProjectMembers is a list of subjects together with the roles they have in this project.
+	// +optional
+	ProjectMembers []ProjectMember `json:"projectMembers,omitempty"`
+	// ServiceAccounts is a list of service accounts for automation (e.g., CI pipelines) in the project namespace. For
+	// every service account, a ServiceAccount bound to the given project roles and a secret containing a kubeconfig
+	// with a regularly rotated token are created.
+	// +optional
+	ServiceAccounts []ProjectServiceAccount `json:"serviceAccounts,omitempty"`
+	// Policy contains restrictions for the shoots of the project which are enforced during admission.
+	// +optional
+	Policy *ProjectPolicy `json:"policy,omitempty"`
+}
+
+// ProjectMember is a subject together with the roles it has in a project.
+type ProjectMember struct {
+	// Subject is representing a user name, an email address, or any other identifier of a user, group, or service
+	// account that is a member of the project.
+	rbacv1.Subject `json:",inline"`
+	// Roles is a list of roles of this member. Besides the predefined roles "admin", "shoot-operator",
+	// "secret-manager", and "viewer", custom roles prefixed with "extension:" can be used. The permissions of a custom
+	// role are the aggregation of all ClusterRoles labelled with "garden.sapcloud.io/project-extension-role: <name>".
+	Roles []string `json:"roles"`
+}
+
+// ProjectServiceAccount is a service account for automation in the project namespace.
+type ProjectServiceAccount struct {
+	// Name is the name of the ServiceAccount in the project namespace.
+	Name string `json:"name"`
+	// Roles is a list of project roles of the service account. The same roles as for project members can be used.
+	Roles []string `json:"roles"`
+}
+
+// ProjectPolicy contains restrictions for the shoots of a project. Unset fields do not restrict the shoots.
+type ProjectPolicy struct {
+	// MaxShoots is the maximum number of shoots in the project.
+	// +optional
+	MaxShoots *int32 `json:"maxShoots,omitempty"`
+	// AllowedCloudProfiles is a list of names of cloud profiles the shoots may use.
+	// +optional
+	AllowedCloudProfiles []string `json:"allowedCloudProfiles,omitempty"`
+	// AllowedRegions is a list of regions the shoots may use.
+	// +optional
+	AllowedRegions []string `json:"allowedRegions,omitempty"`
+	// AllowedSeeds is a list of names of seeds the shoots may be scheduled to.
+	// +optional
+	AllowedSeeds []string `json:"allowedSeeds,omitempty"`
+	// AllowedKubernetesVersions is a semantic version constraint (e.g., ">= 1.14, < 1.16") which the Kubernetes
+	// versions of the shoots must meet.
+	// +optional
+	AllowedKubernetesVersions *string `json:"allowedKubernetesVersions,omitempty"`
+	// RequiredLabels is a list of label keys which the shoots must have.
+	// +optional
+	RequiredLabels []string `json:"requiredLabels,omitempty"`
+	// RequiredAnnotations is a list of annotation keys which the shoots must have.
+	// +optional
+	RequiredAnnotations []string `json:"requiredAnnotations,omitempty"`
+	// MaxWorkerPoolSize is the maximum number of machines of a worker pool of the shoots (maximum of the autoscaler).
+	// +optional
+	MaxWorkerPoolSize *int32 `json:"maxWorkerPoolSize,omitempty"`
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -399,11 +462,54 @@ type ProjectStatus struct {
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Phase is the current phase of the project.
 	Phase ProjectPhase `json:"phase,omitempty"`
+	// Conditions represents the latest available observations of a Project's current state.
+	// +optional
+	Conditions []gardencorev1alpha1.Condition `json:"conditions,omitempty"`
+	// LastActivityTimestamp is the time of the last activity in the project, i.e., the last operation of one of its
+	// shoots or the last change of its specification (e.g., of its members).
+	// +optional
+	LastActivityTimestamp *metav1.Time `json:"lastActivityTimestamp,omitempty"`
+	// StaleSinceTimestamp is the time since when the project is considered to be stale, i.e., since when there was
+	// no activity in the project for the configured stale period. It is unset if the project is not stale.
+	// +optional
+	StaleSinceTimestamp *metav1.Time `json:"staleSinceTimestamp,omitempty"`
+	// StaleAutoDeleteTimestamp is the time after which the project will be deleted automatically if it is still
+	// stale and does not contain any shoots, plants, or backup infrastructures anymore.
+	// +optional
+	StaleAutoDeleteTimestamp *metav1.Time `json:"staleAutoDeleteTimestamp,omitempty"`
+	// ServiceAccounts contains the most recently observed status of the service accounts of the project.
+	// +optional
+	ServiceAccounts []ProjectServiceAccountStatus `json:"serviceAccounts,omitempty"`
+}
+
+// ProjectServiceAccountStatus is the status of a service account of a project.
+type ProjectServiceAccountStatus struct {
+	// Name is the name of the ServiceAccount in the project namespace.
+	Name string `json:"name"`
+	// KubeconfigSecretName is the name of the secret in the project namespace containing the kubeconfig of the
+	// service account (data key "kubeconfig").
+	KubeconfigSecretName string `json:"kubeconfigSecretName"`
+	// LastTokenRotationTime is the time when the token of the service account has been issued.
+	// +optional
+	LastTokenRotationTime *metav1.Time `json:"lastTokenRotationTime,omitempty"`
 }
 
 // ProjectPhase is a label for the condition of a project at the current time.
 type ProjectPhase string
 
+const (
+	// ProjectMemberAdmin is the role of project members with full permissions to manage the project.
+	ProjectMemberAdmin = "admin"
+	// ProjectMemberShootOperator is the role of project members who can manage shoots but cannot access secrets.
+	ProjectMemberShootOperator = "shoot-operator"
+	// ProjectMemberSecretManager is the role of project members who can manage secrets and secret bindings.
+	ProjectMemberSecretManager = "secret-manager"
+	// ProjectMemberViewer is the role of project members who can only view some resources.
+	ProjectMemberViewer = "viewer"
+	// ProjectMemberExtensionPrefix is the prefix of custom project member roles defined by extensions.
+	ProjectMemberExtensionPrefix = "extension:"
+)
+
 const (
 	// ProjectPending indicates that the project reconciliation is pending.
 	ProjectPending ProjectPhase = "Pending"
@@ -504,6 +610,9 @@ type SeedNetworks struct {
 	Pods gardencorev1alpha1.CIDR `json:"pods"`
 	// Services is the CIDR of the service network.
 	Services gardencorev1alpha1.CIDR `json:"services"`
+	// DualStack contains the CIDRs of the networks of the second IP family if the seed cluster is dual-stack.
+	// +optional
+	DualStack *gardencorev1alpha1.DualStackNetworks `json:"dualStack,omitempty"`
 }
 
 ////////////////////////////////////////////////////
@@ -630,6 +739,9 @@ type ShootSpec struct {
 	Backup *Backup `json:"backup,omitempty"`
 	// Cloud contains information about the cloud environment and their specific settings.
 	Cloud Cloud `json:"cloud"`
+	// ControlPlane contains settings that apply to the control plane components of the Shoot.
+	// +optional
+	ControlPlane *ControlPlane `json:"controlPlane,omitempty"`
 	// DNS contains information about the DNS settings of the Shoot.
 	DNS DNS `json:"dns"`
 	// Extensions contain type and provider information for Shoot extensions.
@@ -652,6 +764,13 @@ type ShootStatus struct {
 	// Conditions represents the latest available observations of a Shoots's current state.
 	// +optional
 	Conditions []gardencorev1alpha1.Condition `json:"conditions,omitempty"`
+	// ConditionHistory contains the most recent transitions of the Shoot's conditions. It is bounded in size and
+	// only covers the configured availability window.
+	// +optional
+	ConditionHistory []ConditionTransition `json:"conditionHistory,omitempty"`
+	// Availability contains the availability of the Shoot per condition type computed from the condition history.
+	// +optional
+	Availability []ConditionAvailability `json:"availability,omitempty"`
 	// Gardener holds information about the Gardener which last acted on the Shoot.
 	Gardener Gardener `json:"gardener"`
 	// LastOperation holds information about the last operation on the Shoot.
@@ -679,6 +798,32 @@ type ShootStatus struct {
 	UID types.UID `json:"uid"`
 }
 
+// ConditionTransition is a transition of a condition of a Shoot to a new status.
+type ConditionTransition struct {
+	// Type is the type of the condition.
+	Type gardencorev1alpha1.ConditionType `json:"type"`
+	// Status is the new status of the condition.
+	Status gardencorev1alpha1.ConditionStatus `json:"status"`
+	// Reason is the reason of the transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Time is the point in time of the transition.
+	Time metav1.Time `json:"time"`
+}
+
+// ConditionAvailability is the availability of a condition of a Shoot within a time window. A condition is
+// considered unavailable while its status is False or Unknown.
+type ConditionAvailability struct {
+	// Type is the type of the condition.
+	Type gardencorev1alpha1.ConditionType `json:"type"`
+	// Window is the time window the availability is computed for.
+	Window metav1.Duration `json:"window"`
+	// Downtime is the total time the condition was unavailable within the window.
+	Downtime metav1.Duration `json:"downtime"`
+	// Percentage is the availability of the condition within the window in percent (e.g. "99.95").
+	Percentage string `json:"percentage"`
+}
+
 ///////////////////////////////
 // Shoot Specification Types //
 ///////////////////////////////
@@ -1120,6 +1265,42 @@ type Backup struct {
 	Maximum int `json:"maximum"`
 }
 
+// ControlPlane contains settings that apply to the control plane components of the Shoot.
+type ControlPlane struct {
+	// HighAvailability configures the control plane components to tolerate the failure of a single seed zone.
+	// +optional
+	HighAvailability *HighAvailability `json:"highAvailability,omitempty"`
+	// AdditionalEgress is a list of destinations outside of the seed cluster that the shoot's kube-apiserver is
+	// allowed to reach in addition to the default ones, e.g. an OIDC issuer or admission webhooks in a private network.
+	// +optional
+	AdditionalEgress []EgressDestination `json:"additionalEgress,omitempty"`
+}
+
+// EgressDestination describes a destination which the control plane of a shoot may reach.
+type EgressDestination struct {
+	// Name is a unique name for this destination.
+	Name string `json:"name"`
+	// CIDRs is a list of network addresses of the destination.
+	// +optional
+	CIDRs []gardencorev1alpha1.CIDR `json:"cidrs,omitempty"`
+	// FQDNs is a list of fully qualified domain names of the destination. They are resolved to IP addresses
+	// whenever the shoot is reconciled.
+	// +optional
+	FQDNs []string `json:"fqdns,omitempty"`
+	// Ports is a list of TCP ports of the destination. If empty, all ports are allowed.
+	// +optional
+	Ports []int32 `json:"ports,omitempty"`
+}
+
+// HighAvailability contains information whether the control plane is highly available.
+type HighAvailability struct {
+	// Enabled is true if the control plane runs with multiple replicas spread across the seed zones. The etcd
+	// clusters are deployed with three members and the kube-apiserver, kube-controller-manager and kube-scheduler
+	// are deployed with three (leader-elected) replicas. The etcd members never share a zone, hence, the seed must
+	// have nodes in at least three zones. This setting cannot be changed after creation.
+	Enabled bool `json:"enabled"`
+}
+
 // DNS holds information about the provider, the hosted zone id and the domain.
 type DNS struct {
 	// Provider is the DNS provider type for the Shoot.
@@ -1138,6 +1319,31 @@ type DNS struct {
 	// this behavior, i.e. forcing the Gardener to only look into the given secret.
 	// +optional
 	SecretName *string `json:"secretName,omitempty"`
+	// Providers is a list of additional DNS providers. Gardener registers them for the Shoot and deploys a DNS
+	// controller which creates DNS records in their domains for annotated Services and Ingresses of the Shoot cluster.
+	// +optional
+	Providers []DNSProvider `json:"providers,omitempty"`
+}
+
+// DNSProvider contains information about an additional DNS provider of a Shoot.
+type DNSProvider struct {
+	// Type is the DNS provider type (e.g., aws-route53, google-clouddns, ...).
+	Type string `json:"type"`
+	// SecretName is the name of a secret in the namespace of the Shoot containing the credentials for the provider.
+	SecretName string `json:"secretName"`
+	// Domains contains information about which domains shall be included/excluded for this provider.
+	// +optional
+	Domains *DNSIncludeExclude `json:"domains,omitempty"`
+}
+
+// DNSIncludeExclude contains information about which domains shall be included/excluded.
+type DNSIncludeExclude struct {
+	// Include is a list of domains that shall be included.
+	// +optional
+	Include []string `json:"include,omitempty"`
+	// Exclude is a list of domains that shall be excluded.
+	// +optional
+	Exclude []string `json:"exclude,omitempty"`
 }
 
 // DNSUnmanaged is a constant for the 'unmanaged' DNS provider.
@@ -1530,6 +1736,10 @@ const (
 	ProjectEventNamespaceDeletionFailed = "NamespaceDeletionFailed"
 	// ProjectEventNamespaceMarkedForDeletion indicates that the namespace has been successfully marked for deletion.
 	ProjectEventNamespaceMarkedForDeletion = "NamespaceMarkedForDeletion"
+	// ProjectEventStale indicates that the project has been marked as stale.
+	ProjectEventStale = "ProjectStale"
+	// ProjectEventStaleAutoDeletion indicates that the stale project has been deleted automatically.
+	ProjectEventStaleAutoDeletion = "ProjectStaleAutoDeletion"
 
 	// ShootEventSchedulingSuccessful
 	ShootEventSchedulingSuccessful = "SchedulingSuccessful"
@@ -1554,6 +1764,10 @@ const (
 	// SeedAvailable is a constant for a condition type indicating the Seed cluster availability.
 	SeedAvailable gardencorev1alpha1.ConditionType = "Available"
 
+	// ProjectStale is a constant for a condition type indicating that there was no activity in a project for a
+	// longer period.
+	ProjectStale gardencorev1alpha1.ConditionType = "Stale"
+
 	// ShootControlPlaneHealthy is a constant for a condition type indicating the control plane health.
 	ShootControlPlaneHealthy gardencorev1alpha1.ConditionType = "ControlPlaneHealthy"
 	// ShootEveryNodeReady is a constant for a condition type indicating the node health.
@@ -1564,6 +1778,10 @@ const (
 	ShootAlertsInactive gardencorev1alpha1.ConditionType = "AlertsInactive"
 	// ShootAPIServerAvailable is a constant for a condition type indicating that the Shoot clusters API server is available.
 	ShootAPIServerAvailable gardencorev1alpha1.ConditionType = "APIServerAvailable"
+
+	// BackupInfrastructureBackupRestorable is a constant for a condition type indicating whether the latest backup
+	// could be restored and passed the consistency checks.
+	BackupInfrastructureBackupRestorable gardencorev1alpha1.ConditionType = "BackupRestorable"
 )
 
 ////////////////////////////////////////////////////
@@ -1618,4 +1836,7 @@ type BackupInfrastructureStatus struct {
 	// BackupInfrastructure's generation, which is updated on mutation by the API Server.
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions represents the latest available observations of a BackupInfrastructure's current state.
+	// +optional
+	Conditions []gardencorev1alpha1.Condition `json:"conditions,omitempty"`
 }
@@ -415,6 +415,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ConditionAvailability)(nil), (*garden.ConditionAvailability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ConditionAvailability_To_garden_ConditionAvailability(a.(*ConditionAvailability), b.(*garden.ConditionAvailability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ConditionAvailability)(nil), (*ConditionAvailability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ConditionAvailability_To_v1beta1_ConditionAvailability(a.(*garden.ConditionAvailability), b.(*ConditionAvailability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ConditionTransition)(nil), (*garden.ConditionTransition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ConditionTransition_To_garden_ConditionTransition(a.(*ConditionTransition), b.(*garden.ConditionTransition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ConditionTransition)(nil), (*ConditionTransition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ConditionTransition_To_v1beta1_ConditionTransition(a.(*garden.ConditionTransition), b.(*ConditionTransition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ControlPlane)(nil), (*garden.ControlPlane)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ControlPlane_To_garden_ControlPlane(a.(*ControlPlane), b.(*garden.ControlPlane), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ControlPlane)(nil), (*ControlPlane)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ControlPlane_To_v1beta1_ControlPlane(a.(*garden.ControlPlane), b.(*ControlPlane), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DNS)(nil), (*garden.DNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_DNS_To_garden_DNS(a.(*DNS), b.(*garden.DNS), scope)
 	}); err != nil {
@@ -425,6 +455,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DNSIncludeExclude)(nil), (*garden.DNSIncludeExclude)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DNSIncludeExclude_To_garden_DNSIncludeExclude(a.(*DNSIncludeExclude), b.(*garden.DNSIncludeExclude), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.DNSIncludeExclude)(nil), (*DNSIncludeExclude)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_DNSIncludeExclude_To_v1beta1_DNSIncludeExclude(a.(*garden.DNSIncludeExclude), b.(*DNSIncludeExclude), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSProvider)(nil), (*garden.DNSProvider)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DNSProvider_To_garden_DNSProvider(a.(*DNSProvider), b.(*garden.DNSProvider), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.DNSProvider)(nil), (*DNSProvider)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_DNSProvider_To_v1beta1_DNSProvider(a.(*garden.DNSProvider), b.(*DNSProvider), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DNSProviderConstraint)(nil), (*garden.DNSProviderConstraint)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_DNSProviderConstraint_To_garden_DNSProviderConstraint(a.(*DNSProviderConstraint), b.(*garden.DNSProviderConstraint), scope)
 	}); err != nil {
@@ -435,6 +485,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EgressDestination)(nil), (*garden.EgressDestination)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_EgressDestination_To_garden_EgressDestination(a.(*EgressDestination), b.(*garden.EgressDestination), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.EgressDestination)(nil), (*EgressDestination)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_EgressDestination_To_v1beta1_EgressDestination(a.(*garden.EgressDestination), b.(*EgressDestination), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Extension)(nil), (*garden.Extension)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Extension_To_garden_Extension(a.(*Extension), b.(*garden.Extension), scope)
 	}); err != nil {
@@ -555,6 +615,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HighAvailability)(nil), (*garden.HighAvailability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_HighAvailability_To_garden_HighAvailability(a.(*HighAvailability), b.(*garden.HighAvailability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.HighAvailability)(nil), (*HighAvailability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_HighAvailability_To_v1beta1_HighAvailability(a.(*garden.HighAvailability), b.(*HighAvailability), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HorizontalPodAutoscalerConfig)(nil), (*garden.HorizontalPodAutoscalerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_HorizontalPodAutoscalerConfig_To_garden_HorizontalPodAutoscalerConfig(a.(*HorizontalPodAutoscalerConfig), b.(*garden.HorizontalPodAutoscalerConfig), scope)
 	}); err != nil {
@@ -925,6 +995,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectMember)(nil), (*garden.ProjectMember)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectMember_To_garden_ProjectMember(a.(*ProjectMember), b.(*garden.ProjectMember), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ProjectMember)(nil), (*ProjectMember)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ProjectMember_To_v1beta1_ProjectMember(a.(*garden.ProjectMember), b.(*ProjectMember), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectPolicy)(nil), (*garden.ProjectPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectPolicy_To_garden_ProjectPolicy(a.(*ProjectPolicy), b.(*garden.ProjectPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ProjectPolicy)(nil), (*ProjectPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ProjectPolicy_To_v1beta1_ProjectPolicy(a.(*garden.ProjectPolicy), b.(*ProjectPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectServiceAccount)(nil), (*garden.ProjectServiceAccount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectServiceAccount_To_garden_ProjectServiceAccount(a.(*ProjectServiceAccount), b.(*garden.ProjectServiceAccount), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ProjectServiceAccount)(nil), (*ProjectServiceAccount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ProjectServiceAccount_To_v1beta1_ProjectServiceAccount(a.(*garden.ProjectServiceAccount), b.(*ProjectServiceAccount), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectServiceAccountStatus)(nil), (*garden.ProjectServiceAccountStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectServiceAccountStatus_To_garden_ProjectServiceAccountStatus(a.(*ProjectServiceAccountStatus), b.(*garden.ProjectServiceAccountStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ProjectServiceAccountStatus)(nil), (*ProjectServiceAccountStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ProjectServiceAccountStatus_To_v1beta1_ProjectServiceAccountStatus(a.(*garden.ProjectServiceAccountStatus), b.(*ProjectServiceAccountStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectSpec)(nil), (*garden.ProjectSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ProjectSpec_To_garden_ProjectSpec(a.(*ProjectSpec), b.(*garden.ProjectSpec), scope)
 	}); err != nil {
@@ -2076,6 +2186,7 @@ func autoConvert_v1beta1_BackupInfrastructureStatus_To_garden_BackupInfrastructu
 	if err := metav1.Convert_int64_To_Pointer_int64(&in.ObservedGeneration, &out.ObservedGeneration, s); err != nil {
 		return err
 	}
+	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -2090,6 +2201,7 @@ func autoConvert_garden_BackupInfrastructureStatus_To_v1beta1_BackupInfrastructu
 	if err := metav1.Convert_Pointer_int64_To_int64(&in.ObservedGeneration, &out.ObservedGeneration, s); err != nil {
 		return err
 	}
+	out.Conditions = *(*[]v1alpha1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -2366,11 +2478,86 @@ func Convert_garden_ClusterAutoscaler_To_v1beta1_ClusterAutoscaler(in *garden.Cl
 	return autoConvert_garden_ClusterAutoscaler_To_v1beta1_ClusterAutoscaler(in, out, s)
 }
 
+func autoConvert_v1beta1_ConditionAvailability_To_garden_ConditionAvailability(in *ConditionAvailability, out *garden.ConditionAvailability, s conversion.Scope) error {
+	out.Type = core.ConditionType(in.Type)
+	out.Window = in.Window
+	out.Downtime = in.Downtime
+	out.Percentage = in.Percentage
+	return nil
+}
+
+// Convert_v1beta1_ConditionAvailability_To_garden_ConditionAvailability is an autogenerated conversion function.
+func Convert_v1beta1_ConditionAvailability_To_garden_ConditionAvailability(in *ConditionAvailability, out *garden.ConditionAvailability, s conversion.Scope) error {
+	return autoConvert_v1beta1_ConditionAvailability_To_garden_ConditionAvailability(in, out, s)
+}
+
+func autoConvert_garden_ConditionAvailability_To_v1beta1_ConditionAvailability(in *garden.ConditionAvailability, out *ConditionAvailability, s conversion.Scope) error {
+	out.Type = v1alpha1.ConditionType(in.Type)
+	out.Window = in.Window
+	out.Downtime = in.Downtime
+	out.Percentage = in.Percentage
+	return nil
+}
+
+// Convert_garden_ConditionAvailability_To_v1beta1_ConditionAvailability is an autogenerated conversion function.
+func Convert_garden_ConditionAvailability_To_v1beta1_ConditionAvailability(in *garden.ConditionAvailability, out *ConditionAvailability, s conversion.Scope) error {
+	return autoConvert_garden_ConditionAvailability_To_v1beta1_ConditionAvailability(in, out, s)
+}
+
+func autoConvert_v1beta1_ConditionTransition_To_garden_ConditionTransition(in *ConditionTransition, out *garden.ConditionTransition, s conversion.Scope) error {
+	out.Type = core.ConditionType(in.Type)
+	out.Status = core.ConditionStatus(in.Status)
+	out.Reason = in.Reason
+	out.Time = in.Time
+	return nil
+}
+
+// Convert_v1beta1_ConditionTransition_To_garden_ConditionTransition is an autogenerated conversion function.
+func Convert_v1beta1_ConditionTransition_To_garden_ConditionTransition(in *ConditionTransition, out *garden.ConditionTransition, s conversion.Scope) error {
+	return autoConvert_v1beta1_ConditionTransition_To_garden_ConditionTransition(in, out, s)
+}
+
+func autoConvert_garden_ConditionTransition_To_v1beta1_ConditionTransition(in *garden.ConditionTransition, out *ConditionTransition, s conversion.Scope) error {
+	out.Type = v1alpha1.ConditionType(in.Type)
+	out.Status = v1alpha1.ConditionStatus(in.Status)
+	out.Reason = in.Reason
+	out.Time = in.Time
+	return nil
+}
+
+// Convert_garden_ConditionTransition_To_v1beta1_ConditionTransition is an autogenerated conversion function.
+func Convert_garden_ConditionTransition_To_v1beta1_ConditionTransition(in *garden.ConditionTransition, out *ConditionTransition, s conversion.Scope) error {
+	return autoConvert_garden_ConditionTransition_To_v1beta1_ConditionTransition(in, out, s)
+}
+
+func autoConvert_v1beta1_ControlPlane_To_garden_ControlPlane(in *ControlPlane, out *garden.ControlPlane, s conversion.Scope) error {
+	out.HighAvailability = (*garden.HighAvailability)(unsafe.Pointer(in.HighAvailability))
+	out.AdditionalEgress = *(*[]garden.EgressDestination)(unsafe.Pointer(&in.AdditionalEgress))
+	return nil
+}
+
+// Convert_v1beta1_ControlPlane_To_garden_ControlPlane is an autogenerated conversion function.
+func Convert_v1beta1_ControlPlane_To_garden_ControlPlane(in *ControlPlane, out *garden.ControlPlane, s conversion.Scope) error {
+	return autoConvert_v1beta1_ControlPlane_To_garden_ControlPlane(in, out, s)
+}
+
+func autoConvert_garden_ControlPlane_To_v1beta1_ControlPlane(in *garden.ControlPlane, out *ControlPlane, s conversion.Scope) error {
+	out.HighAvailability = (*HighAvailability)(unsafe.Pointer(in.HighAvailability))
+	out.AdditionalEgress = *(*[]EgressDestination)(unsafe.Pointer(&in.AdditionalEgress))
+	return nil
+}
+
+// Convert_garden_ControlPlane_To_v1beta1_ControlPlane is an autogenerated conversion function.
+func Convert_garden_ControlPlane_To_v1beta1_ControlPlane(in *garden.ControlPlane, out *ControlPlane, s conversion.Scope) error {
+	return autoConvert_garden_ControlPlane_To_v1beta1_ControlPlane(in, out, s)
+}
+
 func autoConvert_v1beta1_DNS_To_garden_DNS(in *DNS, out *garden.DNS, s conversion.Scope) error {
 	out.Provider = (*string)(unsafe.Pointer(in.Provider))
 	out.HostedZoneID = (*string)(unsafe.Pointer(in.HostedZoneID))
 	out.Domain = (*string)(unsafe.Pointer(in.Domain))
 	out.SecretName = (*string)(unsafe.Pointer(in.SecretName))
+	out.Providers = *(*[]garden.DNSProvider)(unsafe.Pointer(&in.Providers))
 	return nil
 }
 
@@ -2384,6 +2571,7 @@ func autoConvert_garden_DNS_To_v1beta1_DNS(in *garden.DNS, out *DNS, s conversio
 	out.HostedZoneID = (*string)(unsafe.Pointer(in.HostedZoneID))
 	out.Domain = (*string)(unsafe.Pointer(in.Domain))
 	out.SecretName = (*string)(unsafe.Pointer(in.SecretName))
+	out.Providers = *(*[]DNSProvider)(unsafe.Pointer(&in.Providers))
 	return nil
 }
 
@@ -2392,6 +2580,52 @@ func Convert_garden_DNS_To_v1beta1_DNS(in *garden.DNS, out *DNS, s conversion.Sc
 	return autoConvert_garden_DNS_To_v1beta1_DNS(in, out, s)
 }
 
+func autoConvert_v1beta1_DNSIncludeExclude_To_garden_DNSIncludeExclude(in *DNSIncludeExclude, out *garden.DNSIncludeExclude, s conversion.Scope) error {
+	out.Include = *(*[]string)(unsafe.Pointer(&in.Include))
+	out.Exclude = *(*[]string)(unsafe.Pointer(&in.Exclude))
+	return nil
+}
+
+// Convert_v1beta1_DNSIncludeExclude_To_garden_DNSIncludeExclude is an autogenerated conversion function.
+func Convert_v1beta1_DNSIncludeExclude_To_garden_DNSIncludeExclude(in *DNSIncludeExclude, out *garden.DNSIncludeExclude, s conversion.Scope) error {
+	return autoConvert_v1beta1_DNSIncludeExclude_To_garden_DNSIncludeExclude(in, out, s)
+}
+
+func autoConvert_garden_DNSIncludeExclude_To_v1beta1_DNSIncludeExclude(in *garden.DNSIncludeExclude, out *DNSIncludeExclude, s conversion.Scope) error {
+	out.Include = *(*[]string)(unsafe.Pointer(&in.Include))
+	out.Exclude = *(*[]string)(unsafe.Pointer(&in.Exclude))
+	return nil
+}
+
+// Convert_garden_DNSIncludeExclude_To_v1beta1_DNSIncludeExclude is an autogenerated conversion function.
+func Convert_garden_DNSIncludeExclude_To_v1beta1_DNSIncludeExclude(in *garden.DNSIncludeExclude, out *DNSIncludeExclude, s conversion.Scope) error {
+	return autoConvert_garden_DNSIncludeExclude_To_v1beta1_DNSIncludeExclude(in, out, s)
+}
+
+func autoConvert_v1beta1_DNSProvider_To_garden_DNSProvider(in *DNSProvider, out *garden.DNSProvider, s conversion.Scope) error {
+	out.Type = in.Type
+	out.SecretName = in.SecretName
+	out.Domains = (*garden.DNSIncludeExclude)(unsafe.Pointer(in.Domains))
+	return nil
+}
+
+// Convert_v1beta1_DNSProvider_To_garden_DNSProvider is an autogenerated conversion function.
+func Convert_v1beta1_DNSProvider_To_garden_DNSProvider(in *DNSProvider, out *garden.DNSProvider, s conversion.Scope) error {
+	return autoConvert_v1beta1_DNSProvider_To_garden_DNSProvider(in, out, s)
+}
+
+func autoConvert_garden_DNSProvider_To_v1beta1_DNSProvider(in *garden.DNSProvider, out *DNSProvider, s conversion.Scope) error {
+	out.Type = in.Type
+	out.SecretName = in.SecretName
+	out.Domains = (*DNSIncludeExclude)(unsafe.Pointer(in.Domains))
+	return nil
+}
+
+// Convert_garden_DNSProvider_To_v1beta1_DNSProvider is an autogenerated conversion function.
+func Convert_garden_DNSProvider_To_v1beta1_DNSProvider(in *garden.DNSProvider, out *DNSProvider, s conversion.Scope) error {
+	return autoConvert_garden_DNSProvider_To_v1beta1_DNSProvider(in, out, s)
+}
+
 func autoConvert_v1beta1_DNSProviderConstraint_To_garden_DNSProviderConstraint(in *DNSProviderConstraint, out *garden.DNSProviderConstraint, s conversion.Scope) error {
 	out.Name = in.Name
 	return nil
@@ -2412,6 +2646,32 @@ func Convert_garden_DNSProviderConstraint_To_v1beta1_DNSProviderConstraint(in *g
 	return autoConvert_garden_DNSProviderConstraint_To_v1beta1_DNSProviderConstraint(in, out, s)
 }
 
+func autoConvert_v1beta1_EgressDestination_To_garden_EgressDestination(in *EgressDestination, out *garden.EgressDestination, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CIDRs = *(*[]core.CIDR)(unsafe.Pointer(&in.CIDRs))
+	out.FQDNs = *(*[]string)(unsafe.Pointer(&in.FQDNs))
+	out.Ports = *(*[]int32)(unsafe.Pointer(&in.Ports))
+	return nil
+}
+
+// Convert_v1beta1_EgressDestination_To_garden_EgressDestination is an autogenerated conversion function.
+func Convert_v1beta1_EgressDestination_To_garden_EgressDestination(in *EgressDestination, out *garden.EgressDestination, s conversion.Scope) error {
+	return autoConvert_v1beta1_EgressDestination_To_garden_EgressDestination(in, out, s)
+}
+
+func autoConvert_garden_EgressDestination_To_v1beta1_EgressDestination(in *garden.EgressDestination, out *EgressDestination, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CIDRs = *(*[]v1alpha1.CIDR)(unsafe.Pointer(&in.CIDRs))
+	out.FQDNs = *(*[]string)(unsafe.Pointer(&in.FQDNs))
+	out.Ports = *(*[]int32)(unsafe.Pointer(&in.Ports))
+	return nil
+}
+
+// Convert_garden_EgressDestination_To_v1beta1_EgressDestination is an autogenerated conversion function.
+func Convert_garden_EgressDestination_To_v1beta1_EgressDestination(in *garden.EgressDestination, out *EgressDestination, s conversion.Scope) error {
+	return autoConvert_garden_EgressDestination_To_v1beta1_EgressDestination(in, out, s)
+}
+
 func autoConvert_v1beta1_Extension_To_garden_Extension(in *Extension, out *garden.Extension, s conversion.Scope) error {
 	out.Type = in.Type
 	out.ProviderConfig = (*core.ProviderConfig)(unsafe.Pointer(in.ProviderConfig))
@@ -2740,6 +3000,26 @@ func Convert_garden_HibernationSchedule_To_v1beta1_HibernationSchedule(in *garde
 	return autoConvert_garden_HibernationSchedule_To_v1beta1_HibernationSchedule(in, out, s)
 }
 
+func autoConvert_v1beta1_HighAvailability_To_garden_HighAvailability(in *HighAvailability, out *garden.HighAvailability, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1beta1_HighAvailability_To_garden_HighAvailability is an autogenerated conversion function.
+func Convert_v1beta1_HighAvailability_To_garden_HighAvailability(in *HighAvailability, out *garden.HighAvailability, s conversion.Scope) error {
+	return autoConvert_v1beta1_HighAvailability_To_garden_HighAvailability(in, out, s)
+}
+
+func autoConvert_garden_HighAvailability_To_v1beta1_HighAvailability(in *garden.HighAvailability, out *HighAvailability, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_garden_HighAvailability_To_v1beta1_HighAvailability is an autogenerated conversion function.
+func Convert_garden_HighAvailability_To_v1beta1_HighAvailability(in *garden.HighAvailability, out *HighAvailability, s conversion.Scope) error {
+	return autoConvert_garden_HighAvailability_To_v1beta1_HighAvailability(in, out, s)
+}
+
 func autoConvert_v1beta1_HorizontalPodAutoscalerConfig_To_garden_HorizontalPodAutoscalerConfig(in *HorizontalPodAutoscalerConfig, out *garden.HorizontalPodAutoscalerConfig, s conversion.Scope) error {
 	out.DownscaleDelay = (*metav1.Duration)(unsafe.Pointer(in.DownscaleDelay))
 	out.SyncPeriod = (*metav1.Duration)(unsafe.Pointer(in.SyncPeriod))
@@ -3768,6 +4048,108 @@ func Convert_garden_ProjectList_To_v1beta1_ProjectList(in *garden.ProjectList, o
 	return autoConvert_garden_ProjectList_To_v1beta1_ProjectList(in, out, s)
 }
 
+func autoConvert_v1beta1_ProjectMember_To_garden_ProjectMember(in *ProjectMember, out *garden.ProjectMember, s conversion.Scope) error {
+	out.Subject = in.Subject
+	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
+	return nil
+}
+
+// Convert_v1beta1_ProjectMember_To_garden_ProjectMember is an autogenerated conversion function.
+func Convert_v1beta1_ProjectMember_To_garden_ProjectMember(in *ProjectMember, out *garden.ProjectMember, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectMember_To_garden_ProjectMember(in, out, s)
+}
+
+func autoConvert_garden_ProjectMember_To_v1beta1_ProjectMember(in *garden.ProjectMember, out *ProjectMember, s conversion.Scope) error {
+	out.Subject = in.Subject
+	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
+	return nil
+}
+
+// Convert_garden_ProjectMember_To_v1beta1_ProjectMember is an autogenerated conversion function.
+func Convert_garden_ProjectMember_To_v1beta1_ProjectMember(in *garden.ProjectMember, out *ProjectMember, s conversion.Scope) error {
+	return autoConvert_garden_ProjectMember_To_v1beta1_ProjectMember(in, out, s)
+}
+
+func autoConvert_v1beta1_ProjectPolicy_To_garden_ProjectPolicy(in *ProjectPolicy, out *garden.ProjectPolicy, s conversion.Scope) error {
+	out.MaxShoots = (*int32)(unsafe.Pointer(in.MaxShoots))
+	out.AllowedCloudProfiles = *(*[]string)(unsafe.Pointer(&in.AllowedCloudProfiles))
+	out.AllowedRegions = *(*[]string)(unsafe.Pointer(&in.AllowedRegions))
+	out.AllowedSeeds = *(*[]string)(unsafe.Pointer(&in.AllowedSeeds))
+	out.AllowedKubernetesVersions = (*string)(unsafe.Pointer(in.AllowedKubernetesVersions))
+	out.RequiredLabels = *(*[]string)(unsafe.Pointer(&in.RequiredLabels))
+	out.RequiredAnnotations = *(*[]string)(unsafe.Pointer(&in.RequiredAnnotations))
+	out.MaxWorkerPoolSize = (*int32)(unsafe.Pointer(in.MaxWorkerPoolSize))
+	return nil
+}
+
+// Convert_v1beta1_ProjectPolicy_To_garden_ProjectPolicy is an autogenerated conversion function.
+func Convert_v1beta1_ProjectPolicy_To_garden_ProjectPolicy(in *ProjectPolicy, out *garden.ProjectPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectPolicy_To_garden_ProjectPolicy(in, out, s)
+}
+
+func autoConvert_garden_ProjectPolicy_To_v1beta1_ProjectPolicy(in *garden.ProjectPolicy, out *ProjectPolicy, s conversion.Scope) error {
+	out.MaxShoots = (*int32)(unsafe.Pointer(in.MaxShoots))
+	out.AllowedCloudProfiles = *(*[]string)(unsafe.Pointer(&in.AllowedCloudProfiles))
+	out.AllowedRegions = *(*[]string)(unsafe.Pointer(&in.AllowedRegions))
+	out.AllowedSeeds = *(*[]string)(unsafe.Pointer(&in.AllowedSeeds))
+	out.AllowedKubernetesVersions = (*string)(unsafe.Pointer(in.AllowedKubernetesVersions))
+	out.RequiredLabels = *(*[]string)(unsafe.Pointer(&in.RequiredLabels))
+	out.RequiredAnnotations = *(*[]string)(unsafe.Pointer(&in.RequiredAnnotations))
+	out.MaxWorkerPoolSize = (*int32)(unsafe.Pointer(in.MaxWorkerPoolSize))
+	return nil
+}
+
+// Convert_garden_ProjectPolicy_To_v1beta1_ProjectPolicy is an autogenerated conversion function.
+func Convert_garden_ProjectPolicy_To_v1beta1_ProjectPolicy(in *garden.ProjectPolicy, out *ProjectPolicy, s conversion.Scope) error {
+	return autoConvert_garden_ProjectPolicy_To_v1beta1_ProjectPolicy(in, out, s)
+}
+
+func autoConvert_v1beta1_ProjectServiceAccount_To_garden_ProjectServiceAccount(in *ProjectServiceAccount, out *garden.ProjectServiceAccount, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
+	return nil
+}
+
+// Convert_v1beta1_ProjectServiceAccount_To_garden_ProjectServiceAccount is an autogenerated conversion function.
+func Convert_v1beta1_ProjectServiceAccount_To_garden_ProjectServiceAccount(in *ProjectServiceAccount, out *garden.ProjectServiceAccount, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectServiceAccount_To_garden_ProjectServiceAccount(in, out, s)
+}
+
+func autoConvert_garden_ProjectServiceAccount_To_v1beta1_ProjectServiceAccount(in *garden.ProjectServiceAccount, out *ProjectServiceAccount, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
+	return nil
+}
+
+// Convert_garden_ProjectServiceAccount_To_v1beta1_ProjectServiceAccount is an autogenerated conversion function.
+func Convert_garden_ProjectServiceAccount_To_v1beta1_ProjectServiceAccount(in *garden.ProjectServiceAccount, out *ProjectServiceAccount, s conversion.Scope) error {
+	return autoConvert_garden_ProjectServiceAccount_To_v1beta1_ProjectServiceAccount(in, out, s)
+}
+
+func autoConvert_v1beta1_ProjectServiceAccountStatus_To_garden_ProjectServiceAccountStatus(in *ProjectServiceAccountStatus, out *garden.ProjectServiceAccountStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.KubeconfigSecretName = in.KubeconfigSecretName
+	out.LastTokenRotationTime = (*metav1.Time)(unsafe.Pointer(in.LastTokenRotationTime))
+	return nil
+}
+
+// Convert_v1beta1_ProjectServiceAccountStatus_To_garden_ProjectServiceAccountStatus is an autogenerated conversion function.
+func Convert_v1beta1_ProjectServiceAccountStatus_To_garden_ProjectServiceAccountStatus(in *ProjectServiceAccountStatus, out *garden.ProjectServiceAccountStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectServiceAccountStatus_To_garden_ProjectServiceAccountStatus(in, out, s)
+}
+
+func autoConvert_garden_ProjectServiceAccountStatus_To_v1beta1_ProjectServiceAccountStatus(in *garden.ProjectServiceAccountStatus, out *ProjectServiceAccountStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.KubeconfigSecretName = in.KubeconfigSecretName
+	out.LastTokenRotationTime = (*metav1.Time)(unsafe.Pointer(in.LastTokenRotationTime))
+	return nil
+}
+
+// Convert_garden_ProjectServiceAccountStatus_To_v1beta1_ProjectServiceAccountStatus is an autogenerated conversion function.
+func Convert_garden_ProjectServiceAccountStatus_To_v1beta1_ProjectServiceAccountStatus(in *garden.ProjectServiceAccountStatus, out *ProjectServiceAccountStatus, s conversion.Scope) error {
+	return autoConvert_garden_ProjectServiceAccountStatus_To_v1beta1_ProjectServiceAccountStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_ProjectSpec_To_garden_ProjectSpec(in *ProjectSpec, out *garden.ProjectSpec, s conversion.Scope) error {
 	out.CreatedBy = (*rbacv1.Subject)(unsafe.Pointer(in.CreatedBy))
 	out.Description = (*string)(unsafe.Pointer(in.Description))
@@ -3776,6 +4158,9 @@ func autoConvert_v1beta1_ProjectSpec_To_garden_ProjectSpec(in *ProjectSpec, out
 	out.Members = *(*[]rbacv1.Subject)(unsafe.Pointer(&in.Members))
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	out.Viewers = *(*[]rbacv1.Subject)(unsafe.Pointer(&in.Viewers))
+	out.ProjectMembers = *(*[]garden.ProjectMember)(unsafe.Pointer(&in.ProjectMembers))
+	out.ServiceAccounts = *(*[]garden.ProjectServiceAccount)(unsafe.Pointer(&in.ServiceAccounts))
+	out.Policy = (*garden.ProjectPolicy)(unsafe.Pointer(in.Policy))
 	return nil
 }
 
@@ -3792,6 +4177,9 @@ func autoConvert_garden_ProjectSpec_To_v1beta1_ProjectSpec(in *garden.ProjectSpe
 	out.Members = *(*[]rbacv1.Subject)(unsafe.Pointer(&in.Members))
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	out.Viewers = *(*[]rbacv1.Subject)(unsafe.Pointer(&in.Viewers))
+	out.ProjectMembers = *(*[]ProjectMember)(unsafe.Pointer(&in.ProjectMembers))
+	out.ServiceAccounts = *(*[]ProjectServiceAccount)(unsafe.Pointer(&in.ServiceAccounts))
+	out.Policy = (*ProjectPolicy)(unsafe.Pointer(in.Policy))
 	return nil
 }
 
@@ -3803,6 +4191,11 @@ func Convert_garden_ProjectSpec_To_v1beta1_ProjectSpec(in *garden.ProjectSpec, o
 func autoConvert_v1beta1_ProjectStatus_To_garden_ProjectStatus(in *ProjectStatus, out *garden.ProjectStatus, s conversion.Scope) error {
 	out.ObservedGeneration = in.ObservedGeneration
 	out.Phase = garden.ProjectPhase(in.Phase)
+	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
+	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
+	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
+	out.ServiceAccounts = *(*[]garden.ProjectServiceAccountStatus)(unsafe.Pointer(&in.ServiceAccounts))
 	return nil
 }
 
@@ -3814,6 +4207,11 @@ func Convert_v1beta1_ProjectStatus_To_garden_ProjectStatus(in *ProjectStatus, ou
 func autoConvert_garden_ProjectStatus_To_v1beta1_ProjectStatus(in *garden.ProjectStatus, out *ProjectStatus, s conversion.Scope) error {
 	out.ObservedGeneration = in.ObservedGeneration
 	out.Phase = ProjectPhase(in.Phase)
+	out.Conditions = *(*[]v1alpha1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
+	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
+	out.ServiceAccounts = *(*[]ProjectServiceAccountStatus)(unsafe.Pointer(&in.ServiceAccounts))
 	return nil
 }
 
@@ -4040,6 +4438,7 @@ func autoConvert_v1beta1_SeedNetworks_To_garden_SeedNetworks(in *SeedNetworks, o
 	out.Nodes = core.CIDR(in.Nodes)
 	out.Pods = core.CIDR(in.Pods)
 	out.Services = core.CIDR(in.Services)
+	out.DualStack = (*core.DualStackNetworks)(unsafe.Pointer(in.DualStack))
 	return nil
 }
 
@@ -4052,6 +4451,7 @@ func autoConvert_garden_SeedNetworks_To_v1beta1_SeedNetworks(in *garden.SeedNetw
 	out.Nodes = v1alpha1.CIDR(in.Nodes)
 	out.Pods = v1alpha1.CIDR(in.Pods)
 	out.Services = v1alpha1.CIDR(in.Services)
+	out.DualStack = (*v1alpha1.DualStackNetworks)(unsafe.Pointer(in.DualStack))
 	return nil
 }
 
@@ -4208,6 +4608,7 @@ func autoConvert_v1beta1_ShootSpec_To_garden_ShootSpec(in *ShootSpec, out *garde
 	if err := Convert_v1beta1_Cloud_To_garden_Cloud(&in.Cloud, &out.Cloud, s); err != nil {
 		return err
 	}
+	out.ControlPlane = (*garden.ControlPlane)(unsafe.Pointer(in.ControlPlane))
 	if err := Convert_v1beta1_DNS_To_garden_DNS(&in.DNS, &out.DNS, s); err != nil {
 		return err
 	}
@@ -4231,6 +4632,7 @@ func autoConvert_garden_ShootSpec_To_v1beta1_ShootSpec(in *garden.ShootSpec, out
 	if err := Convert_garden_Cloud_To_v1beta1_Cloud(&in.Cloud, &out.Cloud, s); err != nil {
 		return err
 	}
+	out.ControlPlane = (*ControlPlane)(unsafe.Pointer(in.ControlPlane))
 	if err := Convert_garden_DNS_To_v1beta1_DNS(&in.DNS, &out.DNS, s); err != nil {
 		return err
 	}
@@ -4250,6 +4652,8 @@ func Convert_garden_ShootSpec_To_v1beta1_ShootSpec(in *garden.ShootSpec, out *Sh
 
 func autoConvert_v1beta1_ShootStatus_To_garden_ShootStatus(in *ShootStatus, out *garden.ShootStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
+	out.ConditionHistory = *(*[]garden.ConditionTransition)(unsafe.Pointer(&in.ConditionHistory))
+	out.Availability = *(*[]garden.ConditionAvailability)(unsafe.Pointer(&in.Availability))
 	if err := Convert_v1beta1_Gardener_To_garden_Gardener(&in.Gardener, &out.Gardener, s); err != nil {
 		return err
 	}
@@ -4270,6 +4674,8 @@ func Convert_v1beta1_ShootStatus_To_garden_ShootStatus(in *ShootStatus, out *gar
 
 func autoConvert_garden_ShootStatus_To_v1beta1_ShootStatus(in *garden.ShootStatus, out *ShootStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]v1alpha1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.ConditionHistory = *(*[]ConditionTransition)(unsafe.Pointer(&in.ConditionHistory))
+	out.Availability = *(*[]ConditionAvailability)(unsafe.Pointer(&in.Availability))
 	if err := Convert_garden_Gardener_To_v1beta1_Gardener(&in.Gardener, &out.Gardener, s); err != nil {
 		return err
 	}
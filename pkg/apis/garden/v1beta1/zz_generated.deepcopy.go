@@ -861,6 +861,13 @@ func (in *BackupInfrastructureStatus) DeepCopyInto(out *BackupInfrastructureStat
 		*out = new(v1alpha1.LastError)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1alpha1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1100,6 +1107,69 @@ func (in *ClusterAutoscaler) DeepCopy() *ClusterAutoscaler {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConditionAvailability) DeepCopyInto(out *ConditionAvailability) {
+	*out = *in
+	out.Window = in.Window
+	out.Downtime = in.Downtime
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionAvailability.
+func (in *ConditionAvailability) DeepCopy() *ConditionAvailability {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionAvailability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConditionTransition) DeepCopyInto(out *ConditionTransition) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionTransition.
+func (in *ConditionTransition) DeepCopy() *ConditionTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlane) DeepCopyInto(out *ControlPlane) {
+	*out = *in
+	if in.HighAvailability != nil {
+		in, out := &in.HighAvailability, &out.HighAvailability
+		*out = new(HighAvailability)
+		**out = **in
+	}
+	if in.AdditionalEgress != nil {
+		in, out := &in.AdditionalEgress, &out.AdditionalEgress
+		*out = make([]EgressDestination, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlane.
+func (in *ControlPlane) DeepCopy() *ControlPlane {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlane)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNS) DeepCopyInto(out *DNS) {
 	*out = *in
@@ -1123,6 +1193,13 @@ func (in *DNS) DeepCopyInto(out *DNS) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Providers != nil {
+		in, out := &in.Providers, &out.Providers
+		*out = make([]DNSProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1136,6 +1213,53 @@ func (in *DNS) DeepCopy() *DNS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSIncludeExclude) DeepCopyInto(out *DNSIncludeExclude) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSIncludeExclude.
+func (in *DNSIncludeExclude) DeepCopy() *DNSIncludeExclude {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSIncludeExclude)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSProvider) DeepCopyInto(out *DNSProvider) {
+	*out = *in
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = new(DNSIncludeExclude)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSProvider.
+func (in *DNSProvider) DeepCopy() *DNSProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNSProviderConstraint) DeepCopyInto(out *DNSProviderConstraint) {
 	*out = *in
@@ -1152,6 +1276,37 @@ func (in *DNSProviderConstraint) DeepCopy() *DNSProviderConstraint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressDestination) DeepCopyInto(out *EgressDestination) {
+	*out = *in
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]v1alpha1.CIDR, len(*in))
+		copy(*out, *in)
+	}
+	if in.FQDNs != nil {
+		in, out := &in.FQDNs, &out.FQDNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressDestination.
+func (in *EgressDestination) DeepCopy() *EgressDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Extension) DeepCopyInto(out *Extension) {
 	*out = *in
@@ -1457,6 +1612,22 @@ func (in *HibernationSchedule) DeepCopy() *HibernationSchedule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HighAvailability) DeepCopyInto(out *HighAvailability) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HighAvailability.
+func (in *HighAvailability) DeepCopy() *HighAvailability {
+	if in == nil {
+		return nil
+	}
+	out := new(HighAvailability)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HorizontalPodAutoscalerConfig) DeepCopyInto(out *HorizontalPodAutoscalerConfig) {
 	*out = *in
@@ -2385,7 +2556,7 @@ func (in *Project) DeepCopyInto(out *Project) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -2440,6 +2611,125 @@ func (in *ProjectList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectMember) DeepCopyInto(out *ProjectMember) {
+	*out = *in
+	out.Subject = in.Subject
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectMember.
+func (in *ProjectMember) DeepCopy() *ProjectMember {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectPolicy) DeepCopyInto(out *ProjectPolicy) {
+	*out = *in
+	if in.MaxShoots != nil {
+		in, out := &in.MaxShoots, &out.MaxShoots
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AllowedCloudProfiles != nil {
+		in, out := &in.AllowedCloudProfiles, &out.AllowedCloudProfiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedRegions != nil {
+		in, out := &in.AllowedRegions, &out.AllowedRegions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedSeeds != nil {
+		in, out := &in.AllowedSeeds, &out.AllowedSeeds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedKubernetesVersions != nil {
+		in, out := &in.AllowedKubernetesVersions, &out.AllowedKubernetesVersions
+		*out = new(string)
+		**out = **in
+	}
+	if in.RequiredLabels != nil {
+		in, out := &in.RequiredLabels, &out.RequiredLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequiredAnnotations != nil {
+		in, out := &in.RequiredAnnotations, &out.RequiredAnnotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxWorkerPoolSize != nil {
+		in, out := &in.MaxWorkerPoolSize, &out.MaxWorkerPoolSize
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectPolicy.
+func (in *ProjectPolicy) DeepCopy() *ProjectPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectServiceAccount) DeepCopyInto(out *ProjectServiceAccount) {
+	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectServiceAccount.
+func (in *ProjectServiceAccount) DeepCopy() *ProjectServiceAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectServiceAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectServiceAccountStatus) DeepCopyInto(out *ProjectServiceAccountStatus) {
+	*out = *in
+	if in.LastTokenRotationTime != nil {
+		in, out := &in.LastTokenRotationTime, &out.LastTokenRotationTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectServiceAccountStatus.
+func (in *ProjectServiceAccountStatus) DeepCopy() *ProjectServiceAccountStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectServiceAccountStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 	*out = *in
@@ -2478,6 +2768,25 @@ func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 		*out = make([]rbacv1.Subject, len(*in))
 		copy(*out, *in)
 	}
+	if in.ProjectMembers != nil {
+		in, out := &in.ProjectMembers, &out.ProjectMembers
+		*out = make([]ProjectMember, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ServiceAccounts != nil {
+		in, out := &in.ServiceAccounts, &out.ServiceAccounts
+		*out = make([]ProjectServiceAccount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(ProjectPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2494,6 +2803,32 @@ func (in *ProjectSpec) DeepCopy() *ProjectSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1alpha1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastActivityTimestamp != nil {
+		in, out := &in.LastActivityTimestamp, &out.LastActivityTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.StaleSinceTimestamp != nil {
+		in, out := &in.StaleSinceTimestamp, &out.StaleSinceTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.StaleAutoDeleteTimestamp != nil {
+		in, out := &in.StaleAutoDeleteTimestamp, &out.StaleAutoDeleteTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.ServiceAccounts != nil {
+		in, out := &in.ServiceAccounts, &out.ServiceAccounts
+		*out = make([]ProjectServiceAccountStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2740,6 +3075,11 @@ func (in *SeedList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedNetworks) DeepCopyInto(out *SeedNetworks) {
 	*out = *in
+	if in.DualStack != nil {
+		in, out := &in.DualStack, &out.DualStack
+		*out = new(v1alpha1.DualStackNetworks)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2758,7 +3098,7 @@ func (in *SeedSpec) DeepCopyInto(out *SeedSpec) {
 	*out = *in
 	out.Cloud = in.Cloud
 	out.SecretRef = in.SecretRef
-	out.Networks = in.Networks
+	in.Networks.DeepCopyInto(&out.Networks)
 	if in.BlockCIDRs != nil {
 		in, out := &in.BlockCIDRs, &out.BlockCIDRs
 		*out = make([]v1alpha1.CIDR, len(*in))
@@ -2886,6 +3226,11 @@ func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
 		**out = **in
 	}
 	in.Cloud.DeepCopyInto(&out.Cloud)
+	if in.ControlPlane != nil {
+		in, out := &in.ControlPlane, &out.ControlPlane
+		*out = new(ControlPlane)
+		(*in).DeepCopyInto(*out)
+	}
 	in.DNS.DeepCopyInto(&out.DNS)
 	if in.Extensions != nil {
 		in, out := &in.Extensions, &out.Extensions
@@ -2928,6 +3273,18 @@ func (in *ShootStatus) DeepCopyInto(out *ShootStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ConditionHistory != nil {
+		in, out := &in.ConditionHistory, &out.ConditionHistory
+		*out = make([]ConditionTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Availability != nil {
+		in, out := &in.Availability, &out.Availability
+		*out = make([]ConditionAvailability, len(*in))
+		copy(*out, *in)
+	}
 	out.Gardener = in.Gardener
 	if in.LastOperation != nil {
 		in, out := &in.LastOperation, &out.LastOperation
@@ -536,6 +536,8 @@ func ValidateProjectSpec(projectSpec *garden.ProjectSpec, fldPath *field.Path) f
 	for i, viewer := range projectSpec.Viewers {
 		allErrs = append(allErrs, ValidateSubject(viewer, fldPath.Child("viewers").Index(i))...)
 	}
+	allErrs = append(allErrs, validateProjectMembers(projectSpec.ProjectMembers, fldPath.Child("projectMembers"))...)
+	allErrs = append(allErrs, validateProjectServiceAccounts(projectSpec.ServiceAccounts, fldPath.Child("serviceAccounts"))...)
 	if createdBy := projectSpec.CreatedBy; createdBy != nil {
 		allErrs = append(allErrs, ValidateSubject(*createdBy, fldPath.Child("createdBy"))...)
 	}
@@ -548,6 +550,129 @@ func ValidateProjectSpec(projectSpec *garden.ProjectSpec, fldPath *field.Path) f
 	if purpose := projectSpec.Description; purpose != nil && len(*purpose) == 0 {
 		allErrs = append(allErrs, field.Required(fldPath.Child("purpose"), "must provide a purpose when key is present"))
 	}
+	if policy := projectSpec.Policy; policy != nil {
+		allErrs = append(allErrs, validateProjectPolicy(policy, fldPath.Child("policy"))...)
+	}
+
+	return allErrs
+}
+
+var availableProjectMemberRoles = sets.NewString(
+	garden.ProjectMemberAdmin,
+	garden.ProjectMemberShootOperator,
+	garden.ProjectMemberSecretManager,
+	garden.ProjectMemberViewer,
+)
+
+func validateProjectMembers(members []garden.ProjectMember, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs  = field.ErrorList{}
+		subjects = sets.NewString()
+	)
+
+	for i, member := range members {
+		idxPath := fldPath.Index(i)
+		allErrs = append(allErrs, ValidateSubject(member.Subject, idxPath)...)
+
+		subject := fmt.Sprintf("%s/%s/%s", member.Kind, member.Namespace, member.Name)
+		if subjects.Has(subject) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, subject))
+		}
+		subjects.Insert(subject)
+
+		allErrs = append(allErrs, validateProjectMemberRoles(member.Roles, idxPath.Child("roles"))...)
+	}
+
+	return allErrs
+}
+
+func validateProjectServiceAccounts(serviceAccounts []garden.ProjectServiceAccount, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.NewString()
+	)
+
+	for i, serviceAccount := range serviceAccounts {
+		idxPath := fldPath.Index(i)
+
+		if len(serviceAccount.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		}
+		for _, msg := range apivalidation.ValidateServiceAccountName(serviceAccount.Name, false) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), serviceAccount.Name, msg))
+		}
+		// The default ServiceAccount is used by all pods in the namespace which do not specify another one.
+		if serviceAccount.Name == "default" {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("name"), "the default service account of the project namespace cannot be used"))
+		}
+		if names.Has(serviceAccount.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), serviceAccount.Name))
+		}
+		names.Insert(serviceAccount.Name)
+
+		allErrs = append(allErrs, validateProjectMemberRoles(serviceAccount.Roles, idxPath.Child("roles"))...)
+	}
+
+	return allErrs
+}
+
+func validateProjectMemberRoles(roles []string, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs    = field.ErrorList{}
+		foundRoles = sets.NewString()
+	)
+
+	if len(roles) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "must specify at least one role"))
+	}
+
+	for i, role := range roles {
+		idxPath := fldPath.Index(i)
+
+		if foundRoles.Has(role) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, role))
+		}
+		foundRoles.Insert(role)
+
+		if strings.HasPrefix(role, garden.ProjectMemberExtensionPrefix) {
+			name := strings.TrimPrefix(role, garden.ProjectMemberExtensionPrefix)
+			for _, msg := range validation.IsDNS1123Label(name) {
+				allErrs = append(allErrs, field.Invalid(idxPath, role, msg))
+			}
+			continue
+		}
+		if !availableProjectMemberRoles.Has(role) {
+			allErrs = append(allErrs, field.NotSupported(idxPath, role, append(availableProjectMemberRoles.List(), garden.ProjectMemberExtensionPrefix+"<name>")))
+		}
+	}
+
+	return allErrs
+}
+
+func validateProjectPolicy(policy *garden.ProjectPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if policy.MaxShoots != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*policy.MaxShoots), fldPath.Child("maxShoots"))...)
+	}
+	if policy.MaxWorkerPoolSize != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*policy.MaxWorkerPoolSize), fldPath.Child("maxWorkerPoolSize"))...)
+	}
+	if constraint := policy.AllowedKubernetesVersions; constraint != nil {
+		if _, err := semver.NewConstraint(*constraint); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("allowedKubernetesVersions"), *constraint, fmt.Sprintf("must be a valid version constraint: %v", err)))
+		}
+	}
+	for i, key := range policy.RequiredLabels {
+		for _, msg := range validation.IsQualifiedName(key) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("requiredLabels").Index(i), key, msg))
+		}
+	}
+	for i, key := range policy.RequiredAnnotations {
+		for _, msg := range validation.IsQualifiedName(strings.ToLower(key)) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("requiredAnnotations").Index(i), key, msg))
+		}
+	}
 
 	return allErrs
 }
@@ -654,12 +779,18 @@ func ValidateSeedSpec(seedSpec *garden.SeedSpec, fldPath *field.Path) field.Erro
 
 	networksPath := fldPath.Child("networks")
 
-	networks := []cidrvalidation.CIDR{
-		cidrvalidation.NewCIDR(seedSpec.Networks.Nodes, networksPath.Child("nodes")),
-		cidrvalidation.NewCIDR(seedSpec.Networks.Pods, networksPath.Child("pods")),
-		cidrvalidation.NewCIDR(seedSpec.Networks.Services, networksPath.Child("services")),
-	}
+	var (
+		nodes    = cidrvalidation.NewCIDR(seedSpec.Networks.Nodes, networksPath.Child("nodes"))
+		pods     = cidrvalidation.NewCIDR(seedSpec.Networks.Pods, networksPath.Child("pods"))
+		services = cidrvalidation.NewCIDR(seedSpec.Networks.Services, networksPath.Child("services"))
+		networks = []cidrvalidation.CIDR{nodes, pods, services}
+	)
 	allErrs = append(allErrs, validateCIDRParse(networks...)...)
+
+	dualStackNetworks, dualStackErrors := validateDualStackNetworks(seedSpec.Networks.DualStack, nodes, pods, services, networksPath.Child("dualStack"))
+	allErrs = append(allErrs, dualStackErrors...)
+	networks = append(networks, dualStackNetworks...)
+
 	allErrs = append(allErrs, validateCIDROVerlap(networks, networks, false)...)
 
 	return allErrs
@@ -849,6 +980,7 @@ func ValidateShootUpdate(newShoot, oldShoot *garden.Shoot) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newShoot.ObjectMeta, &oldShoot.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newShoot.Annotations[common.ShootCloneSource], oldShoot.Annotations[common.ShootCloneSource], field.NewPath("metadata", "annotations").Key(common.ShootCloneSource))...)
 	allErrs = append(allErrs, ValidateShootSpecUpdate(&newShoot.Spec, &oldShoot.Spec, newShoot.DeletionTimestamp != nil, field.NewPath("spec"))...)
 	allErrs = append(allErrs, ValidateShoot(newShoot)...)
 
@@ -867,6 +999,7 @@ func ValidateShootSpec(spec *garden.ShootSpec, fldPath *field.Path) field.ErrorL
 
 	allErrs = append(allErrs, validateAddons(spec.Addons, fldPath.Child("addons"))...)
 	allErrs = append(allErrs, validateCloud(spec.Cloud, fldPath.Child("cloud"))...)
+	allErrs = append(allErrs, validateControlPlane(spec.ControlPlane, fldPath.Child("controlPlane"))...)
 	allErrs = append(allErrs, validateDNS(spec.DNS, fldPath.Child("dns"))...)
 	allErrs = append(allErrs, validateExtensions(spec.Extensions, fldPath.Child("extensions"))...)
 	allErrs = append(allErrs, validateKubernetes(spec.Kubernetes, fldPath.Child("kubernetes"))...)
@@ -1423,6 +1556,7 @@ func ValidateShootSpecUpdate(newSpec, oldSpec *garden.ShootSpec, deletionTimesta
 		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.Packet.Zones, oldSpec.Cloud.Packet.Zones, packetPath.Child("zones"))...)
 	}
 
+	allErrs = append(allErrs, validateControlPlaneUpdate(newSpec.ControlPlane, oldSpec.ControlPlane, fldPath.Child("controlPlane"))...)
 	allErrs = append(allErrs, validateDNSUpdate(newSpec.DNS, oldSpec.DNS, fldPath.Child("dns"))...)
 	allErrs = append(allErrs, validateKubernetesVersionUpdate(newSpec.Kubernetes.Version, oldSpec.Kubernetes.Version, fldPath.Child("kubernetes", "version"))...)
 	allErrs = append(allErrs, validateKubeProxyModeUpdate(newSpec.Kubernetes.KubeProxy, oldSpec.Kubernetes.KubeProxy, newSpec.Kubernetes.Version, fldPath.Child("kubernetes", "kubeProxy"))...)
@@ -1446,6 +1580,59 @@ func validateKubeProxyModeUpdate(newConfig, oldConfig *garden.KubeProxyConfig, v
 	return allErrs
 }
 
+func validateControlPlane(controlPlane *garden.ControlPlane, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if controlPlane == nil {
+		return allErrs
+	}
+
+	names := sets.NewString()
+	for i, destination := range controlPlane.AdditionalEgress {
+		idxPath := fldPath.Child("additionalEgress").Index(i)
+
+		if len(destination.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "name must be specified"))
+		} else {
+			allErrs = append(allErrs, validateDNS1123Label(destination.Name, idxPath.Child("name"))...)
+			if names.Has(destination.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), destination.Name))
+			}
+			names.Insert(destination.Name)
+		}
+
+		if len(destination.CIDRs) == 0 && len(destination.FQDNs) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath, "at least one of cidrs or fqdns must be specified"))
+		}
+		for j, cidr := range destination.CIDRs {
+			allErrs = append(allErrs, cidrvalidation.NewCIDR(cidr, idxPath.Child("cidrs").Index(j)).ValidateParse()...)
+		}
+		for j, fqdn := range destination.FQDNs {
+			allErrs = append(allErrs, validateDNS1123Subdomain(fqdn, idxPath.Child("fqdns").Index(j))...)
+		}
+		for j, port := range destination.Ports {
+			for _, msg := range validation.IsValidPortNum(int(port)) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("ports").Index(j), port, msg))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateControlPlaneUpdate(new, old *garden.ControlPlane, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// Converting a single-member etcd into a multi-member cluster (and vice versa) is not supported.
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(isHighAvailabilityEnabled(new), isHighAvailabilityEnabled(old), fldPath.Child("highAvailability", "enabled"))...)
+
+	return allErrs
+}
+
+func isHighAvailabilityEnabled(controlPlane *garden.ControlPlane) bool {
+	return controlPlane != nil && controlPlane.HighAvailability != nil && controlPlane.HighAvailability.Enabled
+}
+
 func validateDNSUpdate(new, old garden.DNS, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -1515,6 +1702,34 @@ func validateDNS(dns garden.DNS, fldPath *field.Path) field.ErrorList {
 		allErrs = append(allErrs, field.Required(fldPath.Child("provider"), "`.spec.dns.provider` must be set when `.spec.dns.secretName` is set"))
 	}
 
+	secretNames := sets.NewString()
+	for i, provider := range dns.Providers {
+		idxPath := fldPath.Child("providers").Index(i)
+
+		if len(provider.Type) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("type"), "field must not be empty"))
+		}
+
+		if len(provider.SecretName) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("secretName"), "field must not be empty"))
+		} else {
+			allErrs = append(allErrs, validateDNS1123Subdomain(provider.SecretName, idxPath.Child("secretName"))...)
+			if secretNames.Has(provider.SecretName) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("secretName"), provider.SecretName))
+			}
+			secretNames.Insert(provider.SecretName)
+		}
+
+		if provider.Domains != nil {
+			for j, domain := range provider.Domains.Include {
+				allErrs = append(allErrs, validateDNS1123Subdomain(domain, idxPath.Child("domains", "include").Index(j))...)
+			}
+			for j, domain := range provider.Domains.Exclude {
+				allErrs = append(allErrs, validateDNS1123Subdomain(domain, idxPath.Child("domains", "exclude").Index(j))...)
+			}
+		}
+	}
+
 	return allErrs
 }
 
@@ -1583,6 +1798,44 @@ func transformK8SNetworks(networks gardencore.K8SNetworks, fldPath *field.Path)
 	return nodes, pods, services, allErrs
 }
 
+// validateDualStackNetworks validates that the given dual-stack networks can be parsed and belong to another IP family
+// than the respective primary networks. It returns the parsed dual-stack networks for further overlap checks.
+func validateDualStackNetworks(dualStack *gardencore.DualStackNetworks, nodes, pods, services cidrvalidation.CIDR, fldPath *field.Path) ([]cidrvalidation.CIDR, field.ErrorList) {
+	var (
+		cidrs   = []cidrvalidation.CIDR{}
+		allErrs = field.ErrorList{}
+	)
+
+	if dualStack == nil {
+		return cidrs, allErrs
+	}
+
+	for _, network := range []struct {
+		name    string
+		cidr    *gardencore.CIDR
+		primary cidrvalidation.CIDR
+	}{
+		{"nodes", dualStack.Nodes, nodes},
+		{"pods", dualStack.Pods, pods},
+		{"services", dualStack.Services, services},
+	} {
+		if network.cidr == nil {
+			continue
+		}
+
+		cidr := cidrvalidation.NewCIDR(*network.cidr, fldPath.Child(network.name))
+		allErrs = append(allErrs, cidr.ValidateParse()...)
+		if network.primary == nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child(network.name), fmt.Sprintf("dual-stack %s CIDR requires a primary %s CIDR", network.name, network.name)))
+		} else {
+			allErrs = append(allErrs, network.primary.ValidateDifferentIPFamily(cidr)...)
+		}
+		cidrs = append(cidrs, cidr)
+	}
+
+	return cidrs, allErrs
+}
+
 func validateKubernetes(kubernetes garden.Kubernetes, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -1814,6 +1814,140 @@ var _ = Describe("validation", func() {
 			}))))
 		})
 
+		It("should allow a valid project policy", func() {
+			maxShoots, maxWorkerPoolSize := int32(2), int32(5)
+			project.Spec.Policy = &garden.ProjectPolicy{
+				MaxShoots:                 &maxShoots,
+				AllowedCloudProfiles:      []string{"aws"},
+				AllowedKubernetesVersions: makeStringPointer(">= 1.14, < 1.16"),
+				RequiredLabels:            []string{"cost-center"},
+				RequiredAnnotations:       []string{"example.com/Owner"},
+				MaxWorkerPoolSize:         &maxWorkerPoolSize,
+			}
+
+			errorList := ValidateProject(project)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid an invalid project policy", func() {
+			maxShoots, maxWorkerPoolSize := int32(-1), int32(-1)
+			project.Spec.Policy = &garden.ProjectPolicy{
+				MaxShoots:                 &maxShoots,
+				AllowedKubernetesVersions: makeStringPointer("foo"),
+				RequiredLabels:            []string{"in valid"},
+				RequiredAnnotations:       []string{"-invalid"},
+				MaxWorkerPoolSize:         &maxWorkerPoolSize,
+			}
+
+			errorList := ValidateProject(project)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.policy.maxShoots"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.policy.maxWorkerPoolSize"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.policy.allowedKubernetesVersions"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.policy.requiredLabels[0]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.policy.requiredAnnotations[0]"),
+			}))))
+		})
+
+		It("should allow valid project members", func() {
+			project.Spec.ProjectMembers = []garden.ProjectMember{
+				{
+					Subject: rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "alice.doe@example.com"},
+					Roles:   []string{garden.ProjectMemberShootOperator, garden.ProjectMemberSecretManager},
+				},
+				{
+					Subject: rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: "robot", Namespace: "garden-project"},
+					Roles:   []string{garden.ProjectMemberExtensionPrefix + "foo"},
+				},
+			}
+
+			errorList := ValidateProject(project)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid invalid project members", func() {
+			subject := rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "alice.doe@example.com"}
+			project.Spec.ProjectMembers = []garden.ProjectMember{
+				{
+					Subject: subject,
+					Roles:   []string{garden.ProjectMemberViewer, garden.ProjectMemberViewer, "foo", garden.ProjectMemberExtensionPrefix + "Foo_Bar"},
+				},
+				{
+					Subject: subject,
+				},
+			}
+
+			errorList := ValidateProject(project)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.projectMembers[0].roles[1]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.projectMembers[0].roles[2]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.projectMembers[0].roles[3]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.projectMembers[1]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.projectMembers[1].roles"),
+			}))))
+		})
+
+		It("should allow valid service accounts", func() {
+			project.Spec.ServiceAccounts = []garden.ProjectServiceAccount{
+				{Name: "ci", Roles: []string{garden.ProjectMemberShootOperator}},
+				{Name: "secrets", Roles: []string{garden.ProjectMemberSecretManager, garden.ProjectMemberExtensionPrefix + "foo"}},
+			}
+
+			errorList := ValidateProject(project)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid invalid service accounts", func() {
+			project.Spec.ServiceAccounts = []garden.ProjectServiceAccount{
+				{Name: "ci", Roles: []string{"foo"}},
+				{Name: "ci"},
+				{Name: "In_valid", Roles: []string{garden.ProjectMemberViewer}},
+				{Name: "default", Roles: []string{garden.ProjectMemberViewer}},
+			}
+
+			errorList := ValidateProject(project)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.serviceAccounts[0].roles[0]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.serviceAccounts[1].name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.serviceAccounts[1].roles"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.serviceAccounts[2].name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.serviceAccounts[3].name"),
+			}))))
+		})
+
 		DescribeTable("owner validation",
 			func(apiGroup, kind, name, namespace string, expectType field.ErrorType, field string) {
 				subject := rbacv1.Subject{
@@ -2031,6 +2165,38 @@ var _ = Describe("validation", func() {
 			}))
 		})
 
+		It("should allow Seed with dual-stack networks", func() {
+			seed.Spec.Networks.DualStack = &gardencore.DualStackNetworks{
+				Nodes:    makeCIDRPointer("2001:db8:1::/64"),
+				Pods:     makeCIDRPointer("2001:db8:2::/64"),
+				Services: makeCIDRPointer("2001:db8:3::/108"),
+			}
+
+			errorList := ValidateSeed(seed)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid Seed with invalid or overlapping dual-stack networks", func() {
+			seed.Spec.Networks.DualStack = &gardencore.DualStackNetworks{
+				Nodes:    makeCIDRPointer("2001:db8::/32"),
+				Pods:     makeCIDRPointer("2001:db8:2::/64"),
+				Services: makeCIDRPointer("10.3.0.0/16"),
+			}
+
+			errorList := ValidateSeed(seed)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("spec.networks.dualStack.services"),
+				"Detail": Equal(fmt.Sprintf(`must not belong to the same IP family as "spec.networks.services" (%q)`, seed.Spec.Networks.Services)),
+			}, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("spec.networks.dualStack.pods"),
+				"Detail": Equal(`must not be a subset of "spec.networks.dualStack.nodes" ("2001:db8::/32")`),
+			}))
+		})
+
 		It("should fail updating immutable fields", func() {
 			newSeed := prepareSeedForUpdate(seed)
 			newSeed.Spec.Networks = garden.SeedNetworks{
@@ -2743,6 +2909,19 @@ var _ = Describe("validation", func() {
 			}))
 		})
 
+		It("should forbid changing the clone source", func() {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, common.ShootCloneSource, "source")
+			newShoot := prepareShootForUpdate(shoot)
+			newShoot.Annotations[common.ShootCloneSource] = "another-source"
+
+			errorList := ValidateShootUpdate(newShoot, shoot)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("metadata.annotations[shoot.garden.sapcloud.io/clone-source]"),
+			}))))
+		})
+
 		It("should forbid updating some cloud keys", func() {
 			newShoot := prepareShootForUpdate(shoot)
 			newShoot.Spec.Cloud.Profile = "another-profile"
@@ -4952,6 +5131,98 @@ var _ = Describe("validation", func() {
 			})
 		})
 
+		Context("control plane section", func() {
+			It("should forbid enabling high availability for an existing shoot", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.ControlPlane = &garden.ControlPlane{
+					HighAvailability: &garden.HighAvailability{Enabled: true},
+				}
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.controlPlane.highAvailability.enabled"),
+				}))))
+			})
+
+			It("should forbid disabling high availability for an existing shoot", func() {
+				shoot.Spec.ControlPlane = &garden.ControlPlane{
+					HighAvailability: &garden.HighAvailability{Enabled: true},
+				}
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.ControlPlane = nil
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.controlPlane.highAvailability.enabled"),
+				}))))
+			})
+
+			It("should allow specifying an empty control plane section for an existing shoot", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.ControlPlane = &garden.ControlPlane{}
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should allow valid additional egress destinations", func() {
+				shoot.Spec.ControlPlane = &garden.ControlPlane{
+					AdditionalEgress: []garden.EgressDestination{
+						{Name: "oidc", FQDNs: []string{"issuer.example.com"}, Ports: []int32{443}},
+						{Name: "webhooks", CIDRs: []gardencore.CIDR{"10.250.0.0/16", "2001:db8::/64"}},
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid invalid additional egress destinations", func() {
+				shoot.Spec.ControlPlane = &garden.ControlPlane{
+					AdditionalEgress: []garden.EgressDestination{
+						{Name: "Foo_Bar", CIDRs: []gardencore.CIDR{"10.250.0.0"}},
+						{Name: "empty"},
+						{Name: "empty", FQDNs: []string{"foo/bar"}, Ports: []int32{0}},
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.controlPlane.additionalEgress[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.controlPlane.additionalEgress[0].cidrs[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.controlPlane.additionalEgress[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.controlPlane.additionalEgress[2].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.controlPlane.additionalEgress[2].fqdns[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.controlPlane.additionalEgress[2].ports[0]"),
+					})),
+				))
+			})
+		})
+
 		Context("dns section", func() {
 			It("should forbid specifying a provider without a domain", func() {
 				shoot.Spec.DNS.Domain = makeStringPointer("foo/bar.baz")
@@ -5011,6 +5282,73 @@ var _ = Describe("validation", func() {
 				}))))
 			})
 
+			It("should allow specifying additional dns providers", func() {
+				shoot.Spec.DNS.Providers = []garden.DNSProvider{
+					{
+						Type:       "aws-route53",
+						SecretName: "route53-credentials",
+						Domains: &garden.DNSIncludeExclude{
+							Include: []string{"apps.example.com"},
+							Exclude: []string{"internal.apps.example.com"},
+						},
+					},
+					{
+						Type:       "google-clouddns",
+						SecretName: "clouddns-credentials",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid additional dns providers without type or secret name", func() {
+				shoot.Spec.DNS.Providers = []garden.DNSProvider{{}}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.dns.providers[0].type"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.dns.providers[0].secretName"),
+					})),
+				))
+			})
+
+			It("should forbid additional dns providers with duplicate secret names or invalid domains", func() {
+				shoot.Spec.DNS.Providers = []garden.DNSProvider{
+					{
+						Type:       "aws-route53",
+						SecretName: "credentials",
+					},
+					{
+						Type:       "aws-route53",
+						SecretName: "credentials",
+						Domains: &garden.DNSIncludeExclude{
+							Include: []string{"foo/bar.baz"},
+						},
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.dns.providers[1].secretName"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.dns.providers[1].domains.include[0]"),
+					})),
+				))
+			})
+
 			It("should forbid updating the dns domain", func() {
 				newShoot := prepareShootForUpdate(shoot)
 				newShoot.Spec.DNS.Domain = makeStringPointer("another-domain.com")
@@ -5664,6 +6002,11 @@ func makeStringPointer(s string) *string {
 	return &ptr
 }
 
+func makeCIDRPointer(c string) *gardencore.CIDR {
+	cidr := gardencore.CIDR(c)
+	return &cidr
+}
+
 func makeDurationPointer(d time.Duration) *metav1.Duration {
 	return &metav1.Duration{Duration: d}
 }
@@ -23,6 +23,7 @@ type ControllerRegistrationsGetter interface {
 type ControllerRegistrationInterface interface {
 	Create(*v1alpha1.ControllerRegistration) (*v1alpha1.ControllerRegistration, error)
 	Update(*v1alpha1.ControllerRegistration) (*v1alpha1.ControllerRegistration, error)
+	UpdateStatus(*v1alpha1.ControllerRegistration) (*v1alpha1.ControllerRegistration, error)
 	Delete(name string, options *v1.DeleteOptions) error
 	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
 	Get(name string, options v1.GetOptions) (*v1alpha1.ControllerRegistration, error)
@@ -109,6 +110,21 @@ func (c *controllerRegistrations) Update(controllerRegistration *v1alpha1.Contro
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *controllerRegistrations) UpdateStatus(controllerRegistration *v1alpha1.ControllerRegistration) (result *v1alpha1.ControllerRegistration, err error) {
+	result = &v1alpha1.ControllerRegistration{}
+	err = c.client.Put().
+		Resource("controllerregistrations").
+		Name(controllerRegistration.Name).
+		SubResource("status").
+		Body(controllerRegistration).
+		Do().
+		Into(result)
+	return
+}
+
 // Delete takes name of the controllerRegistration and deletes it. Returns an error if one occurs.
 func (c *controllerRegistrations) Delete(name string, options *v1.DeleteOptions) error {
 	return c.client.Delete().
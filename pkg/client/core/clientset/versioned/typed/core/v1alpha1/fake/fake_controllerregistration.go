@@ -78,6 +78,17 @@ func (c *FakeControllerRegistrations) Update(controllerRegistration *v1alpha1.Co
 	return obj.(*v1alpha1.ControllerRegistration), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeControllerRegistrations) UpdateStatus(controllerRegistration *v1alpha1.ControllerRegistration) (*v1alpha1.ControllerRegistration, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(controllerregistrationsResource, "status", controllerRegistration), &v1alpha1.ControllerRegistration{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ControllerRegistration), err
+}
+
 // Delete takes name of the controllerRegistration and deletes it. Returns an error if one occurs.
 func (c *FakeControllerRegistrations) Delete(name string, options *v1.DeleteOptions) error {
 	_, err := c.Fake.
@@ -19,6 +19,8 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strings"
+	"sync/atomic"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -85,9 +87,45 @@ func (c *Applier) applyObject(ctx context.Context, desired *unstructured.Unstruc
 		return fmt.Errorf("Missing 'metadata.name' in: %+v", desired)
 	}
 
+	if options.Mode == ApplyModeServerSide && atomic.LoadInt32(&c.serverSideApplyUnsupported) == 0 {
+		err := c.serverSideApplyObject(ctx, key, desired, options)
+		if !apierrors.IsUnsupportedMediaType(err) {
+			return err
+		}
+		// The API server does not support server-side apply, hence, we fall back to the update mode for this and all
+		// subsequent objects.
+		atomic.StoreInt32(&c.serverSideApplyUnsupported, 1)
+	}
+
+	return c.updateObject(ctx, key, desired, options)
+}
+
+func (c *Applier) serverSideApplyObject(ctx context.Context, key client.ObjectKey, desired *unstructured.Unstructured, options ApplierOptions) error {
+	fieldManager := options.FieldManager
+	if len(fieldManager) == 0 {
+		fieldManager = GardenerFieldManager
+	}
+
+	patchOptions := []client.PatchOptionFunc{client.FieldOwner(fieldManager)}
+	if options.ForceConflicts {
+		patchOptions = append(patchOptions, client.ForceOwnership)
+	}
+
+	err := c.client.Patch(ctx, desired, client.Apply, patchOptions...)
+	if meta.IsNoMatchError(err) {
+		c.discovery.Invalidate()
+		err = c.client.Patch(ctx, desired, client.Apply, patchOptions...)
+	}
+	if apierrors.IsConflict(err) {
+		return newApplyConflictError(desired.GroupVersionKind(), key, err)
+	}
+	return err
+}
+
+func (c *Applier) updateObject(ctx context.Context, key client.ObjectKey, desired *unstructured.Unstructured, options ApplierOptions) error {
 	current := &unstructured.Unstructured{}
 	current.SetGroupVersionKind(desired.GroupVersionKind())
-	err = c.client.Get(ctx, key, current)
+	err := c.client.Get(ctx, key, current)
 	if meta.IsNoMatchError(err) {
 		c.discovery.Invalidate()
 		err = c.client.Get(ctx, key, current)
@@ -106,6 +144,65 @@ func (c *Applier) applyObject(ctx context.Context, desired *unstructured.Unstruc
 	return c.client.Update(ctx, desired)
 }
 
+// ApplyConflict describes a field of an object whose ownership conflicts with another field manager.
+type ApplyConflict struct {
+	// Field is the path of the conflicting field.
+	Field string
+	// Message describes the conflict, i.e., the field manager owning the field.
+	Message string
+}
+
+// ApplyConflictError is returned if a server-side apply request conflicts with fields owned by other field managers.
+type ApplyConflictError struct {
+	// GroupVersionKind is the kind of the object which could not be applied.
+	GroupVersionKind schema.GroupVersionKind
+	// Key is the key of the object which could not be applied.
+	Key client.ObjectKey
+	// Conflicts are the conflicting fields.
+	Conflicts []ApplyConflict
+
+	err error
+}
+
+func newApplyConflictError(gvk schema.GroupVersionKind, key client.ObjectKey, err error) *ApplyConflictError {
+	applyConflictErr := &ApplyConflictError{GroupVersionKind: gvk, Key: key, err: err}
+
+	if status, ok := err.(apierrors.APIStatus); ok && status.Status().Details != nil {
+		for _, cause := range status.Status().Details.Causes {
+			if cause.Type != metav1.CauseTypeFieldManagerConflict {
+				continue
+			}
+			applyConflictErr.Conflicts = append(applyConflictErr.Conflicts, ApplyConflict{Field: cause.Field, Message: cause.Message})
+		}
+	}
+
+	return applyConflictErr
+}
+
+// Error implements error.
+func (e *ApplyConflictError) Error() string {
+	if len(e.Conflicts) == 0 {
+		return fmt.Sprintf("could not apply %s %s: %v", e.GroupVersionKind.Kind, e.Key, e.err)
+	}
+
+	conflicts := make([]string, 0, len(e.Conflicts))
+	for _, conflict := range e.Conflicts {
+		conflicts = append(conflicts, fmt.Sprintf("%s (%s)", conflict.Field, conflict.Message))
+	}
+	return fmt.Sprintf("could not apply %s %s because of conflicting field managers: %s", e.GroupVersionKind.Kind, e.Key, strings.Join(conflicts, ", "))
+}
+
+// Cause returns the error returned by the API server.
+func (e *ApplyConflictError) Cause() error {
+	return e.err
+}
+
+// IsApplyConflict returns true if the given error is an ApplyConflictError.
+func IsApplyConflict(err error) bool {
+	_, ok := err.(*ApplyConflictError)
+	return ok
+}
+
 func (c *Applier) deleteObject(ctx context.Context, desired *unstructured.Unstructured) error {
 	if desired.GetNamespace() == "" {
 		desired.SetNamespace(metav1.NamespaceDefault)
@@ -124,6 +221,7 @@ func (c *Applier) deleteObject(ctx context.Context, desired *unstructured.Unstru
 
 // DefaultApplierOptions contains options for common k8s objects, e.g. Service, ServiceAccount.
 var DefaultApplierOptions = ApplierOptions{
+	Mode: ApplyModeUpdate,
 	MergeFuncs: map[schema.GroupKind]MergeFunc{
 		corev1.SchemeGroupVersion.WithKind("Service").GroupKind(): func(newObj, oldObj *unstructured.Unstructured) {
 			// We do not want to overwrite a Service's `.spec.clusterIP' or '.spec.ports[*].nodePort' values.
@@ -163,7 +261,10 @@ var DefaultApplierOptions = ApplierOptions{
 // CopyApplierOptions returns a copies of the provided applier options.
 func CopyApplierOptions(in ApplierOptions) ApplierOptions {
 	out := ApplierOptions{
-		MergeFuncs: make(map[schema.GroupKind]MergeFunc, len(in.MergeFuncs)),
+		MergeFuncs:     make(map[schema.GroupKind]MergeFunc, len(in.MergeFuncs)),
+		Mode:           in.Mode,
+		FieldManager:   in.FieldManager,
+		ForceConflicts: in.ForceConflicts,
 	}
 
 	for k, v := range in.MergeFuncs {
@@ -189,6 +290,8 @@ func (c *Applier) mergeObjects(newObj, oldObj *unstructured.Unstructured, mergeF
 // ApplyManifest is a function which does the same like `kubectl apply -f <file>`. It takes a bunch of manifests <m>,
 // all concatenated in a byte slice, and sends them one after the other to the API server. If a resource
 // already exists at the API server, it will update it. It returns an error as soon as the first error occurs.
+// If server-side apply is selected via the <options>, conflicts with other field managers are reported as
+// ApplyConflictError.
 func (c *Applier) ApplyManifest(ctx context.Context, r UnstructuredReader, options ApplierOptions) error {
 	for {
 		obj, err := r.Read()
@@ -18,10 +18,12 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"net/http"
 	"sync"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/ghodss/yaml"
@@ -30,6 +32,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/discovery"
 	memcache "k8s.io/client-go/discovery/cached/memory"
 	fakediscovery "k8s.io/client-go/discovery/fake"
@@ -82,6 +85,20 @@ func (c *fakeDiscovery) ServerGroups() (*metav1.APIGroupList, error) {
 	return groupList, nil
 }
 
+// patchInterceptingClient is a client.Client which hands server-side apply requests to the given function instead of
+// the fake client (which does not support them).
+type patchInterceptingClient struct {
+	client.Client
+	patchFn func(obj runtime.Object, patch client.Patch, options *client.PatchOptions) error
+}
+
+func (c *patchInterceptingClient) Patch(ctx context.Context, obj runtime.Object, patch client.Patch, opts ...client.PatchOptionFunc) error {
+	if patch.Type() != types.ApplyPatchType {
+		return c.Client.Patch(ctx, obj, patch, opts...)
+	}
+	return c.patchFn(obj, patch, (&client.PatchOptions{}).ApplyOptions(opts))
+}
+
 func newTestApplier(c client.Client, discovery discovery.DiscoveryInterface) *kubernetes.Applier {
 	tmp := kubernetes.NewControllerClient
 	defer func() {
@@ -257,6 +274,87 @@ spec:
 
 		})
 
+		Context("#ApplyManifest with server-side apply", func() {
+			var (
+				fakeClient client.Client
+				patches    int
+				options    kubernetes.ApplierOptions
+				cm         corev1.ConfigMap
+			)
+
+			BeforeEach(func() {
+				fakeClient = c
+				patches = 0
+				options = kubernetes.CopyApplierOptions(kubernetes.DefaultApplierOptions)
+				options.Mode = kubernetes.ApplyModeServerSide
+				cm = corev1.ConfigMap{
+					TypeMeta:   configMapTypeMeta,
+					ObjectMeta: metav1.ObjectMeta{Name: "c", Namespace: "n"},
+					Data:       map[string]string{"foo": "bar"},
+				}
+			})
+
+			apply := func(patchFn func(obj runtime.Object, patch client.Patch, options *client.PatchOptions) error) error {
+				applier = newTestApplier(&patchInterceptingClient{Client: fakeClient, patchFn: func(obj runtime.Object, patch client.Patch, options *client.PatchOptions) error {
+					patches++
+					return patchFn(obj, patch, options)
+				}}, d)
+				return applier.ApplyManifest(context.TODO(), kubernetes.NewManifestReader(mkManifest(&cm)), options)
+			}
+
+			It("should send server-side apply requests with the Gardener field manager", func() {
+				Expect(apply(func(obj runtime.Object, patch client.Patch, patchOptions *client.PatchOptions) error {
+					Expect(patchOptions.FieldManager).To(Equal(kubernetes.GardenerFieldManager))
+					Expect(patchOptions.Force).To(BeNil())
+					return nil
+				})).To(Succeed())
+				Expect(patches).To(Equal(1))
+			})
+
+			It("should force the ownership with a custom field manager if configured", func() {
+				options.FieldManager = "foo"
+				options.ForceConflicts = true
+
+				Expect(apply(func(obj runtime.Object, patch client.Patch, patchOptions *client.PatchOptions) error {
+					Expect(patchOptions.FieldManager).To(Equal("foo"))
+					Expect(patchOptions.Force).To(PointTo(BeTrue()))
+					return nil
+				})).To(Succeed())
+			})
+
+			It("should report conflicts with other field managers", func() {
+				err := apply(func(obj runtime.Object, patch client.Patch, patchOptions *client.PatchOptions) error {
+					return apierrors.NewApplyConflict([]metav1.StatusCause{{
+						Type:    metav1.CauseTypeFieldManagerConflict,
+						Message: `conflict with "kube-controller-manager"`,
+						Field:   ".data.foo",
+					}}, "conflict")
+				})
+
+				Expect(kubernetes.IsApplyConflict(err)).To(BeTrue())
+				Expect(err.(*kubernetes.ApplyConflictError).Conflicts).To(ConsistOf(kubernetes.ApplyConflict{Field: ".data.foo", Message: `conflict with "kube-controller-manager"`}))
+				Expect(err.Error()).To(ContainSubstring(`.data.foo (conflict with "kube-controller-manager")`))
+			})
+
+			It("should fall back to the update mode if the API server does not support server-side apply", func() {
+				unsupported := func(obj runtime.Object, patch client.Patch, patchOptions *client.PatchOptions) error {
+					return &apierrors.StatusError{ErrStatus: metav1.Status{Status: metav1.StatusFailure, Code: http.StatusUnsupportedMediaType, Reason: metav1.StatusReasonUnsupportedMediaType}}
+				}
+
+				Expect(apply(unsupported)).To(Succeed())
+
+				var actualCM corev1.ConfigMap
+				Expect(c.Get(context.TODO(), client.ObjectKey{Name: "c", Namespace: "n"}, &actualCM)).To(Succeed())
+				Expect(actualCM.Data).To(Equal(cm.Data))
+
+				cm.Data = map[string]string{"foo": "baz"}
+				Expect(applier.ApplyManifest(context.TODO(), kubernetes.NewManifestReader(mkManifest(&cm)), options)).To(Succeed())
+				Expect(c.Get(context.TODO(), client.ObjectKey{Name: "c", Namespace: "n"}, &actualCM)).To(Succeed())
+				Expect(actualCM.Data).To(Equal(cm.Data))
+				Expect(patches).To(Equal(1))
+			})
+		})
+
 		Context("#DeleteManifest", func() {
 			var (
 				result error
@@ -171,18 +171,46 @@ type Clientset struct {
 
 // Applier is a default implementation of the ApplyInterface. It applies objects with
 // by first checking whether they exist and then either creating / updating them (update happens
-// with a predefined merge logic).
+// with a predefined merge logic), or by using server-side apply (depending on the ApplierOptions).
 type Applier struct {
 	client    client.Client
 	discovery discovery.CachedDiscoveryInterface
+
+	// serverSideApplyUnsupported is set to 1 as soon as the API server rejected a server-side apply request
+	// because it does not support it. It must be accessed atomically.
+	serverSideApplyUnsupported int32
 }
 
 // MergeFunc determines how oldOj is merged into new oldObj.
 type MergeFunc func(newObj, oldObj *unstructured.Unstructured)
 
+// ApplyMode determines how the Applier applies objects.
+type ApplyMode string
+
+const (
+	// ApplyModeUpdate reads the current object, merges it with the desired object by using the configured
+	// MergeFuncs and updates it.
+	ApplyModeUpdate ApplyMode = "Update"
+	// ApplyModeServerSide uses server-side apply with the configured field manager. If the API server does not
+	// support server-side apply the Applier falls back to ApplyModeUpdate.
+	ApplyModeServerSide ApplyMode = "ServerSide"
+
+	// GardenerFieldManager is the default field manager used for server-side apply requests.
+	GardenerFieldManager = "gardener"
+)
+
 // ApplierOptions contains options used by the Applier.
 type ApplierOptions struct {
+	// MergeFuncs are the functions merging the current object into the desired object per GroupKind. They are
+	// only used in ApplyModeUpdate (or when falling back to it).
 	MergeFuncs map[schema.GroupKind]MergeFunc
+	// Mode is the mode used to apply objects. Defaults to ApplyModeUpdate.
+	Mode ApplyMode
+	// FieldManager is the field manager used for server-side apply requests. Defaults to GardenerFieldManager.
+	FieldManager string
+	// ForceConflicts makes server-side apply requests take over the ownership of fields managed by other field
+	// managers instead of reporting a conflict.
+	ForceConflicts bool
 }
 
 // ApplierInterface is an interface which describes declarative operations to apply multiple
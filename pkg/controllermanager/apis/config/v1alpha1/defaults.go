@@ -85,6 +85,11 @@ func SetDefaults_ControllerManagerConfiguration(obj *ControllerManagerConfigurat
 			ConcurrentSyncs: 5,
 		}
 	}
+	if obj.Controllers.ControllerInstallation.HealthSyncPeriod == nil {
+		obj.Controllers.ControllerInstallation.HealthSyncPeriod = &metav1.Duration{
+			Duration: time.Minute,
+		}
+	}
 	if obj.Controllers.SecretBinding == nil {
 		obj.Controllers.SecretBinding = &SecretBindingControllerConfiguration{
 			ConcurrentSyncs: 5,
@@ -95,6 +100,15 @@ func SetDefaults_ControllerManagerConfiguration(obj *ControllerManagerConfigurat
 			ConcurrentSyncs: 5,
 		}
 	}
+	if obj.Controllers.Project.StaleSyncPeriod == nil {
+		obj.Controllers.Project.StaleSyncPeriod = &metav1.Duration{Duration: 12 * time.Hour}
+	}
+	if obj.Controllers.Project.ServiceAccountTokenRotationPeriod == nil {
+		obj.Controllers.Project.ServiceAccountTokenRotationPeriod = &metav1.Duration{Duration: 30 * 24 * time.Hour}
+	}
+	if obj.Controllers.Project.ServiceAccountTokenGracePeriod == nil {
+		obj.Controllers.Project.ServiceAccountTokenGracePeriod = &metav1.Duration{Duration: 24 * time.Hour}
+	}
 	if obj.Controllers.Quota == nil {
 		obj.Controllers.Quota = &QuotaControllerConfiguration{
 			ConcurrentSyncs: 5,
@@ -120,10 +134,33 @@ func SetDefaults_ControllerManagerConfiguration(obj *ControllerManagerConfigurat
 		obj.Controllers.Shoot.RetrySyncPeriod = &durationVar
 	}
 
+	if obj.Controllers.ShootCare.ConditionHistoryLimit == nil {
+		v := DefaultShootConditionHistoryLimit
+		obj.Controllers.ShootCare.ConditionHistoryLimit = &v
+	}
+	if obj.Controllers.ShootCare.AvailabilityWindow == nil {
+		obj.Controllers.ShootCare.AvailabilityWindow = &metav1.Duration{Duration: DefaultShootAvailabilityWindow}
+	}
+
 	if obj.Controllers.BackupInfrastructure.DeletionGracePeriodHours == nil || *obj.Controllers.BackupInfrastructure.DeletionGracePeriodHours < 0 {
 		var defaultBackupInfrastructureDeletionGracePeriodHours = DefaultBackupInfrastructureDeletionGracePeriodHours
 		obj.Controllers.BackupInfrastructure.DeletionGracePeriodHours = &defaultBackupInfrastructureDeletionGracePeriodHours
 	}
+	if verification := obj.Controllers.BackupInfrastructure.Verification; verification != nil {
+		if verification.ConcurrentSyncs == 0 {
+			verification.ConcurrentSyncs = 5
+		}
+		if verification.SyncPeriod == nil {
+			verification.SyncPeriod = &metav1.Duration{Duration: 24 * time.Hour}
+		}
+		if verification.ConcurrentVerificationsPerSeed == nil {
+			v := 1
+			verification.ConcurrentVerificationsPerSeed = &v
+		}
+		if verification.Timeout == nil {
+			verification.Timeout = &metav1.Duration{Duration: 30 * time.Minute}
+		}
+	}
 
 	if obj.Controllers.Plant == nil {
 		obj.Controllers.Plant = &PlantConfiguration{
@@ -144,6 +181,29 @@ func SetDefaults_ControllerManagerConfiguration(obj *ControllerManagerConfigurat
 		}
 	}
 
+	if obj.NamespaceDeletionProtection == nil {
+		obj.NamespaceDeletionProtection = &NamespaceDeletionProtection{}
+	}
+	if obj.NamespaceDeletionProtection.ProtectedKinds == nil {
+		obj.NamespaceDeletionProtection.ProtectedKinds = DefaultNamespaceDeletionProtectedKinds()
+	}
+
+	if obj.Sharding != nil {
+		if len(obj.Sharding.LeaseNamespace) == 0 {
+			obj.Sharding.LeaseNamespace = ControllerManagerDefaultLockObjectNamespace
+		}
+		if obj.Sharding.LeaseDuration == nil {
+			obj.Sharding.LeaseDuration = &metav1.Duration{Duration: 40 * time.Second}
+		}
+		if obj.Sharding.RenewPeriod == nil {
+			obj.Sharding.RenewPeriod = &metav1.Duration{Duration: 10 * time.Second}
+		}
+		if obj.Sharding.VirtualNodes == nil {
+			virtualNodes := 100
+			obj.Sharding.VirtualNodes = &virtualNodes
+		}
+	}
+
 	if obj.Discovery.TTL == nil {
 		obj.Discovery.TTL = &metav1.Duration{Duration: DefaultDiscoveryTTL}
 	}
@@ -204,3 +264,14 @@ func SetDefaults_LeaderElectionConfiguration(obj *LeaderElectionConfiguration) {
 		obj.LockObjectName = ControllerManagerDefaultLockObjectName
 	}
 }
+
+// DefaultNamespaceDeletionProtectedKinds returns the kinds which are protected against namespace deletion by default.
+func DefaultNamespaceDeletionProtectedKinds() []ProtectedKind {
+	return []ProtectedKind{
+		{APIVersion: "garden.sapcloud.io/v1beta1", Kind: "BackupInfrastructure"},
+		{APIVersion: "core.gardener.cloud/v1alpha1", Kind: "Plant"},
+		{APIVersion: "garden.sapcloud.io/v1beta1", Kind: "SecretBinding"},
+		{APIVersion: "garden.sapcloud.io/v1beta1", Kind: "Shoot"},
+		{APIVersion: "v1", Kind: "Secret"},
+	}
+}
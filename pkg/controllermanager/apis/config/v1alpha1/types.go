@@ -45,6 +45,18 @@ type ControllerManagerConfiguration struct {
 	// ShootBackup contains configuration settings for the etcd backups.
 	// +optional
 	ShootBackup *ShootBackup `json:"shootBackup,omitempty"`
+	// NamespaceDeletionProtection contains configuration settings for the webhook protecting namespaces
+	// with Gardener-managed objects from being deleted.
+	// +optional
+	NamespaceDeletionProtection *NamespaceDeletionProtection `json:"namespaceDeletionProtection,omitempty"`
+	// SeedAgent contains configuration settings for the gardener-seed-agent. It must only be set for the
+	// gardener-seed-agent and is ignored by the Gardener controller manager.
+	// +optional
+	SeedAgent *SeedAgentConfiguration `json:"seedAgent,omitempty"`
+	// Sharding contains configuration settings for distributing the Shoot controllers across all running replicas
+	// of the Gardener controller manager.
+	// +optional
+	Sharding *ShardingConfiguration `json:"sharding,omitempty"`
 	// FeatureGates is a map of feature names to bools that enable or disable alpha/experimental
 	// features. This field modifies piecemeal the built-in default values from
 	// "github.com/gardener/gardener/pkg/features/gardener_features.go".
@@ -115,6 +127,10 @@ type ControllerInstallationControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on
 	// events.
 	ConcurrentSyncs int `json:"concurrentSyncs"`
+	// HealthSyncPeriod is the duration how often the health of the workloads of the
+	// installed controllers is checked.
+	// +optional
+	HealthSyncPeriod *metav1.Duration `json:"healthSyncPeriod,omitempty"`
 }
 
 // PlantConfiguration defines the configuration of the
@@ -141,6 +157,33 @@ type ProjectControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on
 	// events.
 	ConcurrentSyncs int `json:"concurrentSyncs"`
+	// StaleSyncPeriod is the duration how often the staleness of the projects is checked.
+	// +optional
+	StaleSyncPeriod *metav1.Duration `json:"staleSyncPeriod,omitempty"`
+	// StaleGracePeriodDays is the number of days without any activity in a project after which it is
+	// considered to be stale. If it is not set then projects are never considered to be stale.
+	// +optional
+	StaleGracePeriodDays *int `json:"staleGracePeriodDays,omitempty"`
+	// StaleExpirationDays is the number of days after which a stale project is deleted automatically if it
+	// does not contain any shoots, plants, or backup infrastructures anymore. As backup infrastructures are
+	// kept in the project namespace for their deletion grace period (see `DeletionGracePeriodHoursByPurpose`
+	// of the BackupInfrastructure controller), projects are never deleted before it has passed. If it is not
+	// set then stale projects are never deleted automatically.
+	// +optional
+	StaleExpirationDays *int `json:"staleExpirationDays,omitempty"`
+	// ServiceAccountTokenRotationPeriod is the duration after which the tokens of the project service accounts are
+	// rotated.
+	// +optional
+	ServiceAccountTokenRotationPeriod *metav1.Duration `json:"serviceAccountTokenRotationPeriod,omitempty"`
+	// ServiceAccountTokenGracePeriod is the duration for which the previous tokens of the project service accounts
+	// stay valid after a rotation, i.e., the time clients have to pick up the new kubeconfig.
+	// +optional
+	ServiceAccountTokenGracePeriod *metav1.Duration `json:"serviceAccountTokenGracePeriod,omitempty"`
+	// GardenAPIServerURL is the URL of the API server of the garden cluster which is written into the kubeconfigs of
+	// the project service accounts. If it is not set then the host of the client connection of the controller
+	// manager is used.
+	// +optional
+	GardenAPIServerURL *string `json:"gardenAPIServerURL,omitempty"`
 }
 
 // QuotaControllerConfiguration defines the configuration of the Quota controller.
@@ -204,6 +247,17 @@ type ShootCareControllerConfiguration struct {
 	// ConditionThresholds defines the condition threshold per condition type.
 	// +optional
 	ConditionThresholds []ConditionThreshold `json:"conditionThresholds,omitempty"`
+	// HealthChecks defines additional health checks which are performed for every Shoot. The checks can
+	// contribute to the existing conditions or define new conditions. Shoot owners may add or overwrite checks
+	// by referencing a ConfigMap with the "shoot.garden.sapcloud.io/health-checks" annotation.
+	// +optional
+	HealthChecks []HealthCheck `json:"healthChecks,omitempty"`
+	// ConditionHistoryLimit is the maximum number of condition transitions kept in the status of a Shoot.
+	// +optional
+	ConditionHistoryLimit *int `json:"conditionHistoryLimit,omitempty"`
+	// AvailabilityWindow is the time window the availability of the Shoot conditions is computed for.
+	// +optional
+	AvailabilityWindow *metav1.Duration `json:"availabilityWindow,omitempty"`
 }
 
 // ConditionThreshold defines the duration how long a flappy condition stays in progressing state.
@@ -214,6 +268,60 @@ type ConditionThreshold struct {
 	Duration metav1.Duration `json:"duration"`
 }
 
+// HealthCheck defines an additional health check performed by the ShootCare controller. Exactly one of
+// Workload, Endpoint or PromQL must be set.
+type HealthCheck struct {
+	// Name is the unique name of the health check. It is used in the messages of failed conditions.
+	Name string `json:"name"`
+	// Condition is the type of the condition the result of the health check is reported to. It may be one
+	// of the conditions maintained by Gardener (e.g. ControlPlaneHealthy) or a new condition type.
+	Condition string `json:"condition"`
+	// Workload checks the health of a workload in the Seed or Shoot cluster.
+	// +optional
+	Workload *WorkloadHealthCheck `json:"workload,omitempty"`
+	// Endpoint probes an HTTP(S) endpoint.
+	// +optional
+	Endpoint *EndpointHealthCheck `json:"endpoint,omitempty"`
+	// PromQL evaluates a query against the Prometheus of the Shoot.
+	// +optional
+	PromQL *PromQLHealthCheck `json:"promQL,omitempty"`
+}
+
+// WorkloadHealthCheck defines a health check for a Deployment, StatefulSet or DaemonSet.
+type WorkloadHealthCheck struct {
+	// Cluster is the cluster the workload is running in. Must be one of [seed,shoot].
+	Cluster string `json:"cluster"`
+	// Kind is the kind of the workload. Must be one of [Deployment,StatefulSet,DaemonSet].
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the workload. Defaults to the namespace of the Shoot in the Seed cluster
+	// and to kube-system in the Shoot cluster. Health checks supplied by Shoot owners must not set it for
+	// workloads in the Seed cluster.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+	// Name is the name of the workload.
+	Name string `json:"name"`
+}
+
+// EndpointHealthCheck defines a health check probing an HTTP(S) endpoint.
+type EndpointHealthCheck struct {
+	// URL is the HTTP(S) URL of the endpoint. Only endpoints resolving to public addresses are probed.
+	URL string `json:"url"`
+	// ExpectedStatusCodes are the status codes of a healthy endpoint. Defaults to [200].
+	// +optional
+	ExpectedStatusCodes []int `json:"expectedStatusCodes,omitempty"`
+	// Timeout is the timeout of the probe. Defaults to 10s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// PromQLHealthCheck defines a health check evaluating a PromQL query against the Prometheus of the Shoot.
+// The check fails if the query returns a non-empty result, i.e. the query has to select the unhealthy state
+// (similar to an alerting rule).
+type PromQLHealthCheck struct {
+	// Query is the PromQL query.
+	Query string `json:"query"`
+}
+
 // ShootMaintenanceControllerConfiguration defines the configuration of the
 // ShootMaintenance controller.
 type ShootMaintenanceControllerConfiguration struct {
@@ -255,6 +363,27 @@ type BackupInfrastructureControllerConfiguration struct {
 	// DeletionGracePeriodHoursByPurpose holds various shoot purposes mapped to the respective deletion grace periods in hours for the backup infrastructure associated with the shoot
 	// +optional
 	DeletionGracePeriodHoursByPurpose map[string]int `json:"deletionGracePeriodHoursByPurpose,omitempty"`
+	// Verification defines the configuration of the periodic verification of the backups. If it is not set then
+	// the backups are not verified.
+	// +optional
+	Verification *BackupInfrastructureVerificationConfiguration `json:"verification,omitempty"`
+}
+
+// BackupInfrastructureVerificationConfiguration defines the configuration of the periodic verification of the
+// backups of BackupInfrastructures. A verification restores the latest snapshot into a temporary etcd in the seed
+// and checks its consistency.
+type BackupInfrastructureVerificationConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	ConcurrentSyncs int `json:"concurrentSyncs"`
+	// SyncPeriod is the duration how often the backups of a BackupInfrastructure are verified.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// ConcurrentVerificationsPerSeed is the maximum number of verifications running in the same seed at a time.
+	// +optional
+	ConcurrentVerificationsPerSeed *int `json:"concurrentVerificationsPerSeed,omitempty"`
+	// Timeout is the duration after which a verification which has not completed is considered to have failed.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
 }
 
 // DiscoveryConfiguration defines the configuration of how to discover API groups.
@@ -315,6 +444,52 @@ type TLSServer struct {
 	ServerKeyPath string `json:"serverKeyPath"`
 }
 
+// NamespaceDeletionProtection holds information about the namespace deletion protection.
+type NamespaceDeletionProtection struct {
+	// ProtectedKinds is the list of kinds whose objects prevent the deletion of their namespace as long as they carry
+	// a Gardener finalizer.
+	// +optional
+	ProtectedKinds []ProtectedKind `json:"protectedKinds,omitempty"`
+}
+
+// ProtectedKind identifies a kind of objects that are protected against namespace deletion.
+type ProtectedKind struct {
+	// APIVersion is the API version of the kind, e.g. garden.sapcloud.io/v1beta1.
+	APIVersion string `json:"apiVersion"`
+	// Kind is the name of the kind, e.g. SecretBinding.
+	Kind string `json:"kind"`
+}
+
+// SeedAgentConfiguration holds information about the gardener-seed-agent.
+type SeedAgentConfiguration struct {
+	// SeedName is the name of the Seed the agent is responsible for.
+	SeedName string `json:"seedName"`
+	// GardenSecretNames are the names of the secrets in the garden namespace which are required for reconciling the
+	// Shoots, e.g., the internal and default domain secrets. The agent cannot list the secrets in the garden namespace,
+	// hence, it reads them by name.
+	// +optional
+	GardenSecretNames []string `json:"gardenSecretNames,omitempty"`
+}
+
+// ShardingConfiguration defines the configuration of the sharding of the Shoot controllers.
+type ShardingConfiguration struct {
+	// Enabled defines whether the Shoots are distributed across all running replicas. If enabled, the Shoot controllers
+	// run on every replica while the remaining controllers are still only run by the leader.
+	Enabled bool `json:"enabled"`
+	// LeaseNamespace is the namespace in which the replicas maintain their membership Leases.
+	// +optional
+	LeaseNamespace string `json:"leaseNamespace,omitempty"`
+	// LeaseDuration is the duration after which a replica which did not renew its Lease is considered to be gone.
+	// +optional
+	LeaseDuration *metav1.Duration `json:"leaseDuration,omitempty"`
+	// RenewPeriod is the period in which the replicas renew their Leases and refresh the list of members.
+	// +optional
+	RenewPeriod *metav1.Duration `json:"renewPeriod,omitempty"`
+	// VirtualNodes is the number of points every replica occupies on the consistent hash ring.
+	// +optional
+	VirtualNodes *int `json:"virtualNodes,omitempty"`
+}
+
 // ShootBackup holds information about backup settings.
 type ShootBackup struct {
 	// Schedule defines the cron schedule according to which a backup is taken from etcd.
@@ -337,4 +512,10 @@ const (
 
 	// DefaultDiscoveryTTL is the default ttl for the cached discovery client.
 	DefaultDiscoveryTTL = 10 * time.Second
+
+	// DefaultShootConditionHistoryLimit is the default number of condition transitions kept in the status of a Shoot.
+	DefaultShootConditionHistoryLimit = 100
+
+	// DefaultShootAvailabilityWindow is the default time window the availability of the Shoot conditions is computed for.
+	DefaultShootAvailabilityWindow = 30 * 24 * time.Hour
 )
@@ -48,6 +48,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BackupInfrastructureVerificationConfiguration)(nil), (*config.BackupInfrastructureVerificationConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupInfrastructureVerificationConfiguration_To_config_BackupInfrastructureVerificationConfiguration(a.(*BackupInfrastructureVerificationConfiguration), b.(*config.BackupInfrastructureVerificationConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.BackupInfrastructureVerificationConfiguration)(nil), (*BackupInfrastructureVerificationConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_BackupInfrastructureVerificationConfiguration_To_v1alpha1_BackupInfrastructureVerificationConfiguration(a.(*config.BackupInfrastructureVerificationConfiguration), b.(*BackupInfrastructureVerificationConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudProfileControllerConfiguration)(nil), (*config.CloudProfileControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudProfileControllerConfiguration_To_config_CloudProfileControllerConfiguration(a.(*CloudProfileControllerConfiguration), b.(*config.CloudProfileControllerConfiguration), scope)
 	}); err != nil {
@@ -118,6 +128,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EndpointHealthCheck)(nil), (*config.EndpointHealthCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EndpointHealthCheck_To_config_EndpointHealthCheck(a.(*EndpointHealthCheck), b.(*config.EndpointHealthCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.EndpointHealthCheck)(nil), (*EndpointHealthCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_EndpointHealthCheck_To_v1alpha1_EndpointHealthCheck(a.(*config.EndpointHealthCheck), b.(*EndpointHealthCheck), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HTTPSServer)(nil), (*config.HTTPSServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_HTTPSServer_To_config_HTTPSServer(a.(*HTTPSServer), b.(*config.HTTPSServer), scope)
 	}); err != nil {
@@ -128,6 +148,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HealthCheck)(nil), (*config.HealthCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HealthCheck_To_config_HealthCheck(a.(*HealthCheck), b.(*config.HealthCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.HealthCheck)(nil), (*HealthCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_HealthCheck_To_v1alpha1_HealthCheck(a.(*config.HealthCheck), b.(*HealthCheck), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*LeaderElectionConfiguration)(nil), (*config.LeaderElectionConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_LeaderElectionConfiguration_To_config_LeaderElectionConfiguration(a.(*LeaderElectionConfiguration), b.(*config.LeaderElectionConfiguration), scope)
 	}); err != nil {
@@ -138,6 +168,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceDeletionProtection)(nil), (*config.NamespaceDeletionProtection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NamespaceDeletionProtection_To_config_NamespaceDeletionProtection(a.(*NamespaceDeletionProtection), b.(*config.NamespaceDeletionProtection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.NamespaceDeletionProtection)(nil), (*NamespaceDeletionProtection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_NamespaceDeletionProtection_To_v1alpha1_NamespaceDeletionProtection(a.(*config.NamespaceDeletionProtection), b.(*NamespaceDeletionProtection), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PlantConfiguration)(nil), (*config.PlantConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_PlantConfiguration_To_config_PlantConfiguration(a.(*PlantConfiguration), b.(*config.PlantConfiguration), scope)
 	}); err != nil {
@@ -158,6 +198,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PromQLHealthCheck)(nil), (*config.PromQLHealthCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PromQLHealthCheck_To_config_PromQLHealthCheck(a.(*PromQLHealthCheck), b.(*config.PromQLHealthCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.PromQLHealthCheck)(nil), (*PromQLHealthCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_PromQLHealthCheck_To_v1alpha1_PromQLHealthCheck(a.(*config.PromQLHealthCheck), b.(*PromQLHealthCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProtectedKind)(nil), (*config.ProtectedKind)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ProtectedKind_To_config_ProtectedKind(a.(*ProtectedKind), b.(*config.ProtectedKind), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ProtectedKind)(nil), (*ProtectedKind)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ProtectedKind_To_v1alpha1_ProtectedKind(a.(*config.ProtectedKind), b.(*ProtectedKind), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*QuotaControllerConfiguration)(nil), (*config.QuotaControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_QuotaControllerConfiguration_To_config_QuotaControllerConfiguration(a.(*QuotaControllerConfiguration), b.(*config.QuotaControllerConfiguration), scope)
 	}); err != nil {
@@ -178,6 +238,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedAgentConfiguration)(nil), (*config.SeedAgentConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedAgentConfiguration_To_config_SeedAgentConfiguration(a.(*SeedAgentConfiguration), b.(*config.SeedAgentConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SeedAgentConfiguration)(nil), (*SeedAgentConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SeedAgentConfiguration_To_v1alpha1_SeedAgentConfiguration(a.(*config.SeedAgentConfiguration), b.(*SeedAgentConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedControllerConfiguration)(nil), (*config.SeedControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SeedControllerConfiguration_To_config_SeedControllerConfiguration(a.(*SeedControllerConfiguration), b.(*config.SeedControllerConfiguration), scope)
 	}); err != nil {
@@ -208,6 +278,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShardingConfiguration)(nil), (*config.ShardingConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShardingConfiguration_To_config_ShardingConfiguration(a.(*ShardingConfiguration), b.(*config.ShardingConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShardingConfiguration)(nil), (*ShardingConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShardingConfiguration_To_v1alpha1_ShardingConfiguration(a.(*config.ShardingConfiguration), b.(*ShardingConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootBackup)(nil), (*config.ShootBackup)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootBackup_To_config_ShootBackup(a.(*ShootBackup), b.(*config.ShootBackup), scope)
 	}); err != nil {
@@ -278,6 +358,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkloadHealthCheck)(nil), (*config.WorkloadHealthCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkloadHealthCheck_To_config_WorkloadHealthCheck(a.(*WorkloadHealthCheck), b.(*config.WorkloadHealthCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.WorkloadHealthCheck)(nil), (*WorkloadHealthCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_WorkloadHealthCheck_To_v1alpha1_WorkloadHealthCheck(a.(*config.WorkloadHealthCheck), b.(*WorkloadHealthCheck), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -286,6 +376,7 @@ func autoConvert_v1alpha1_BackupInfrastructureControllerConfiguration_To_config_
 	out.SyncPeriod = in.SyncPeriod
 	out.DeletionGracePeriodHours = (*int)(unsafe.Pointer(in.DeletionGracePeriodHours))
 	out.DeletionGracePeriodHoursByPurpose = *(*map[string]int)(unsafe.Pointer(&in.DeletionGracePeriodHoursByPurpose))
+	out.Verification = (*config.BackupInfrastructureVerificationConfiguration)(unsafe.Pointer(in.Verification))
 	return nil
 }
 
@@ -299,6 +390,7 @@ func autoConvert_config_BackupInfrastructureControllerConfiguration_To_v1alpha1_
 	out.SyncPeriod = in.SyncPeriod
 	out.DeletionGracePeriodHours = (*int)(unsafe.Pointer(in.DeletionGracePeriodHours))
 	out.DeletionGracePeriodHoursByPurpose = *(*map[string]int)(unsafe.Pointer(&in.DeletionGracePeriodHoursByPurpose))
+	out.Verification = (*BackupInfrastructureVerificationConfiguration)(unsafe.Pointer(in.Verification))
 	return nil
 }
 
@@ -307,6 +399,32 @@ func Convert_config_BackupInfrastructureControllerConfiguration_To_v1alpha1_Back
 	return autoConvert_config_BackupInfrastructureControllerConfiguration_To_v1alpha1_BackupInfrastructureControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_BackupInfrastructureVerificationConfiguration_To_config_BackupInfrastructureVerificationConfiguration(in *BackupInfrastructureVerificationConfiguration, out *config.BackupInfrastructureVerificationConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.ConcurrentVerificationsPerSeed = (*int)(unsafe.Pointer(in.ConcurrentVerificationsPerSeed))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1alpha1_BackupInfrastructureVerificationConfiguration_To_config_BackupInfrastructureVerificationConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_BackupInfrastructureVerificationConfiguration_To_config_BackupInfrastructureVerificationConfiguration(in *BackupInfrastructureVerificationConfiguration, out *config.BackupInfrastructureVerificationConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupInfrastructureVerificationConfiguration_To_config_BackupInfrastructureVerificationConfiguration(in, out, s)
+}
+
+func autoConvert_config_BackupInfrastructureVerificationConfiguration_To_v1alpha1_BackupInfrastructureVerificationConfiguration(in *config.BackupInfrastructureVerificationConfiguration, out *BackupInfrastructureVerificationConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.ConcurrentVerificationsPerSeed = (*int)(unsafe.Pointer(in.ConcurrentVerificationsPerSeed))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_config_BackupInfrastructureVerificationConfiguration_To_v1alpha1_BackupInfrastructureVerificationConfiguration is an autogenerated conversion function.
+func Convert_config_BackupInfrastructureVerificationConfiguration_To_v1alpha1_BackupInfrastructureVerificationConfiguration(in *config.BackupInfrastructureVerificationConfiguration, out *BackupInfrastructureVerificationConfiguration, s conversion.Scope) error {
+	return autoConvert_config_BackupInfrastructureVerificationConfiguration_To_v1alpha1_BackupInfrastructureVerificationConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudProfileControllerConfiguration_To_config_CloudProfileControllerConfiguration(in *CloudProfileControllerConfiguration, out *config.CloudProfileControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	return nil
@@ -351,6 +469,7 @@ func Convert_config_ConditionThreshold_To_v1alpha1_ConditionThreshold(in *config
 
 func autoConvert_v1alpha1_ControllerInstallationControllerConfiguration_To_config_ControllerInstallationControllerConfiguration(in *ControllerInstallationControllerConfiguration, out *config.ControllerInstallationControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.HealthSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.HealthSyncPeriod))
 	return nil
 }
 
@@ -361,6 +480,7 @@ func Convert_v1alpha1_ControllerInstallationControllerConfiguration_To_config_Co
 
 func autoConvert_config_ControllerInstallationControllerConfiguration_To_v1alpha1_ControllerInstallationControllerConfiguration(in *config.ControllerInstallationControllerConfiguration, out *ControllerInstallationControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.HealthSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.HealthSyncPeriod))
 	return nil
 }
 
@@ -388,6 +508,9 @@ func autoConvert_v1alpha1_ControllerManagerConfiguration_To_config_ControllerMan
 		return err
 	}
 	out.ShootBackup = (*config.ShootBackup)(unsafe.Pointer(in.ShootBackup))
+	out.NamespaceDeletionProtection = (*config.NamespaceDeletionProtection)(unsafe.Pointer(in.NamespaceDeletionProtection))
+	out.SeedAgent = (*config.SeedAgentConfiguration)(unsafe.Pointer(in.SeedAgent))
+	out.Sharding = (*config.ShardingConfiguration)(unsafe.Pointer(in.Sharding))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	return nil
 }
@@ -416,6 +539,9 @@ func autoConvert_config_ControllerManagerConfiguration_To_v1alpha1_ControllerMan
 		return err
 	}
 	out.ShootBackup = (*ShootBackup)(unsafe.Pointer(in.ShootBackup))
+	out.NamespaceDeletionProtection = (*NamespaceDeletionProtection)(unsafe.Pointer(in.NamespaceDeletionProtection))
+	out.SeedAgent = (*SeedAgentConfiguration)(unsafe.Pointer(in.SeedAgent))
+	out.Sharding = (*ShardingConfiguration)(unsafe.Pointer(in.Sharding))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	return nil
 }
@@ -539,6 +665,30 @@ func Convert_config_DiscoveryConfiguration_To_v1alpha1_DiscoveryConfiguration(in
 	return autoConvert_config_DiscoveryConfiguration_To_v1alpha1_DiscoveryConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_EndpointHealthCheck_To_config_EndpointHealthCheck(in *EndpointHealthCheck, out *config.EndpointHealthCheck, s conversion.Scope) error {
+	out.URL = in.URL
+	out.ExpectedStatusCodes = *(*[]int)(unsafe.Pointer(&in.ExpectedStatusCodes))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1alpha1_EndpointHealthCheck_To_config_EndpointHealthCheck is an autogenerated conversion function.
+func Convert_v1alpha1_EndpointHealthCheck_To_config_EndpointHealthCheck(in *EndpointHealthCheck, out *config.EndpointHealthCheck, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EndpointHealthCheck_To_config_EndpointHealthCheck(in, out, s)
+}
+
+func autoConvert_config_EndpointHealthCheck_To_v1alpha1_EndpointHealthCheck(in *config.EndpointHealthCheck, out *EndpointHealthCheck, s conversion.Scope) error {
+	out.URL = in.URL
+	out.ExpectedStatusCodes = *(*[]int)(unsafe.Pointer(&in.ExpectedStatusCodes))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_config_EndpointHealthCheck_To_v1alpha1_EndpointHealthCheck is an autogenerated conversion function.
+func Convert_config_EndpointHealthCheck_To_v1alpha1_EndpointHealthCheck(in *config.EndpointHealthCheck, out *EndpointHealthCheck, s conversion.Scope) error {
+	return autoConvert_config_EndpointHealthCheck_To_v1alpha1_EndpointHealthCheck(in, out, s)
+}
+
 func autoConvert_v1alpha1_HTTPSServer_To_config_HTTPSServer(in *HTTPSServer, out *config.HTTPSServer, s conversion.Scope) error {
 	if err := Convert_v1alpha1_Server_To_config_Server(&in.Server, &out.Server, s); err != nil {
 		return err
@@ -569,6 +719,34 @@ func Convert_config_HTTPSServer_To_v1alpha1_HTTPSServer(in *config.HTTPSServer,
 	return autoConvert_config_HTTPSServer_To_v1alpha1_HTTPSServer(in, out, s)
 }
 
+func autoConvert_v1alpha1_HealthCheck_To_config_HealthCheck(in *HealthCheck, out *config.HealthCheck, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Condition = in.Condition
+	out.Workload = (*config.WorkloadHealthCheck)(unsafe.Pointer(in.Workload))
+	out.Endpoint = (*config.EndpointHealthCheck)(unsafe.Pointer(in.Endpoint))
+	out.PromQL = (*config.PromQLHealthCheck)(unsafe.Pointer(in.PromQL))
+	return nil
+}
+
+// Convert_v1alpha1_HealthCheck_To_config_HealthCheck is an autogenerated conversion function.
+func Convert_v1alpha1_HealthCheck_To_config_HealthCheck(in *HealthCheck, out *config.HealthCheck, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HealthCheck_To_config_HealthCheck(in, out, s)
+}
+
+func autoConvert_config_HealthCheck_To_v1alpha1_HealthCheck(in *config.HealthCheck, out *HealthCheck, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Condition = in.Condition
+	out.Workload = (*WorkloadHealthCheck)(unsafe.Pointer(in.Workload))
+	out.Endpoint = (*EndpointHealthCheck)(unsafe.Pointer(in.Endpoint))
+	out.PromQL = (*PromQLHealthCheck)(unsafe.Pointer(in.PromQL))
+	return nil
+}
+
+// Convert_config_HealthCheck_To_v1alpha1_HealthCheck is an autogenerated conversion function.
+func Convert_config_HealthCheck_To_v1alpha1_HealthCheck(in *config.HealthCheck, out *HealthCheck, s conversion.Scope) error {
+	return autoConvert_config_HealthCheck_To_v1alpha1_HealthCheck(in, out, s)
+}
+
 func autoConvert_v1alpha1_LeaderElectionConfiguration_To_config_LeaderElectionConfiguration(in *LeaderElectionConfiguration, out *config.LeaderElectionConfiguration, s conversion.Scope) error {
 	if err := configv1alpha1.Convert_v1alpha1_LeaderElectionConfiguration_To_config_LeaderElectionConfiguration(&in.LeaderElectionConfiguration, &out.LeaderElectionConfiguration, s); err != nil {
 		return err
@@ -597,6 +775,26 @@ func Convert_config_LeaderElectionConfiguration_To_v1alpha1_LeaderElectionConfig
 	return autoConvert_config_LeaderElectionConfiguration_To_v1alpha1_LeaderElectionConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_NamespaceDeletionProtection_To_config_NamespaceDeletionProtection(in *NamespaceDeletionProtection, out *config.NamespaceDeletionProtection, s conversion.Scope) error {
+	out.ProtectedKinds = *(*[]config.ProtectedKind)(unsafe.Pointer(&in.ProtectedKinds))
+	return nil
+}
+
+// Convert_v1alpha1_NamespaceDeletionProtection_To_config_NamespaceDeletionProtection is an autogenerated conversion function.
+func Convert_v1alpha1_NamespaceDeletionProtection_To_config_NamespaceDeletionProtection(in *NamespaceDeletionProtection, out *config.NamespaceDeletionProtection, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NamespaceDeletionProtection_To_config_NamespaceDeletionProtection(in, out, s)
+}
+
+func autoConvert_config_NamespaceDeletionProtection_To_v1alpha1_NamespaceDeletionProtection(in *config.NamespaceDeletionProtection, out *NamespaceDeletionProtection, s conversion.Scope) error {
+	out.ProtectedKinds = *(*[]ProtectedKind)(unsafe.Pointer(&in.ProtectedKinds))
+	return nil
+}
+
+// Convert_config_NamespaceDeletionProtection_To_v1alpha1_NamespaceDeletionProtection is an autogenerated conversion function.
+func Convert_config_NamespaceDeletionProtection_To_v1alpha1_NamespaceDeletionProtection(in *config.NamespaceDeletionProtection, out *NamespaceDeletionProtection, s conversion.Scope) error {
+	return autoConvert_config_NamespaceDeletionProtection_To_v1alpha1_NamespaceDeletionProtection(in, out, s)
+}
+
 func autoConvert_v1alpha1_PlantConfiguration_To_config_PlantConfiguration(in *PlantConfiguration, out *config.PlantConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.SyncPeriod = in.SyncPeriod
@@ -621,6 +819,12 @@ func Convert_config_PlantConfiguration_To_v1alpha1_PlantConfiguration(in *config
 
 func autoConvert_v1alpha1_ProjectControllerConfiguration_To_config_ProjectControllerConfiguration(in *ProjectControllerConfiguration, out *config.ProjectControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.StaleSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.StaleSyncPeriod))
+	out.StaleGracePeriodDays = (*int)(unsafe.Pointer(in.StaleGracePeriodDays))
+	out.StaleExpirationDays = (*int)(unsafe.Pointer(in.StaleExpirationDays))
+	out.ServiceAccountTokenRotationPeriod = (*v1.Duration)(unsafe.Pointer(in.ServiceAccountTokenRotationPeriod))
+	out.ServiceAccountTokenGracePeriod = (*v1.Duration)(unsafe.Pointer(in.ServiceAccountTokenGracePeriod))
+	out.GardenAPIServerURL = (*string)(unsafe.Pointer(in.GardenAPIServerURL))
 	return nil
 }
 
@@ -631,6 +835,12 @@ func Convert_v1alpha1_ProjectControllerConfiguration_To_config_ProjectController
 
 func autoConvert_config_ProjectControllerConfiguration_To_v1alpha1_ProjectControllerConfiguration(in *config.ProjectControllerConfiguration, out *ProjectControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.StaleSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.StaleSyncPeriod))
+	out.StaleGracePeriodDays = (*int)(unsafe.Pointer(in.StaleGracePeriodDays))
+	out.StaleExpirationDays = (*int)(unsafe.Pointer(in.StaleExpirationDays))
+	out.ServiceAccountTokenRotationPeriod = (*v1.Duration)(unsafe.Pointer(in.ServiceAccountTokenRotationPeriod))
+	out.ServiceAccountTokenGracePeriod = (*v1.Duration)(unsafe.Pointer(in.ServiceAccountTokenGracePeriod))
+	out.GardenAPIServerURL = (*string)(unsafe.Pointer(in.GardenAPIServerURL))
 	return nil
 }
 
@@ -639,6 +849,48 @@ func Convert_config_ProjectControllerConfiguration_To_v1alpha1_ProjectController
 	return autoConvert_config_ProjectControllerConfiguration_To_v1alpha1_ProjectControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_PromQLHealthCheck_To_config_PromQLHealthCheck(in *PromQLHealthCheck, out *config.PromQLHealthCheck, s conversion.Scope) error {
+	out.Query = in.Query
+	return nil
+}
+
+// Convert_v1alpha1_PromQLHealthCheck_To_config_PromQLHealthCheck is an autogenerated conversion function.
+func Convert_v1alpha1_PromQLHealthCheck_To_config_PromQLHealthCheck(in *PromQLHealthCheck, out *config.PromQLHealthCheck, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PromQLHealthCheck_To_config_PromQLHealthCheck(in, out, s)
+}
+
+func autoConvert_config_PromQLHealthCheck_To_v1alpha1_PromQLHealthCheck(in *config.PromQLHealthCheck, out *PromQLHealthCheck, s conversion.Scope) error {
+	out.Query = in.Query
+	return nil
+}
+
+// Convert_config_PromQLHealthCheck_To_v1alpha1_PromQLHealthCheck is an autogenerated conversion function.
+func Convert_config_PromQLHealthCheck_To_v1alpha1_PromQLHealthCheck(in *config.PromQLHealthCheck, out *PromQLHealthCheck, s conversion.Scope) error {
+	return autoConvert_config_PromQLHealthCheck_To_v1alpha1_PromQLHealthCheck(in, out, s)
+}
+
+func autoConvert_v1alpha1_ProtectedKind_To_config_ProtectedKind(in *ProtectedKind, out *config.ProtectedKind, s conversion.Scope) error {
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	return nil
+}
+
+// Convert_v1alpha1_ProtectedKind_To_config_ProtectedKind is an autogenerated conversion function.
+func Convert_v1alpha1_ProtectedKind_To_config_ProtectedKind(in *ProtectedKind, out *config.ProtectedKind, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ProtectedKind_To_config_ProtectedKind(in, out, s)
+}
+
+func autoConvert_config_ProtectedKind_To_v1alpha1_ProtectedKind(in *config.ProtectedKind, out *ProtectedKind, s conversion.Scope) error {
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	return nil
+}
+
+// Convert_config_ProtectedKind_To_v1alpha1_ProtectedKind is an autogenerated conversion function.
+func Convert_config_ProtectedKind_To_v1alpha1_ProtectedKind(in *config.ProtectedKind, out *ProtectedKind, s conversion.Scope) error {
+	return autoConvert_config_ProtectedKind_To_v1alpha1_ProtectedKind(in, out, s)
+}
+
 func autoConvert_v1alpha1_QuotaControllerConfiguration_To_config_QuotaControllerConfiguration(in *QuotaControllerConfiguration, out *config.QuotaControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	return nil
@@ -679,6 +931,28 @@ func Convert_config_SecretBindingControllerConfiguration_To_v1alpha1_SecretBindi
 	return autoConvert_config_SecretBindingControllerConfiguration_To_v1alpha1_SecretBindingControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_SeedAgentConfiguration_To_config_SeedAgentConfiguration(in *SeedAgentConfiguration, out *config.SeedAgentConfiguration, s conversion.Scope) error {
+	out.SeedName = in.SeedName
+	out.GardenSecretNames = *(*[]string)(unsafe.Pointer(&in.GardenSecretNames))
+	return nil
+}
+
+// Convert_v1alpha1_SeedAgentConfiguration_To_config_SeedAgentConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SeedAgentConfiguration_To_config_SeedAgentConfiguration(in *SeedAgentConfiguration, out *config.SeedAgentConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedAgentConfiguration_To_config_SeedAgentConfiguration(in, out, s)
+}
+
+func autoConvert_config_SeedAgentConfiguration_To_v1alpha1_SeedAgentConfiguration(in *config.SeedAgentConfiguration, out *SeedAgentConfiguration, s conversion.Scope) error {
+	out.SeedName = in.SeedName
+	out.GardenSecretNames = *(*[]string)(unsafe.Pointer(&in.GardenSecretNames))
+	return nil
+}
+
+// Convert_config_SeedAgentConfiguration_To_v1alpha1_SeedAgentConfiguration is an autogenerated conversion function.
+func Convert_config_SeedAgentConfiguration_To_v1alpha1_SeedAgentConfiguration(in *config.SeedAgentConfiguration, out *SeedAgentConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SeedAgentConfiguration_To_v1alpha1_SeedAgentConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_SeedControllerConfiguration_To_config_SeedControllerConfiguration(in *SeedControllerConfiguration, out *config.SeedControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.ReserveExcessCapacity = (*bool)(unsafe.Pointer(in.ReserveExcessCapacity))
@@ -755,6 +1029,34 @@ func Convert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in *conf
 	return autoConvert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShardingConfiguration_To_config_ShardingConfiguration(in *ShardingConfiguration, out *config.ShardingConfiguration, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.LeaseNamespace = in.LeaseNamespace
+	out.LeaseDuration = (*v1.Duration)(unsafe.Pointer(in.LeaseDuration))
+	out.RenewPeriod = (*v1.Duration)(unsafe.Pointer(in.RenewPeriod))
+	out.VirtualNodes = (*int)(unsafe.Pointer(in.VirtualNodes))
+	return nil
+}
+
+// Convert_v1alpha1_ShardingConfiguration_To_config_ShardingConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShardingConfiguration_To_config_ShardingConfiguration(in *ShardingConfiguration, out *config.ShardingConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShardingConfiguration_To_config_ShardingConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShardingConfiguration_To_v1alpha1_ShardingConfiguration(in *config.ShardingConfiguration, out *ShardingConfiguration, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.LeaseNamespace = in.LeaseNamespace
+	out.LeaseDuration = (*v1.Duration)(unsafe.Pointer(in.LeaseDuration))
+	out.RenewPeriod = (*v1.Duration)(unsafe.Pointer(in.RenewPeriod))
+	out.VirtualNodes = (*int)(unsafe.Pointer(in.VirtualNodes))
+	return nil
+}
+
+// Convert_config_ShardingConfiguration_To_v1alpha1_ShardingConfiguration is an autogenerated conversion function.
+func Convert_config_ShardingConfiguration_To_v1alpha1_ShardingConfiguration(in *config.ShardingConfiguration, out *ShardingConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShardingConfiguration_To_v1alpha1_ShardingConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootBackup_To_config_ShootBackup(in *ShootBackup, out *config.ShootBackup, s conversion.Scope) error {
 	out.Schedule = in.Schedule
 	return nil
@@ -779,6 +1081,9 @@ func autoConvert_v1alpha1_ShootCareControllerConfiguration_To_config_ShootCareCo
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.SyncPeriod = in.SyncPeriod
 	out.ConditionThresholds = *(*[]config.ConditionThreshold)(unsafe.Pointer(&in.ConditionThresholds))
+	out.HealthChecks = *(*[]config.HealthCheck)(unsafe.Pointer(&in.HealthChecks))
+	out.ConditionHistoryLimit = (*int)(unsafe.Pointer(in.ConditionHistoryLimit))
+	out.AvailabilityWindow = (*v1.Duration)(unsafe.Pointer(in.AvailabilityWindow))
 	return nil
 }
 
@@ -791,6 +1096,9 @@ func autoConvert_config_ShootCareControllerConfiguration_To_v1alpha1_ShootCareCo
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.SyncPeriod = in.SyncPeriod
 	out.ConditionThresholds = *(*[]ConditionThreshold)(unsafe.Pointer(&in.ConditionThresholds))
+	out.HealthChecks = *(*[]HealthCheck)(unsafe.Pointer(&in.HealthChecks))
+	out.ConditionHistoryLimit = (*int)(unsafe.Pointer(in.ConditionHistoryLimit))
+	out.AvailabilityWindow = (*v1.Duration)(unsafe.Pointer(in.AvailabilityWindow))
 	return nil
 }
 
@@ -912,3 +1220,29 @@ func autoConvert_config_TLSServer_To_v1alpha1_TLSServer(in *config.TLSServer, ou
 func Convert_config_TLSServer_To_v1alpha1_TLSServer(in *config.TLSServer, out *TLSServer, s conversion.Scope) error {
 	return autoConvert_config_TLSServer_To_v1alpha1_TLSServer(in, out, s)
 }
+
+func autoConvert_v1alpha1_WorkloadHealthCheck_To_config_WorkloadHealthCheck(in *WorkloadHealthCheck, out *config.WorkloadHealthCheck, s conversion.Scope) error {
+	out.Cluster = in.Cluster
+	out.Kind = in.Kind
+	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_WorkloadHealthCheck_To_config_WorkloadHealthCheck is an autogenerated conversion function.
+func Convert_v1alpha1_WorkloadHealthCheck_To_config_WorkloadHealthCheck(in *WorkloadHealthCheck, out *config.WorkloadHealthCheck, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkloadHealthCheck_To_config_WorkloadHealthCheck(in, out, s)
+}
+
+func autoConvert_config_WorkloadHealthCheck_To_v1alpha1_WorkloadHealthCheck(in *config.WorkloadHealthCheck, out *WorkloadHealthCheck, s conversion.Scope) error {
+	out.Cluster = in.Cluster
+	out.Kind = in.Kind
+	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_config_WorkloadHealthCheck_To_v1alpha1_WorkloadHealthCheck is an autogenerated conversion function.
+func Convert_config_WorkloadHealthCheck_To_v1alpha1_WorkloadHealthCheck(in *config.WorkloadHealthCheck, out *WorkloadHealthCheck, s conversion.Scope) error {
+	return autoConvert_config_WorkloadHealthCheck_To_v1alpha1_WorkloadHealthCheck(in, out, s)
+}
@@ -41,6 +41,11 @@ func (in *BackupInfrastructureControllerConfiguration) DeepCopyInto(out *BackupI
 			(*out)[key] = val
 		}
 	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(BackupInfrastructureVerificationConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -54,6 +59,37 @@ func (in *BackupInfrastructureControllerConfiguration) DeepCopy() *BackupInfrast
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupInfrastructureVerificationConfiguration) DeepCopyInto(out *BackupInfrastructureVerificationConfiguration) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ConcurrentVerificationsPerSeed != nil {
+		in, out := &in.ConcurrentVerificationsPerSeed, &out.ConcurrentVerificationsPerSeed
+		*out = new(int)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupInfrastructureVerificationConfiguration.
+func (in *BackupInfrastructureVerificationConfiguration) DeepCopy() *BackupInfrastructureVerificationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupInfrastructureVerificationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudProfileControllerConfiguration) DeepCopyInto(out *CloudProfileControllerConfiguration) {
 	*out = *in
@@ -90,6 +126,11 @@ func (in *ConditionThreshold) DeepCopy() *ConditionThreshold {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerInstallationControllerConfiguration) DeepCopyInto(out *ControllerInstallationControllerConfiguration) {
 	*out = *in
+	if in.HealthSyncPeriod != nil {
+		in, out := &in.HealthSyncPeriod, &out.HealthSyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -117,6 +158,21 @@ func (in *ControllerManagerConfiguration) DeepCopyInto(out *ControllerManagerCon
 		*out = new(ShootBackup)
 		**out = **in
 	}
+	if in.NamespaceDeletionProtection != nil {
+		in, out := &in.NamespaceDeletionProtection, &out.NamespaceDeletionProtection
+		*out = new(NamespaceDeletionProtection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SeedAgent != nil {
+		in, out := &in.SeedAgent, &out.SeedAgent
+		*out = new(SeedAgentConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sharding != nil {
+		in, out := &in.Sharding, &out.Sharding
+		*out = new(ShardingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.FeatureGates != nil {
 		in, out := &in.FeatureGates, &out.FeatureGates
 		*out = make(map[string]bool, len(*in))
@@ -162,7 +218,7 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 	if in.ControllerInstallation != nil {
 		in, out := &in.ControllerInstallation, &out.ControllerInstallation
 		*out = new(ControllerInstallationControllerConfiguration)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Plant != nil {
 		in, out := &in.Plant, &out.Plant
@@ -177,7 +233,7 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 	if in.Project != nil {
 		in, out := &in.Project, &out.Project
 		*out = new(ProjectControllerConfiguration)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Quota != nil {
 		in, out := &in.Quota, &out.Quota
@@ -254,6 +310,32 @@ func (in *DiscoveryConfiguration) DeepCopy() *DiscoveryConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointHealthCheck) DeepCopyInto(out *EndpointHealthCheck) {
+	*out = *in
+	if in.ExpectedStatusCodes != nil {
+		in, out := &in.ExpectedStatusCodes, &out.ExpectedStatusCodes
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointHealthCheck.
+func (in *EndpointHealthCheck) DeepCopy() *EndpointHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPSServer) DeepCopyInto(out *HTTPSServer) {
 	*out = *in
@@ -272,6 +354,37 @@ func (in *HTTPSServer) DeepCopy() *HTTPSServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheck) DeepCopyInto(out *HealthCheck) {
+	*out = *in
+	if in.Workload != nil {
+		in, out := &in.Workload, &out.Workload
+		*out = new(WorkloadHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(EndpointHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PromQL != nil {
+		in, out := &in.PromQL, &out.PromQL
+		*out = new(PromQLHealthCheck)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheck.
+func (in *HealthCheck) DeepCopy() *HealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LeaderElectionConfiguration) DeepCopyInto(out *LeaderElectionConfiguration) {
 	*out = *in
@@ -289,6 +402,27 @@ func (in *LeaderElectionConfiguration) DeepCopy() *LeaderElectionConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceDeletionProtection) DeepCopyInto(out *NamespaceDeletionProtection) {
+	*out = *in
+	if in.ProtectedKinds != nil {
+		in, out := &in.ProtectedKinds, &out.ProtectedKinds
+		*out = make([]ProtectedKind, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceDeletionProtection.
+func (in *NamespaceDeletionProtection) DeepCopy() *NamespaceDeletionProtection {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceDeletionProtection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PlantConfiguration) DeepCopyInto(out *PlantConfiguration) {
 	*out = *in
@@ -309,6 +443,36 @@ func (in *PlantConfiguration) DeepCopy() *PlantConfiguration {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectControllerConfiguration) DeepCopyInto(out *ProjectControllerConfiguration) {
 	*out = *in
+	if in.StaleSyncPeriod != nil {
+		in, out := &in.StaleSyncPeriod, &out.StaleSyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.StaleGracePeriodDays != nil {
+		in, out := &in.StaleGracePeriodDays, &out.StaleGracePeriodDays
+		*out = new(int)
+		**out = **in
+	}
+	if in.StaleExpirationDays != nil {
+		in, out := &in.StaleExpirationDays, &out.StaleExpirationDays
+		*out = new(int)
+		**out = **in
+	}
+	if in.ServiceAccountTokenRotationPeriod != nil {
+		in, out := &in.ServiceAccountTokenRotationPeriod, &out.ServiceAccountTokenRotationPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ServiceAccountTokenGracePeriod != nil {
+		in, out := &in.ServiceAccountTokenGracePeriod, &out.ServiceAccountTokenGracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.GardenAPIServerURL != nil {
+		in, out := &in.GardenAPIServerURL, &out.GardenAPIServerURL
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -322,6 +486,38 @@ func (in *ProjectControllerConfiguration) DeepCopy() *ProjectControllerConfigura
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromQLHealthCheck) DeepCopyInto(out *PromQLHealthCheck) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromQLHealthCheck.
+func (in *PromQLHealthCheck) DeepCopy() *PromQLHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(PromQLHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtectedKind) DeepCopyInto(out *ProtectedKind) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedKind.
+func (in *ProtectedKind) DeepCopy() *ProtectedKind {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtectedKind)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QuotaControllerConfiguration) DeepCopyInto(out *QuotaControllerConfiguration) {
 	*out = *in
@@ -354,6 +550,27 @@ func (in *SecretBindingControllerConfiguration) DeepCopy() *SecretBindingControl
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedAgentConfiguration) DeepCopyInto(out *SeedAgentConfiguration) {
+	*out = *in
+	if in.GardenSecretNames != nil {
+		in, out := &in.GardenSecretNames, &out.GardenSecretNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedAgentConfiguration.
+func (in *SeedAgentConfiguration) DeepCopy() *SeedAgentConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedAgentConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedControllerConfiguration) DeepCopyInto(out *SeedControllerConfiguration) {
 	*out = *in
@@ -410,6 +627,37 @@ func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShardingConfiguration) DeepCopyInto(out *ShardingConfiguration) {
+	*out = *in
+	if in.LeaseDuration != nil {
+		in, out := &in.LeaseDuration, &out.LeaseDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RenewPeriod != nil {
+		in, out := &in.RenewPeriod, &out.RenewPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.VirtualNodes != nil {
+		in, out := &in.VirtualNodes, &out.VirtualNodes
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShardingConfiguration.
+func (in *ShardingConfiguration) DeepCopy() *ShardingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShardingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootBackup) DeepCopyInto(out *ShootBackup) {
 	*out = *in
@@ -435,6 +683,23 @@ func (in *ShootCareControllerConfiguration) DeepCopyInto(out *ShootCareControlle
 		*out = make([]ConditionThreshold, len(*in))
 		copy(*out, *in)
 	}
+	if in.HealthChecks != nil {
+		in, out := &in.HealthChecks, &out.HealthChecks
+		*out = make([]HealthCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConditionHistoryLimit != nil {
+		in, out := &in.ConditionHistoryLimit, &out.ConditionHistoryLimit
+		*out = new(int)
+		**out = **in
+	}
+	if in.AvailabilityWindow != nil {
+		in, out := &in.AvailabilityWindow, &out.AvailabilityWindow
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -545,3 +810,24 @@ func (in *TLSServer) DeepCopy() *TLSServer {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadHealthCheck) DeepCopyInto(out *WorkloadHealthCheck) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadHealthCheck.
+func (in *WorkloadHealthCheck) DeepCopy() *WorkloadHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
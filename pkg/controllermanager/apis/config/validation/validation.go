@@ -0,0 +1,109 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package validation
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// ValidateConfiguration validates the configuration.
+func ValidateConfiguration(cfg *config.ControllerManagerConfiguration) error {
+	if err := ValidateHealthChecks(cfg.Controllers.ShootCare.HealthChecks); err != nil {
+		return fmt.Errorf("invalid shoot care health checks: %v", err)
+	}
+	if err := ValidateSharding(cfg.Sharding); err != nil {
+		return fmt.Errorf("invalid sharding configuration: %v", err)
+	}
+	return nil
+}
+
+// ValidateSharding validates the given sharding configuration. The replicas only take over Shoots after a grace
+// period of one lease duration, hence, they must refresh the members more often.
+func ValidateSharding(sharding *config.ShardingConfiguration) error {
+	if sharding == nil || !sharding.Enabled || sharding.LeaseDuration == nil || sharding.RenewPeriod == nil {
+		return nil
+	}
+	if sharding.RenewPeriod.Duration >= sharding.LeaseDuration.Duration {
+		return fmt.Errorf("renew period %s must be shorter than the lease duration %s", sharding.RenewPeriod.Duration, sharding.LeaseDuration.Duration)
+	}
+	return nil
+}
+
+// ValidateHealthChecks validates the given health checks and ensures that their names are unique.
+func ValidateHealthChecks(healthChecks []config.HealthCheck) error {
+	names := sets.NewString()
+	for _, healthCheck := range healthChecks {
+		if err := ValidateHealthCheck(healthCheck); err != nil {
+			return err
+		}
+		if names.Has(healthCheck.Name) {
+			return fmt.Errorf("duplicate health check name %q", healthCheck.Name)
+		}
+		names.Insert(healthCheck.Name)
+	}
+	return nil
+}
+
+// ValidateHealthCheck validates the given health check.
+func ValidateHealthCheck(healthCheck config.HealthCheck) error {
+	if len(healthCheck.Name) == 0 {
+		return fmt.Errorf("name must be set")
+	}
+	if len(healthCheck.Condition) == 0 {
+		return fmt.Errorf("health check %s: condition must be set", healthCheck.Name)
+	}
+
+	checks := 0
+	if workload := healthCheck.Workload; workload != nil {
+		checks++
+		if workload.Cluster != config.HealthCheckClusterSeed && workload.Cluster != config.HealthCheckClusterShoot {
+			return fmt.Errorf("health check %s: unsupported cluster %q, must be one of [%s,%s]", healthCheck.Name, workload.Cluster, config.HealthCheckClusterSeed, config.HealthCheckClusterShoot)
+		}
+		if !sets.NewString("Deployment", "StatefulSet", "DaemonSet").Has(workload.Kind) {
+			return fmt.Errorf("health check %s: unsupported workload kind %q, must be one of [Deployment,StatefulSet,DaemonSet]", healthCheck.Name, workload.Kind)
+		}
+		if len(workload.Name) == 0 {
+			return fmt.Errorf("health check %s: workload name must be set", healthCheck.Name)
+		}
+	}
+	if endpoint := healthCheck.Endpoint; endpoint != nil {
+		checks++
+		if len(endpoint.URL) == 0 {
+			return fmt.Errorf("health check %s: endpoint url must be set", healthCheck.Name)
+		}
+		u, err := url.Parse(endpoint.URL)
+		if err != nil {
+			return fmt.Errorf("health check %s: invalid endpoint url: %v", healthCheck.Name, err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("health check %s: unsupported endpoint url scheme %q, must be one of [http,https]", healthCheck.Name, u.Scheme)
+		}
+	}
+	if promQL := healthCheck.PromQL; promQL != nil {
+		checks++
+		if len(promQL.Query) == 0 {
+			return fmt.Errorf("health check %s: query must be set", healthCheck.Name)
+		}
+	}
+
+	if checks != 1 {
+		return fmt.Errorf("health check %s: exactly one of workload, endpoint or promQL must be set", healthCheck.Name)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package validation_test
+
+import (
+	"time"
+
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/apis/config/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Validation", func() {
+	var (
+		workloadCheck = config.HealthCheck{
+			Name:      "foo",
+			Condition: "ControlPlaneHealthy",
+			Workload: &config.WorkloadHealthCheck{
+				Cluster: "seed",
+				Kind:    "Deployment",
+				Name:    "foo",
+			},
+		}
+		endpointCheck = config.HealthCheck{
+			Name:      "bar",
+			Condition: "BarHealthy",
+			Endpoint:  &config.EndpointHealthCheck{URL: "https://bar.example.com/healthz"},
+		}
+		promQLCheck = config.HealthCheck{
+			Name:      "baz",
+			Condition: "BazHealthy",
+			PromQL:    &config.PromQLHealthCheck{Query: "up == 0"},
+		}
+	)
+
+	Describe("#ValidateConfiguration", func() {
+		It("should reject invalid shoot care health checks", func() {
+			cfg := &config.ControllerManagerConfiguration{}
+			cfg.Controllers.ShootCare.HealthChecks = []config.HealthCheck{workloadCheck, workloadCheck}
+
+			Expect(ValidateConfiguration(cfg)).To(HaveOccurred())
+		})
+
+		It("should accept valid shoot care health checks", func() {
+			cfg := &config.ControllerManagerConfiguration{}
+			cfg.Controllers.ShootCare.HealthChecks = []config.HealthCheck{workloadCheck, endpointCheck, promQLCheck}
+
+			Expect(ValidateConfiguration(cfg)).To(Succeed())
+		})
+
+		It("should reject a sharding renew period which is not shorter than the lease duration", func() {
+			cfg := &config.ControllerManagerConfiguration{}
+			cfg.Sharding = &config.ShardingConfiguration{
+				Enabled:       true,
+				LeaseDuration: &metav1.Duration{Duration: 10 * time.Second},
+				RenewPeriod:   &metav1.Duration{Duration: 10 * time.Second},
+			}
+
+			Expect(ValidateConfiguration(cfg)).To(HaveOccurred())
+		})
+	})
+
+	DescribeTable("#ValidateHealthChecks",
+		func(healthChecks []config.HealthCheck, matcher types.GomegaMatcher) {
+			Expect(ValidateHealthChecks(healthChecks)).To(matcher)
+		},
+		Entry("no checks", nil, BeNil()),
+		Entry("unique names", []config.HealthCheck{workloadCheck, endpointCheck, promQLCheck}, BeNil()),
+		Entry("duplicate names", []config.HealthCheck{workloadCheck, endpointCheck, workloadCheck}, HaveOccurred()),
+		Entry("invalid check", []config.HealthCheck{workloadCheck, {Name: "foo"}}, HaveOccurred()),
+	)
+
+	DescribeTable("#ValidateHealthCheck",
+		func(healthCheck config.HealthCheck, matcher types.GomegaMatcher) {
+			Expect(ValidateHealthCheck(healthCheck)).To(matcher)
+		},
+		Entry("valid workload check", workloadCheck, BeNil()),
+		Entry("valid endpoint check", endpointCheck, BeNil()),
+		Entry("valid promQL check", promQLCheck, BeNil()),
+		Entry("missing name", config.HealthCheck{Condition: "Foo", PromQL: &config.PromQLHealthCheck{Query: "up"}}, HaveOccurred()),
+		Entry("missing condition", config.HealthCheck{Name: "foo", PromQL: &config.PromQLHealthCheck{Query: "up"}}, HaveOccurred()),
+		Entry("no check", config.HealthCheck{Name: "foo", Condition: "Foo"}, HaveOccurred()),
+		Entry("multiple checks", config.HealthCheck{
+			Name:      "foo",
+			Condition: "Foo",
+			Endpoint:  endpointCheck.Endpoint,
+			PromQL:    promQLCheck.PromQL,
+		}, HaveOccurred()),
+		Entry("unsupported cluster", config.HealthCheck{
+			Name:      "foo",
+			Condition: "Foo",
+			Workload:  &config.WorkloadHealthCheck{Cluster: "garden", Kind: "Deployment", Name: "foo"},
+		}, HaveOccurred()),
+		Entry("unsupported kind", config.HealthCheck{
+			Name:      "foo",
+			Condition: "Foo",
+			Workload:  &config.WorkloadHealthCheck{Cluster: "shoot", Kind: "Pod", Name: "foo"},
+		}, HaveOccurred()),
+		Entry("unsupported endpoint scheme", config.HealthCheck{
+			Name:      "foo",
+			Condition: "Foo",
+			Endpoint:  &config.EndpointHealthCheck{URL: "file:///etc/passwd"},
+		}, HaveOccurred()),
+	)
+})
@@ -33,6 +33,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
 // Controller controls BackupInfrastructures.
@@ -41,6 +42,7 @@ type Controller struct {
 	k8sGardenInformers gardeninformers.SharedInformerFactory
 
 	config      *config.ControllerManagerConfiguration
+	identity    *gardenv1beta1.Gardener
 	control     ControlInterface
 	recorder    record.EventRecorder
 	secrets     map[string]*corev1.Secret
@@ -50,7 +52,14 @@ type Controller struct {
 	backupInfrastructureQueue  workqueue.RateLimitingInterface
 	backupInfrastructureSynced cache.InformerSynced
 
+	backupInfrastructureVerificationQueue workqueue.RateLimitingInterface
+	verificationStates                    map[string]*verificationState
+	verificationStatesLock                sync.RWMutex
+
+	seedLister             gardenlisters.SeedLister
 	seedSynced             cache.InformerSynced
+	shootLister            gardenlisters.ShootLister
+	shootSynced            cache.InformerSynced
 	workerCh               chan int
 	numberOfRunningWorkers int
 }
@@ -66,16 +75,21 @@ func NewBackupInfrastructureController(k8sGardenClient kubernetes.Interface, gar
 	)
 
 	backupInfrastructureController := &Controller{
-		k8sGardenClient:            k8sGardenClient,
-		k8sGardenInformers:         gardenInformerFactory,
-		config:                     config,
-		control:                    NewDefaultControl(k8sGardenClient, gardenv1beta1Informer, secrets, imageVector, identity, config, recorder),
-		recorder:                   recorder,
-		secrets:                    secrets,
-		imageVector:                imageVector,
-		backupInfrastructureLister: backupInfrastructureLister,
-		backupInfrastructureQueue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "BackupInfrastructure"),
-		workerCh:                   make(chan int),
+		k8sGardenClient:                       k8sGardenClient,
+		k8sGardenInformers:                    gardenInformerFactory,
+		config:                                config,
+		identity:                              identity,
+		control:                               NewDefaultControl(k8sGardenClient, gardenv1beta1Informer, secrets, imageVector, identity, config, recorder),
+		recorder:                              recorder,
+		secrets:                               secrets,
+		imageVector:                           imageVector,
+		backupInfrastructureLister:            backupInfrastructureLister,
+		seedLister:                            gardenv1beta1Informer.Seeds().Lister(),
+		shootLister:                           gardenv1beta1Informer.Shoots().Lister(),
+		backupInfrastructureQueue:             workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "BackupInfrastructure"),
+		backupInfrastructureVerificationQueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "BackupInfrastructure Verification"),
+		verificationStates:                    make(map[string]*verificationState),
+		workerCh:                              make(chan int),
 	}
 
 	backupInfrastructureInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -83,16 +97,24 @@ func NewBackupInfrastructureController(k8sGardenClient kubernetes.Interface, gar
 		UpdateFunc: backupInfrastructureController.backupInfrastructureUpdate,
 		DeleteFunc: backupInfrastructureController.backupInfrastructureDelete,
 	})
+	if config.Controllers.BackupInfrastructure.Verification != nil {
+		backupInfrastructureInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    backupInfrastructureController.backupInfrastructureVerificationAdd,
+			DeleteFunc: backupInfrastructureController.backupInfrastructureVerificationDelete,
+		})
+	}
 	backupInfrastructureController.backupInfrastructureSynced = backupInfrastructureInformer.Informer().HasSynced
 	backupInfrastructureController.seedSynced = gardenv1beta1Informer.Seeds().Informer().HasSynced
+	backupInfrastructureController.shootSynced = gardenv1beta1Informer.Shoots().Informer().HasSynced
 	return backupInfrastructureController
 }
 
-// Run runs the Controller until the given stop channel can be read from.
-func (c *Controller) Run(ctx context.Context, workers int) {
+// Run runs the Controller until the given stop channel can be read from. The backups are only verified if
+// <verificationWorkers> is greater than zero.
+func (c *Controller) Run(ctx context.Context, workers, verificationWorkers int) {
 	var waitGroup sync.WaitGroup
 
-	if !cache.WaitForCacheSync(ctx.Done(), c.backupInfrastructureSynced, c.seedSynced) {
+	if !cache.WaitForCacheSync(ctx.Done(), c.backupInfrastructureSynced, c.seedSynced, c.shootSynced) {
 		logger.Logger.Error("Timed out waiting for caches to sync")
 		return
 	}
@@ -113,23 +135,35 @@ func (c *Controller) Run(ctx context.Context, workers int) {
 	for i := 0; i < workers; i++ {
 		controllerutils.DeprecatedCreateWorker(ctx, c.backupInfrastructureQueue, "backupinfrastructure", c.reconcileBackupInfrastructureKey, &waitGroup, c.workerCh)
 	}
+	for i := 0; i < verificationWorkers; i++ {
+		controllerutils.CreateWorker(ctx, c.backupInfrastructureVerificationQueue, "backupinfrastructure verification", reconcile.Func(c.reconcileBackupInfrastructureVerificationRequest), &waitGroup, c.workerCh)
+	}
 
 	// Shutdown handling
 	<-ctx.Done()
 	c.backupInfrastructureQueue.ShutDown()
+	c.backupInfrastructureVerificationQueue.ShutDown()
 
 	for {
-		if c.backupInfrastructureQueue.Len() == 0 && c.numberOfRunningWorkers == 0 {
+		if c.backupInfrastructureQueue.Len() == 0 && c.backupInfrastructureVerificationQueue.Len() == 0 && c.numberOfRunningWorkers == 0 {
 			logger.Logger.Info("No running BackupInfrastructure worker and no items left in the queues. Terminated BackupInfrastructure controller...")
 			break
 		}
-		logger.Logger.Infof("Waiting for %d BackupInfrastructure worker(s) to finish (%d item(s) left in the queues)...", c.numberOfRunningWorkers, c.backupInfrastructureQueue.Len())
+		logger.Logger.Infof("Waiting for %d BackupInfrastructure worker(s) to finish (%d item(s) left in the queues)...", c.numberOfRunningWorkers, c.backupInfrastructureQueue.Len()+c.backupInfrastructureVerificationQueue.Len())
 		time.Sleep(5 * time.Second)
 	}
 
 	waitGroup.Wait()
 }
 
+// VerificationWorkers returns the number of workers verifying backups, i.e. zero if the verification is disabled.
+func VerificationWorkers(config *config.ControllerManagerConfiguration) int {
+	if config.Controllers.BackupInfrastructure.Verification == nil {
+		return 0
+	}
+	return config.Controllers.BackupInfrastructure.Verification.ConcurrentSyncs
+}
+
 // RunningWorkers returns the number of running workers.
 func (c *Controller) RunningWorkers() int {
 	return c.numberOfRunningWorkers
@@ -143,4 +177,37 @@ func (c *Controller) CollectMetrics(ch chan<- prometheus.Metric) {
 		return
 	}
 	ch <- metric
+
+	c.verificationStatesLock.RLock()
+	defer c.verificationStatesLock.RUnlock()
+
+	for key, state := range c.verificationStates {
+		namespace, name, err := cache.SplitMetaNamespaceKey(key)
+		if err != nil {
+			continue
+		}
+
+		success := 0.0
+		if state.success {
+			success = 1.0
+		}
+
+		values := map[*prometheus.Desc]float64{
+			gardenmetrics.BackupInfrastructureVerificationSuccess:   success,
+			gardenmetrics.BackupInfrastructureVerificationTimestamp: float64(state.timestamp.Unix()),
+		}
+		if state.result != nil {
+			values[gardenmetrics.BackupInfrastructureVerificationRevision] = float64(state.result.Revision)
+			values[gardenmetrics.BackupInfrastructureVerificationKeys] = float64(state.result.Keys)
+		}
+
+		for desc, value := range values {
+			metric, err := prometheus.NewConstMetric(desc, prometheus.GaugeValue, value, name, namespace, state.seed)
+			if err != nil {
+				gardenmetrics.ScrapeFailures.With(prometheus.Labels{"kind": "backupinfrastructure-controller"}).Inc()
+				continue
+			}
+			ch <- metric
+		}
+	}
 }
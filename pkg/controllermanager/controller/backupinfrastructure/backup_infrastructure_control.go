@@ -31,6 +31,9 @@ import (
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
+	controllermanagerfeatures "github.com/gardener/gardener/pkg/controllermanager/features"
+	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation"
 	botanistpkg "github.com/gardener/gardener/pkg/operation/botanist"
@@ -102,6 +105,16 @@ func (c *Controller) reconcileBackupInfrastructureKey(key string) error {
 
 	backupInfrastructureLogger := logger.NewFieldLogger(logger.Logger, "backupinfrastructure", fmt.Sprintf("%s/%s", backupInfrastructure.Namespace, backupInfrastructure.Name))
 
+	responsible, err := controllerutils.ResponsibleForBackupInfrastructure(c.config, c.seedLister, backupInfrastructure)
+	if err != nil {
+		return err
+	}
+	if !responsible {
+		backupInfrastructureLogger.Debug("Skipping because the BackupInfrastructure is reconciled by another Gardener component responsible for its Seed")
+		c.backupInfrastructureQueue.AddAfter(key, c.config.Controllers.BackupInfrastructure.SyncPeriod.Duration)
+		return nil
+	}
+
 	if backupInfrastructure.DeletionTimestamp != nil && !sets.NewString(backupInfrastructure.Finalizers...).Has(gardenv1beta1.GardenerName) {
 		backupInfrastructureLogger.Debug("Do not need to do anything as the BackupInfrastructure does not have my finalizer")
 		c.backupInfrastructureQueue.Forget(key)
@@ -266,21 +279,40 @@ func (c *defaultControl) reconcileBackupInfrastructure(o *operation.Operation) *
 	}
 
 	var (
-		defaultTimeout  = 30 * time.Second
-		defaultInterval = 5 * time.Second
+		defaultTimeout          = 30 * time.Second
+		defaultInterval         = 5 * time.Second
+		backupExtensionsEnabled = controllermanagerfeatures.FeatureGate.Enabled(features.BackupExtensions)
 
 		g = flow.NewGraph("Backup Infrastructure Creation")
 
 		deployBackupNamespace = g.Add(flow.Task{
 			Name: "Deploying backup namespace",
-			Fn:   flow.TaskFn(botanist.DeployBackupNamespace).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Fn:   flow.TaskFn(botanist.DeployBackupNamespace).RetryUntilTimeout(defaultInterval, defaultTimeout).DoIf(!backupExtensionsEnabled),
 		})
-
 		_ = g.Add(flow.Task{
 			Name:         "Deploying backup infrastructure",
-			Fn:           flow.SimpleTaskFn(seedCloudBotanist.DeployBackupInfrastructure),
+			Fn:           flow.SimpleTaskFn(seedCloudBotanist.DeployBackupInfrastructure).DoIf(!backupExtensionsEnabled),
 			Dependencies: flow.NewTaskIDs(deployBackupNamespace),
 		})
+		deployBackupBucket = g.Add(flow.Task{
+			Name: "Deploying backup bucket of the Seed",
+			Fn:   flow.TaskFn(botanist.DeployBackupBucket).RetryUntilTimeout(defaultInterval, defaultTimeout).DoIf(backupExtensionsEnabled),
+		})
+		waitUntilBackupBucketReady = g.Add(flow.Task{
+			Name:         "Waiting until backup bucket of the Seed has been reconciled",
+			Fn:           flow.TaskFn(botanist.WaitUntilBackupBucketReady).DoIf(backupExtensionsEnabled),
+			Dependencies: flow.NewTaskIDs(deployBackupBucket),
+		})
+		deployBackupEntry = g.Add(flow.Task{
+			Name:         "Deploying backup entry",
+			Fn:           flow.TaskFn(botanist.DeployBackupEntry).RetryUntilTimeout(defaultInterval, defaultTimeout).DoIf(backupExtensionsEnabled),
+			Dependencies: flow.NewTaskIDs(waitUntilBackupBucketReady),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Waiting until backup entry has been reconciled",
+			Fn:           flow.TaskFn(botanist.WaitUntilBackupEntryReady).DoIf(backupExtensionsEnabled),
+			Dependencies: flow.NewTaskIDs(deployBackupEntry),
+		})
 
 		f = g.Compile()
 	)
@@ -305,16 +337,17 @@ func (c *defaultControl) deleteBackupInfrastructure(o *operation.Operation) *gar
 		return gardencorev1alpha1helper.LastError(fmt.Sprintf("Failed to create a Botanist (%s)", err.Error()))
 	}
 
-	// We first check whether the namespace in the Seed cluster does exist - if it does not, then we assume that
-	// all resources have already been deleted. We can delete the BackupInfrastructure resource as a consequence.
+	// We first check whether the backup namespace in the Seed cluster does exist - if it does not, then we assume that
+	// the backups have never been managed by Terraform or that all of its resources have already been deleted.
+	// Independent of that, the backup entry is deleted as it may have been created after a migration.
 	namespace := &corev1.Namespace{}
 	namespaceName := common.GenerateBackupNamespaceName(o.BackupInfrastructure.Name)
+	backupNamespaceExists := true
 	err = botanist.K8sSeedClient.Client().Get(context.TODO(), client.ObjectKey{Name: namespaceName}, namespace)
 	if apierrors.IsNotFound(err) {
-		o.Logger.Infof("Did not find '%s' namespace in the Seed cluster - nothing to be done", common.GenerateBackupNamespaceName(o.BackupInfrastructure.Name))
-		return nil
-	}
-	if err != nil {
+		o.Logger.Infof("Did not find '%s' namespace in the Seed cluster - no Terraform resources to be deleted", namespaceName)
+		backupNamespaceExists = false
+	} else if err != nil {
 		return gardencorev1alpha1helper.LastError(fmt.Sprintf("Failed to retrieve the backup namespace in the Seed cluster (%s)", err.Error()))
 	}
 
@@ -327,23 +360,32 @@ func (c *defaultControl) deleteBackupInfrastructure(o *operation.Operation) *gar
 	// we have tried to delete it in a previous run. In that case, we do not need to cleanup backup infrastructure resource because
 	// that would have already been done.
 	var (
-		cleanupBackupInfrastructureResources = namespace.Status.Phase != corev1.NamespaceTerminating
+		cleanupBackupInfrastructureResources = backupNamespaceExists && namespace.Status.Phase != corev1.NamespaceTerminating
 		defaultInterval                      = 5 * time.Second
 		defaultTimeout                       = 30 * time.Second
 
-		g                           = flow.NewGraph("Backup infrastructure deletion")
+		g                  = flow.NewGraph("Backup infrastructure deletion")
+		destroyBackupEntry = g.Add(flow.Task{
+			Name: "Destroying backup entry",
+			Fn:   flow.TaskFn(botanist.DestroyBackupEntry).RetryUntilTimeout(defaultInterval, defaultTimeout),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Waiting until backup entry has been deleted",
+			Fn:           botanist.WaitUntilBackupEntryDeleted,
+			Dependencies: flow.NewTaskIDs(destroyBackupEntry),
+		})
 		destroyBackupInfrastructure = g.Add(flow.Task{
 			Name: "Destroying backup infrastructure",
 			Fn:   flow.SimpleTaskFn(seedCloudBotanist.DestroyBackupInfrastructure).DoIf(cleanupBackupInfrastructureResources),
 		})
 		deleteBackupNamespace = g.Add(flow.Task{
 			Name:         "Deleting backup namespace",
-			Fn:           flow.TaskFn(botanist.DeleteBackupNamespace).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Fn:           flow.TaskFn(botanist.DeleteBackupNamespace).RetryUntilTimeout(defaultInterval, defaultTimeout).DoIf(backupNamespaceExists),
 			Dependencies: flow.NewTaskIDs(destroyBackupInfrastructure),
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Waiting until backup namespace is deleted",
-			Fn:           botanist.WaitUntilBackupNamespaceDeleted,
+			Fn:           flow.TaskFn(botanist.WaitUntilBackupNamespaceDeleted).DoIf(backupNamespaceExists),
 			Dependencies: flow.NewTaskIDs(deleteBackupNamespace),
 		})
 		f = g.Compile()
@@ -0,0 +1,289 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupinfrastructure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation"
+	cloudbotanistpkg "github.com/gardener/gardener/pkg/operation/cloudbotanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// verificationPollInterval is the interval in which running verifications are checked for completion.
+const verificationPollInterval = 30 * time.Second
+
+// verificationState is the state of the last verification of a BackupInfrastructure which is exposed as metrics.
+type verificationState struct {
+	seed      string
+	success   bool
+	timestamp time.Time
+	// result is the result of the last successful verification.
+	result *VerificationResult
+}
+
+func (c *Controller) backupInfrastructureVerificationAdd(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		logger.Logger.Errorf("Couldn't get key for object %+v: %v", obj, err)
+		return
+	}
+	c.backupInfrastructureVerificationQueue.AddAfter(key, c.durationUntilNextVerification(obj.(*gardenv1beta1.BackupInfrastructure)))
+}
+
+func (c *Controller) backupInfrastructureVerificationDelete(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		logger.Logger.Errorf("Couldn't get key for object %+v: %v", obj, err)
+		return
+	}
+
+	c.verificationStatesLock.Lock()
+	delete(c.verificationStates, key)
+	c.verificationStatesLock.Unlock()
+}
+
+// durationUntilNextVerification computes the duration until the backups of the given BackupInfrastructure are due to
+// be verified again, based on the last update of its BackupRestorable condition.
+func (c *Controller) durationUntilNextVerification(backupInfrastructure *gardenv1beta1.BackupInfrastructure) time.Duration {
+	condition := gardencorev1alpha1helper.GetCondition(backupInfrastructure.Status.Conditions, gardenv1beta1.BackupInfrastructureBackupRestorable)
+	if condition == nil {
+		return 0
+	}
+	if d := c.config.Controllers.BackupInfrastructure.Verification.SyncPeriod.Duration - time.Since(condition.LastUpdateTime.Time); d > 0 {
+		return d
+	}
+	return 0
+}
+
+func (c *Controller) reconcileBackupInfrastructureVerificationRequest(req reconcile.Request) (reconcile.Result, error) {
+	var (
+		key        = req.String()
+		syncPeriod = c.config.Controllers.BackupInfrastructure.Verification.SyncPeriod.Duration
+	)
+
+	backupInfrastructure, err := c.backupInfrastructureLister.BackupInfrastructures(req.Namespace).Get(req.Name)
+	if apierrors.IsNotFound(err) {
+		logger.Logger.Debugf("[BACKUPINFRASTRUCTURE VERIFICATION] %s - skipping because BackupInfrastructure has been deleted", key)
+		return reconcile.Result{}, nil
+	}
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	verificationLogger := logger.NewFieldLogger(logger.Logger, "backupinfrastructure", key).WithField("operation", "verification")
+
+	responsible, err := controllerutils.ResponsibleForBackupInfrastructure(c.config, c.seedLister, backupInfrastructure)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if !responsible {
+		verificationLogger.Debug("Skipping because the BackupInfrastructure is verified by another Gardener component responsible for its Seed")
+		return reconcile.Result{RequeueAfter: syncPeriod}, nil
+	}
+	if backupInfrastructure.DeletionTimestamp != nil {
+		verificationLogger.Debug("Skipping because the BackupInfrastructure is being deleted")
+		return reconcile.Result{}, nil
+	}
+
+	shoot, err := c.shootOfBackupInfrastructure(backupInfrastructure)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if shoot == nil || len(shoot.Status.TechnicalID) == 0 {
+		verificationLogger.Debug("Skipping because the Shoot of the BackupInfrastructure does not exist (anymore)")
+		return reconcile.Result{RequeueAfter: syncPeriod}, nil
+	}
+
+	o, err := operation.NewWithBackupInfrastructure(backupInfrastructure, verificationLogger, c.k8sGardenClient, c.k8sGardenInformers.Garden().V1beta1(), c.identity, c.secrets, c.imageVector)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if err := o.InitializeSeedClients(); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	var (
+		ctx       = context.TODO()
+		namespace = shoot.Status.TechnicalID
+		pod       = &corev1.Pod{}
+	)
+
+	if err := o.K8sSeedClient.Client().Get(ctx, kutil.Key(namespace, VerificationPodName), pod); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return reconcile.Result{}, err
+		}
+		return c.startVerification(ctx, o, backupInfrastructure, namespace, verificationLogger)
+	}
+
+	completed, result, verificationErr := VerificationPodResult(pod)
+	if !completed {
+		verificationLogger.Debug("Waiting for the verification to complete")
+		return reconcile.Result{RequeueAfter: verificationPollInterval}, nil
+	}
+
+	previous := c.lastSuccessfulVerificationResult(key)
+	if verificationErr == nil {
+		verificationErr = result.Check(previous)
+	}
+
+	condition := gardencorev1alpha1helper.GetOrInitCondition(backupInfrastructure.Status.Conditions, gardenv1beta1.BackupInfrastructureBackupRestorable)
+	if verificationErr != nil {
+		verificationLogger.Errorf("Verification of the latest backup failed: %v", verificationErr)
+		condition = gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "VerificationFailed", verificationErr.Error())
+		c.recorder.Event(backupInfrastructure, corev1.EventTypeWarning, "VerificationFailed", verificationErr.Error())
+		result = nil
+	} else {
+		message := fmt.Sprintf("The latest backup has been restored successfully (revision %d, %d keys).", result.Revision, result.Keys)
+		verificationLogger.Info(message)
+		condition = gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "VerificationSucceeded", message)
+	}
+
+	if err := c.updateBackupInfrastructureVerificationCondition(backupInfrastructure, condition); err != nil {
+		return reconcile.Result{}, err
+	}
+	c.recordVerification(key, backupInfrastructure.Spec.Seed, verificationErr == nil, result)
+
+	if err := o.K8sSeedClient.Client().Delete(ctx, pod); client.IgnoreNotFound(err) != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{RequeueAfter: syncPeriod}, nil
+}
+
+// startVerification creates the verification pod in the Shoot namespace in the Seed if the backups are due to be
+// verified and if the number of verifications running in the Seed does not exceed the configured limit.
+func (c *Controller) startVerification(ctx context.Context, o *operation.Operation, backupInfrastructure *gardenv1beta1.BackupInfrastructure, namespace string, verificationLogger *logrus.Entry) (reconcile.Result, error) {
+	verificationConfig := c.config.Controllers.BackupInfrastructure.Verification
+
+	if d := c.durationUntilNextVerification(backupInfrastructure); d > 0 {
+		return reconcile.Result{RequeueAfter: d}, nil
+	}
+
+	seedCloudBotanist, err := cloudbotanistpkg.New(o, common.CloudPurposeSeed)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	store := seedCloudBotanist.GetETCDBackupStore()
+	if store == nil {
+		condition := gardencorev1alpha1helper.GetOrInitCondition(backupInfrastructure.Status.Conditions, gardenv1beta1.BackupInfrastructureBackupRestorable)
+		condition = gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionUnknown, "VerificationNotSupported", fmt.Sprintf("Backups are not supported for seeds on %s.", seedCloudBotanist.GetCloudProviderName()))
+		return reconcile.Result{RequeueAfter: verificationConfig.SyncPeriod.Duration}, c.updateBackupInfrastructureVerificationCondition(backupInfrastructure, condition)
+	}
+
+	podList := &corev1.PodList{}
+	if err := o.K8sSeedClient.Client().List(ctx, podList, client.MatchingLabels(map[string]string{"app": VerificationLabelApp})); err != nil {
+		return reconcile.Result{}, err
+	}
+	if len(podList.Items) >= *verificationConfig.ConcurrentVerificationsPerSeed {
+		verificationLogger.Debugf("Waiting because %d verifications are already running in seed %s", len(podList.Items), backupInfrastructure.Spec.Seed)
+		return reconcile.Result{RequeueAfter: verificationPollInterval}, nil
+	}
+
+	backupRestoreImage, err := c.imageVector.FindImage(common.ETCDBackupRestoreImageName)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	etcdImage, err := c.imageVector.FindImage(common.ETCDImageName)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	backupSecret := &corev1.Secret{}
+	if err := o.K8sSeedClient.Client().Get(ctx, kutil.Key(namespace, common.BackupSecretName), backupSecret); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	verificationLogger.Info("Starting verification of the latest backup")
+	pod := NewVerificationPod(namespace, store, common.ETCDMainStorePrefix(backupSecret), backupRestoreImage.String(), etcdImage.String(), verificationConfig.Timeout.Duration)
+	if err := o.K8sSeedClient.Client().Create(ctx, pod); err != nil && !apierrors.IsAlreadyExists(err) {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{RequeueAfter: verificationPollInterval}, nil
+}
+
+// shootOfBackupInfrastructure returns the Shoot owning the given BackupInfrastructure or nil if it does not exist.
+func (c *Controller) shootOfBackupInfrastructure(backupInfrastructure *gardenv1beta1.BackupInfrastructure) (*gardenv1beta1.Shoot, error) {
+	for _, ownerReference := range backupInfrastructure.OwnerReferences {
+		if ownerReference.Kind != "Shoot" {
+			continue
+		}
+
+		shoot, err := c.shootLister.Shoots(backupInfrastructure.Namespace).Get(ownerReference.Name)
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if shoot.UID != backupInfrastructure.Spec.ShootUID {
+			return nil, nil
+		}
+		return shoot, nil
+	}
+	return nil, nil
+}
+
+func (c *Controller) updateBackupInfrastructureVerificationCondition(backupInfrastructure *gardenv1beta1.BackupInfrastructure, condition gardencorev1alpha1.Condition) error {
+	_, err := kutil.TryUpdateBackupInfrastructureStatus(c.k8sGardenClient.Garden(), retry.DefaultRetry, backupInfrastructure.ObjectMeta,
+		func(backupInfrastructure *gardenv1beta1.BackupInfrastructure) (*gardenv1beta1.BackupInfrastructure, error) {
+			backupInfrastructure.Status.Conditions = gardencorev1alpha1helper.MergeConditions(backupInfrastructure.Status.Conditions, condition)
+			return backupInfrastructure, nil
+		})
+	return err
+}
+
+func (c *Controller) lastSuccessfulVerificationResult(key string) *VerificationResult {
+	c.verificationStatesLock.RLock()
+	defer c.verificationStatesLock.RUnlock()
+
+	if state, ok := c.verificationStates[key]; ok {
+		return state.result
+	}
+	return nil
+}
+
+func (c *Controller) recordVerification(key, seed string, success bool, result *VerificationResult) {
+	c.verificationStatesLock.Lock()
+	defer c.verificationStatesLock.Unlock()
+
+	state, ok := c.verificationStates[key]
+	if !ok {
+		state = &verificationState{}
+		c.verificationStates[key] = state
+	}
+
+	state.seed = seed
+	state.success = success
+	state.timestamp = time.Now()
+	if result != nil {
+		state.result = result
+	}
+}
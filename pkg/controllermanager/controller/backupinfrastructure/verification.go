@@ -0,0 +1,152 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupinfrastructure
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// VerificationPodName is the name of the pod verifying the backups of a Shoot in its namespace in the Seed.
+	VerificationPodName = "backup-verification"
+	// VerificationLabelApp is the value of the 'app' label of the pods verifying backups.
+	VerificationLabelApp = "backup-verification"
+
+	verificationContainerRestore = "restore"
+	verificationContainerVerify  = "verify"
+	verificationDataDir          = "/var/etcd/data"
+
+	// verificationScript starts a temporary etcd on the restored data directory and writes the revision and the
+	// number of keys to the termination log.
+	verificationScript = `export ETCDCTL_API=3
+etcd --data-dir=` + verificationDataDir + `/default.etcd --listen-client-urls=http://127.0.0.1:2379 --advertise-client-urls=http://127.0.0.1:2379 --listen-peer-urls=http://127.0.0.1:2380 &
+for i in $(seq 1 60); do
+  etcdctl --endpoints=http://127.0.0.1:2379 endpoint health && break
+  sleep 5
+done
+OUTPUT=$(etcdctl --endpoints=http://127.0.0.1:2379 get "" --prefix --count-only --write-out=json) || exit 1
+REVISION=$(echo "$OUTPUT" | sed -n 's/.*"revision":\([0-9]*\).*/\1/p')
+KEYS=$(echo "$OUTPUT" | sed -n 's/.*"count":\([0-9]*\).*/\1/p')
+echo "{\"revision\":${REVISION:-0},\"keys\":${KEYS:-0}}" > /dev/termination-log`
+)
+
+// VerificationResult is the result of restoring the latest backup of a Shoot's main etcd.
+type VerificationResult struct {
+	// Revision is the revision of the restored etcd.
+	Revision int64 `json:"revision"`
+	// Keys is the number of keys in the restored etcd.
+	Keys int64 `json:"keys"`
+}
+
+// Check performs the consistency checks on the result of a verification. The <previous> result of the last successful
+// verification is optional.
+func (r *VerificationResult) Check(previous *VerificationResult) error {
+	if r.Revision <= 0 {
+		return fmt.Errorf("the restored etcd has no revision")
+	}
+	if r.Keys <= 0 {
+		return fmt.Errorf("the restored etcd does not contain any keys")
+	}
+	if previous != nil && r.Revision < previous.Revision {
+		return fmt.Errorf("the restored revision %d is older than the revision %d restored by the previous verification", r.Revision, previous.Revision)
+	}
+	return nil
+}
+
+// NewVerificationPod returns a pod for the given namespace in the Seed which restores the latest backup of the main etcd
+// with the given <storePrefix> from the given <store> into a temporary data directory, starts an etcd on it and reports its revision and number of
+// keys in the termination message of the 'verify' container. The pod fails if it does not complete within <timeout>.
+func NewVerificationPod(namespace string, store *common.ETCDBackupStore, storePrefix, backupRestoreImage, etcdImage string, timeout time.Duration) *corev1.Pod {
+	var (
+		activeDeadlineSeconds = int64(timeout / time.Second)
+		dataVolumeMount       = corev1.VolumeMount{Name: "etcd-data", MountPath: verificationDataDir}
+		restore, volumes      = store.RestoreContainer(verificationContainerRestore, backupRestoreImage, common.BackupSecretName, storePrefix, verificationDataDir+"/default.etcd", dataVolumeMount)
+	)
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      VerificationPodName,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"app":                              VerificationLabelApp,
+				"networking.gardener.cloud/to-dns": "allowed",
+				"networking.gardener.cloud/to-public-networks":  "allowed",
+				"networking.gardener.cloud/to-private-networks": "allowed",
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:         corev1.RestartPolicyNever,
+			ActiveDeadlineSeconds: &activeDeadlineSeconds,
+			InitContainers:        []corev1.Container{restore},
+			Containers: []corev1.Container{
+				{
+					Name:                     verificationContainerVerify,
+					Image:                    etcdImage,
+					Command:                  []string{"/bin/sh", "-c", verificationScript},
+					VolumeMounts:             []corev1.VolumeMount{dataVolumeMount},
+					TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+				},
+			},
+			Volumes: append([]corev1.Volume{
+				{
+					Name:         "etcd-data",
+					VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+				},
+			}, volumes...),
+		},
+	}
+}
+
+// VerificationPodResult evaluates a completed verification pod. It returns true if the pod has completed. For a
+// succeeded pod the result is returned, for a failed pod an error describing the failure.
+func VerificationPodResult(pod *corev1.Pod) (bool, *VerificationResult, error) {
+	switch pod.Status.Phase {
+	case corev1.PodSucceeded:
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Name != verificationContainerVerify || status.State.Terminated == nil {
+				continue
+			}
+			result := &VerificationResult{}
+			if err := json.Unmarshal([]byte(status.State.Terminated.Message), result); err != nil {
+				return true, nil, fmt.Errorf("could not read the verification result: %v", err)
+			}
+			return true, result, nil
+		}
+		return true, nil, fmt.Errorf("the verification did not report a result")
+
+	case corev1.PodFailed:
+		for _, status := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+			if terminated := status.State.Terminated; terminated != nil && terminated.ExitCode != 0 {
+				return true, nil, fmt.Errorf("container %s failed with exit code %d: %s", status.Name, terminated.ExitCode, lastLine(terminated.Message))
+			}
+		}
+		return true, nil, fmt.Errorf("the verification failed: %s %s", pod.Status.Reason, pod.Status.Message)
+	}
+
+	return false, nil, nil
+}
+
+func lastLine(message string) string {
+	lines := strings.Split(strings.TrimSpace(message), "\n")
+	return lines[len(lines)-1]
+}
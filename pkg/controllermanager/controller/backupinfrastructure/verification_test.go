@@ -0,0 +1,157 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupinfrastructure_test
+
+import (
+	"time"
+
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/backupinfrastructure"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("Verification", func() {
+	Describe("#NewVerificationPod", func() {
+		It("should pass the credentials from the backup secret as environment variables", func() {
+			store := &common.ETCDBackupStore{
+				Provider: "S3",
+				Env: map[string]string{
+					"AWS_SECRET_ACCESS_KEY": "secretAccessKey",
+					"AWS_ACCESS_KEY_ID":     "accessKeyID",
+				},
+			}
+
+			pod := NewVerificationPod("shoot--foo--bar", store, "etcd-main", "etcdbrctl:1", "etcd:1", 10*time.Minute)
+
+			Expect(pod.Name).To(Equal(VerificationPodName))
+			Expect(pod.Namespace).To(Equal("shoot--foo--bar"))
+			Expect(pod.Labels).To(HaveKeyWithValue("app", VerificationLabelApp))
+			Expect(*pod.Spec.ActiveDeadlineSeconds).To(Equal(int64(600)))
+			Expect(pod.Spec.RestartPolicy).To(Equal(corev1.RestartPolicyNever))
+
+			Expect(pod.Spec.InitContainers).To(HaveLen(1))
+			restore := pod.Spec.InitContainers[0]
+			Expect(restore.Image).To(Equal("etcdbrctl:1"))
+			Expect(restore.Command).To(ContainElement("--storage-provider=S3"))
+			Expect(restore.Command).To(ContainElement("--store-prefix=etcd-main"))
+			Expect(restore.Env).To(HaveLen(3))
+			for i, env := range []struct{ name, key string }{
+				{"STORAGE_CONTAINER", common.BackupBucketName},
+				{"AWS_ACCESS_KEY_ID", "accessKeyID"},
+				{"AWS_SECRET_ACCESS_KEY", "secretAccessKey"},
+			} {
+				Expect(restore.Env[i].Name).To(Equal(env.name))
+				Expect(restore.Env[i].ValueFrom.SecretKeyRef.Name).To(Equal(common.BackupSecretName))
+				Expect(restore.Env[i].ValueFrom.SecretKeyRef.Key).To(Equal(env.key))
+			}
+
+			Expect(pod.Spec.Containers).To(HaveLen(1))
+			Expect(pod.Spec.Containers[0].Image).To(Equal("etcd:1"))
+			Expect(pod.Spec.Volumes).To(HaveLen(1))
+		})
+
+		It("should mount the credentials file if required", func() {
+			store := &common.ETCDBackupStore{
+				Provider:           "GCS",
+				CredentialsFileKey: "serviceaccount.json",
+				CredentialsFileEnv: "GOOGLE_APPLICATION_CREDENTIALS",
+			}
+
+			pod := NewVerificationPod("shoot--foo--bar", store, "etcd-main", "etcdbrctl:1", "etcd:1", 10*time.Minute)
+
+			Expect(pod.Spec.Volumes).To(HaveLen(2))
+			Expect(pod.Spec.Volumes[1].Secret.SecretName).To(Equal(common.BackupSecretName))
+			restore := pod.Spec.InitContainers[0]
+			Expect(restore.VolumeMounts).To(HaveLen(2))
+			Expect(restore.Env).To(ContainElement(corev1.EnvVar{Name: "GOOGLE_APPLICATION_CREDENTIALS", Value: "/var/etcd-backup/serviceaccount.json"}))
+		})
+	})
+
+	Describe("#VerificationPodResult", func() {
+		var pod *corev1.Pod
+
+		BeforeEach(func() {
+			pod = &corev1.Pod{}
+		})
+
+		It("should report running pods as not completed", func() {
+			pod.Status.Phase = corev1.PodRunning
+
+			completed, result, err := VerificationPodResult(pod)
+
+			Expect(completed).To(BeFalse())
+			Expect(result).To(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should read the result of succeeded pods", func() {
+			pod.Status.Phase = corev1.PodSucceeded
+			pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+				{
+					Name: "verify",
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+						Message: `{"revision":4711,"keys":42}`,
+					}},
+				},
+			}
+
+			completed, result, err := VerificationPodResult(pod)
+
+			Expect(completed).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(&VerificationResult{Revision: 4711, Keys: 42}))
+		})
+
+		It("should report the failed container of failed pods", func() {
+			pod.Status.Phase = corev1.PodFailed
+			pod.Status.InitContainerStatuses = []corev1.ContainerStatus{
+				{
+					Name: "restore",
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+						ExitCode: 1,
+						Message:  "restoring\nno snapshot found",
+					}},
+				},
+			}
+
+			completed, result, err := VerificationPodResult(pod)
+
+			Expect(completed).To(BeTrue())
+			Expect(result).To(BeNil())
+			Expect(err).To(MatchError("container restore failed with exit code 1: no snapshot found"))
+		})
+	})
+
+	Describe("VerificationResult#Check", func() {
+		It("should succeed for a non-empty etcd", func() {
+			Expect((&VerificationResult{Revision: 10, Keys: 5}).Check(nil)).To(Succeed())
+		})
+
+		It("should fail for an etcd without keys", func() {
+			Expect((&VerificationResult{Revision: 10}).Check(nil)).NotTo(Succeed())
+		})
+
+		It("should fail for an etcd without revision", func() {
+			Expect((&VerificationResult{Keys: 5}).Check(nil)).NotTo(Succeed())
+		})
+
+		It("should fail if the revision is older than the previously restored one", func() {
+			Expect((&VerificationResult{Revision: 10, Keys: 5}).Check(&VerificationResult{Revision: 11, Keys: 5})).NotTo(Succeed())
+		})
+	})
+})
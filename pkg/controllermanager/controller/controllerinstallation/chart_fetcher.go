@@ -0,0 +1,207 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerinstallation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+
+	lru "github.com/hashicorp/golang-lru"
+)
+
+const (
+	// maxChartSize is the maximum size of fetched chart tarballs and OCI manifests.
+	maxChartSize = 20 * 1024 * 1024
+	// DefaultChartCacheSize is the default number of chart tarballs kept in the cache of a ChartFetcher.
+	DefaultChartCacheSize = 32
+
+	ociScheme                 = "oci://"
+	ociManifestMediaType      = "application/vnd.oci.image.manifest.v1+json"
+	helmChartContentMediaType = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+	// helmChartLegacyContentMediaType is the media type of chart layers pushed with Helm 2.
+	helmChartLegacyContentMediaType = "application/tar+gzip"
+)
+
+var digestRegex = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+
+// ChartFetcher fetches Helm chart tarballs referenced by ChartReferences.
+type ChartFetcher interface {
+	Fetch(ctx context.Context, chartRef ChartReference) ([]byte, error)
+}
+
+// NewChartFetcher returns a ChartFetcher using the given HTTP client. As charts are pinned by their digest, the
+// fetched tarballs are cached in memory. The cache holds at most <cacheSize> charts (DefaultChartCacheSize if not
+// positive), the least recently used ones are evicted first.
+func NewChartFetcher(httpClient *http.Client, cacheSize int) ChartFetcher {
+	if cacheSize <= 0 {
+		cacheSize = DefaultChartCacheSize
+	}
+	// lru.New only fails for non-positive sizes.
+	cache, _ := lru.New(cacheSize)
+
+	return &chartFetcher{
+		client: httpClient,
+		cache:  cache,
+	}
+}
+
+type chartFetcher struct {
+	client *http.Client
+	cache  *lru.Cache
+}
+
+func (f *chartFetcher) Fetch(ctx context.Context, chartRef ChartReference) ([]byte, error) {
+	if !digestRegex.MatchString(chartRef.Digest) {
+		return nil, fmt.Errorf("digest of chart %q must be of the form sha256:<hex>, got %q", chartRef.URL, chartRef.Digest)
+	}
+
+	if chart, ok := f.cache.Get(chartRef.Digest); ok {
+		return chart.([]byte), nil
+	}
+
+	var (
+		chart []byte
+		err   error
+	)
+	switch {
+	case strings.HasPrefix(chartRef.URL, ociScheme):
+		chart, err = f.fetchOCI(ctx, chartRef)
+	case strings.HasPrefix(chartRef.URL, "https://"), strings.HasPrefix(chartRef.URL, "http://"):
+		chart, err = f.get(ctx, chartRef.URL, "")
+	default:
+		err = fmt.Errorf("unsupported scheme of chart %q, must be one of [oci,https,http]", chartRef.URL)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if digest := computeDigest(chart); digest != chartRef.Digest {
+		return nil, fmt.Errorf("digest of chart %q does not match: expected %s, got %s", chartRef.URL, chartRef.Digest, digest)
+	}
+
+	f.cache.Add(chartRef.Digest, chart)
+
+	return chart, nil
+}
+
+type ociManifest struct {
+	Layers []ociDescriptor `json:"layers"`
+}
+
+type ociDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+}
+
+// fetchOCI fetches the chart layer of the referenced OCI artifact via the registry's HTTP API. Only anonymous
+// access is supported.
+func (f *chartFetcher) fetchOCI(ctx context.Context, chartRef ChartReference) ([]byte, error) {
+	registry, repository, reference, err := ParseOCIReference(chartRef.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := f.get(ctx, fmt.Sprintf("https://%s/v2/%s/manifests/%s", registry, repository, reference), ociManifestMediaType)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &ociManifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("could not decode manifest of chart %q: %v", chartRef.URL, err)
+	}
+
+	for _, layer := range manifest.Layers {
+		if layer.MediaType != helmChartContentMediaType && layer.MediaType != helmChartLegacyContentMediaType {
+			continue
+		}
+		if layer.Digest != chartRef.Digest {
+			return nil, fmt.Errorf("digest of chart %q does not match: expected %s, got %s", chartRef.URL, chartRef.Digest, layer.Digest)
+		}
+		return f.get(ctx, fmt.Sprintf("https://%s/v2/%s/blobs/%s", registry, repository, layer.Digest), "")
+	}
+
+	return nil, fmt.Errorf("manifest of %q does not contain a chart layer", chartRef.URL)
+}
+
+func (f *chartFetcher) get(ctx context.Context, url, accept string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(accept) > 0 {
+		req.Header.Set("Accept", accept)
+	}
+
+	resp, err := f.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d when fetching %q", resp.StatusCode, url)
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxChartSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxChartSize {
+		return nil, fmt.Errorf("response of %q exceeds the maximum size of %d bytes", url, maxChartSize)
+	}
+	return data, nil
+}
+
+// ParseOCIReference parses a reference of the form oci://<registry>/<repository>[:<tag>|@<digest>] and returns the
+// registry, the repository and the tag or digest (defaulting to 'latest').
+func ParseOCIReference(ref string) (string, string, string, error) {
+	trimmed := strings.TrimPrefix(ref, ociScheme)
+
+	parts := strings.SplitN(trimmed, "/", 2)
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return "", "", "", fmt.Errorf("invalid OCI reference %q, must be of the form %s<registry>/<repository>[:<tag>|@<digest>]", ref, ociScheme)
+	}
+
+	var (
+		registry   = parts[0]
+		repository = parts[1]
+		reference  = "latest"
+	)
+
+	if i := strings.Index(repository, "@"); i >= 0 {
+		repository, reference = repository[:i], repository[i+1:]
+	} else if i := strings.LastIndex(repository, ":"); i > strings.LastIndex(repository, "/") {
+		repository, reference = repository[:i], repository[i+1:]
+	}
+
+	if len(repository) == 0 || len(reference) == 0 {
+		return "", "", "", fmt.Errorf("invalid OCI reference %q", ref)
+	}
+	return registry, repository, reference, nil
+}
+
+func computeDigest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
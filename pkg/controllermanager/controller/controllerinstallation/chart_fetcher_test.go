@@ -0,0 +1,161 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerinstallation_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/controllerinstallation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Chart Fetcher", func() {
+	var (
+		ctx    = context.TODO()
+		chart  = []byte("chart-tarball")
+		digest = func(data []byte) string {
+			sum := sha256.Sum256(data)
+			return "sha256:" + hex.EncodeToString(sum[:])
+		}
+		chartDigest      = digest(chart)
+		otherChart       = []byte("other-chart-tarball")
+		otherChartDigest = digest(otherChart)
+
+		server   *httptest.Server
+		requests int
+		fetcher  ChartFetcher
+	)
+
+	BeforeEach(func() {
+		requests = 0
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("/charts/foo.tgz", func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.Write(chart)
+		})
+		mux.HandleFunc("/charts/bar.tgz", func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.Write(otherChart)
+		})
+		mux.HandleFunc("/v2/charts/foo/manifests/1.0.0", func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.Header().Set("Content-Type", "application/vnd.oci.image.manifest.v1+json")
+			fmt.Fprintf(w, `{"schemaVersion":2,"config":{"mediaType":"application/vnd.cncf.helm.config.v1+json","digest":"sha256:0"},"layers":[{"mediaType":"application/vnd.cncf.helm.chart.content.v1.tar+gzip","digest":%q}]}`, chartDigest)
+		})
+		mux.HandleFunc("/v2/charts/foo/blobs/"+chartDigest, func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.Write(chart)
+		})
+
+		server = httptest.NewTLSServer(mux)
+		fetcher = NewChartFetcher(server.Client(), 1)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should fetch a chart from an HTTP server", func() {
+		data, err := fetcher.Fetch(ctx, ChartReference{URL: server.URL + "/charts/foo.tgz", Digest: chartDigest})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(Equal(chart))
+	})
+
+	It("should fetch a chart from an OCI registry", func() {
+		data, err := fetcher.Fetch(ctx, ChartReference{URL: "oci://" + strings.TrimPrefix(server.URL, "https://") + "/charts/foo:1.0.0", Digest: chartDigest})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(Equal(chart))
+	})
+
+	It("should cache fetched charts", func() {
+		chartRef := ChartReference{URL: server.URL + "/charts/foo.tgz", Digest: chartDigest}
+
+		_, err := fetcher.Fetch(ctx, chartRef)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = fetcher.Fetch(ctx, chartRef)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(requests).To(Equal(1))
+	})
+
+	It("should evict the least recently used charts", func() {
+		var (
+			chartRef      = ChartReference{URL: server.URL + "/charts/foo.tgz", Digest: chartDigest}
+			otherChartRef = ChartReference{URL: server.URL + "/charts/bar.tgz", Digest: otherChartDigest}
+		)
+
+		for _, ref := range []ChartReference{chartRef, otherChartRef, otherChartRef, chartRef} {
+			_, err := fetcher.Fetch(ctx, ref)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		Expect(requests).To(Equal(3))
+	})
+
+	It("should reject charts with a different digest", func() {
+		otherDigest := "sha256:" + strings.Repeat("0", 64)
+
+		_, err := fetcher.Fetch(ctx, ChartReference{URL: server.URL + "/charts/foo.tgz", Digest: otherDigest})
+		Expect(err).To(HaveOccurred())
+
+		_, err = fetcher.Fetch(ctx, ChartReference{URL: "oci://" + strings.TrimPrefix(server.URL, "https://") + "/charts/foo:1.0.0", Digest: otherDigest})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject references without a valid digest", func() {
+		_, err := fetcher.Fetch(ctx, ChartReference{URL: server.URL + "/charts/foo.tgz"})
+
+		Expect(err).To(HaveOccurred())
+		Expect(requests).To(BeZero())
+	})
+
+	It("should fail for missing charts", func() {
+		_, err := fetcher.Fetch(ctx, ChartReference{URL: server.URL + "/charts/baz.tgz", Digest: chartDigest})
+
+		Expect(err).To(HaveOccurred())
+	})
+
+	DescribeTable("#ParseOCIReference",
+		func(ref, registry, repository, reference string, expectErr bool) {
+			actualRegistry, actualRepository, actualReference, err := ParseOCIReference(ref)
+
+			if expectErr {
+				Expect(err).To(HaveOccurred())
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualRegistry).To(Equal(registry))
+			Expect(actualRepository).To(Equal(repository))
+			Expect(actualReference).To(Equal(reference))
+		},
+		Entry("tag", "oci://registry.example.com/charts/foo:1.0.0", "registry.example.com", "charts/foo", "1.0.0", false),
+		Entry("digest", "oci://localhost:5000/foo@sha256:abc", "localhost:5000", "foo", "sha256:abc", false),
+		Entry("default tag", "oci://localhost:5000/charts/foo", "localhost:5000", "charts/foo", "latest", false),
+		Entry("missing repository", "oci://localhost:5000", "", "", "", true),
+		Entry("empty tag", "oci://localhost:5000/foo:", "", "", "", true),
+	)
+})
@@ -49,6 +49,7 @@ type Controller struct {
 	config *config.ControllerManagerConfiguration
 
 	controllerInstallationControl ControlInterface
+	careControl                   CareControlInterface
 
 	recorder record.EventRecorder
 
@@ -63,6 +64,8 @@ type Controller struct {
 	controllerInstallationLister gardencorelisters.ControllerInstallationLister
 	controllerInstallationSynced cache.InformerSynced
 
+	controllerInstallationCareQueue workqueue.RateLimitingInterface
+
 	workerCh               chan int
 	numberOfRunningWorkers int
 }
@@ -83,6 +86,8 @@ func NewController(k8sGardenClient kubernetes.Interface, gardenInformerFactory g
 		controllerInstallationInformer = gardenCoreInformer.ControllerInstallations()
 		controllerInstallationLister   = controllerInstallationInformer.Lister()
 		controllerInstallationQueue    = workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "controllerinstallation")
+
+		controllerInstallationCareQueue = workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "controllerinstallation-care")
 	)
 
 	controller := &Controller{
@@ -90,6 +95,7 @@ func NewController(k8sGardenClient kubernetes.Interface, gardenInformerFactory g
 		k8sGardenInformers:            gardenInformerFactory,
 		k8sGardenCoreInformers:        gardenCoreInformerFactory,
 		controllerInstallationControl: NewDefaultControllerInstallationControl(k8sGardenClient, gardenInformerFactory, gardenCoreInformerFactory, recorder, config, seedLister, controllerRegistrationLister, controllerInstallationLister, gardenNamespace),
+		careControl:                   NewDefaultCareControl(k8sGardenClient, seedLister, controllerRegistrationLister),
 		config:                        config,
 		recorder:                      recorder,
 
@@ -99,6 +105,8 @@ func NewController(k8sGardenClient kubernetes.Interface, gardenInformerFactory g
 		controllerInstallationLister: controllerInstallationLister,
 		controllerInstallationQueue:  controllerInstallationQueue,
 
+		controllerInstallationCareQueue: controllerInstallationCareQueue,
+
 		workerCh: make(chan int),
 	}
 
@@ -110,6 +118,10 @@ func NewController(k8sGardenClient kubernetes.Interface, gardenInformerFactory g
 		UpdateFunc: controller.controllerInstallationUpdate,
 		DeleteFunc: controller.controllerInstallationDelete,
 	})
+	controllerInstallationInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    controller.controllerInstallationCareAdd,
+		UpdateFunc: controller.controllerInstallationCareUpdate,
+	})
 	controller.controllerInstallationSynced = controllerInstallationInformer.Informer().HasSynced
 
 	return controller
@@ -138,18 +150,20 @@ func (c *Controller) Run(ctx context.Context, workers int) {
 
 	for i := 0; i < workers; i++ {
 		controllerutils.DeprecatedCreateWorker(ctx, c.controllerInstallationQueue, "ControllerInstallation", c.reconcileControllerInstallationKey, &waitGroup, c.workerCh)
+		controllerutils.DeprecatedCreateWorker(ctx, c.controllerInstallationCareQueue, "ControllerInstallation Care", c.reconcileControllerInstallationCareKey, &waitGroup, c.workerCh)
 	}
 
 	// Shutdown handling
 	<-ctx.Done()
 	c.controllerInstallationQueue.ShutDown()
+	c.controllerInstallationCareQueue.ShutDown()
 
 	for {
-		if c.controllerInstallationQueue.Len() == 0 && c.numberOfRunningWorkers == 0 {
+		if c.controllerInstallationQueue.Len() == 0 && c.controllerInstallationCareQueue.Len() == 0 && c.numberOfRunningWorkers == 0 {
 			logger.Logger.Debug("No running ControllerInstallation worker and no items left in the queues. Terminated ControllerInstallation controller...")
 			break
 		}
-		logger.Logger.Debugf("Waiting for %d ControllerInstallation worker(s) to finish (%d item(s) left in the queues)...", c.numberOfRunningWorkers, c.controllerInstallationQueue.Len())
+		logger.Logger.Debugf("Waiting for %d ControllerInstallation worker(s) to finish (%d item(s) left in the queues)...", c.numberOfRunningWorkers, c.controllerInstallationQueue.Len()+c.controllerInstallationCareQueue.Len())
 		time.Sleep(5 * time.Second)
 	}
 
@@ -0,0 +1,224 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerinstallation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	gardencorelisters "github.com/gardener/gardener/pkg/client/core/listers/core/v1alpha1"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
+	"github.com/gardener/gardener/pkg/logger"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (c *Controller) controllerInstallationCareAdd(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		logger.Logger.Errorf("Couldn't get key for object %+v: %v", obj, err)
+		return
+	}
+	c.controllerInstallationCareQueue.Add(key)
+}
+
+func (c *Controller) controllerInstallationCareUpdate(oldObj, newObj interface{}) {
+	old, ok1 := oldObj.(*gardencorev1alpha1.ControllerInstallation)
+	new, ok2 := newObj.(*gardencorev1alpha1.ControllerInstallation)
+	if !ok1 || !ok2 {
+		return
+	}
+
+	// A new version of the controller has been installed, hence, its health is checked right away.
+	if old.Status.ObservedGeneration != new.Status.ObservedGeneration {
+		c.controllerInstallationCareAdd(newObj)
+	}
+}
+
+func (c *Controller) reconcileControllerInstallationCareKey(key string) error {
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	controllerInstallation, err := c.controllerInstallationLister.Get(name)
+	if apierrors.IsNotFound(err) {
+		logger.Logger.Debugf("[CONTROLLERINSTALLATION CARE] Stopping care operations for ControllerInstallation %s since it has been deleted", key)
+		c.controllerInstallationCareQueue.Done(key)
+		return nil
+	}
+	if err != nil {
+		logger.Logger.Infof("[CONTROLLERINSTALLATION CARE] %s - unable to retrieve object from store: %v", key, err)
+		return err
+	}
+
+	responsible, err := controllerutils.ResponsibleForSeed(c.config, c.seedLister, controllerInstallation.Spec.SeedRef.Name)
+	if err != nil {
+		return err
+	}
+	if !responsible {
+		c.controllerInstallationCareQueue.AddAfter(key, c.config.Controllers.ControllerInstallation.HealthSyncPeriod.Duration)
+		return nil
+	}
+
+	if err := c.careControl.Care(controllerInstallation); err != nil {
+		return err
+	}
+
+	c.controllerInstallationCareQueue.AddAfter(key, c.config.Controllers.ControllerInstallation.HealthSyncPeriod.Duration)
+	return nil
+}
+
+// CareControlInterface implements the control logic for caring for ControllerInstallations. It is implemented as an
+// interface to allow for extensions that provide different semantics. Currently, there is only one implementation.
+type CareControlInterface interface {
+	Care(controllerInstallation *gardencorev1alpha1.ControllerInstallation) error
+}
+
+// NewDefaultCareControl returns a new instance of the default implementation CareControlInterface that implements
+// the documented semantics for caring for ControllerInstallations. You should use an instance returned from
+// NewDefaultCareControl() for any scenario other than testing.
+func NewDefaultCareControl(k8sGardenClient kubernetes.Interface, seedLister gardenlisters.SeedLister, controllerRegistrationLister gardencorelisters.ControllerRegistrationLister) CareControlInterface {
+	return &defaultCareControl{k8sGardenClient, seedLister, controllerRegistrationLister}
+}
+
+type defaultCareControl struct {
+	k8sGardenClient              kubernetes.Interface
+	seedLister                   gardenlisters.SeedLister
+	controllerRegistrationLister gardencorelisters.ControllerRegistrationLister
+}
+
+func (c *defaultCareControl) Care(obj *gardencorev1alpha1.ControllerInstallation) error {
+	var (
+		ctx                    = context.TODO()
+		controllerInstallation = obj.DeepCopy()
+		logger                 = logger.NewFieldLogger(logger.Logger, "controllerinstallation-care", controllerInstallation.Name)
+	)
+
+	if controllerInstallation.DeletionTimestamp != nil || !helper.IsControllerInstallationSuccessful(*controllerInstallation) {
+		return nil
+	}
+
+	controllerRegistration, err := c.controllerRegistrationLister.Get(controllerInstallation.Spec.RegistrationRef.Name)
+	if err != nil {
+		return err
+	}
+	if deployment := controllerRegistration.Spec.Deployment; deployment == nil || !SupportedDeploymentTypes.Has(deployment.Type) {
+		return nil
+	}
+
+	conditionHealthy := helper.GetOrInitCondition(controllerInstallation.Status.Conditions, gardencorev1alpha1.ControllerInstallationHealthy)
+
+	seed, err := c.seedLister.Get(controllerInstallation.Spec.SeedRef.Name)
+	if err != nil {
+		return err
+	}
+
+	k8sSeedClient, err := kubernetes.NewClientFromSecret(c.k8sGardenClient, seed.Spec.SecretRef.Namespace, seed.Spec.SecretRef.Name, client.Options{
+		Scheme: kubernetes.SeedScheme,
+	})
+	if err != nil {
+		conditionHealthy = helper.UpdatedConditionUnknownErrorMessage(conditionHealthy, fmt.Sprintf("Client for referenced Seed cannot be created: %+v", err))
+	} else {
+		conditionHealthy = CheckControllerInstallationHealth(ctx, k8sSeedClient.Client(), controllerInstallation, conditionHealthy)
+	}
+
+	if _, err := kutil.TryUpdateControllerInstallationStatusWithEqualFunc(c.k8sGardenClient.GardenCore(), retry.DefaultBackoff, controllerInstallation.ObjectMeta,
+		func(controllerInstallation *gardencorev1alpha1.ControllerInstallation) (*gardencorev1alpha1.ControllerInstallation, error) {
+			controllerInstallation.Status.Conditions = helper.MergeConditions(controllerInstallation.Status.Conditions, conditionHealthy)
+			return controllerInstallation, nil
+		}, func(cur, updated *gardencorev1alpha1.ControllerInstallation) bool {
+			return equality.Semantic.DeepEqual(cur.Status.Conditions, updated.Status.Conditions)
+		},
+	); err != nil {
+		logger.Errorf("Failed to update the health condition: %+v", err)
+		return err
+	}
+
+	return nil
+}
+
+// CheckControllerInstallationHealth checks the health of the workloads (Deployments, StatefulSets and DaemonSets)
+// which have been deployed for the given ControllerInstallation and returns the updated <condition>. The message of
+// the condition lists every unhealthy workload together with the reason.
+func CheckControllerInstallationHealth(ctx context.Context, seedClient client.Client, controllerInstallation *gardencorev1alpha1.ControllerInstallation, condition gardencorev1alpha1.Condition) gardencorev1alpha1.Condition {
+	providerStatus := controllerInstallation.Status.ProviderStatus
+	if providerStatus == nil {
+		return helper.UpdatedConditionUnknownErrorMessage(condition, "No resources have been deployed for the controller yet.")
+	}
+
+	var deployedResources DeployedResources
+	if err := json.Unmarshal(providerStatus.Raw, &deployedResources); err != nil {
+		return helper.UpdatedConditionUnknownErrorMessage(condition, fmt.Sprintf("Deployed resources cannot be read: %+v", err))
+	}
+
+	var unhealthy []string
+	for _, resource := range deployedResources.Resources {
+		if err := checkWorkload(ctx, seedClient, resource); err != nil {
+			unhealthy = append(unhealthy, fmt.Sprintf("%s %s/%s: %v", resource.Kind, resource.Namespace, resource.Name, err))
+		}
+	}
+
+	if len(unhealthy) > 0 {
+		return helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "ControllerNotHealthy", fmt.Sprintf("Workloads of the controller are unhealthy: %s", strings.Join(unhealthy, "; ")))
+	}
+	return helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "ControllerHealthy", "All workloads of the controller are healthy.")
+}
+
+// checkWorkload checks the health of the given object if it is a workload. Other objects are ignored.
+func checkWorkload(ctx context.Context, seedClient client.Client, resource corev1.ObjectReference) error {
+	namespace := resource.Namespace
+	if len(namespace) == 0 {
+		namespace = metav1.NamespaceDefault
+	}
+	key := kutil.Key(namespace, resource.Name)
+
+	switch resource.Kind {
+	case "Deployment":
+		deployment := &appsv1.Deployment{}
+		if err := seedClient.Get(ctx, key, deployment); err != nil {
+			return err
+		}
+		return health.CheckDeployment(deployment)
+	case "StatefulSet":
+		statefulSet := &appsv1.StatefulSet{}
+		if err := seedClient.Get(ctx, key, statefulSet); err != nil {
+			return err
+		}
+		return health.CheckStatefulSet(statefulSet)
+	case "DaemonSet":
+		daemonSet := &appsv1.DaemonSet{}
+		if err := seedClient.Get(ctx, key, daemonSet); err != nil {
+			return err
+		}
+		return health.CheckDaemonSet(daemonSet)
+	}
+	return nil
+}
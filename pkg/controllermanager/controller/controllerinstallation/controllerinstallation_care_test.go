@@ -0,0 +1,116 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerinstallation_test
+
+import (
+	"context"
+	"encoding/json"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/controllerinstallation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("ControllerInstallation Care", func() {
+	Describe("#CheckControllerInstallationHealth", func() {
+		var (
+			ctx       = context.TODO()
+			condition = gardencorev1alpha1.Condition{Type: gardencorev1alpha1.ControllerInstallationHealthy}
+			replicas  = int32(1)
+
+			deployment = &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "provider-foo", Namespace: "extension-foo", Generation: 1},
+				Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Replicas:           1,
+					UpdatedReplicas:    1,
+					AvailableReplicas:  1,
+					Conditions: []appsv1.DeploymentCondition{
+						{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue},
+					},
+				},
+			}
+
+			newControllerInstallation = func(resources ...corev1.ObjectReference) *gardencorev1alpha1.ControllerInstallation {
+				raw, err := json.Marshal(DeployedResources{Resources: resources})
+				Expect(err).NotTo(HaveOccurred())
+
+				return &gardencorev1alpha1.ControllerInstallation{
+					Status: gardencorev1alpha1.ControllerInstallationStatus{
+						ProviderStatus: &gardencorev1alpha1.ProviderConfig{RawExtension: runtime.RawExtension{Raw: raw}},
+					},
+				}
+			}
+			deploymentRef = corev1.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "extension-foo", Name: "provider-foo"}
+		)
+
+		It("should report healthy workloads", func() {
+			var (
+				c                      = fake.NewFakeClient(deployment.DeepCopy())
+				controllerInstallation = newControllerInstallation(
+					deploymentRef,
+					corev1.ObjectReference{APIVersion: "v1", Kind: "ServiceAccount", Namespace: "extension-foo", Name: "provider-foo"},
+				)
+			)
+
+			updated := CheckControllerInstallationHealth(ctx, c, controllerInstallation, condition)
+
+			Expect(updated.Status).To(Equal(gardencorev1alpha1.ConditionTrue))
+			Expect(updated.Reason).To(Equal("ControllerHealthy"))
+		})
+
+		It("should report unhealthy workloads", func() {
+			unhealthyDeployment := deployment.DeepCopy()
+			unhealthyDeployment.Status.Conditions[0].Status = corev1.ConditionFalse
+
+			var (
+				c                      = fake.NewFakeClient(unhealthyDeployment)
+				controllerInstallation = newControllerInstallation(deploymentRef)
+			)
+
+			updated := CheckControllerInstallationHealth(ctx, c, controllerInstallation, condition)
+
+			Expect(updated.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(updated.Reason).To(Equal("ControllerNotHealthy"))
+			Expect(updated.Message).To(ContainSubstring("Deployment extension-foo/provider-foo"))
+		})
+
+		It("should report missing workloads", func() {
+			var (
+				c                      = fake.NewFakeClient()
+				controllerInstallation = newControllerInstallation(deploymentRef)
+			)
+
+			updated := CheckControllerInstallationHealth(ctx, c, controllerInstallation, condition)
+
+			Expect(updated.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+			Expect(updated.Message).To(ContainSubstring("Deployment extension-foo/provider-foo"))
+		})
+
+		It("should report an unknown status if no resources have been deployed", func() {
+			updated := CheckControllerInstallationHealth(ctx, fake.NewFakeClient(), &gardencorev1alpha1.ControllerInstallation{}, condition)
+
+			Expect(updated.Status).To(Equal(gardencorev1alpha1.ConditionUnknown))
+		})
+	})
+})
@@ -19,6 +19,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"time"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	"github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
@@ -30,10 +32,11 @@ import (
 	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/controllerregistration"
+	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation/common"
 	seedpkg "github.com/gardener/gardener/pkg/operation/seed"
-	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
@@ -47,14 +50,14 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/yaml"
+	memcache "k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-const installationTypeHelm = "helm"
-
 func (c *Controller) controllerInstallationAdd(obj interface{}) {
 	key, err := cache.MetaNamespaceKeyFunc(obj)
 	if err != nil {
@@ -103,6 +106,15 @@ func (c *Controller) reconcileControllerInstallationKey(key string) error {
 		return err
 	}
 
+	responsible, err := controllerutils.ResponsibleForSeed(c.config, c.seedLister, controllerInstallation.Spec.SeedRef.Name)
+	if err != nil {
+		return err
+	}
+	if !responsible {
+		logger.Logger.Debugf("[CONTROLLERINSTALLATION RECONCILE] %s - skipping because ControllerInstallation is reconciled by another Gardener component", key)
+		return nil
+	}
+
 	return c.controllerInstallationControl.Reconcile(controllerInstallation)
 }
 
@@ -117,7 +129,7 @@ type ControlInterface interface {
 // to update the status of ControllerInstallations. You should use an instance returned from NewDefaultControllerInstallationControl() for any
 // scenario other than testing.
 func NewDefaultControllerInstallationControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory, recorder record.EventRecorder, config *config.ControllerManagerConfiguration, seedLister gardenlisters.SeedLister, controllerRegistrationLister gardencorelisters.ControllerRegistrationLister, controllerInstallationLister gardencorelisters.ControllerInstallationLister, gardenNamespace *corev1.Namespace) ControlInterface {
-	return &defaultControllerInstallationControl{k8sGardenClient, k8sGardenInformers, k8sGardenCoreInformers, recorder, config, seedLister, controllerRegistrationLister, controllerInstallationLister, gardenNamespace}
+	return &defaultControllerInstallationControl{k8sGardenClient, k8sGardenInformers, k8sGardenCoreInformers, recorder, config, seedLister, controllerRegistrationLister, controllerInstallationLister, gardenNamespace, NewChartFetcher(&http.Client{Timeout: chartFetchTimeout}, DefaultChartCacheSize)}
 }
 
 type defaultControllerInstallationControl struct {
@@ -130,8 +142,11 @@ type defaultControllerInstallationControl struct {
 	controllerRegistrationLister gardencorelisters.ControllerRegistrationLister
 	controllerInstallationLister gardencorelisters.ControllerInstallationLister
 	gardenNamespace              *corev1.Namespace
+	chartFetcher                 ChartFetcher
 }
 
+const chartFetchTimeout = time.Minute
+
 func (c *defaultControllerInstallationControl) Reconcile(obj *gardencorev1alpha1.ControllerInstallation) error {
 	var (
 		controllerInstallation = obj.DeepCopy()
@@ -165,14 +180,31 @@ func (c *defaultControllerInstallationControl) reconcile(controllerInstallation
 		return err
 	}
 
+	// Installations which have not been promoted to the current specification of the registration yet (because it is
+	// being rolled out to other seeds first) keep their previous deployment configuration. Installations created
+	// before the deployment configuration was stored in their specification cannot be reconciled with their previous
+	// version and are skipped until they are promoted.
+	if controllerInstallation.Spec.Deployment == nil {
+		rolledOut, err := c.isRolledOut(controllerInstallation)
+		if err != nil {
+			return err
+		}
+		if !rolledOut {
+			logger.Infof("Skipping reconciliation because the current version of the ControllerRegistration has not been rolled out to the seed yet")
+			return nil
+		}
+	}
+
 	var (
 		newConditions      = helper.MergeConditions(controllerInstallation.Status.Conditions, helper.InitCondition(gardencorev1alpha1.ControllerInstallationValid), helper.InitCondition(gardencorev1alpha1.ControllerInstallationInstalled))
 		conditionValid     = newConditions[0]
 		conditionInstalled = newConditions[1]
+
+		additionalConditions []gardencorev1alpha1.Condition
 	)
 
 	defer func() {
-		if _, err := c.updateConditions(controllerInstallation, conditionValid, conditionInstalled); err != nil {
+		if _, err := c.updateConditions(controllerInstallation, append([]gardencorev1alpha1.Condition{conditionValid, conditionInstalled}, additionalConditions...)...); err != nil {
 			logger.Errorf("Failed to update the conditions : %+v", err)
 		}
 	}()
@@ -207,16 +239,44 @@ func (c *defaultControllerInstallationControl) reconcile(controllerInstallation
 		}
 		return err
 	}
+
+	// Installations which keep the rolled-out deployment configuration of their registration also keep its
+	// compatibility.
+	compatibility := controllerRegistration.Spec.Compatibility
+	if controllerInstallation.Spec.Deployment != nil {
+		compatibility = controllerInstallation.Spec.Compatibility
+	}
+
+	if compatibility != nil {
+		seedVersion := k8sSeedClient.Version()
+
+		compatible, err := controllerregistration.IsVersionCompatible(seedVersion, compatibility.Kubernetes)
+		if err != nil {
+			conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionUnknown, "KubernetesVersionCheckFailed", fmt.Sprintf("Kubernetes version %s of the referenced Seed could not be checked: %+v", seedVersion, err))
+			return err
+		}
+		if !compatible {
+			conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionFalse, controllerregistration.ReasonSeedKubernetesVersionUnsupported, fmt.Sprintf("Kubernetes version %s of the referenced Seed is not supported by the controller.", seedVersion))
+			return nil
+		}
+	}
+
 	chartRenderer, err := chartrenderer.NewForConfig(k8sSeedClient.RESTConfig())
 	if err != nil {
 		conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionUnknown, "ChartRendererCreationFailed", fmt.Sprintf("ChartRenderer cannot be recreated for referenced Seed: %+v", err))
 		return err
 	}
 
-	var helmDeployment HelmDeployment
-	if err := json.Unmarshal(controllerRegistration.Spec.Deployment.ProviderConfig.Raw, &helmDeployment); err != nil {
-		conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionFalse, "ChartInformationInvalid", fmt.Sprintf("Chart Information cannot be unmarshalled: %+v", err))
-		return err
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memcache.NewMemCacheClient(k8sSeedClient.Kubernetes().Discovery()))
+
+	deployment := controllerRegistration.Spec.Deployment
+	if controllerInstallation.Spec.Deployment != nil {
+		deployment = controllerInstallation.Spec.Deployment
+	}
+	deploymentRenderer, ok := NewDeploymentRenderers(chartRenderer, c.chartFetcher, mapper)[deployment.Type]
+	if !ok {
+		conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionFalse, "DeploymentTypeUnsupported", fmt.Sprintf("Deployment type %q is not supported", deployment.Type))
+		return fmt.Errorf("deployment type %q is not supported", deployment.Type)
 	}
 
 	namespace := getNamespaceForControllerInstallation(controllerInstallation)
@@ -248,15 +308,14 @@ func (c *defaultControllerInstallationControl) reconcile(controllerInstallation
 		},
 	}
 
-	release, err := chartRenderer.RenderArchive(helmDeployment.Chart, controllerRegistration.Name, namespace.Name, utils.MergeMaps(helmDeployment.Values, seedValues))
+	manifest, err := deploymentRenderer.Render(ctx, deployment.ProviderConfig, controllerRegistration.Name, namespace.Name, seedValues)
 	if err != nil {
-		conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionFalse, "ChartCannotBeRendered", fmt.Sprintf("Chart rendering process failed: %+v", err))
+		conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionFalse, "DeploymentCannotBeRendered", fmt.Sprintf("Rendering of the %s deployment failed: %+v", deployment.Type, err))
 		return err
 	}
-	conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionTrue, "RegistrationValid", "Chart could be rendered successfully.")
+	conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionTrue, "RegistrationValid", "Deployment could be rendered successfully.")
 
 	var (
-		manifest        = release.Manifest()
 		newResources    DeployedResources
 		newResourcesSet = sets.NewString()
 
@@ -314,12 +373,18 @@ func (c *defaultControllerInstallationControl) reconcile(controllerInstallation
 		return err
 	}
 
-	if err := k8sSeedClient.Applier().ApplyManifest(context.TODO(), kubernetes.NewManifestReader(release.Manifest()), kubernetes.DefaultApplierOptions); err != nil {
+	if err := k8sSeedClient.Applier().ApplyManifest(context.TODO(), kubernetes.NewManifestReader(manifest), kubernetes.DefaultApplierOptions); err != nil {
 		conditionInstalled = helper.UpdatedCondition(conditionInstalled, gardencorev1alpha1.ConditionFalse, "InstallationFailed", fmt.Sprintf("Installation of new resources failed: %+v", err))
 		return err
 	}
 
 	conditionInstalled = helper.UpdatedCondition(conditionInstalled, gardencorev1alpha1.ConditionTrue, "InstallationSuccessful", "Installation of new resources succeeded.")
+
+	// The health of a new version of the controller has to be checked again before it is considered for rollouts.
+	if controllerInstallation.Status.ObservedGeneration != controllerInstallation.Generation {
+		conditionHealthy := helper.GetOrInitCondition(controllerInstallation.Status.Conditions, gardencorev1alpha1.ControllerInstallationHealthy)
+		additionalConditions = append(additionalConditions, helper.UpdatedCondition(conditionHealthy, gardencorev1alpha1.ConditionUnknown, "HealthCheckPending", "Health of the installed version of the controller has not been checked yet."))
+	}
 	return nil
 }
 
@@ -400,10 +465,12 @@ func (c *defaultControllerInstallationControl) delete(controllerInstallation *ga
 func (c *defaultControllerInstallationControl) updateConditions(controllerInstallation *gardencorev1alpha1.ControllerInstallation, conditions ...gardencorev1alpha1.Condition) (*gardencorev1alpha1.ControllerInstallation, error) {
 	return kutil.TryUpdateControllerInstallationStatusWithEqualFunc(c.k8sGardenClient.GardenCore(), retry.DefaultBackoff, controllerInstallation.ObjectMeta,
 		func(controllerInstallation *gardencorev1alpha1.ControllerInstallation) (*gardencorev1alpha1.ControllerInstallation, error) {
-			controllerInstallation.Status.Conditions = conditions
+			// The health condition is maintained by the care control and must not be dropped here.
+			controllerInstallation.Status.Conditions = helper.MergeConditions(controllerInstallation.Status.Conditions, conditions...)
+			controllerInstallation.Status.ObservedGeneration = controllerInstallation.Generation
 			return controllerInstallation, nil
 		}, func(cur, updated *gardencorev1alpha1.ControllerInstallation) bool {
-			return equality.Semantic.DeepEqual(cur.Status.Conditions, updated.Status.Conditions)
+			return equality.Semantic.DeepEqual(cur.Status.Conditions, updated.Status.Conditions) && cur.Status.ObservedGeneration == updated.Status.ObservedGeneration
 		},
 	)
 }
@@ -415,11 +482,33 @@ func (c *defaultControllerInstallationControl) isResponsible(controllerInstallat
 	}
 
 	if deployment := controllerRegistration.Spec.Deployment; deployment != nil {
-		return deployment.Type == installationTypeHelm, nil
+		return SupportedDeploymentTypes.Has(deployment.Type), nil
 	}
 	return false, nil
 }
 
+// isRolledOut checks whether the current specification of the referenced registration has been rolled out to the
+// installation, i.e., whether the registration spec hash label of the installation matches the current specification.
+func (c *defaultControllerInstallationControl) isRolledOut(controllerInstallation *gardencorev1alpha1.ControllerInstallation) (bool, error) {
+	installationSpecHash, ok := controllerInstallation.Labels[common.RegistrationSpecHash]
+	if !ok {
+		return true, nil
+	}
+
+	controllerRegistration, err := c.controllerRegistrationLister.Get(controllerInstallation.Spec.RegistrationRef.Name)
+	if err != nil {
+		// Errors are handled when the registration is read during the reconciliation.
+		return true, nil
+	}
+
+	registrationSpecHash, err := controllerregistration.ComputeRegistrationSpecHash(controllerRegistration.Spec)
+	if err != nil {
+		return false, err
+	}
+
+	return installationSpecHash == registrationSpecHash, nil
+}
+
 func (c *defaultControllerInstallationControl) cleanOldExtensions(ctx context.Context, seedClient client.Client, controllerRegistration *gardencorev1alpha1.ControllerRegistration) error {
 	var fns []flow.TaskFn
 
@@ -31,3 +31,50 @@ type DeployedResources struct {
 	// Resources is a list of objects that have been created.
 	Resources []corev1.ObjectReference `json:"resources,omitempty"`
 }
+
+// HelmChartRefDeployment is a providerConfig specific type for ControllerInstallation whose chart is fetched
+// from a repository.
+type HelmChartRefDeployment struct {
+	// ChartRef references the Helm chart tarball.
+	ChartRef ChartReference `json:"chartRef"`
+	// Values is a map of values for the given chart.
+	Values map[string]interface{} `json:"values,omitempty"`
+}
+
+// ChartReference references a Helm chart tarball in an OCI registry or on an HTTP server.
+type ChartReference struct {
+	// URL is either the http(s) URL of the chart tarball or a reference of the form
+	// oci://<registry>/<repository>[:<tag>|@<digest>].
+	URL string `json:"url"`
+	// Digest is the digest of the chart tarball in the form sha256:<hex>. Fetched charts with a different
+	// digest are rejected.
+	Digest string `json:"digest"`
+}
+
+// ManifestsDeployment is a providerConfig specific type for ControllerInstallation consisting of plain manifests.
+type ManifestsDeployment struct {
+	// Manifests is a multi-document YAML stream of the objects to deploy.
+	Manifests string `json:"manifests,omitempty"`
+	// Patches is a list of kustomize-style patches which are applied to the objects.
+	Patches []ManifestPatch `json:"patches,omitempty"`
+}
+
+// ManifestPatch is a patch for the objects of a ManifestsDeployment.
+type ManifestPatch struct {
+	// Target selects the objects the patch is applied to.
+	Target PatchTarget `json:"target,omitempty"`
+	// Patch is either a strategic merge patch (a partial object) or a JSON6902 patch (a list of operations).
+	Patch string `json:"patch"`
+}
+
+// PatchTarget selects objects by their API version, kind, namespace and name. Empty fields match all objects.
+type PatchTarget struct {
+	// APIVersion is the API version of the objects.
+	APIVersion string `json:"apiVersion,omitempty"`
+	// Kind is the kind of the objects.
+	Kind string `json:"kind,omitempty"`
+	// Namespace is the namespace of the objects.
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the name of the objects.
+	Name string `json:"name,omitempty"`
+}
@@ -0,0 +1,283 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerinstallation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/ghodss/yaml"
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+const (
+	// DeploymentTypeHelm is the deployment type for Helm charts embedded into the ControllerRegistration.
+	DeploymentTypeHelm = "helm"
+	// DeploymentTypeHelmChartRef is the deployment type for Helm charts fetched from an OCI or HTTP repository.
+	DeploymentTypeHelmChartRef = "helm-chart-ref"
+	// DeploymentTypeManifests is the deployment type for plain Kubernetes manifests.
+	DeploymentTypeManifests = "manifests"
+)
+
+// SupportedDeploymentTypes are the deployment types of ControllerRegistrations Gardener deploys itself.
+var SupportedDeploymentTypes = sets.NewString(DeploymentTypeHelm, DeploymentTypeHelmChartRef, DeploymentTypeManifests)
+
+// DeploymentRenderer renders the manifests of the extension controller of a ControllerRegistration for a
+// certain deployment type. <values> are the standard values for the seed which are mixed into the values
+// of Helm charts.
+type DeploymentRenderer interface {
+	Render(ctx context.Context, providerConfig *gardencorev1alpha1.ProviderConfig, releaseName, namespace string, values map[string]interface{}) ([]byte, error)
+}
+
+// NewDeploymentRenderers returns the renderers for all supported deployment types. Helm charts are rendered
+// with the given <chartRenderer>, referenced charts are fetched with the given <chartFetcher>. The <mapper> of
+// the seed is used to determine which plain manifests are namespaced.
+func NewDeploymentRenderers(chartRenderer chartrenderer.Interface, chartFetcher ChartFetcher, mapper meta.RESTMapper) map[string]DeploymentRenderer {
+	return map[string]DeploymentRenderer{
+		DeploymentTypeHelm:         &helmRenderer{chartRenderer},
+		DeploymentTypeHelmChartRef: &helmChartRefRenderer{chartRenderer, chartFetcher},
+		DeploymentTypeManifests:    &manifestsRenderer{mapper},
+	}
+}
+
+type helmRenderer struct {
+	chartRenderer chartrenderer.Interface
+}
+
+func (r *helmRenderer) Render(_ context.Context, providerConfig *gardencorev1alpha1.ProviderConfig, releaseName, namespace string, values map[string]interface{}) ([]byte, error) {
+	var helmDeployment HelmDeployment
+	if err := decodeProviderConfig(providerConfig, &helmDeployment); err != nil {
+		return nil, err
+	}
+
+	release, err := r.chartRenderer.RenderArchive(helmDeployment.Chart, releaseName, namespace, utils.MergeMaps(helmDeployment.Values, values))
+	if err != nil {
+		return nil, err
+	}
+	return release.Manifest(), nil
+}
+
+type helmChartRefRenderer struct {
+	chartRenderer chartrenderer.Interface
+	chartFetcher  ChartFetcher
+}
+
+func (r *helmChartRefRenderer) Render(ctx context.Context, providerConfig *gardencorev1alpha1.ProviderConfig, releaseName, namespace string, values map[string]interface{}) ([]byte, error) {
+	var helmChartRefDeployment HelmChartRefDeployment
+	if err := decodeProviderConfig(providerConfig, &helmChartRefDeployment); err != nil {
+		return nil, err
+	}
+
+	chart, err := r.chartFetcher.Fetch(ctx, helmChartRefDeployment.ChartRef)
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := r.chartRenderer.RenderArchive(chart, releaseName, namespace, utils.MergeMaps(helmChartRefDeployment.Values, values))
+	if err != nil {
+		return nil, err
+	}
+	return release.Manifest(), nil
+}
+
+type manifestsRenderer struct {
+	mapper meta.RESTMapper
+}
+
+// Render renders the plain manifests. They are not templated, hence, the standard seed values are not used and
+// the providerConfig must not contain any values.
+func (r *manifestsRenderer) Render(_ context.Context, providerConfig *gardencorev1alpha1.ProviderConfig, _, namespace string, _ map[string]interface{}) ([]byte, error) {
+	var fields map[string]json.RawMessage
+	if err := decodeProviderConfig(providerConfig, &fields); err != nil {
+		return nil, err
+	}
+	if _, ok := fields["values"]; ok {
+		return nil, fmt.Errorf("values are not supported for deployment type %q", DeploymentTypeManifests)
+	}
+
+	var manifestsDeployment ManifestsDeployment
+	if err := decodeProviderConfig(providerConfig, &manifestsDeployment); err != nil {
+		return nil, err
+	}
+
+	return RenderManifests(manifestsDeployment, namespace, r.mapper)
+}
+
+// RenderManifests decodes the objects of the given ManifestsDeployment, puts the namespaced objects without a
+// namespace into the given <namespace>, applies the patches in order and returns the resulting objects as
+// multi-document YAML stream. The <mapper> determines whether an object is namespaced, the scope of kinds which
+// are unknown to it is taken from the CustomResourceDefinitions contained in the manifests.
+func RenderManifests(manifestsDeployment ManifestsDeployment, namespace string, mapper meta.RESTMapper) ([]byte, error) {
+	var (
+		objects []*unstructured.Unstructured
+		decoder = yamlutil.NewYAMLOrJSONDecoder(bytes.NewReader([]byte(manifestsDeployment.Manifests)), 1024)
+	)
+
+	for {
+		var decodedObj map[string]interface{}
+		if err := decoder.Decode(&decodedObj); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("could not decode manifests: %v", err)
+		}
+		if decodedObj == nil {
+			continue
+		}
+		objects = append(objects, &unstructured.Unstructured{Object: decodedObj})
+	}
+
+	if err := defaultNamespaces(objects, namespace, mapper); err != nil {
+		return nil, err
+	}
+
+	for i, patch := range manifestsDeployment.Patches {
+		for _, obj := range objects {
+			if !patch.Target.matches(obj) {
+				continue
+			}
+			if err := applyPatch(obj, patch.Patch); err != nil {
+				return nil, fmt.Errorf("could not apply patch %d to %s %s/%s: %v", i, obj.GetKind(), obj.GetNamespace(), obj.GetName(), err)
+			}
+		}
+	}
+
+	var out bytes.Buffer
+	for _, obj := range objects {
+		data, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return nil, err
+		}
+		out.WriteString("---\n")
+		out.Write(data)
+	}
+	return out.Bytes(), nil
+}
+
+// defaultNamespaces sets the given <namespace> for all namespaced objects without a namespace.
+func defaultNamespaces(objects []*unstructured.Unstructured, namespace string, mapper meta.RESTMapper) error {
+	crdScopes := map[schema.GroupKind]string{}
+	for _, obj := range objects {
+		if obj.GroupVersionKind().GroupKind() != crdGroupKind {
+			continue
+		}
+		group, _, _ := unstructured.NestedString(obj.Object, "spec", "group")
+		kind, _, _ := unstructured.NestedString(obj.Object, "spec", "names", "kind")
+		scope, _, _ := unstructured.NestedString(obj.Object, "spec", "scope")
+		crdScopes[schema.GroupKind{Group: group, Kind: kind}] = scope
+	}
+
+	for _, obj := range objects {
+		if len(obj.GetNamespace()) > 0 {
+			continue
+		}
+
+		gvk := obj.GroupVersionKind()
+		mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		switch {
+		case err == nil:
+			if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+				continue
+			}
+		case meta.IsNoMatchError(err):
+			scope, ok := crdScopes[gvk.GroupKind()]
+			if !ok {
+				return fmt.Errorf("could not determine whether %s %s is namespaced: %v", gvk.Kind, obj.GetName(), err)
+			}
+			if scope != string(apiextensionsv1beta1.NamespaceScoped) {
+				continue
+			}
+		default:
+			return err
+		}
+
+		obj.SetNamespace(namespace)
+	}
+	return nil
+}
+
+var crdGroupKind = apiextensionsv1beta1.SchemeGroupVersion.WithKind("CustomResourceDefinition").GroupKind()
+
+func (t PatchTarget) matches(obj *unstructured.Unstructured) bool {
+	return (len(t.APIVersion) == 0 || t.APIVersion == obj.GetAPIVersion()) &&
+		(len(t.Kind) == 0 || t.Kind == obj.GetKind()) &&
+		(len(t.Namespace) == 0 || t.Namespace == obj.GetNamespace()) &&
+		(len(t.Name) == 0 || t.Name == obj.GetName())
+}
+
+// applyPatch applies the given patch to the object. A list of operations is treated as JSON6902 patch, everything
+// else as strategic merge patch. Objects whose kinds are not known to the seed scheme are patched with JSON merge
+// patch semantics.
+func applyPatch(obj *unstructured.Unstructured, patch string) error {
+	var decodedPatch interface{}
+	if err := yaml.Unmarshal([]byte(patch), &decodedPatch); err != nil {
+		return err
+	}
+	patchJSON, err := json.Marshal(decodedPatch)
+	if err != nil {
+		return err
+	}
+	objJSON, err := obj.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	var patchedJSON []byte
+	switch decodedPatch.(type) {
+	case []interface{}:
+		jsonPatch, err := jsonpatch.DecodePatch(patchJSON)
+		if err != nil {
+			return err
+		}
+		if patchedJSON, err = jsonPatch.Apply(objJSON); err != nil {
+			return err
+		}
+	case map[string]interface{}:
+		if typed, err := kubernetes.SeedScheme.New(obj.GroupVersionKind()); err == nil {
+			patchedJSON, err = strategicpatch.StrategicMergePatch(objJSON, patchJSON, typed)
+			if err != nil {
+				return err
+			}
+		} else if patchedJSON, err = jsonpatch.MergePatch(objJSON, patchJSON); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("patch must either be an object or a list of operations")
+	}
+
+	return obj.UnmarshalJSON(patchedJSON)
+}
+
+func decodeProviderConfig(providerConfig *gardencorev1alpha1.ProviderConfig, into interface{}) error {
+	if providerConfig == nil {
+		return fmt.Errorf("providerConfig must be set")
+	}
+	return json.Unmarshal(providerConfig.Raw, into)
+}
@@ -0,0 +1,245 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerinstallation_test
+
+import (
+	"context"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/controllerinstallation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var _ = Describe("Deployment Renderers", func() {
+	const namespace = "extension-foo"
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"}, meta.RESTScopeRoot)
+	mapper.Add(schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1beta1", Kind: "CustomResourceDefinition"}, meta.RESTScopeRoot)
+	mapper.Add(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Foo"}, meta.RESTScopeNamespace)
+
+	Describe("#Render", func() {
+		It("should reject values for manifests", func() {
+			renderer := NewDeploymentRenderers(nil, nil, mapper)[DeploymentTypeManifests]
+
+			_, err := renderer.Render(context.TODO(), &gardencorev1alpha1.ProviderConfig{
+				RawExtension: runtime.RawExtension{Raw: []byte(`{"manifests": "", "values": {"foo": "bar"}}`)},
+			}, "foo", namespace, nil)
+
+			Expect(err).To(MatchError(ContainSubstring("values are not supported")))
+		})
+	})
+
+	Describe("#RenderManifests", func() {
+		const manifests = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: provider-foo
+  namespace: extension-foo
+spec:
+  template:
+    spec:
+      containers:
+      - name: provider-foo
+        image: provider-foo:v1
+      - name: sidecar
+        image: sidecar:v1
+---
+apiVersion: example.com/v1
+kind: Foo
+metadata:
+  name: foo
+spec:
+  bar: baz
+  qux: quux
+`
+
+		It("should render the manifests without patches", func() {
+			rendered, err := RenderManifests(ManifestsDeployment{Manifests: manifests}, namespace, mapper)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(rendered)).To(ContainSubstring("image: provider-foo:v1"))
+			Expect(string(rendered)).To(ContainSubstring("kind: Foo"))
+		})
+
+		It("should apply strategic merge patches to matching objects", func() {
+			rendered, err := RenderManifests(ManifestsDeployment{
+				Manifests: manifests,
+				Patches: []ManifestPatch{
+					{
+						Target: PatchTarget{Kind: "Deployment", Name: "provider-foo"},
+						Patch: `spec:
+  template:
+    spec:
+      containers:
+      - name: provider-foo
+        image: provider-foo:v2`,
+					},
+				},
+			}, namespace, mapper)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(rendered)).To(ContainSubstring("image: provider-foo:v2"))
+			Expect(string(rendered)).To(ContainSubstring("image: sidecar:v1"))
+		})
+
+		It("should apply JSON merge patches to objects of unknown kinds", func() {
+			rendered, err := RenderManifests(ManifestsDeployment{
+				Manifests: manifests,
+				Patches: []ManifestPatch{
+					{
+						Target: PatchTarget{APIVersion: "example.com/v1", Kind: "Foo"},
+						Patch:  `{"spec": {"qux": null, "bar": "patched"}}`,
+					},
+				},
+			}, namespace, mapper)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(rendered)).To(ContainSubstring("bar: patched"))
+			Expect(string(rendered)).NotTo(ContainSubstring("qux"))
+		})
+
+		It("should apply JSON6902 patches", func() {
+			rendered, err := RenderManifests(ManifestsDeployment{
+				Manifests: manifests,
+				Patches: []ManifestPatch{
+					{
+						Target: PatchTarget{Kind: "Deployment"},
+						Patch: `- op: replace
+  path: /spec/template/spec/containers/1/image
+  value: sidecar:v2`,
+					},
+				},
+			}, namespace, mapper)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(rendered)).To(ContainSubstring("image: provider-foo:v1"))
+			Expect(string(rendered)).To(ContainSubstring("image: sidecar:v2"))
+		})
+
+		It("should not patch objects which do not match the target", func() {
+			rendered, err := RenderManifests(ManifestsDeployment{
+				Manifests: manifests,
+				Patches: []ManifestPatch{
+					{
+						Target: PatchTarget{Kind: "Deployment", Namespace: "other"},
+						Patch:  `{"metadata": {"labels": {"foo": "bar"}}}`,
+					},
+				},
+			}, namespace, mapper)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(rendered)).NotTo(ContainSubstring("labels"))
+		})
+
+		It("should put namespaced objects without namespace into the installation namespace", func() {
+			rendered, err := RenderManifests(ManifestsDeployment{Manifests: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: provider-foo
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: provider-bar
+  namespace: other
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: provider-foo
+---
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: bars.example.com
+spec:
+  group: example.com
+  names:
+    kind: Bar
+  scope: Namespaced
+---
+apiVersion: example.com/v1
+kind: Bar
+metadata:
+  name: bar
+`}, namespace, mapper)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(rendered)).To(Equal(`---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: provider-foo
+  namespace: extension-foo
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: provider-bar
+  namespace: other
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: provider-foo
+---
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: bars.example.com
+spec:
+  group: example.com
+  names:
+    kind: Bar
+  scope: Namespaced
+---
+apiVersion: example.com/v1
+kind: Bar
+metadata:
+  name: bar
+  namespace: extension-foo
+`))
+		})
+
+		It("should fail for objects of unknown kinds without namespace", func() {
+			_, err := RenderManifests(ManifestsDeployment{Manifests: `apiVersion: example.com/v1
+kind: Baz
+metadata:
+  name: baz
+`}, namespace, mapper)
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail for invalid patches", func() {
+			_, err := RenderManifests(ManifestsDeployment{
+				Manifests: manifests,
+				Patches: []ManifestPatch{
+					{Patch: `- op: remove
+  path: /does/not/exist`},
+				},
+			}, namespace, mapper)
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
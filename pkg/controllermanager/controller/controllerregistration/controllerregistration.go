@@ -28,8 +28,10 @@ import (
 	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	gardenmetrics "github.com/gardener/gardener/pkg/controllermanager/metrics"
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/garden"
 	"github.com/prometheus/client_golang/prometheus"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
@@ -55,6 +57,10 @@ type Controller struct {
 	seedLister gardenlisters.SeedLister
 	seedSynced cache.InformerSynced
 
+	shootSynced cache.InformerSynced
+
+	cloudProfileSynced cache.InformerSynced
+
 	controllerRegistrationQueue  workqueue.RateLimitingInterface
 	controllerRegistrationLister gardencorelisters.ControllerRegistrationLister
 	controllerRegistrationSynced cache.InformerSynced
@@ -66,7 +72,7 @@ type Controller struct {
 }
 
 // NewController instantiates a new ControllerRegistration controller.
-func NewController(k8sGardenClient kubernetes.Interface, gardenInformerFactory gardeninformers.SharedInformerFactory, gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory, config *config.ControllerManagerConfiguration, recorder record.EventRecorder) *Controller {
+func NewController(k8sGardenClient kubernetes.Interface, gardenInformerFactory gardeninformers.SharedInformerFactory, gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory, config *config.ControllerManagerConfiguration, secrets map[string]*corev1.Secret, recorder record.EventRecorder) *Controller {
 	var (
 		gardenInformer     = gardenInformerFactory.Garden().V1beta1()
 		gardenCoreInformer = gardenCoreInformerFactory.Core().V1alpha1()
@@ -75,20 +81,33 @@ func NewController(k8sGardenClient kubernetes.Interface, gardenInformerFactory g
 		seedLister   = seedInformer.Lister()
 		seedQueue    = workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "seed")
 
+		shootInformer = gardenInformer.Shoots()
+		shootLister   = shootInformer.Lister()
+
+		cloudProfileInformer = gardenInformer.CloudProfiles()
+		cloudProfileLister   = cloudProfileInformer.Lister()
+
 		controllerRegistrationInformer = gardenCoreInformer.ControllerRegistrations()
 		controllerRegistrationLister   = controllerRegistrationInformer.Lister()
 		controllerRegistrationQueue    = workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "controllerregistration")
 
 		controllerInstallationInformer = gardenCoreInformer.ControllerInstallations()
 		controllerInstallationLister   = controllerInstallationInformer.Lister()
+
+		internalDomainProvider string
 	)
 
+	// The internal domain secret is only required if shoots exist, hence, a missing secret is not an error here.
+	if internalDomain, err := garden.GetInternalDomain(secrets); err == nil {
+		internalDomainProvider = internalDomain.Provider
+	}
+
 	controller := &Controller{
 		k8sGardenClient:               k8sGardenClient,
 		k8sGardenInformers:            gardenInformerFactory,
 		k8sGardenCoreInformers:        gardenCoreInformerFactory,
 		seedControl:                   NewDefaultSeedControl(k8sGardenClient, gardenInformerFactory, gardenCoreInformerFactory, recorder, config, controllerRegistrationLister, controllerInstallationLister, controllerRegistrationQueue),
-		controllerRegistrationControl: NewDefaultControllerRegistrationControl(k8sGardenClient, gardenInformerFactory, gardenCoreInformerFactory, recorder, config, seedLister, controllerRegistrationLister, controllerInstallationLister),
+		controllerRegistrationControl: NewDefaultControllerRegistrationControl(k8sGardenClient, gardenInformerFactory, gardenCoreInformerFactory, recorder, config, seedLister, shootLister, cloudProfileLister, controllerRegistrationLister, controllerInstallationLister, internalDomainProvider),
 		config:                        config,
 		recorder:                      recorder,
 
@@ -108,6 +127,15 @@ func NewController(k8sGardenClient kubernetes.Interface, gardenInformerFactory g
 	})
 	controller.seedSynced = seedInformer.Informer().HasSynced
 
+	shootInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    controller.shootAdd,
+		UpdateFunc: controller.shootUpdate,
+		DeleteFunc: controller.shootDelete,
+	})
+	controller.shootSynced = shootInformer.Informer().HasSynced
+
+	controller.cloudProfileSynced = cloudProfileInformer.Informer().HasSynced
+
 	controllerRegistrationInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    controller.controllerRegistrationAdd,
 		UpdateFunc: controller.controllerRegistrationUpdate,
@@ -115,6 +143,9 @@ func NewController(k8sGardenClient kubernetes.Interface, gardenInformerFactory g
 	})
 	controller.controllerRegistrationSynced = controllerRegistrationInformer.Informer().HasSynced
 
+	controllerInstallationInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: controller.controllerInstallationUpdate,
+	})
 	controller.controllerInstallationSynced = controllerInstallationInformer.Informer().HasSynced
 
 	return controller
@@ -124,7 +155,7 @@ func NewController(k8sGardenClient kubernetes.Interface, gardenInformerFactory g
 func (c *Controller) Run(ctx context.Context, workers int) {
 	var waitGroup sync.WaitGroup
 
-	if !cache.WaitForCacheSync(ctx.Done(), c.seedSynced, c.controllerRegistrationSynced, c.controllerInstallationSynced) {
+	if !cache.WaitForCacheSync(ctx.Done(), c.seedSynced, c.shootSynced, c.cloudProfileSynced, c.controllerRegistrationSynced, c.controllerInstallationSynced) {
 		logger.Logger.Error("Timed out waiting for caches to sync")
 		return
 	}
@@ -15,28 +15,32 @@
 package controllerregistration
 
 import (
-	"encoding/json"
 	"fmt"
+	"sort"
 
 	"github.com/gardener/gardener/pkg/operation/common"
 
-	"github.com/gardener/gardener/pkg/utils"
-
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardenv1beta1helper "github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	gardencorelisters "github.com/gardener/gardener/pkg/client/core/listers/core/v1alpha1"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
 	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	controllermanagerfeatures "github.com/gardener/gardener/pkg/controllermanager/features"
+	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/logger"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/version"
 	multierror "github.com/hashicorp/go-multierror"
 
 	"github.com/sirupsen/logrus"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -68,6 +72,37 @@ func (c *Controller) controllerRegistrationDelete(obj interface{}) {
 	c.controllerRegistrationQueue.Add(key)
 }
 
+func (c *Controller) controllerInstallationUpdate(oldObj, newObj interface{}) {
+	old, ok1 := oldObj.(*gardencorev1alpha1.ControllerInstallation)
+	new, ok2 := newObj.(*gardencorev1alpha1.ControllerInstallation)
+	if !ok1 || !ok2 {
+		return
+	}
+
+	// The rollout of a registration depends on the state of its installations, hence, we have to reconcile it when
+	// the state of an installation changes.
+	if old.Status.ObservedGeneration == new.Status.ObservedGeneration && !conditionStatusesChanged(old.Status.Conditions, new.Status.Conditions) {
+		return
+	}
+
+	c.controllerRegistrationQueue.Add(new.Spec.RegistrationRef.Name)
+}
+
+func conditionStatusesChanged(oldConditions, newConditions []gardencorev1alpha1.Condition) bool {
+	if len(oldConditions) != len(newConditions) {
+		return true
+	}
+
+	for _, newCondition := range newConditions {
+		oldCondition := helper.GetCondition(oldConditions, newCondition.Type)
+		if oldCondition == nil || oldCondition.Status != newCondition.Status || oldCondition.Reason != newCondition.Reason {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (c *Controller) reconcileControllerRegistrationKey(key string) error {
 	_, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
@@ -97,8 +132,8 @@ type ControlInterface interface {
 // implements the documented semantics for ControllerRegistrations. updater is the UpdaterInterface used
 // to update the status of ControllerRegistrations. You should use an instance returned from NewDefaultControllerRegistrationControl() for any
 // scenario other than testing.
-func NewDefaultControllerRegistrationControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory, recorder record.EventRecorder, config *config.ControllerManagerConfiguration, seedLister gardenlisters.SeedLister, controllerRegistrationLister gardencorelisters.ControllerRegistrationLister, controllerInstallationLister gardencorelisters.ControllerInstallationLister) ControlInterface {
-	return &defaultControllerRegistrationControl{k8sGardenClient, k8sGardenInformers, k8sGardenCoreInformers, recorder, config, seedLister, controllerRegistrationLister, controllerInstallationLister}
+func NewDefaultControllerRegistrationControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory, recorder record.EventRecorder, config *config.ControllerManagerConfiguration, seedLister gardenlisters.SeedLister, shootLister gardenlisters.ShootLister, cloudProfileLister gardenlisters.CloudProfileLister, controllerRegistrationLister gardencorelisters.ControllerRegistrationLister, controllerInstallationLister gardencorelisters.ControllerInstallationLister, internalDomainProvider string) ControlInterface {
+	return &defaultControllerRegistrationControl{k8sGardenClient, k8sGardenInformers, k8sGardenCoreInformers, recorder, config, seedLister, shootLister, cloudProfileLister, controllerRegistrationLister, controllerInstallationLister, internalDomainProvider}
 }
 
 type defaultControllerRegistrationControl struct {
@@ -108,8 +143,11 @@ type defaultControllerRegistrationControl struct {
 	recorder                     record.EventRecorder
 	config                       *config.ControllerManagerConfiguration
 	seedLister                   gardenlisters.SeedLister
+	shootLister                  gardenlisters.ShootLister
+	cloudProfileLister           gardenlisters.CloudProfileLister
 	controllerRegistrationLister gardencorelisters.ControllerRegistrationLister
 	controllerInstallationLister gardencorelisters.ControllerInstallationLister
+	internalDomainProvider       string
 }
 
 func (c *defaultControllerRegistrationControl) Reconcile(obj *gardencorev1alpha1.ControllerRegistration) error {
@@ -129,7 +167,7 @@ func (c *defaultControllerRegistrationControl) reconcile(controllerRegistration
 	var (
 		err              error
 		result           error
-		installationsMap = map[string]string{}
+		installationsMap = map[string]*gardencorev1alpha1.ControllerInstallation{}
 
 		mustWriteFinalizer = false
 	)
@@ -167,31 +205,146 @@ func (c *defaultControllerRegistrationControl) reconcile(controllerRegistration
 		return err
 	}
 
-	for _, controllerInstallation := range controllerInstallationList.Items {
+	for i, controllerInstallation := range controllerInstallationList.Items {
 		if controllerInstallation.Spec.RegistrationRef.Name == controllerRegistration.Name {
-			installationsMap[controllerInstallation.Spec.SeedRef.Name] = controllerInstallation.Name
+			installationsMap[controllerInstallation.Spec.SeedRef.Name] = &controllerInstallationList.Items[i]
 		}
 	}
 
+	shootList, err := c.shootLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+
+	registrationSpecHash, err := ComputeRegistrationSpecHash(controllerRegistration.Spec)
+	if err != nil {
+		return err
+	}
+
+	var (
+		gardenerVersion     = version.Get().GitVersion
+		conditionCompatible = helper.GetOrInitCondition(controllerRegistration.Status.Conditions, gardencorev1alpha1.ControllerRegistrationCompatible)
+		conditionRolledOut  = helper.GetOrInitCondition(controllerRegistration.Status.Conditions, gardencorev1alpha1.ControllerRegistrationRolledOut)
+
+		requiredSeeds     []*gardenv1beta1.Seed
+		requiredSeedNames []string
+	)
+
+	compatible, err := IsVersionCompatible(gardenerVersion, getGardenerConstraint(controllerRegistration))
+	if err != nil {
+		conditionCompatible = updatedCondition(conditionCompatible, gardencorev1alpha1.ConditionUnknown, "VersionCheckFailed", fmt.Sprintf("Gardener version %s could not be checked: %v", gardenerVersion, err))
+	} else if !compatible {
+		conditionCompatible = updatedCondition(conditionCompatible, gardencorev1alpha1.ConditionFalse, "GardenerVersionUnsupported", fmt.Sprintf("Gardener version %s is not supported by the controller.", gardenerVersion))
+	} else {
+		conditionCompatible = updatedCondition(conditionCompatible, gardencorev1alpha1.ConditionTrue, "GardenerVersionSupported", fmt.Sprintf("Gardener version %s is supported by the controller.", gardenerVersion))
+	}
+
 	for _, seed := range seedList {
-		if err := c.reconcileSeedInstallations(controllerRegistration, seed, installationsMap); err != nil {
+		required, err := c.isDeploymentRequired(controllerRegistration, seed, shootList)
+		if err != nil {
 			result = multierror.Append(result, err)
+			continue
+		}
+
+		if seed.DeletionTimestamp != nil || !required {
+			if err := c.deleteSeedInstallation(installationsMap[seed.Name]); err != nil {
+				result = multierror.Append(result, err)
+			}
+			continue
 		}
+
+		requiredSeeds = append(requiredSeeds, seed)
+		requiredSeedNames = append(requiredSeedNames, seed.Name)
+	}
+
+	// Controllers not supporting the running Gardener version are neither installed nor updated. Existing
+	// installations are kept as they are.
+	if !compatible {
+		seeds := make(map[string]SeedRollout, len(requiredSeedNames))
+		for _, seedName := range requiredSeedNames {
+			seeds[seedName] = SeedRollout{State: gardencorev1alpha1.ControllerRolloutStatePending, Message: conditionCompatible.Message}
+		}
+		conditionRolledOut = updatedCondition(conditionRolledOut, gardencorev1alpha1.ConditionFalse, "GardenerVersionUnsupported", conditionCompatible.Message)
+
+		if err := c.updateStatus(controllerRegistration, seeds, conditionCompatible, conditionRolledOut); err != nil {
+			result = multierror.Append(result, err)
+		}
+		return result
+	}
+
+	var rollout *gardencorev1alpha1.ControllerRolloutStrategy
+	if deployment := controllerRegistration.Spec.Deployment; deployment != nil {
+		rollout = deployment.Rollout
+	}
+	plan := ComputeRolloutPlan(registrationSpecHash, rollout, requiredSeedNames, installationsMap)
+
+	for _, seed := range requiredSeeds {
+		if err := c.reconcileSeedInstallation(controllerRegistration, seed, installationsMap[seed.Name], registrationSpecHash, plan.Promote.Has(seed.Name)); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	switch {
+	case plan.Halted:
+		conditionRolledOut = updatedCondition(conditionRolledOut, gardencorev1alpha1.ConditionFalse, "RolloutHalted", "The rollout has been halted because it failed on at least one seed.")
+	case isRolloutFinished(plan):
+		conditionRolledOut = updatedCondition(conditionRolledOut, gardencorev1alpha1.ConditionTrue, "RolloutSucceeded", "The controller has been rolled out to all seeds.")
+	default:
+		conditionRolledOut = updatedCondition(conditionRolledOut, gardencorev1alpha1.ConditionFalse, "RolloutProgressing", "The controller is being rolled out to the seeds.")
+	}
+
+	if err := c.updateStatus(controllerRegistration, plan.Seeds, conditionCompatible, conditionRolledOut); err != nil {
+		result = multierror.Append(result, err)
 	}
 
 	return result
 }
 
-func (c *defaultControllerRegistrationControl) reconcileSeedInstallations(controllerRegistration *gardencorev1alpha1.ControllerRegistration, seed *gardenv1beta1.Seed, installationsMap map[string]string) error {
-	if seed.DeletionTimestamp != nil {
-		if installation, ok := installationsMap[seed.Name]; ok {
-			if err := c.k8sGardenClient.GardenCore().CoreV1alpha1().ControllerInstallations().Delete(installation, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
-				return err
-			}
+// isDeploymentRequired checks whether the given controller registration must be deployed to the given seed based on
+// the seed itself and the shoots scheduled to it.
+func (c *defaultControllerRegistrationControl) isDeploymentRequired(controllerRegistration *gardencorev1alpha1.ControllerRegistration, seed *gardenv1beta1.Seed, shootList []*gardenv1beta1.Shoot) (bool, error) {
+	if deployment := controllerRegistration.Spec.Deployment; deployment == nil || deployment.Policy == nil || *deployment.Policy != gardencorev1alpha1.ControllerDeploymentPolicyOnDemand {
+		return IsDeploymentRequired(controllerRegistration, seed, nil, false)
+	}
+
+	cloudProfile, err := c.cloudProfileLister.Get(seed.Spec.Cloud.Profile)
+	if err != nil {
+		return false, err
+	}
+	seedCloudProvider, err := gardenv1beta1helper.DetermineCloudProviderInProfile(cloudProfile.Spec)
+	if err != nil {
+		return false, err
+	}
+
+	var seedShoots []*gardenv1beta1.Shoot
+	for _, shoot := range shootList {
+		if shoot.Spec.Cloud.Seed != nil && *shoot.Spec.Cloud.Seed == seed.Name {
+			seedShoots = append(seedShoots, shoot)
 		}
+	}
+
+	requiredResources, err := ComputeRequiredResources(seedCloudProvider, seedShoots, c.internalDomainProvider, controllermanagerfeatures.FeatureGate.Enabled(features.BackupExtensions))
+	if err != nil {
+		return false, err
+	}
+
+	return IsDeploymentRequired(controllerRegistration, seed, requiredResources, len(seedShoots) > 0)
+}
+
+func (c *defaultControllerRegistrationControl) deleteSeedInstallation(controllerInstallation *gardencorev1alpha1.ControllerInstallation) error {
+	if controllerInstallation == nil {
 		return nil
 	}
 
+	if err := c.k8sGardenClient.GardenCore().CoreV1alpha1().ControllerInstallations().Delete(controllerInstallation.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// reconcileSeedInstallation creates or updates the installation of the given registration for the given seed. If
+// <promote> is false then an existing installation is kept at its current version of the registration.
+func (c *defaultControllerRegistrationControl) reconcileSeedInstallation(controllerRegistration *gardencorev1alpha1.ControllerRegistration, seed *gardenv1beta1.Seed, existingInstallation *gardencorev1alpha1.ControllerInstallation, registrationSpecHash string, promote bool) error {
 	seed, err := kutil.TryUpdateSeedWithEqualFunc(c.k8sGardenClient.Garden(), retry.DefaultBackoff, seed.ObjectMeta, func(s *gardenv1beta1.Seed) (*gardenv1beta1.Seed, error) {
 		if finalizers := sets.NewString(s.Finalizers...); !finalizers.Has(FinalizerName) {
 			finalizers.Insert(FinalizerName)
@@ -216,24 +369,21 @@ func (c *defaultControllerRegistrationControl) reconcileSeedInstallations(contro
 		},
 	}
 
-	seedSpecMap, err := convertObjToMap(seed.Spec)
-	if err != nil {
-		return err
-	}
-	registrationSpecMap, err := convertObjToMap(controllerRegistration.Spec)
+	seedSpecHash, err := ComputeSpecHash(seed.Spec)
 	if err != nil {
 		return err
 	}
 
-	var (
-		seedSpecHash         = utils.HashForMap(seedSpecMap)[:16]
-		registrationSpecHash = utils.HashForMap(registrationSpecMap)[:16]
-	)
-
-	if name, ok := installationsMap[seed.Name]; ok {
-		_, err := kutil.CreateOrPatchControllerInstallation(c.k8sGardenClient.GardenCore(), metav1.ObjectMeta{Name: name}, func(controllerInstallation *gardencorev1alpha1.ControllerInstallation) *gardencorev1alpha1.ControllerInstallation {
+	if existingInstallation != nil {
+		_, err := kutil.CreateOrPatchControllerInstallation(c.k8sGardenClient.GardenCore(), metav1.ObjectMeta{Name: existingInstallation.Name}, func(controllerInstallation *gardencorev1alpha1.ControllerInstallation) *gardencorev1alpha1.ControllerInstallation {
 			kutil.SetMetaDataLabel(&controllerInstallation.ObjectMeta, common.SeedSpecHash, seedSpecHash)
-			kutil.SetMetaDataLabel(&controllerInstallation.ObjectMeta, common.RegistrationSpecHash, registrationSpecHash)
+			if promote {
+				kutil.SetMetaDataLabel(&controllerInstallation.ObjectMeta, common.RegistrationSpecHash, registrationSpecHash)
+				SetRolledOutSpec(&installationSpec, controllerRegistration.Spec)
+			} else {
+				installationSpec.Deployment = existingInstallation.Spec.Deployment
+				installationSpec.Compatibility = existingInstallation.Spec.Compatibility
+			}
 			controllerInstallation.Spec = installationSpec
 			return controllerInstallation
 		})
@@ -250,11 +400,89 @@ func (c *defaultControllerRegistrationControl) reconcileSeedInstallations(contro
 		},
 		Spec: installationSpec,
 	}
+	SetRolledOutSpec(&controllerInstallation.Spec, controllerRegistration.Spec)
 
 	_, err = c.k8sGardenClient.GardenCore().CoreV1alpha1().ControllerInstallations().Create(controllerInstallation)
 	return err
 }
 
+func (c *defaultControllerRegistrationControl) updateStatus(controllerRegistration *gardencorev1alpha1.ControllerRegistration, seeds map[string]SeedRollout, conditions ...gardencorev1alpha1.Condition) error {
+	_, err := kutil.TryUpdateControllerRegistrationStatusWithEqualFunc(c.k8sGardenClient.GardenCore(), retry.DefaultBackoff, controllerRegistration.ObjectMeta,
+		func(cr *gardencorev1alpha1.ControllerRegistration) (*gardencorev1alpha1.ControllerRegistration, error) {
+			cr.Status.ObservedGeneration = controllerRegistration.Generation
+			cr.Status.Conditions = helper.MergeConditions(cr.Status.Conditions, conditions...)
+			cr.Status.Seeds = computeSeedStatuses(cr.Status.Seeds, seeds)
+			return cr, nil
+		}, func(cur, updated *gardencorev1alpha1.ControllerRegistration) bool {
+			return equality.Semantic.DeepEqual(cur.Status, updated.Status)
+		},
+	)
+	return err
+}
+
+// computeSeedStatuses computes the per-seed status of a registration. The update time of a seed is only changed if
+// its state or message changed.
+func computeSeedStatuses(oldStatuses []gardencorev1alpha1.ControllerRegistrationSeedStatus, seeds map[string]SeedRollout) []gardencorev1alpha1.ControllerRegistrationSeedStatus {
+	if len(seeds) == 0 {
+		return nil
+	}
+
+	oldStatusesMap := make(map[string]gardencorev1alpha1.ControllerRegistrationSeedStatus, len(oldStatuses))
+	for _, status := range oldStatuses {
+		oldStatusesMap[status.Name] = status
+	}
+
+	seedNames := make([]string, 0, len(seeds))
+	for seedName := range seeds {
+		seedNames = append(seedNames, seedName)
+	}
+	sort.Strings(seedNames)
+
+	statuses := make([]gardencorev1alpha1.ControllerRegistrationSeedStatus, 0, len(seedNames))
+	for _, seedName := range seedNames {
+		seedRollout := seeds[seedName]
+
+		if oldStatus, ok := oldStatusesMap[seedName]; ok && oldStatus.State == seedRollout.State && oldStatus.Message == seedRollout.Message {
+			statuses = append(statuses, oldStatus)
+			continue
+		}
+
+		statuses = append(statuses, gardencorev1alpha1.ControllerRegistrationSeedStatus{
+			Name:           seedName,
+			State:          seedRollout.State,
+			Message:        seedRollout.Message,
+			LastUpdateTime: metav1.Now(),
+		})
+	}
+
+	return statuses
+}
+
+func isRolloutFinished(plan RolloutPlan) bool {
+	for _, seedRollout := range plan.Seeds {
+		if seedRollout.State != gardencorev1alpha1.ControllerRolloutStateSucceeded && seedRollout.State != gardencorev1alpha1.ControllerRolloutStateIncompatible {
+			return false
+		}
+	}
+	return true
+}
+
+func getGardenerConstraint(controllerRegistration *gardencorev1alpha1.ControllerRegistration) *string {
+	if compatibility := controllerRegistration.Spec.Compatibility; compatibility != nil {
+		return compatibility.Gardener
+	}
+	return nil
+}
+
+// updatedCondition updates the given condition only if its status, reason or message changed in order to prevent
+// needless status updates.
+func updatedCondition(condition gardencorev1alpha1.Condition, status gardencorev1alpha1.ConditionStatus, reason, message string) gardencorev1alpha1.Condition {
+	if condition.Status == status && condition.Reason == reason && condition.Message == message {
+		return condition
+	}
+	return helper.UpdatedCondition(condition, status, reason, message)
+}
+
 func (c *defaultControllerRegistrationControl) delete(controllerRegistration *gardencorev1alpha1.ControllerRegistration, logger logrus.FieldLogger) error {
 	var (
 		result error
@@ -293,17 +521,3 @@ func (c *defaultControllerRegistrationControl) delete(controllerRegistration *ga
 	})
 	return err
 }
-
-func convertObjToMap(in interface{}) (map[string]interface{}, error) {
-	var out map[string]interface{}
-
-	data, err := json.Marshal(in)
-	if err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(data, &out); err != nil {
-		return nil, err
-	}
-
-	return out, nil
-}
@@ -0,0 +1,115 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerregistration
+
+import (
+	"fmt"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardenv1beta1helper "github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+
+	dnsv1alpha1 "github.com/gardener/external-dns-management/pkg/apis/dns/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// ResourceKey returns the key of the given kind/type combination in the set of required resources.
+func ResourceKey(kind, resourceType string) string {
+	return fmt.Sprintf("%s/%s", kind, resourceType)
+}
+
+// ComputeRequiredResources computes the kind/type combinations of extension resources which are required by a seed
+// with the given cloud provider and the given shoots scheduled to it. If <backupExtensionsEnabled> is true then the
+// backups of the shoots are managed via the backup bucket of the seed and their backup entries in it.
+func ComputeRequiredResources(seedCloudProvider gardenv1beta1.CloudProvider, shoots []*gardenv1beta1.Shoot, internalDomainProvider string, backupExtensionsEnabled bool) (sets.String, error) {
+	required := sets.NewString(ResourceKey(extensionsv1alpha1.ControlPlaneResource, string(seedCloudProvider)))
+
+	if backupExtensionsEnabled && len(shoots) > 0 {
+		required.Insert(
+			ResourceKey(extensionsv1alpha1.BackupBucketResource, string(seedCloudProvider)),
+			ResourceKey(extensionsv1alpha1.BackupEntryResource, string(seedCloudProvider)),
+		)
+	}
+
+	for _, shoot := range shoots {
+		cloudProvider, err := gardenv1beta1helper.DetermineCloudProviderInShoot(shoot.Spec.Cloud)
+		if err != nil {
+			return nil, fmt.Errorf("could not determine cloud provider of shoot %s/%s: %v", shoot.Namespace, shoot.Name, err)
+		}
+
+		required.Insert(
+			ResourceKey(extensionsv1alpha1.InfrastructureResource, string(cloudProvider)),
+			ResourceKey(extensionsv1alpha1.WorkerResource, string(cloudProvider)),
+			ResourceKey(extensionsv1alpha1.ControlPlaneResource, string(cloudProvider)),
+		)
+
+		if machineImage := gardenv1beta1helper.GetMachineImageFromShoot(cloudProvider, shoot); machineImage != nil {
+			required.Insert(ResourceKey(extensionsv1alpha1.OperatingSystemConfigResource, string(machineImage.Name)))
+		}
+
+		if len(internalDomainProvider) > 0 && internalDomainProvider != gardenv1beta1.DNSUnmanaged {
+			required.Insert(ResourceKey(dnsv1alpha1.DNSProviderKind, internalDomainProvider))
+		}
+		if provider := shoot.Spec.DNS.Provider; provider != nil && *provider != gardenv1beta1.DNSUnmanaged {
+			required.Insert(ResourceKey(dnsv1alpha1.DNSProviderKind, *provider))
+		}
+		for _, provider := range shoot.Spec.DNS.Providers {
+			required.Insert(ResourceKey(dnsv1alpha1.DNSProviderKind, provider.Type))
+		}
+
+		for _, extension := range shoot.Spec.Extensions {
+			required.Insert(ResourceKey(extensionsv1alpha1.ExtensionResource, extension.Type))
+		}
+	}
+
+	return required, nil
+}
+
+// IsDeploymentRequired checks whether the given controller registration must be deployed to the given seed. The seed
+// must match the seed selector of the registration. If the registration's deployment policy is 'OnDemand' then one of
+// its resources must be contained in <requiredResources> or be globally enabled while the seed hosts shoots.
+func IsDeploymentRequired(controllerRegistration *gardencorev1alpha1.ControllerRegistration, seed *gardenv1beta1.Seed, requiredResources sets.String, seedHasShoots bool) (bool, error) {
+	deployment := controllerRegistration.Spec.Deployment
+	if deployment == nil {
+		return true, nil
+	}
+
+	if deployment.SeedSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(deployment.SeedSelector)
+		if err != nil {
+			return false, err
+		}
+		if !selector.Matches(labels.Set(seed.Labels)) {
+			return false, nil
+		}
+	}
+
+	if deployment.Policy == nil || *deployment.Policy != gardencorev1alpha1.ControllerDeploymentPolicyOnDemand {
+		return true, nil
+	}
+
+	for _, resource := range controllerRegistration.Spec.Resources {
+		if requiredResources.Has(ResourceKey(resource.Kind, resource.Type)) {
+			return true, nil
+		}
+		if seedHasShoots && resource.GloballyEnabled != nil && *resource.GloballyEnabled {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerregistration_test
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/controllerregistration"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var _ = Describe("Required resources", func() {
+	var (
+		dnsProvider = "aws-route53"
+		trueVar     = true
+
+		shoot = &gardenv1beta1.Shoot{
+			Spec: gardenv1beta1.ShootSpec{
+				Cloud: gardenv1beta1.Cloud{
+					GCP: &gardenv1beta1.GCPCloud{
+						MachineImage: &gardenv1beta1.MachineImage{Name: "coreos"},
+					},
+				},
+				DNS:        gardenv1beta1.DNS{Provider: &dnsProvider},
+				Extensions: []gardenv1beta1.Extension{{Type: "foo"}},
+			},
+		}
+	)
+
+	Describe("#ComputeRequiredResources", func() {
+		It("should only require the seed's control plane without shoots", func() {
+			required, err := ComputeRequiredResources(gardenv1beta1.CloudProviderAWS, nil, "aws-route53", true)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(required).To(Equal(sets.NewString("ControlPlane/aws")))
+		})
+
+		It("should compute the resources required by the shoots", func() {
+			required, err := ComputeRequiredResources(gardenv1beta1.CloudProviderAWS, []*gardenv1beta1.Shoot{shoot}, "google-clouddns", false)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(required).To(Equal(sets.NewString(
+				"ControlPlane/aws",
+				"ControlPlane/gcp",
+				"Infrastructure/gcp",
+				"Worker/gcp",
+				"OperatingSystemConfig/coreos",
+				"DNSProvider/google-clouddns",
+				"DNSProvider/aws-route53",
+				"Extension/foo",
+			)))
+		})
+
+		It("should require the additional DNS providers of the shoots", func() {
+			shootWithProviders := shoot.DeepCopy()
+			shootWithProviders.Spec.DNS.Providers = []gardenv1beta1.DNSProvider{{Type: "azure-dns"}, {Type: "openstack-designate"}}
+
+			required, err := ComputeRequiredResources(gardenv1beta1.CloudProviderAWS, []*gardenv1beta1.Shoot{shootWithProviders}, "google-clouddns", false)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(required).To(HaveKey("DNSProvider/azure-dns"))
+			Expect(required).To(HaveKey("DNSProvider/openstack-designate"))
+		})
+
+		It("should require the backup bucket and entries of the seed if backup extensions are enabled", func() {
+			required, err := ComputeRequiredResources(gardenv1beta1.CloudProviderAWS, []*gardenv1beta1.Shoot{shoot}, "google-clouddns", true)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(required).To(HaveKey("BackupBucket/aws"))
+			Expect(required).To(HaveKey("BackupEntry/aws"))
+		})
+	})
+
+	Describe("#IsDeploymentRequired", func() {
+		var (
+			onDemand = gardencorev1alpha1.ControllerDeploymentPolicyOnDemand
+			always   = gardencorev1alpha1.ControllerDeploymentPolicyAlways
+
+			seed = &gardenv1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"provider": "aws"}}}
+
+			registration = func(policy *gardencorev1alpha1.ControllerDeploymentPolicy, selector *metav1.LabelSelector, resources ...gardencorev1alpha1.ControllerResource) *gardencorev1alpha1.ControllerRegistration {
+				return &gardencorev1alpha1.ControllerRegistration{
+					Spec: gardencorev1alpha1.ControllerRegistrationSpec{
+						Resources: resources,
+						Deployment: &gardencorev1alpha1.ControllerDeployment{
+							Policy:       policy,
+							SeedSelector: selector,
+						},
+					},
+				}
+			}
+			infrastructureGCP = gardencorev1alpha1.ControllerResource{Kind: "Infrastructure", Type: "gcp"}
+		)
+
+		It("should always require registrations without deployment", func() {
+			Expect(IsDeploymentRequired(&gardencorev1alpha1.ControllerRegistration{}, seed, sets.NewString(), false)).To(BeTrue())
+		})
+
+		It("should require registrations with policy Always", func() {
+			Expect(IsDeploymentRequired(registration(&always, nil, infrastructureGCP), seed, sets.NewString(), false)).To(BeTrue())
+			Expect(IsDeploymentRequired(registration(nil, nil, infrastructureGCP), seed, sets.NewString(), false)).To(BeTrue())
+		})
+
+		It("should not require registrations whose seed selector does not match", func() {
+			selector := &metav1.LabelSelector{MatchLabels: map[string]string{"provider": "gcp"}}
+
+			Expect(IsDeploymentRequired(registration(&always, selector, infrastructureGCP), seed, sets.NewString(), false)).To(BeFalse())
+		})
+
+		It("should require on demand registrations only if one of their resources is required", func() {
+			Expect(IsDeploymentRequired(registration(&onDemand, nil, infrastructureGCP), seed, sets.NewString("Infrastructure/gcp"), true)).To(BeTrue())
+			Expect(IsDeploymentRequired(registration(&onDemand, nil, infrastructureGCP), seed, sets.NewString("Infrastructure/aws"), true)).To(BeFalse())
+		})
+
+		It("should require on demand registrations with globally enabled resources if the seed has shoots", func() {
+			extension := gardencorev1alpha1.ControllerResource{Kind: "Extension", Type: "foo", GloballyEnabled: &trueVar}
+
+			Expect(IsDeploymentRequired(registration(&onDemand, nil, extension), seed, sets.NewString(), true)).To(BeTrue())
+			Expect(IsDeploymentRequired(registration(&onDemand, nil, extension), seed, sets.NewString(), false)).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,233 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerregistration
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
+
+	"github.com/Masterminds/semver"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// ReasonSeedKubernetesVersionUnsupported is the reason of the `Valid` condition of a ControllerInstallation if the
+// controller does not support the Kubernetes version of the seed.
+const ReasonSeedKubernetesVersionUnsupported = "SeedKubernetesVersionUnsupported"
+
+// ComputeSpecHash computes the hash of the given specification that is used for the spec hash labels of
+// ControllerInstallations.
+func ComputeSpecHash(spec interface{}) (string, error) {
+	specMap, err := convertObjToMap(spec)
+	if err != nil {
+		return "", err
+	}
+	return utils.HashForMap(specMap)[:16], nil
+}
+
+// ComputeRegistrationSpecHash computes the hash of the given registration specification that is used for the
+// registration spec hash labels of ControllerInstallations. The rollout strategy of the registration is not part of
+// the hash as changing it does not change what is installed on the seeds.
+func ComputeRegistrationSpecHash(spec gardencorev1alpha1.ControllerRegistrationSpec) (string, error) {
+	spec = *spec.DeepCopy()
+	if spec.Deployment != nil {
+		spec.Deployment.Rollout = nil
+	}
+	return ComputeSpecHash(spec)
+}
+
+// SetRolledOutSpec sets the part of the given registration specification that is rolled out to the seeds in the
+// given installation specification. It is only kept by the installations if the registration specifies a rollout
+// strategy, otherwise all installations are updated at once and use the current specification of the registration.
+func SetRolledOutSpec(installationSpec *gardencorev1alpha1.ControllerInstallationSpec, registrationSpec gardencorev1alpha1.ControllerRegistrationSpec) {
+	installationSpec.Deployment = nil
+	installationSpec.Compatibility = nil
+
+	if registrationSpec.Deployment == nil || registrationSpec.Deployment.Rollout == nil {
+		return
+	}
+
+	installationSpec.Deployment = &gardencorev1alpha1.ControllerDeployment{
+		Type:           registrationSpec.Deployment.Type,
+		ProviderConfig: registrationSpec.Deployment.ProviderConfig.DeepCopy(),
+	}
+	installationSpec.Compatibility = registrationSpec.Compatibility.DeepCopy()
+}
+
+// IsVersionCompatible checks whether the given version meets the given constraint. Pre-release and build metadata
+// information of the version is ignored. A nil constraint is met by all versions.
+func IsVersionCompatible(version string, constraint *string) (bool, error) {
+	if constraint == nil {
+		return true, nil
+	}
+
+	c, err := semver.NewConstraint(*constraint)
+	if err != nil {
+		return false, err
+	}
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return false, err
+	}
+	release, err := semver.NewVersion(fmt.Sprintf("%d.%d.%d", v.Major(), v.Minor(), v.Patch()))
+	if err != nil {
+		return false, err
+	}
+
+	return c.Check(release), nil
+}
+
+// SeedRollout contains the rollout state of a registration on a seed.
+type SeedRollout struct {
+	// State is the rollout state.
+	State gardencorev1alpha1.ControllerRolloutState
+	// Message contains details about the state.
+	Message string
+}
+
+// RolloutPlan is the result of a rollout computation for a registration.
+type RolloutPlan struct {
+	// Seeds contains the rollout state per seed.
+	Seeds map[string]SeedRollout
+	// Promote contains the names of the seeds whose installations shall be updated to the current specification of
+	// the registration.
+	Promote sets.String
+	// Halted is true if the rollout was halted because it failed on at least one seed.
+	Halted bool
+}
+
+// ComputeRolloutPlan computes the rollout state of the registration with the given specification hash for the given
+// seeds and determines the seeds to which the specification shall be rolled out next. <installations> maps the seed
+// names to the existing installations of the registration. Seeds without installation are always promoted as there is
+// no previous version that could be kept. If no rollout strategy is given then all seeds are promoted at once.
+func ComputeRolloutPlan(registrationSpecHash string, rollout *gardencorev1alpha1.ControllerRolloutStrategy, seedNames []string, installations map[string]*gardencorev1alpha1.ControllerInstallation) RolloutPlan {
+	var (
+		plan = RolloutPlan{
+			Seeds:   make(map[string]SeedRollout, len(seedNames)),
+			Promote: sets.NewString(),
+		}
+		pending     []string
+		progressing int
+		failed      bool
+
+		waitForHealthy = rollout == nil || rollout.WaitForHealthy == nil || *rollout.WaitForHealthy
+		haltOnFailure  = rollout == nil || rollout.HaltOnFailure == nil || *rollout.HaltOnFailure
+	)
+
+	for _, seedName := range seedNames {
+		controllerInstallation, ok := installations[seedName]
+		if !ok {
+			plan.Promote.Insert(seedName)
+			plan.Seeds[seedName] = SeedRollout{State: gardencorev1alpha1.ControllerRolloutStateProgressing, Message: "Installation is being created."}
+			continue
+		}
+
+		if controllerInstallation.Labels[common.RegistrationSpecHash] != registrationSpecHash {
+			pending = append(pending, seedName)
+			plan.Seeds[seedName] = SeedRollout{State: gardencorev1alpha1.ControllerRolloutStatePending, Message: "Waiting for the rollout to the seed."}
+			continue
+		}
+
+		seedRollout := computeSeedRollout(controllerInstallation, waitForHealthy)
+		switch seedRollout.State {
+		case gardencorev1alpha1.ControllerRolloutStateProgressing:
+			progressing++
+		case gardencorev1alpha1.ControllerRolloutStateFailed:
+			failed = true
+		}
+		plan.Seeds[seedName] = seedRollout
+	}
+
+	if rollout == nil {
+		plan.Promote.Insert(pending...)
+	} else {
+		if failed && haltOnFailure {
+			plan.Halted = true
+			return plan
+		}
+
+		maxParallelSeeds := 1
+		if rollout.MaxParallelSeeds != nil {
+			maxParallelSeeds = int(*rollout.MaxParallelSeeds)
+		}
+
+		sort.Strings(pending)
+		for i := 0; i < len(pending) && i < maxParallelSeeds-progressing; i++ {
+			plan.Promote.Insert(pending[i])
+		}
+	}
+
+	for _, seedName := range plan.Promote.UnsortedList() {
+		if _, ok := installations[seedName]; ok {
+			plan.Seeds[seedName] = SeedRollout{State: gardencorev1alpha1.ControllerRolloutStateProgressing, Message: "Installation is being updated."}
+		}
+	}
+
+	return plan
+}
+
+func computeSeedRollout(controllerInstallation *gardencorev1alpha1.ControllerInstallation, waitForHealthy bool) SeedRollout {
+	conditions := controllerInstallation.Status.Conditions
+
+	if c := helper.GetCondition(conditions, gardencorev1alpha1.ControllerInstallationValid); c != nil && c.Status == gardencorev1alpha1.ConditionFalse {
+		if c.Reason == ReasonSeedKubernetesVersionUnsupported {
+			return SeedRollout{State: gardencorev1alpha1.ControllerRolloutStateIncompatible, Message: c.Message}
+		}
+		return SeedRollout{State: gardencorev1alpha1.ControllerRolloutStateFailed, Message: c.Message}
+	}
+
+	if controllerInstallation.Status.ObservedGeneration < controllerInstallation.Generation {
+		return SeedRollout{State: gardencorev1alpha1.ControllerRolloutStateProgressing, Message: "Installation is being updated."}
+	}
+
+	installed := helper.GetCondition(conditions, gardencorev1alpha1.ControllerInstallationInstalled)
+	if installed == nil || installed.Status == gardencorev1alpha1.ConditionUnknown || installed.Reason == "DeletionPending" {
+		return SeedRollout{State: gardencorev1alpha1.ControllerRolloutStateProgressing, Message: "Installation is being updated."}
+	}
+	if installed.Status == gardencorev1alpha1.ConditionFalse {
+		return SeedRollout{State: gardencorev1alpha1.ControllerRolloutStateFailed, Message: installed.Message}
+	}
+
+	if waitForHealthy {
+		healthy := helper.GetCondition(conditions, gardencorev1alpha1.ControllerInstallationHealthy)
+		if healthy == nil || healthy.Status == gardencorev1alpha1.ConditionUnknown {
+			return SeedRollout{State: gardencorev1alpha1.ControllerRolloutStateProgressing, Message: "Waiting for the controller to become healthy."}
+		}
+		if healthy.Status == gardencorev1alpha1.ConditionFalse {
+			return SeedRollout{State: gardencorev1alpha1.ControllerRolloutStateFailed, Message: healthy.Message}
+		}
+	}
+
+	return SeedRollout{State: gardencorev1alpha1.ControllerRolloutStateSucceeded, Message: "Controller has been rolled out successfully."}
+}
+
+func convertObjToMap(in interface{}) (map[string]interface{}, error) {
+	var out map[string]interface{}
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
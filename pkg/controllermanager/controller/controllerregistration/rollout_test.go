@@ -0,0 +1,263 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerregistration_test
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/controllerregistration"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var _ = Describe("Rollout", func() {
+	Describe("#IsVersionCompatible", func() {
+		It("should be compatible without constraint", func() {
+			Expect(IsVersionCompatible("v0.28.0", nil)).To(BeTrue())
+		})
+
+		It("should ignore pre-release information", func() {
+			constraint := ">= 0.28, < 0.30"
+
+			Expect(IsVersionCompatible("v0.29.0-dev", &constraint)).To(BeTrue())
+			Expect(IsVersionCompatible("v0.30.0-dev", &constraint)).To(BeFalse())
+		})
+
+		It("should return an error for invalid versions", func() {
+			constraint := ">= 1.14"
+
+			_, err := IsVersionCompatible("foo", &constraint)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#ComputeRegistrationSpecHash", func() {
+		var (
+			maxParallelSeeds = int32(2)
+			kubernetes       = ">= 1.14"
+			spec             = gardencorev1alpha1.ControllerRegistrationSpec{
+				Resources: []gardencorev1alpha1.ControllerResource{{Kind: "Infrastructure", Type: "aws"}},
+				Deployment: &gardencorev1alpha1.ControllerDeployment{
+					Type:           "helm",
+					ProviderConfig: &gardencorev1alpha1.ProviderConfig{RawExtension: runtime.RawExtension{Raw: []byte(`{"chart":"foo"}`)}},
+				},
+			}
+		)
+
+		It("should not consider the rollout strategy", func() {
+			changed := spec.DeepCopy()
+			changed.Deployment.Rollout = &gardencorev1alpha1.ControllerRolloutStrategy{MaxParallelSeeds: &maxParallelSeeds}
+
+			hash, err := ComputeRegistrationSpecHash(spec)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ComputeRegistrationSpecHash(*changed)).To(Equal(hash))
+			Expect(changed.Deployment.Rollout).NotTo(BeNil())
+		})
+
+		It("should consider the compatibility", func() {
+			changed := spec.DeepCopy()
+			changed.Compatibility = &gardencorev1alpha1.ControllerCompatibility{Kubernetes: &kubernetes}
+
+			hash, err := ComputeRegistrationSpecHash(spec)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ComputeRegistrationSpecHash(*changed)).NotTo(Equal(hash))
+		})
+
+		It("should consider the deployment configuration", func() {
+			changed := spec.DeepCopy()
+			changed.Deployment.ProviderConfig.Raw = []byte(`{"chart":"bar"}`)
+
+			hash, err := ComputeRegistrationSpecHash(spec)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ComputeRegistrationSpecHash(*changed)).NotTo(Equal(hash))
+		})
+	})
+
+	Describe("#SetRolledOutSpec", func() {
+		var (
+			policy         = gardencorev1alpha1.ControllerDeploymentPolicyOnDemand
+			kubernetes     = ">= 1.14"
+			providerConfig = &gardencorev1alpha1.ProviderConfig{RawExtension: runtime.RawExtension{Raw: []byte(`{"chart":"foo"}`)}}
+			compatibility  = &gardencorev1alpha1.ControllerCompatibility{Kubernetes: &kubernetes}
+		)
+
+		It("should only keep the type, the provider config and the compatibility if a rollout strategy is set", func() {
+			installationSpec := gardencorev1alpha1.ControllerInstallationSpec{}
+
+			SetRolledOutSpec(&installationSpec, gardencorev1alpha1.ControllerRegistrationSpec{
+				Deployment: &gardencorev1alpha1.ControllerDeployment{
+					Type:           "helm",
+					ProviderConfig: providerConfig,
+					Policy:         &policy,
+					Rollout:        &gardencorev1alpha1.ControllerRolloutStrategy{},
+				},
+				Compatibility: compatibility,
+			})
+
+			Expect(installationSpec.Deployment).To(Equal(&gardencorev1alpha1.ControllerDeployment{Type: "helm", ProviderConfig: providerConfig}))
+			Expect(installationSpec.Compatibility).To(Equal(compatibility))
+		})
+
+		It("should not keep anything without rollout strategy", func() {
+			installationSpec := gardencorev1alpha1.ControllerInstallationSpec{
+				Deployment:    &gardencorev1alpha1.ControllerDeployment{Type: "helm", ProviderConfig: providerConfig},
+				Compatibility: compatibility,
+			}
+
+			SetRolledOutSpec(&installationSpec, gardencorev1alpha1.ControllerRegistrationSpec{
+				Deployment:    &gardencorev1alpha1.ControllerDeployment{Type: "helm", ProviderConfig: providerConfig},
+				Compatibility: compatibility,
+			})
+
+			Expect(installationSpec.Deployment).To(BeNil())
+			Expect(installationSpec.Compatibility).To(BeNil())
+		})
+
+		It("should not keep anything without deployment", func() {
+			installationSpec := gardencorev1alpha1.ControllerInstallationSpec{}
+
+			SetRolledOutSpec(&installationSpec, gardencorev1alpha1.ControllerRegistrationSpec{Compatibility: compatibility})
+
+			Expect(installationSpec.Deployment).To(BeNil())
+			Expect(installationSpec.Compatibility).To(BeNil())
+		})
+	})
+
+	Describe("#ComputeRolloutPlan", func() {
+		var (
+			newHash = "new"
+			oldHash = "old"
+
+			maxParallelSeeds = int32(1)
+			trueVar          = true
+			rollout          = &gardencorev1alpha1.ControllerRolloutStrategy{
+				MaxParallelSeeds: &maxParallelSeeds,
+				WaitForHealthy:   &trueVar,
+				HaltOnFailure:    &trueVar,
+			}
+
+			installation = func(hash string, generation, observedGeneration int64, installed, healthy gardencorev1alpha1.ConditionStatus) *gardencorev1alpha1.ControllerInstallation {
+				return &gardencorev1alpha1.ControllerInstallation{
+					ObjectMeta: metav1.ObjectMeta{
+						Generation: generation,
+						Labels:     map[string]string{common.RegistrationSpecHash: hash},
+					},
+					Status: gardencorev1alpha1.ControllerInstallationStatus{
+						ObservedGeneration: observedGeneration,
+						Conditions: []gardencorev1alpha1.Condition{
+							{Type: gardencorev1alpha1.ControllerInstallationInstalled, Status: installed},
+							{Type: gardencorev1alpha1.ControllerInstallationHealthy, Status: healthy},
+						},
+					},
+				}
+			}
+		)
+
+		It("should promote all seeds without rollout strategy", func() {
+			plan := ComputeRolloutPlan(newHash, nil, []string{"a", "b", "c"}, map[string]*gardencorev1alpha1.ControllerInstallation{
+				"a": installation(oldHash, 1, 1, gardencorev1alpha1.ConditionTrue, gardencorev1alpha1.ConditionTrue),
+				"b": installation(oldHash, 1, 1, gardencorev1alpha1.ConditionTrue, gardencorev1alpha1.ConditionTrue),
+			})
+
+			Expect(plan.Promote).To(Equal(sets.NewString("a", "b", "c")))
+			Expect(plan.Halted).To(BeFalse())
+		})
+
+		It("should promote the next seed once the previous one succeeded", func() {
+			plan := ComputeRolloutPlan(newHash, rollout, []string{"a", "b", "c"}, map[string]*gardencorev1alpha1.ControllerInstallation{
+				"a": installation(newHash, 2, 2, gardencorev1alpha1.ConditionTrue, gardencorev1alpha1.ConditionTrue),
+				"b": installation(oldHash, 1, 1, gardencorev1alpha1.ConditionTrue, gardencorev1alpha1.ConditionTrue),
+				"c": installation(oldHash, 1, 1, gardencorev1alpha1.ConditionTrue, gardencorev1alpha1.ConditionTrue),
+			})
+
+			Expect(plan.Promote).To(Equal(sets.NewString("b")))
+			Expect(plan.Seeds["a"].State).To(Equal(gardencorev1alpha1.ControllerRolloutStateSucceeded))
+			Expect(plan.Seeds["b"].State).To(Equal(gardencorev1alpha1.ControllerRolloutStateProgressing))
+			Expect(plan.Seeds["c"].State).To(Equal(gardencorev1alpha1.ControllerRolloutStatePending))
+		})
+
+		It("should not promote further seeds while the maximum number of seeds is progressing", func() {
+			plan := ComputeRolloutPlan(newHash, rollout, []string{"a", "b"}, map[string]*gardencorev1alpha1.ControllerInstallation{
+				"a": installation(newHash, 2, 2, gardencorev1alpha1.ConditionTrue, gardencorev1alpha1.ConditionUnknown),
+				"b": installation(oldHash, 1, 1, gardencorev1alpha1.ConditionTrue, gardencorev1alpha1.ConditionTrue),
+			})
+
+			Expect(plan.Promote).To(BeEmpty())
+			Expect(plan.Seeds["a"].State).To(Equal(gardencorev1alpha1.ControllerRolloutStateProgressing))
+			Expect(plan.Seeds["b"].State).To(Equal(gardencorev1alpha1.ControllerRolloutStatePending))
+		})
+
+		It("should consider installations whose generation has not been observed yet as progressing", func() {
+			plan := ComputeRolloutPlan(newHash, rollout, []string{"a", "b"}, map[string]*gardencorev1alpha1.ControllerInstallation{
+				"a": installation(newHash, 2, 1, gardencorev1alpha1.ConditionTrue, gardencorev1alpha1.ConditionTrue),
+				"b": installation(oldHash, 1, 1, gardencorev1alpha1.ConditionTrue, gardencorev1alpha1.ConditionTrue),
+			})
+
+			Expect(plan.Promote).To(BeEmpty())
+			Expect(plan.Seeds["a"].State).To(Equal(gardencorev1alpha1.ControllerRolloutStateProgressing))
+		})
+
+		It("should halt the rollout if it failed on a seed", func() {
+			plan := ComputeRolloutPlan(newHash, rollout, []string{"a", "b"}, map[string]*gardencorev1alpha1.ControllerInstallation{
+				"a": installation(newHash, 2, 2, gardencorev1alpha1.ConditionTrue, gardencorev1alpha1.ConditionFalse),
+				"b": installation(oldHash, 1, 1, gardencorev1alpha1.ConditionTrue, gardencorev1alpha1.ConditionTrue),
+			})
+
+			Expect(plan.Halted).To(BeTrue())
+			Expect(plan.Promote).To(BeEmpty())
+			Expect(plan.Seeds["a"].State).To(Equal(gardencorev1alpha1.ControllerRolloutStateFailed))
+		})
+
+		It("should continue the rollout on failures if halting is disabled and not wait for health if disabled", func() {
+			falseVar := false
+			plan := ComputeRolloutPlan(newHash, &gardencorev1alpha1.ControllerRolloutStrategy{
+				MaxParallelSeeds: &maxParallelSeeds,
+				WaitForHealthy:   &falseVar,
+				HaltOnFailure:    &falseVar,
+			}, []string{"a", "b", "c"}, map[string]*gardencorev1alpha1.ControllerInstallation{
+				"a": installation(newHash, 2, 2, gardencorev1alpha1.ConditionFalse, gardencorev1alpha1.ConditionTrue),
+				"b": installation(newHash, 2, 2, gardencorev1alpha1.ConditionTrue, gardencorev1alpha1.ConditionFalse),
+				"c": installation(oldHash, 1, 1, gardencorev1alpha1.ConditionTrue, gardencorev1alpha1.ConditionTrue),
+			})
+
+			Expect(plan.Halted).To(BeFalse())
+			Expect(plan.Promote).To(Equal(sets.NewString("c")))
+			Expect(plan.Seeds["a"].State).To(Equal(gardencorev1alpha1.ControllerRolloutStateFailed))
+			Expect(plan.Seeds["b"].State).To(Equal(gardencorev1alpha1.ControllerRolloutStateSucceeded))
+		})
+
+		It("should consider seeds with unsupported Kubernetes versions as incompatible", func() {
+			incompatible := installation(newHash, 2, 2, gardencorev1alpha1.ConditionTrue, gardencorev1alpha1.ConditionTrue)
+			incompatible.Status.Conditions = append(incompatible.Status.Conditions, gardencorev1alpha1.Condition{
+				Type:   gardencorev1alpha1.ControllerInstallationValid,
+				Status: gardencorev1alpha1.ConditionFalse,
+				Reason: ReasonSeedKubernetesVersionUnsupported,
+			})
+
+			plan := ComputeRolloutPlan(newHash, rollout, []string{"a", "b"}, map[string]*gardencorev1alpha1.ControllerInstallation{
+				"a": incompatible,
+				"b": installation(oldHash, 1, 1, gardencorev1alpha1.ConditionTrue, gardencorev1alpha1.ConditionTrue),
+			})
+
+			Expect(plan.Halted).To(BeFalse())
+			Expect(plan.Promote).To(Equal(sets.NewString("b")))
+			Expect(plan.Seeds["a"].State).To(Equal(gardencorev1alpha1.ControllerRolloutStateIncompatible))
+		})
+	})
+})
@@ -0,0 +1,71 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerregistration
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/logger"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/tools/cache"
+)
+
+// shootAdd enqueues the seed the added shoot is scheduled to, as the seed might require additional controllers now.
+func (c *Controller) shootAdd(obj interface{}) {
+	shoot, ok := obj.(*gardenv1beta1.Shoot)
+	if !ok {
+		return
+	}
+	c.enqueueShootSeed(shoot)
+}
+
+func (c *Controller) shootUpdate(oldObj, newObj interface{}) {
+	oldShoot, ok1 := oldObj.(*gardenv1beta1.Shoot)
+	newShoot, ok2 := newObj.(*gardenv1beta1.Shoot)
+	if !ok1 || !ok2 {
+		return
+	}
+
+	if apiequality.Semantic.DeepEqual(oldShoot.Spec, newShoot.Spec) {
+		return
+	}
+
+	c.enqueueShootSeed(oldShoot)
+	c.enqueueShootSeed(newShoot)
+}
+
+func (c *Controller) shootDelete(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	shoot, ok := obj.(*gardenv1beta1.Shoot)
+	if !ok {
+		return
+	}
+	c.enqueueShootSeed(shoot)
+}
+
+func (c *Controller) enqueueShootSeed(shoot *gardenv1beta1.Shoot) {
+	if shoot.Spec.Cloud.Seed == nil {
+		return
+	}
+
+	seed, err := c.seedLister.Get(*shoot.Spec.Cloud.Seed)
+	if err != nil {
+		logger.Logger.Debugf("Couldn't get seed %s of shoot %s/%s: %v", *shoot.Spec.Cloud.Seed, shoot.Namespace, shoot.Name, err)
+		return
+	}
+	c.seedAdd(seed)
+}
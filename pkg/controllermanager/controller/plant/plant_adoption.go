@@ -0,0 +1,353 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plant
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	"github.com/Masterminds/semver"
+	"github.com/ghodss/yaml"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// labelNodeRoleMaster is the label of nodes running a self-hosted control plane.
+	labelNodeRoleMaster = "node-role.kubernetes.io/master"
+	// labelWorkerPool is the label of nodes denoting the worker pool they belong to.
+	labelWorkerPool = "worker.gardener.cloud/pool"
+	// annotationDescription is the annotation describing the purpose of the adoption export ConfigMap.
+	annotationDescription = "gardener.cloud/description"
+
+	// AdoptionConfigMapKeyKubernetesVersion is the key of the control plane's Kubernetes version in the adoption export ConfigMap.
+	AdoptionConfigMapKeyKubernetesVersion = "kubernetesVersion"
+	// AdoptionConfigMapKeyInfrastructure is the key of the Infrastructure resource in the adoption export ConfigMap.
+	AdoptionConfigMapKeyInfrastructure = "infrastructure.yaml"
+	// AdoptionConfigMapKeyWorker is the key of the Worker resource in the adoption export ConfigMap.
+	AdoptionConfigMapKeyWorker = "worker.yaml"
+	// AdoptionConfigMapKeyControlPlane is the key of the ControlPlane resource in the adoption export ConfigMap.
+	AdoptionConfigMapKeyControlPlane = "controlplane.yaml"
+
+	// AnnotationAdoptionInfrastructureConfig is the annotation of a Plant containing the provider-specific
+	// configuration of the Infrastructure which references the existing network resources (e.g. the VPC) of the
+	// cluster. It cannot be derived from the nodes and is carried over into the Infrastructure unchanged.
+	AnnotationAdoptionInfrastructureConfig = "adoption.gardener.cloud/infrastructure-config"
+)
+
+// adoptionProviders maps the prefixes of node provider IDs to the provider types for which Plants can be adopted.
+var adoptionProviders = map[string]string{
+	"aws":       "aws",
+	"azure":     "azure",
+	"gce":       "gcp",
+	"openstack": "openstack",
+}
+
+// adoptionMachineImages maps the operating systems reported by nodes to the machine images of worker pools. The
+// first submatch of the expression is the version of the machine image.
+var adoptionMachineImages = []struct {
+	name      string
+	osImageRE *regexp.Regexp
+}{
+	{"coreos", regexp.MustCompile(`^Container Linux by CoreOS (\d+\.\d+\.\d+)`)},
+	{"ubuntu", regexp.MustCompile(`^Ubuntu (\d+\.\d+(?:\.\d+)?)`)},
+}
+
+var invalidPoolNameCharacters = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// IsAdoptionExportRequested checks whether the given Plant is annotated with the adoption export operation.
+func IsAdoptionExportRequested(plant *gardencorev1alpha1.Plant) bool {
+	return plant.Annotations[gardencorev1alpha1.GardenerOperation] == gardencorev1alpha1.GardenerOperationAdoptionExport
+}
+
+// IsAdoptionDryRunRequested checks whether the given Plant is annotated with the adoption dry-run operation.
+func IsAdoptionDryRunRequested(plant *gardencorev1alpha1.Plant) bool {
+	return plant.Annotations[gardencorev1alpha1.GardenerOperation] == gardencorev1alpha1.GardenerOperationAdoptionDryRun
+}
+
+// IsAdoptionOperationAdded checks whether the adoption dry-run or the adoption export operation has been requested with the
+// update from <old> to <new>.
+func IsAdoptionOperationAdded(old, new *gardencorev1alpha1.Plant) bool {
+	return (IsAdoptionDryRunRequested(new) && !IsAdoptionDryRunRequested(old)) || (IsAdoptionExportRequested(new) && !IsAdoptionExportRequested(old))
+}
+
+// ComputeAdoptionReport maps the given nodes of a Plant cluster to the infrastructure and worker pools of a Shoot.
+// Nodes are grouped into worker pools by their pool label or, if missing, by their machine type. Everything which
+// prevents the cluster from being adopted is reported as issue, including a missing infrastructure configuration
+// annotation on the Plant.
+func ComputeAdoptionReport(plant *gardencorev1alpha1.Plant, nodes []corev1.Node, controlPlaneVersion string, now time.Time) *gardencorev1alpha1.PlantAdoptionReport {
+	var (
+		report = &gardencorev1alpha1.PlantAdoptionReport{
+			LastUpdateTime: metav1.NewTime(now),
+			Infrastructure: gardencorev1alpha1.PlantAdoptionInfrastructure{
+				KubernetesVersion: controlPlaneVersion,
+			},
+		}
+
+		providers   = sets.NewString()
+		regions     = sets.NewString()
+		zones       = sets.NewString()
+		pools       = map[string]*gardencorev1alpha1.PlantAdoptionWorker{}
+		poolZones   = map[string]sets.String{}
+		masterNodes int
+	)
+
+	controlPlaneMinor, err := minorVersion(controlPlaneVersion)
+	if err != nil {
+		report.Issues = append(report.Issues, fmt.Sprintf("Could not determine the control plane version: %v", err))
+	}
+
+	if config, ok := plant.Annotations[AnnotationAdoptionInfrastructureConfig]; !ok {
+		report.Issues = append(report.Issues, fmt.Sprintf("The Plant has no annotation %s with the infrastructure configuration referencing the existing network resources.", AnnotationAdoptionInfrastructureConfig))
+	} else if !json.Valid([]byte(config)) {
+		report.Issues = append(report.Issues, fmt.Sprintf("The annotation %s of the Plant does not contain valid JSON.", AnnotationAdoptionInfrastructureConfig))
+	}
+
+	for _, node := range nodes {
+		if _, ok := node.Labels[labelNodeRoleMaster]; ok {
+			masterNodes++
+			continue
+		}
+
+		providers.Insert(getCloudProviderForNode(node.Spec.ProviderID))
+		if region, ok := node.Labels[corev1.LabelZoneRegion]; ok {
+			regions.Insert(region)
+		}
+
+		zone, ok := node.Labels[corev1.LabelZoneFailureDomain]
+		if !ok {
+			report.Issues = append(report.Issues, fmt.Sprintf("Node %s has no zone label %s.", node.Name, corev1.LabelZoneFailureDomain))
+		}
+
+		machineType, ok := node.Labels[corev1.LabelInstanceType]
+		if !ok {
+			report.Issues = append(report.Issues, fmt.Sprintf("Node %s has no machine type label %s.", node.Name, corev1.LabelInstanceType))
+			continue
+		}
+
+		if kubeletMinor, err := minorVersion(node.Status.NodeInfo.KubeletVersion); err != nil || (len(controlPlaneMinor) > 0 && kubeletMinor != controlPlaneMinor) {
+			report.Issues = append(report.Issues, fmt.Sprintf("Node %s runs kubelet version %q which does not match the control plane version %s.", node.Name, node.Status.NodeInfo.KubeletVersion, controlPlaneVersion))
+		}
+
+		poolName, ok := node.Labels[labelWorkerPool]
+		if !ok {
+			poolName = workerPoolName(machineType)
+		}
+		machineImage := machineImageForOSImage(node.Status.NodeInfo.OSImage)
+		if machineImage == nil {
+			report.Issues = append(report.Issues, fmt.Sprintf("Node %s runs operating system %q which does not correspond to a supported machine image.", node.Name, node.Status.NodeInfo.OSImage))
+		}
+
+		pool, ok := pools[poolName]
+		if !ok {
+			pool = &gardencorev1alpha1.PlantAdoptionWorker{Name: poolName, MachineType: machineType, MachineImage: machineImage}
+			pools[poolName] = pool
+			poolZones[poolName] = sets.NewString()
+		}
+		if pool.MachineType != machineType {
+			report.Issues = append(report.Issues, fmt.Sprintf("Worker pool %s contains nodes of different machine types (%s, %s).", poolName, pool.MachineType, machineType))
+		}
+		if machineImage != nil && pool.MachineImage != nil && *pool.MachineImage != *machineImage {
+			report.Issues = append(report.Issues, fmt.Sprintf("Worker pool %s contains nodes of different machine images (%s %s, %s %s).", poolName, pool.MachineImage.Name, pool.MachineImage.Version, machineImage.Name, machineImage.Version))
+		}
+		if pool.MachineImage == nil {
+			pool.MachineImage = machineImage
+		}
+		pool.Nodes++
+
+		if len(zone) > 0 {
+			zones.Insert(zone)
+			poolZones[poolName].Insert(zone)
+		}
+	}
+
+	if masterNodes > 0 {
+		report.Issues = append(report.Issues, fmt.Sprintf("%d nodes run a self-hosted control plane which cannot be adopted.", masterNodes))
+	}
+	if len(pools) == 0 {
+		report.Issues = append(report.Issues, "The cluster has no adoptable worker nodes.")
+	}
+
+	switch {
+	case providers.Len() > 1:
+		report.Issues = append(report.Issues, fmt.Sprintf("The nodes run on multiple providers %v.", providers.List()))
+	case providers.Len() == 1:
+		provider := providers.List()[0]
+		if providerType, ok := adoptionProviders[provider]; ok {
+			report.Infrastructure.Provider = providerType
+		} else {
+			report.Issues = append(report.Issues, fmt.Sprintf("Provider %q is not supported for adoption.", provider))
+		}
+	}
+
+	switch regions.Len() {
+	case 0:
+		if len(pools) > 0 {
+			report.Issues = append(report.Issues, fmt.Sprintf("The region of the nodes is unknown, missing label %s.", corev1.LabelZoneRegion))
+		}
+	case 1:
+		report.Infrastructure.Region = regions.List()[0]
+	default:
+		report.Issues = append(report.Issues, fmt.Sprintf("The nodes run in multiple regions %v.", regions.List()))
+	}
+
+	report.Infrastructure.Zones = zones.List()
+	for name, pool := range pools {
+		pool.Zones = poolZones[name].List()
+		report.Workers = append(report.Workers, *pool)
+	}
+	sort.Slice(report.Workers, func(i, j int) bool { return report.Workers[i].Name < report.Workers[j].Name })
+
+	report.Adoptable = len(report.Issues) == 0
+	return report
+}
+
+func minorVersion(version string) (string, error) {
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d.%d", v.Major(), v.Minor()), nil
+}
+
+func machineImageForOSImage(osImage string) *gardencorev1alpha1.PlantAdoptionMachineImage {
+	for _, image := range adoptionMachineImages {
+		if match := image.osImageRE.FindStringSubmatch(osImage); match != nil {
+			return &gardencorev1alpha1.PlantAdoptionMachineImage{Name: image.name, Version: match[1]}
+		}
+	}
+	return nil
+}
+
+func workerPoolName(machineType string) string {
+	name := strings.Trim(invalidPoolNameCharacters.ReplaceAllString(strings.ToLower(machineType), "-"), "-")
+	if len(name) > 15 {
+		name = strings.TrimRight(name[:15], "-")
+	}
+	if len(name) == 0 {
+		return "worker"
+	}
+	return name
+}
+
+// AdoptionExportConfigMapName returns the name of the ConfigMap containing the exported extension resources of the
+// given Plant.
+func AdoptionExportConfigMapName(plantName string) string {
+	return fmt.Sprintf("%s.adoption-export", plantName)
+}
+
+// ConvertToExtensionResources converts the given adoption report of a Plant into the Infrastructure, Worker and
+// ControlPlane extension resources of a Shoot with the same name. The resources are meant to be created in the
+// namespace of the Shoot in its Seed, they refer to the cloud provider secret which is maintained there by Gardener.
+// The Infrastructure carries over the configuration of the existing network resources from the Plant's annotation
+// and the worker pools the machine images of their nodes, so that the extension controllers reuse them instead of
+// creating new ones. Only adoptable reports can be converted.
+func ConvertToExtensionResources(plant *gardencorev1alpha1.Plant, report *gardencorev1alpha1.PlantAdoptionReport) (*extensionsv1alpha1.Infrastructure, *extensionsv1alpha1.Worker, *extensionsv1alpha1.ControlPlane, error) {
+	if !report.Adoptable {
+		return nil, nil, nil, fmt.Errorf("plant %s/%s is not adoptable", plant.Namespace, plant.Name)
+	}
+
+	var (
+		secretRef = corev1.SecretReference{Name: gardencorev1alpha1.SecretNameCloudProvider}
+		spec      = extensionsv1alpha1.DefaultSpec{Type: report.Infrastructure.Provider}
+		meta      = func(kind string) (metav1.TypeMeta, metav1.ObjectMeta) {
+			return metav1.TypeMeta{APIVersion: extensionsv1alpha1.SchemeGroupVersion.String(), Kind: kind}, metav1.ObjectMeta{Name: plant.Name}
+		}
+	)
+
+	infrastructureConfig, ok := plant.Annotations[AnnotationAdoptionInfrastructureConfig]
+	if !ok || !json.Valid([]byte(infrastructureConfig)) {
+		return nil, nil, nil, fmt.Errorf("plant %s/%s has no valid infrastructure configuration", plant.Namespace, plant.Name)
+	}
+
+	infrastructure := &extensionsv1alpha1.Infrastructure{
+		Spec: extensionsv1alpha1.InfrastructureSpec{
+			DefaultSpec:    spec,
+			Region:         report.Infrastructure.Region,
+			SecretRef:      secretRef,
+			ProviderConfig: &runtime.RawExtension{Raw: []byte(infrastructureConfig)},
+		},
+	}
+	infrastructure.TypeMeta, infrastructure.ObjectMeta = meta(extensionsv1alpha1.InfrastructureResource)
+
+	worker := &extensionsv1alpha1.Worker{
+		Spec: extensionsv1alpha1.WorkerSpec{
+			DefaultSpec: spec,
+			Region:      report.Infrastructure.Region,
+			SecretRef:   secretRef,
+		},
+	}
+	worker.TypeMeta, worker.ObjectMeta = meta(extensionsv1alpha1.WorkerResource)
+	for _, pool := range report.Workers {
+		if pool.MachineImage == nil {
+			return nil, nil, nil, fmt.Errorf("worker pool %s of plant %s/%s has no machine image", pool.Name, plant.Namespace, plant.Name)
+		}
+
+		worker.Spec.Pools = append(worker.Spec.Pools, extensionsv1alpha1.WorkerPool{
+			Name:           pool.Name,
+			MachineType:    pool.MachineType,
+			MachineImage:   extensionsv1alpha1.MachineImage{Name: pool.MachineImage.Name, Version: pool.MachineImage.Version},
+			Minimum:        int(pool.Nodes),
+			Maximum:        int(pool.Nodes),
+			MaxSurge:       intstr.FromInt(1),
+			MaxUnavailable: intstr.FromInt(0),
+			Labels:         map[string]string{labelWorkerPool: pool.Name},
+			Zones:          pool.Zones,
+		})
+	}
+
+	controlPlane := &extensionsv1alpha1.ControlPlane{
+		Spec: extensionsv1alpha1.ControlPlaneSpec{
+			DefaultSpec: spec,
+			Region:      report.Infrastructure.Region,
+			SecretRef:   secretRef,
+		},
+	}
+	controlPlane.TypeMeta, controlPlane.ObjectMeta = meta(extensionsv1alpha1.ControlPlaneResource)
+
+	return infrastructure, worker, controlPlane, nil
+}
+
+// AdoptionConfigMapData serializes the given extension resources and the Kubernetes version of the control plane
+// into the data of the adoption ConfigMap of a Plant.
+func AdoptionConfigMapData(kubernetesVersion string, infrastructure *extensionsv1alpha1.Infrastructure, worker *extensionsv1alpha1.Worker, controlPlane *extensionsv1alpha1.ControlPlane) (map[string]string, error) {
+	data := map[string]string{
+		AdoptionConfigMapKeyKubernetesVersion: kubernetesVersion,
+	}
+
+	for key, obj := range map[string]interface{}{
+		AdoptionConfigMapKeyInfrastructure: infrastructure,
+		AdoptionConfigMapKeyWorker:         worker,
+		AdoptionConfigMapKeyControlPlane:   controlPlane,
+	} {
+		out, err := yaml.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		data[key] = string(out)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,273 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plant_test
+
+import (
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/plant"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ = Describe("Plant Adoption", func() {
+	var (
+		coreos = &gardencorev1alpha1.PlantAdoptionMachineImage{Name: "coreos", Version: "2135.4.0"}
+		now    = time.Date(2019, 7, 1, 0, 0, 0, 0, time.UTC)
+
+		infrastructureConfig = `{"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"vpc":{"id":"vpc-123"}}}`
+		adoptablePlant       = &gardencorev1alpha1.Plant{ObjectMeta: metav1.ObjectMeta{
+			Name:        "example-plant",
+			Namespace:   "garden-dev",
+			Annotations: map[string]string{plant.AnnotationAdoptionInfrastructureConfig: infrastructureConfig},
+		}}
+
+		makeNode = func(name, providerID, machineType, zone, kubeletVersion string, labels map[string]string) corev1.Node {
+			node := corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+					Labels: map[string]string{
+						corev1.LabelZoneRegion:        "eu-west-1",
+						corev1.LabelZoneFailureDomain: zone,
+						corev1.LabelInstanceType:      machineType,
+					},
+				},
+				Spec:   corev1.NodeSpec{ProviderID: providerID},
+				Status: corev1.NodeStatus{NodeInfo: corev1.NodeSystemInfo{KubeletVersion: kubeletVersion, OSImage: "Container Linux by CoreOS 2135.4.0 (Rhyolite)"}},
+			}
+			for k, v := range labels {
+				node.Labels[k] = v
+			}
+			return node
+		}
+	)
+
+	Describe("#IsAdoptionDryRunRequested", func() {
+		It("should detect the adoption dry-run operation", func() {
+			p := &gardencorev1alpha1.Plant{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				gardencorev1alpha1.GardenerOperation: gardencorev1alpha1.GardenerOperationAdoptionDryRun,
+			}}}
+
+			Expect(plant.IsAdoptionDryRunRequested(p)).To(BeTrue())
+			Expect(plant.IsAdoptionDryRunRequested(&gardencorev1alpha1.Plant{})).To(BeFalse())
+		})
+	})
+
+	Describe("#IsAdoptionExportRequested", func() {
+		It("should detect the adoption export operation", func() {
+			p := &gardencorev1alpha1.Plant{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				gardencorev1alpha1.GardenerOperation: gardencorev1alpha1.GardenerOperationAdoptionExport,
+			}}}
+
+			Expect(plant.IsAdoptionExportRequested(p)).To(BeTrue())
+			Expect(plant.IsAdoptionExportRequested(&gardencorev1alpha1.Plant{})).To(BeFalse())
+		})
+	})
+
+	Describe("#IsAdoptionOperationAdded", func() {
+		var withOperation = func(operation string) *gardencorev1alpha1.Plant {
+			return &gardencorev1alpha1.Plant{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				gardencorev1alpha1.GardenerOperation: operation,
+			}}}
+		}
+
+		It("should detect an added adoption dry-run operation", func() {
+			Expect(plant.IsAdoptionOperationAdded(&gardencorev1alpha1.Plant{}, withOperation(gardencorev1alpha1.GardenerOperationAdoptionDryRun))).To(BeTrue())
+		})
+
+		It("should detect an added adoption export operation", func() {
+			Expect(plant.IsAdoptionOperationAdded(&gardencorev1alpha1.Plant{}, withOperation(gardencorev1alpha1.GardenerOperationAdoptionExport))).To(BeTrue())
+			Expect(plant.IsAdoptionOperationAdded(withOperation(gardencorev1alpha1.GardenerOperationAdoptionDryRun), withOperation(gardencorev1alpha1.GardenerOperationAdoptionExport))).To(BeTrue())
+		})
+
+		It("should ignore unchanged or removed operations", func() {
+			Expect(plant.IsAdoptionOperationAdded(withOperation(gardencorev1alpha1.GardenerOperationAdoptionExport), withOperation(gardencorev1alpha1.GardenerOperationAdoptionExport))).To(BeFalse())
+			Expect(plant.IsAdoptionOperationAdded(withOperation(gardencorev1alpha1.GardenerOperationAdoptionExport), &gardencorev1alpha1.Plant{})).To(BeFalse())
+		})
+	})
+
+	Describe("#ComputeAdoptionReport", func() {
+		It("should map the nodes to infrastructure and worker pools", func() {
+			nodes := []corev1.Node{
+				makeNode("node-1", "aws:///eu-west-1a/i-1", "m5.large", "eu-west-1a", "v1.14.3", nil),
+				makeNode("node-2", "aws:///eu-west-1b/i-2", "m5.large", "eu-west-1b", "v1.14.3", nil),
+				makeNode("node-3", "aws:///eu-west-1a/i-3", "c5.xlarge", "eu-west-1a", "v1.14.1", map[string]string{"worker.gardener.cloud/pool": "compute"}),
+			}
+
+			Expect(plant.ComputeAdoptionReport(adoptablePlant, nodes, "v1.14.3", now)).To(Equal(&gardencorev1alpha1.PlantAdoptionReport{
+				LastUpdateTime: metav1.NewTime(now),
+				Adoptable:      true,
+				Infrastructure: gardencorev1alpha1.PlantAdoptionInfrastructure{
+					Provider:          "aws",
+					Region:            "eu-west-1",
+					Zones:             []string{"eu-west-1a", "eu-west-1b"},
+					KubernetesVersion: "v1.14.3",
+				},
+				Workers: []gardencorev1alpha1.PlantAdoptionWorker{
+					{Name: "compute", MachineType: "c5.xlarge", Zones: []string{"eu-west-1a"}, Nodes: 1, MachineImage: coreos},
+					{Name: "m5-large", MachineType: "m5.large", Zones: []string{"eu-west-1a", "eu-west-1b"}, Nodes: 2, MachineImage: coreos},
+				},
+			}))
+		})
+
+		It("should report unsupported and mixed machine images", func() {
+			nodes := []corev1.Node{
+				makeNode("node-1", "aws:///eu-west-1a/i-1", "m5.large", "eu-west-1a", "v1.14.3", nil),
+				makeNode("node-2", "aws:///eu-west-1b/i-2", "m5.large", "eu-west-1b", "v1.14.3", nil),
+				makeNode("node-3", "aws:///eu-west-1b/i-3", "m5.large", "eu-west-1b", "v1.14.3", nil),
+			}
+			nodes[1].Status.NodeInfo.OSImage = "Ubuntu 18.04.2 LTS"
+			nodes[2].Status.NodeInfo.OSImage = "Some Linux"
+
+			report := plant.ComputeAdoptionReport(adoptablePlant, nodes, "v1.14.3", now)
+
+			Expect(report.Adoptable).To(BeFalse())
+			Expect(report.Workers).To(ConsistOf(MatchFields(IgnoreExtras, Fields{"MachineImage": Equal(coreos)})))
+			Expect(report.Issues).To(ConsistOf(
+				ContainSubstring("Worker pool m5-large contains nodes of different machine images (coreos 2135.4.0, ubuntu 18.04.2)"),
+				ContainSubstring(`Node node-3 runs operating system "Some Linux"`),
+			))
+		})
+
+		It("should report a missing or invalid infrastructure configuration", func() {
+			nodes := []corev1.Node{makeNode("node-1", "aws:///eu-west-1a/i-1", "m5.large", "eu-west-1a", "v1.14.3", nil)}
+
+			report := plant.ComputeAdoptionReport(&gardencorev1alpha1.Plant{}, nodes, "v1.14.3", now)
+			Expect(report.Adoptable).To(BeFalse())
+			Expect(report.Issues).To(ConsistOf(ContainSubstring("has no annotation adoption.gardener.cloud/infrastructure-config")))
+
+			invalidPlant := adoptablePlant.DeepCopy()
+			invalidPlant.Annotations[plant.AnnotationAdoptionInfrastructureConfig] = "{"
+			report = plant.ComputeAdoptionReport(invalidPlant, nodes, "v1.14.3", now)
+			Expect(report.Adoptable).To(BeFalse())
+			Expect(report.Issues).To(ConsistOf(ContainSubstring("does not contain valid JSON")))
+		})
+
+		It("should report what cannot be adopted", func() {
+			nodes := []corev1.Node{
+				makeNode("master", "kind://docker/master", "", "", "v1.14.3", map[string]string{"node-role.kubernetes.io/master": ""}),
+				makeNode("node-1", "kind://docker/node-1", "standard", "zone-a", "v1.12.0", nil),
+			}
+
+			report := plant.ComputeAdoptionReport(adoptablePlant, nodes, "v1.14.3", now)
+
+			Expect(report.Adoptable).To(BeFalse())
+			Expect(report.Workers).To(HaveLen(1))
+			Expect(report.Issues).To(ConsistOf(
+				ContainSubstring("Node node-1 runs kubelet version"),
+				ContainSubstring("1 nodes run a self-hosted control plane"),
+				ContainSubstring(`Provider "kind" is not supported`),
+			))
+		})
+
+		It("should report a cluster without nodes", func() {
+			report := plant.ComputeAdoptionReport(adoptablePlant, nil, "v1.14.3", now)
+
+			Expect(report.Adoptable).To(BeFalse())
+			Expect(report.Issues).To(ConsistOf(ContainSubstring("no adoptable worker nodes")))
+		})
+	})
+
+	Describe("#ConvertToExtensionResources", func() {
+		var (
+			p      = adoptablePlant
+			report = &gardencorev1alpha1.PlantAdoptionReport{
+				Adoptable: true,
+				Infrastructure: gardencorev1alpha1.PlantAdoptionInfrastructure{
+					Provider:          "aws",
+					Region:            "eu-west-1",
+					Zones:             []string{"eu-west-1a", "eu-west-1b"},
+					KubernetesVersion: "v1.14.3",
+				},
+				Workers: []gardencorev1alpha1.PlantAdoptionWorker{
+					{Name: "m5-large", MachineType: "m5.large", Zones: []string{"eu-west-1a", "eu-west-1b"}, Nodes: 2, MachineImage: coreos},
+				},
+			}
+			secretRef = corev1.SecretReference{Name: "cloudprovider"}
+		)
+
+		It("should convert the report into extension resources", func() {
+			infrastructure, worker, controlPlane, err := plant.ConvertToExtensionResources(p, report)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(infrastructure.Name).To(Equal("example-plant"))
+			Expect(infrastructure.Kind).To(Equal("Infrastructure"))
+			Expect(infrastructure.Spec).To(Equal(extensionsv1alpha1.InfrastructureSpec{
+				DefaultSpec:    extensionsv1alpha1.DefaultSpec{Type: "aws"},
+				Region:         "eu-west-1",
+				SecretRef:      secretRef,
+				ProviderConfig: &runtime.RawExtension{Raw: []byte(infrastructureConfig)},
+			}))
+			Expect(worker.Name).To(Equal("example-plant"))
+			Expect(worker.Kind).To(Equal("Worker"))
+			Expect(worker.Spec).To(Equal(extensionsv1alpha1.WorkerSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "aws"},
+				Region:      "eu-west-1",
+				SecretRef:   secretRef,
+				Pools: []extensionsv1alpha1.WorkerPool{{
+					Name:           "m5-large",
+					MachineType:    "m5.large",
+					MachineImage:   extensionsv1alpha1.MachineImage{Name: "coreos", Version: "2135.4.0"},
+					Minimum:        2,
+					Maximum:        2,
+					MaxSurge:       intstr.FromInt(1),
+					MaxUnavailable: intstr.FromInt(0),
+					Labels:         map[string]string{"worker.gardener.cloud/pool": "m5-large"},
+					Zones:          []string{"eu-west-1a", "eu-west-1b"},
+				}},
+			}))
+			Expect(controlPlane.Name).To(Equal("example-plant"))
+			Expect(controlPlane.Kind).To(Equal("ControlPlane"))
+			Expect(controlPlane.Spec).To(Equal(extensionsv1alpha1.ControlPlaneSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "aws"},
+				Region:      "eu-west-1",
+				SecretRef:   secretRef,
+			}))
+		})
+
+		It("should serialize the extension resources", func() {
+			infrastructure, worker, controlPlane, err := plant.ConvertToExtensionResources(p, report)
+			Expect(err).NotTo(HaveOccurred())
+
+			data, err := plant.AdoptionConfigMapData(report.Infrastructure.KubernetesVersion, infrastructure, worker, controlPlane)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(HaveKeyWithValue("kubernetesVersion", "v1.14.3"))
+			Expect(data).To(HaveKeyWithValue("infrastructure.yaml", ContainSubstring("kind: Infrastructure")))
+			Expect(data).To(HaveKeyWithValue("worker.yaml", ContainSubstring("machineType: m5.large")))
+			Expect(data).To(HaveKeyWithValue("controlplane.yaml", ContainSubstring("kind: ControlPlane")))
+		})
+
+		It("should fail for Plants without infrastructure configuration", func() {
+			_, _, _, err := plant.ConvertToExtensionResources(&gardencorev1alpha1.Plant{}, report)
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail for Plants which are not adoptable", func() {
+			_, _, _, err := plant.ConvertToExtensionResources(p, &gardencorev1alpha1.PlantAdoptionReport{})
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
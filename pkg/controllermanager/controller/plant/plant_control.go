@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"k8s.io/apimachinery/pkg/labels"
 
@@ -40,6 +41,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -103,7 +105,7 @@ func (c *Controller) plantUpdate(oldObj, newObj interface{}) {
 		return
 	}
 
-	if new.ObjectMeta.Generation == old.ObjectMeta.Generation {
+	if new.ObjectMeta.Generation == old.ObjectMeta.Generation && !IsAdoptionOperationAdded(old, new) {
 		return
 	}
 
@@ -191,14 +193,28 @@ func (c *defaultPlantControl) reconcile(ctx context.Context, plant *gardencorev1
 	}
 
 	var (
-		conditionAPIServerAvailable = helper.GetOrInitCondition(plant.Status.Conditions, gardencorev1alpha1.PlantAPIServerAvailable)
-		conditionEveryNodeReady     = helper.GetOrInitCondition(plant.Status.Conditions, gardencorev1alpha1.PlantEveryNodeReady)
+		conditionAPIServerAvailable       = helper.GetOrInitCondition(plant.Status.Conditions, gardencorev1alpha1.PlantAPIServerAvailable)
+		conditionEveryNodeReady           = helper.GetOrInitCondition(plant.Status.Conditions, gardencorev1alpha1.PlantEveryNodeReady)
+		conditionSystemComponentsHealthy  = helper.GetOrInitCondition(plant.Status.Conditions, gardencorev1alpha1.PlantSystemComponentsHealthy)
+		conditionNodeVersionSkewSupported = helper.GetOrInitCondition(plant.Status.Conditions, gardencorev1alpha1.PlantNodeVersionSkewSupported)
+		conditionEndpointsAvailable       = helper.GetOrInitCondition(plant.Status.Conditions, gardencorev1alpha1.PlantEndpointsAvailable)
+		probeEndpoints                    = hasEndpointProbes(plant.Spec.Endpoints)
 	)
 
+	// additionalConditions returns the conditions which are reported in addition to the API server availability
+	// and node readiness. The endpoints condition is only reported if at least one endpoint is probed.
+	additionalConditions := func() []gardencorev1alpha1.Condition {
+		conditions := []gardencorev1alpha1.Condition{conditionSystemComponentsHealthy, conditionNodeVersionSkewSupported}
+		if probeEndpoints {
+			conditions = append(conditions, conditionEndpointsAvailable)
+		}
+		return conditions
+	}
+
 	kubeconfigSecret, err := c.secretsLister.Secrets(plant.Namespace).Get(plant.Spec.SecretRef.Name)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
-			return c.updateStatusToUnknown(ctx, plant, "Referenced Plant secret could not be found.", conditionAPIServerAvailable, conditionEveryNodeReady)
+			return c.updateStatusToUnknown(ctx, plant, "Referenced Plant secret could not be found.", conditionAPIServerAvailable, conditionEveryNodeReady, additionalConditions()...)
 		}
 		return err
 	}
@@ -215,35 +231,111 @@ func (c *defaultPlantControl) reconcile(ctx context.Context, plant *gardencorev1
 	kubeconfig, ok := kubeconfigSecret.Data["kubeconfig"]
 	if !ok {
 		message := "Plant secret needs to contain a kubeconfig key."
-		return c.updateStatusToUnknown(ctx, plant, message, conditionAPIServerAvailable, conditionEveryNodeReady)
+		return c.updateStatusToUnknown(ctx, plant, message, conditionAPIServerAvailable, conditionEveryNodeReady, additionalConditions()...)
 	}
 
 	plantClusterClient, discoveryClient, err := c.initializePlantClients(plant, key, kubeconfig)
 	if err != nil {
 		message := fmt.Sprintf("Could not initialize Plant clients: %+v", err)
-		return c.updateStatusToUnknown(ctx, plant, message, conditionAPIServerAvailable, conditionEveryNodeReady)
+		return c.updateStatusToUnknown(ctx, plant, message, conditionAPIServerAvailable, conditionEveryNodeReady, additionalConditions()...)
 	}
 
 	healthChecker := NewHealthChecker(plantClusterClient, discoveryClient)
 
 	// Trigger health check
 	conditionAPIServerAvailable, conditionEveryNodeReady = c.healthChecks(ctx, healthChecker, logger, conditionAPIServerAvailable, conditionEveryNodeReady)
+	conditionSystemComponentsHealthy, conditionNodeVersionSkewSupported, conditionEndpointsAvailable = c.additionalHealthChecks(ctx, healthChecker, plant.Spec.Endpoints, probeEndpoints, conditionSystemComponentsHealthy, conditionNodeVersionSkewSupported, conditionEndpointsAvailable)
 
 	cloudInfo, err := FetchCloudInfo(ctx, plantClusterClient, discoveryClient, logger)
 	if err != nil {
 		return err
 	}
 
-	return c.updateStatus(ctx, plant, cloudInfo, conditionAPIServerAvailable, conditionEveryNodeReady)
+	inventory, err := FetchInventory(ctx, plantClusterClient)
+	if err != nil {
+		return err
+	}
+
+	var (
+		adoption          = plant.Status.Adoption
+		adoptionRequested = IsAdoptionDryRunRequested(plant) || IsAdoptionExportRequested(plant)
+	)
+	if adoptionRequested {
+		logger.Infof("[PLANT RECONCILE] Computing adoption report")
+
+		nodeList := &corev1.NodeList{}
+		if err := plantClusterClient.List(ctx, nodeList); err != nil {
+			return err
+		}
+		adoption = ComputeAdoptionReport(plant, nodeList.Items, cloudInfo.K8sVersion, time.Now())
+
+		if IsAdoptionExportRequested(plant) {
+			if err := c.deployAdoptionExportConfigMap(ctx, plant, adoption); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := c.updateStatus(ctx, plant, cloudInfo, inventory, adoption, append([]gardencorev1alpha1.Condition{conditionAPIServerAvailable, conditionEveryNodeReady}, additionalConditions()...)...); err != nil {
+		return err
+	}
+
+	if adoptionRequested {
+		return c.removeOperationAnnotation(ctx, plant)
+	}
+	return nil
+}
+
+// deployAdoptionExportConfigMap converts an adoptable Plant into the extension resources of a Shoot and exports them
+// to a ConfigMap owned by the Plant. The export is a dry-run: neither a Shoot nor any of the resources are created from
+// it. Plants which are not adoptable only get their adoption report.
+func (c *defaultPlantControl) deployAdoptionExportConfigMap(ctx context.Context, plant *gardencorev1alpha1.Plant, adoption *gardencorev1alpha1.PlantAdoptionReport) error {
+	if !adoption.Adoptable {
+		c.recorder.Eventf(plant, corev1.EventTypeWarning, "AdoptionExportFailed", "Plant cannot be adopted, see the issues of the adoption report")
+		return nil
+	}
+
+	infrastructure, worker, controlPlane, err := ConvertToExtensionResources(plant, adoption)
+	if err != nil {
+		return err
+	}
+	data, err := AdoptionConfigMapData(adoption.Infrastructure.KubernetesVersion, infrastructure, worker, controlPlane)
+	if err != nil {
+		return err
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: AdoptionExportConfigMapName(plant.Name), Namespace: plant.Namespace}}
+	if err := kutil.CreateOrUpdate(ctx, c.k8sGardenClient.Client(), configMap, func() error {
+		metav1.SetMetaDataAnnotation(&configMap.ObjectMeta, annotationDescription, "Dry-run export of the extension resources of a Shoot adopting the Plant. Gardener does not create any resources from it.")
+		configMap.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(plant, gardencorev1alpha1.SchemeGroupVersion.WithKind("Plant"))}
+		configMap.Data = data
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	c.recorder.Eventf(plant, corev1.EventTypeNormal, "AdoptionExported", "Extension resources of the Plant have been exported to ConfigMap %s (dry-run, nothing has been created)", configMap.Name)
+	return nil
+}
+
+func (c *defaultPlantControl) removeOperationAnnotation(ctx context.Context, plant *gardencorev1alpha1.Plant) error {
+	withOperation := plant.DeepCopy()
+	delete(plant.Annotations, gardencorev1alpha1.GardenerOperation)
+	return c.k8sGardenClient.Client().Patch(ctx, plant, client.MergeFrom(withOperation))
 }
 
-func (c *defaultPlantControl) updateStatusToUnknown(ctx context.Context, plant *gardencorev1alpha1.Plant, message string, conditionAPIServerAvailable, conditionEveryNodeReady gardencorev1alpha1.Condition) error {
+func (c *defaultPlantControl) updateStatusToUnknown(ctx context.Context, plant *gardencorev1alpha1.Plant, message string, conditionAPIServerAvailable, conditionEveryNodeReady gardencorev1alpha1.Condition, additionalConditions ...gardencorev1alpha1.Condition) error {
 	conditionAPIServerAvailable = helper.UpdatedCondition(conditionAPIServerAvailable, gardencorev1alpha1.ConditionFalse, "APIServerDown", message)
 	conditionEveryNodeReady = helper.UpdatedCondition(conditionEveryNodeReady, gardencorev1alpha1.ConditionFalse, "Nodes not reachable", message)
-	return c.updateStatus(ctx, plant, &StatusCloudInfo{}, conditionAPIServerAvailable, conditionEveryNodeReady)
+
+	conditions := []gardencorev1alpha1.Condition{conditionAPIServerAvailable, conditionEveryNodeReady}
+	for _, condition := range additionalConditions {
+		conditions = append(conditions, helper.UpdatedConditionUnknownErrorMessage(condition, message))
+	}
+	return c.updateStatus(ctx, plant, &StatusCloudInfo{}, nil, plant.Status.Adoption, conditions...)
 }
 
-func (c *defaultPlantControl) updateStatus(ctx context.Context, plant *gardencorev1alpha1.Plant, cloudInfo *StatusCloudInfo, conditions ...gardencorev1alpha1.Condition) error {
+func (c *defaultPlantControl) updateStatus(ctx context.Context, plant *gardencorev1alpha1.Plant, cloudInfo *StatusCloudInfo, inventory *StatusInventory, adoption *gardencorev1alpha1.PlantAdoptionReport, conditions ...gardencorev1alpha1.Condition) error {
 	updatePlant := plant.DeepCopy()
 	if updatePlant.Status.ClusterInfo == nil {
 		updatePlant.Status.ClusterInfo = &gardencorev1alpha1.ClusterInfo{}
@@ -251,6 +343,16 @@ func (c *defaultPlantControl) updateStatus(ctx context.Context, plant *gardencor
 	updatePlant.Status.ClusterInfo.Cloud.Type = cloudInfo.CloudType
 	updatePlant.Status.ClusterInfo.Cloud.Region = cloudInfo.Region
 	updatePlant.Status.ClusterInfo.Kubernetes.Version = cloudInfo.K8sVersion
+	updatePlant.Status.ClusterInfo.Kubernetes.NodeVersions = nil
+	updatePlant.Status.ClusterInfo.Inventory = nil
+	if inventory != nil {
+		updatePlant.Status.ClusterInfo.Kubernetes.NodeVersions = inventory.NodeVersions
+		updatePlant.Status.ClusterInfo.Inventory = &gardencorev1alpha1.ClusterInventory{
+			Nodes: inventory.Nodes,
+			Pods:  inventory.Pods,
+		}
+	}
+	updatePlant.Status.Adoption = adoption
 	updatePlant.Status.Conditions = conditions
 
 	if !equality.Semantic.DeepEqual(plant, updatePlant) {
@@ -333,3 +435,28 @@ func (c *defaultPlantControl) healthChecks(ctx context.Context, healthChecker *H
 
 	return apiserverAvailability, everyNodeReady
 }
+
+func (c *defaultPlantControl) additionalHealthChecks(ctx context.Context, healthChecker *HealthChecker, endpoints []gardencorev1alpha1.Endpoint, probeEndpoints bool, systemComponents, nodeVersionSkew, endpointsAvailable gardencorev1alpha1.Condition) (gardencorev1alpha1.Condition, gardencorev1alpha1.Condition, gardencorev1alpha1.Condition) {
+	var wg sync.WaitGroup
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		systemComponents = healthChecker.CheckPlantSystemComponents(ctx, systemComponents)
+	}()
+	go func() {
+		defer wg.Done()
+		nodeVersionSkew = healthChecker.CheckPlantNodeVersionSkew(ctx, nodeVersionSkew)
+	}()
+	if probeEndpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			endpointsAvailable = healthChecker.CheckPlantEndpoints(ctx, endpointsAvailable, endpoints)
+		}()
+	}
+
+	wg.Wait()
+
+	return systemComponents, nodeVersionSkew, endpointsAvailable
+}
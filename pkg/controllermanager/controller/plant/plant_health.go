@@ -18,7 +18,11 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
+	"github.com/Masterminds/semver"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/discovery"
 
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
@@ -33,6 +37,16 @@ import (
 	"github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 )
 
+const (
+	// maxNodeMinorVersionSkew is the maximum number of minor versions the kubelet may be older than the control plane.
+	maxNodeMinorVersionSkew = 2
+	// defaultEndpointProbeTimeout is the timeout of an endpoint probe without explicit timeout.
+	defaultEndpointProbeTimeout = 10 * time.Second
+)
+
+// checkHTTPEndpoint probes an HTTP(S) endpoint. It is a variable so that tests can probe local endpoints.
+var checkHTTPEndpoint = health.CheckPublicHTTPEndpoint
+
 // NewHealthChecker creates a new health checker.
 func NewHealthChecker(plantClient client.Client, discoveryClient discovery.DiscoveryInterface) *HealthChecker {
 	return &HealthChecker{
@@ -70,6 +84,116 @@ func (h *HealthChecker) CheckAPIServerAvailability(condition gardencorev1alpha1.
 	})
 }
 
+// CheckPlantSystemComponents checks whether the workloads in the kube-system namespace of the Plant cluster are healthy.
+func (h *HealthChecker) CheckPlantSystemComponents(ctx context.Context, condition gardencorev1alpha1.Condition) gardencorev1alpha1.Condition {
+	deploymentList := &appsv1.DeploymentList{}
+	if err := h.plantClient.List(ctx, deploymentList, client.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return helper.UpdatedConditionUnknownError(condition, err)
+	}
+	for _, deployment := range deploymentList.Items {
+		if err := health.CheckDeployment(&deployment); err != nil {
+			return helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "DeploymentUnhealthy", fmt.Sprintf("Deployment %s is unhealthy: %v", deployment.Name, err))
+		}
+	}
+
+	daemonSetList := &appsv1.DaemonSetList{}
+	if err := h.plantClient.List(ctx, daemonSetList, client.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return helper.UpdatedConditionUnknownError(condition, err)
+	}
+	for _, daemonSet := range daemonSetList.Items {
+		if err := health.CheckDaemonSet(&daemonSet); err != nil {
+			return helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "DaemonSetUnhealthy", fmt.Sprintf("DaemonSet %s is unhealthy: %v", daemonSet.Name, err))
+		}
+	}
+
+	statefulSetList := &appsv1.StatefulSetList{}
+	if err := h.plantClient.List(ctx, statefulSetList, client.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return helper.UpdatedConditionUnknownError(condition, err)
+	}
+	for _, statefulSet := range statefulSetList.Items {
+		if err := health.CheckStatefulSet(&statefulSet); err != nil {
+			return helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "StatefulSetUnhealthy", fmt.Sprintf("StatefulSet %s is unhealthy: %v", statefulSet.Name, err))
+		}
+	}
+
+	return helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "SystemComponentsRunning", "All system components are healthy.")
+}
+
+// CheckPlantNodeVersionSkew checks whether the kubelet versions of all nodes of the Plant cluster are supported by the
+// version of its control plane.
+func (h *HealthChecker) CheckPlantNodeVersionSkew(ctx context.Context, condition gardencorev1alpha1.Condition) gardencorev1alpha1.Condition {
+	versionInfo, err := h.discoveryClient.ServerVersion()
+	if err != nil {
+		return helper.UpdatedConditionUnknownError(condition, err)
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := h.plantClient.List(ctx, nodeList); err != nil {
+		return helper.UpdatedConditionUnknownError(condition, err)
+	}
+
+	for _, node := range nodeList.Items {
+		if err := CheckNodeVersionSkew(versionInfo.GitVersion, node.Status.NodeInfo.KubeletVersion); err != nil {
+			return helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "NodeVersionSkewUnsupported", fmt.Sprintf("Node %s has an unsupported version: %v", node.Name, err))
+		}
+	}
+
+	return helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "NodeVersionSkewSupported", "The versions of all nodes are supported by the control plane.")
+}
+
+// CheckPlantEndpoints probes all endpoints of the Plant which have a probe configuration.
+func (h *HealthChecker) CheckPlantEndpoints(ctx context.Context, condition gardencorev1alpha1.Condition, endpoints []gardencorev1alpha1.Endpoint) gardencorev1alpha1.Condition {
+	for _, endpoint := range endpoints {
+		if endpoint.Probe == nil {
+			continue
+		}
+		if err := probeEndpoint(ctx, endpoint.URL, endpoint.Probe); err != nil {
+			return helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "EndpointUnavailable", fmt.Sprintf("Endpoint %s is unavailable: %v", endpoint.Name, err))
+		}
+	}
+
+	return helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "EndpointsAvailable", "All probed endpoints are available.")
+}
+
+// CheckNodeVersionSkew checks whether the given kubelet version is supported by the given control plane version, i.e.
+// the kubelet must not be newer than the control plane and may be at most two minor versions older.
+func CheckNodeVersionSkew(controlPlaneVersion, kubeletVersion string) error {
+	controlPlane, err := semver.NewVersion(controlPlaneVersion)
+	if err != nil {
+		return fmt.Errorf("could not parse control plane version %q: %v", controlPlaneVersion, err)
+	}
+	kubelet, err := semver.NewVersion(kubeletVersion)
+	if err != nil {
+		return fmt.Errorf("could not parse kubelet version %q: %v", kubeletVersion, err)
+	}
+
+	if kubelet.Major() != controlPlane.Major() {
+		return fmt.Errorf("kubelet version %s does not match the major version of control plane version %s", kubeletVersion, controlPlaneVersion)
+	}
+	if kubelet.Minor() > controlPlane.Minor() {
+		return fmt.Errorf("kubelet version %s is newer than control plane version %s", kubeletVersion, controlPlaneVersion)
+	}
+	if controlPlane.Minor()-kubelet.Minor() > maxNodeMinorVersionSkew {
+		return fmt.Errorf("kubelet version %s is more than %d minor versions older than control plane version %s", kubeletVersion, maxNodeMinorVersionSkew, controlPlaneVersion)
+	}
+	return nil
+}
+
+// probeEndpoint probes the given endpoint. Only public addresses are probed so that Plant endpoints cannot be used
+// to reach into the network of the Garden cluster.
+func probeEndpoint(ctx context.Context, url string, probe *gardencorev1alpha1.EndpointProbe) error {
+	timeout := defaultEndpointProbeTimeout
+	if probe.Timeout != nil {
+		timeout = probe.Timeout.Duration
+	}
+
+	expectedStatusCodes := make([]int, 0, len(probe.ExpectedStatusCodes))
+	for _, statusCode := range probe.ExpectedStatusCodes {
+		expectedStatusCodes = append(expectedStatusCodes, int(statusCode))
+	}
+	return checkHTTPEndpoint(ctx, url, timeout, expectedStatusCodes)
+}
+
 func (h *HealthChecker) checkNodes(condition gardencorev1alpha1.Condition, nodeList *corev1.NodeList) (gardencorev1alpha1.Condition, error) {
 	for _, object := range nodeList.Items {
 		if err := health.CheckNode(&object); err != nil {
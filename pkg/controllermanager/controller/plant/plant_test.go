@@ -18,8 +18,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -39,10 +41,12 @@ import (
 	mockrest "github.com/gardener/gardener/pkg/mock/client-go/rest"
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
 	mockio "github.com/gardener/gardener/pkg/mock/go/io"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -64,6 +68,17 @@ func makeNodeWithProvider(provider string, withLabels map[string]string) corev1.
 	}
 }
 
+func makeNodeWithKubeletVersion(version string) corev1.Node {
+	return corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node-" + version,
+		},
+		Status: corev1.NodeStatus{
+			NodeInfo: corev1.NodeSystemInfo{KubeletVersion: version},
+		},
+	}
+}
+
 func hasConditonTrue(cond gardencorev1alpha1.Condition) bool {
 	return cond.Status == gardencorev1alpha1.ConditionTrue
 }
@@ -114,6 +129,42 @@ var _ = Describe("Plant", func() {
 			Entry("It should return the provider successfully",
 				makeNodeWithProvider("aws://zones.something", map[string]string{labelZoneRegion: region}), BeNil(), &plant.StatusCloudInfo{CloudType: "aws", K8sVersion: k8sVersion, Region: region}),
 		)
+
+		It("should fetch the inventory", func() {
+			runtimeClient := mockclient.NewMockClient(ctrl)
+
+			gomock.InOrder(
+				runtimeClient.EXPECT().List(context.TODO(), gomock.AssignableToTypeOf(&corev1.NodeList{})).DoAndReturn(func(ctx context.Context, list runtime.Object, opts ...client.ListOptionFunc) error {
+					list.(*corev1.NodeList).Items = []corev1.Node{
+						makeNodeWithKubeletVersion("v1.14.1"),
+						makeNodeWithKubeletVersion("v1.13.4"),
+						makeNodeWithKubeletVersion("v1.14.1"),
+					}
+					return nil
+				}),
+				runtimeClient.EXPECT().List(context.TODO(), gomock.AssignableToTypeOf(&corev1.PodList{}), gomock.Any()).DoAndReturn(func(ctx context.Context, list runtime.Object, opts ...client.ListOptionFunc) error {
+					listOptions := (&client.ListOptions{}).ApplyOptions(opts).AsListOptions()
+					Expect(listOptions.FieldSelector).To(Equal("status.phase!=Succeeded,status.phase!=Failed"))
+					Expect(listOptions.Continue).To(BeEmpty())
+					list.(*corev1.PodList).Items = make([]corev1.Pod, 3)
+					list.(*corev1.PodList).Continue = "next"
+					return nil
+				}),
+				runtimeClient.EXPECT().List(context.TODO(), gomock.AssignableToTypeOf(&corev1.PodList{}), gomock.Any()).DoAndReturn(func(ctx context.Context, list runtime.Object, opts ...client.ListOptionFunc) error {
+					Expect((&client.ListOptions{}).ApplyOptions(opts).AsListOptions().Continue).To(Equal("next"))
+					list.(*corev1.PodList).Items = make([]corev1.Pod, 2)
+					return nil
+				}),
+			)
+
+			inventory, err := plant.FetchInventory(context.TODO(), runtimeClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(inventory).To(Equal(&plant.StatusInventory{
+				Nodes:        3,
+				Pods:         5,
+				NodeVersions: []string{"v1.13.4", "v1.14.1"},
+			}))
+		})
 	})
 	Context("HealthChecker", func() {
 		var (
@@ -196,5 +247,124 @@ var _ = Describe("Plant", func() {
 			},
 			Entry("no healthy cluster nodes", BeTrue()),
 		)
+
+		Describe("#CheckPlantSystemComponents", func() {
+			var (
+				conditionSystemComponentsHealthy = helper.InitCondition(gardencorev1alpha1.PlantSystemComponentsHealthy)
+				runtimeClient                    *mockclient.MockClient
+				replicas                         = int32(1)
+			)
+
+			BeforeEach(func() {
+				runtimeClient = mockclient.NewMockClient(ctrl)
+				healthChecker = plant.NewHealthChecker(runtimeClient, discoveryMockclient)
+			})
+
+			It("should report healthy system components", func() {
+				runtimeClient.EXPECT().List(context.TODO(), gomock.AssignableToTypeOf(&appsv1.DeploymentList{}), gomock.Any()).DoAndReturn(func(ctx context.Context, list runtime.Object, opts ...client.ListOptionFunc) error {
+					list.(*appsv1.DeploymentList).Items = []appsv1.Deployment{{
+						Spec:   appsv1.DeploymentSpec{Replicas: &replicas},
+						Status: appsv1.DeploymentStatus{Conditions: []appsv1.DeploymentCondition{{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue}}},
+					}}
+					return nil
+				})
+				runtimeClient.EXPECT().List(context.TODO(), gomock.AssignableToTypeOf(&appsv1.DaemonSetList{}), gomock.Any())
+				runtimeClient.EXPECT().List(context.TODO(), gomock.AssignableToTypeOf(&appsv1.StatefulSetList{}), gomock.Any())
+
+				condition := healthChecker.CheckPlantSystemComponents(context.TODO(), conditionSystemComponentsHealthy)
+				Expect(hasConditonTrue(condition)).To(BeTrue())
+			})
+
+			It("should report unhealthy system components", func() {
+				runtimeClient.EXPECT().List(context.TODO(), gomock.AssignableToTypeOf(&appsv1.DeploymentList{}), gomock.Any())
+				runtimeClient.EXPECT().List(context.TODO(), gomock.AssignableToTypeOf(&appsv1.DaemonSetList{}), gomock.Any()).DoAndReturn(func(ctx context.Context, list runtime.Object, opts ...client.ListOptionFunc) error {
+					list.(*appsv1.DaemonSetList).Items = []appsv1.DaemonSet{{
+						ObjectMeta: metav1.ObjectMeta{Name: "kube-proxy", Generation: 2},
+						Status:     appsv1.DaemonSetStatus{ObservedGeneration: 1},
+					}}
+					return nil
+				})
+
+				condition := healthChecker.CheckPlantSystemComponents(context.TODO(), conditionSystemComponentsHealthy)
+				Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+				Expect(condition.Reason).To(Equal("DaemonSetUnhealthy"))
+			})
+		})
+
+		DescribeTable("#CheckNodeVersionSkew",
+			func(controlPlaneVersion, kubeletVersion string, matcher types.GomegaMatcher) {
+				Expect(plant.CheckNodeVersionSkew(controlPlaneVersion, kubeletVersion)).To(matcher)
+			},
+			Entry("same version", "v1.14.1", "v1.14.1", Succeed()),
+			Entry("older patch version", "v1.14.1", "v1.14.0", Succeed()),
+			Entry("two minor versions older", "v1.14.1", "v1.12.7", Succeed()),
+			Entry("three minor versions older", "v1.14.1", "v1.11.7", HaveOccurred()),
+			Entry("newer minor version", "v1.13.1", "v1.14.1", HaveOccurred()),
+			Entry("different major version", "v1.14.1", "v2.0.0", HaveOccurred()),
+			Entry("invalid version", "v1.14.1", "foo", HaveOccurred()),
+		)
+
+		Describe("#CheckPlantEndpoints", func() {
+			var (
+				conditionEndpointsAvailable = helper.InitCondition(gardencorev1alpha1.PlantEndpointsAvailable)
+				server                      *httptest.Server
+				checkHTTPEndpoint           = *plant.ExportCheckHTTPEndpoint
+			)
+
+			BeforeEach(func() {
+				// The test server listens on a loopback address which is refused by the default probe.
+				*plant.ExportCheckHTTPEndpoint = func(ctx context.Context, url string, timeout time.Duration, expectedStatusCodes []int) error {
+					req, err := http.NewRequest(http.MethodGet, url, nil)
+					if err != nil {
+						return err
+					}
+					resp, err := (&http.Client{Timeout: timeout}).Do(req.WithContext(ctx))
+					if err != nil {
+						return err
+					}
+					defer resp.Body.Close()
+					return health.CheckHTTPStatusCode(resp.StatusCode, expectedStatusCodes)
+				}
+				server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.URL.Path == "/healthy" {
+						w.WriteHeader(http.StatusNoContent)
+						return
+					}
+					w.WriteHeader(http.StatusServiceUnavailable)
+				}))
+				healthChecker = plant.NewHealthChecker(runtimeClient, discoveryMockclient)
+			})
+
+			AfterEach(func() {
+				*plant.ExportCheckHTTPEndpoint = checkHTTPEndpoint
+				server.Close()
+			})
+
+			It("should refuse to probe non-public endpoints", func() {
+				*plant.ExportCheckHTTPEndpoint = checkHTTPEndpoint
+
+				condition := healthChecker.CheckPlantEndpoints(context.TODO(), conditionEndpointsAvailable, []gardencorev1alpha1.Endpoint{
+					{Name: "healthy", URL: server.URL + "/healthy", Probe: &gardencorev1alpha1.EndpointProbe{ExpectedStatusCodes: []int32{http.StatusNoContent}}},
+				})
+				Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+				Expect(condition.Message).To(ContainSubstring("non-public address"))
+			})
+
+			It("should only probe endpoints with a probe configuration", func() {
+				condition := healthChecker.CheckPlantEndpoints(context.TODO(), conditionEndpointsAvailable, []gardencorev1alpha1.Endpoint{
+					{Name: "healthy", URL: server.URL + "/healthy", Probe: &gardencorev1alpha1.EndpointProbe{ExpectedStatusCodes: []int32{http.StatusNoContent}}},
+					{Name: "not-probed", URL: server.URL + "/unhealthy"},
+				})
+				Expect(hasConditonTrue(condition)).To(BeTrue())
+			})
+
+			It("should report unavailable endpoints", func() {
+				condition := healthChecker.CheckPlantEndpoints(context.TODO(), conditionEndpointsAvailable, []gardencorev1alpha1.Endpoint{
+					{Name: "unhealthy", URL: server.URL + "/unhealthy", Probe: &gardencorev1alpha1.EndpointProbe{}},
+				})
+				Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+				Expect(condition.Reason).To(Equal("EndpointUnavailable"))
+			})
+		})
 	})
 })
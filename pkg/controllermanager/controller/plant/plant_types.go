@@ -37,3 +37,10 @@ type StatusCloudInfo struct {
 	Region     string
 	K8sVersion string
 }
+
+// StatusInventory contains the inventory info for the plant status
+type StatusInventory struct {
+	Nodes        int32
+	Pods         int32
+	NodeVersions []string
+}
@@ -22,6 +22,9 @@ import (
 
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/discovery"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -47,6 +50,62 @@ func FetchCloudInfo(ctx context.Context, plantClient client.Client, discoveryCli
 	return cloudInfo, nil
 }
 
+// inventoryPodsPageSize is the number of pods fetched per request when counting the pods of a plant cluster.
+const inventoryPodsPageSize = 500
+
+// FetchInventory counts the nodes and the non-terminated pods of the plant cluster and collects the distinct kubelet
+// versions of its nodes. The pods are filtered by the API server and fetched in pages to limit the load on both sides.
+func FetchInventory(ctx context.Context, plantClient client.Client) (*StatusInventory, error) {
+	nodes := &corev1.NodeList{}
+	if err := plantClient.List(ctx, nodes); err != nil {
+		return nil, err
+	}
+
+	pods, err := countNonTerminatedPods(ctx, plantClient)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeVersions := sets.NewString()
+	for _, node := range nodes.Items {
+		if version := node.Status.NodeInfo.KubeletVersion; len(version) > 0 {
+			nodeVersions.Insert(version)
+		}
+	}
+
+	return &StatusInventory{
+		Nodes:        int32(len(nodes.Items)),
+		Pods:         pods,
+		NodeVersions: nodeVersions.List(),
+	}, nil
+}
+
+func countNonTerminatedPods(ctx context.Context, plantClient client.Client) (int32, error) {
+	var (
+		count         int32
+		continueToken string
+		selector      = fields.AndSelectors(
+			fields.OneTermNotEqualSelector("status.phase", string(corev1.PodSucceeded)),
+			fields.OneTermNotEqualSelector("status.phase", string(corev1.PodFailed)),
+		)
+	)
+
+	for {
+		pods := &corev1.PodList{}
+		if err := plantClient.List(ctx, pods, client.UseListOptions(&client.ListOptions{
+			FieldSelector: selector,
+			Raw:           &metav1.ListOptions{Limit: inventoryPodsPageSize, Continue: continueToken},
+		})); err != nil {
+			return 0, err
+		}
+
+		count += int32(len(pods.Items))
+		if continueToken = pods.Continue; len(continueToken) == 0 {
+			return count, nil
+		}
+	}
+}
+
 // getClusterInfo gets the kubernetes cluster zones and Region by inspecting labels on nodes in the cluster.
 func getClusterInfo(ctx context.Context, cl client.Client, logger logrus.FieldLogger) (*StatusCloudInfo, error) {
 	nodes := &corev1.NodeList{}
@@ -93,6 +152,15 @@ func getRegionNameForNode(node corev1.Node) string {
 	return Unknown
 }
 
+func hasEndpointProbes(endpoints []gardencorev1alpha1.Endpoint) bool {
+	for _, endpoint := range endpoints {
+		if endpoint.Probe != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func isPlantSecret(plant *gardencorev1alpha1.Plant, secretKey client.ObjectKey) bool {
 	return plant.Spec.SecretRef.Name == secretKey.Name && plant.Namespace == secretKey.Namespace
 }
@@ -19,9 +19,11 @@ import (
 	"sync"
 	"time"
 
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
 	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	"github.com/gardener/gardener/pkg/logger"
 
@@ -48,14 +50,16 @@ type Controller struct {
 	namespaceQueue  workqueue.RateLimitingInterface
 	namespaceSynced cache.InformerSynced
 
+	staleSyncPeriod time.Duration
+
 	workerCh               chan int
 	numberOfRunningWorkers int
 }
 
 // NewProjectController takes a Kubernetes client for the Garden clusters <k8sGardenClient>, a struct
-// holding information about the acting Gardener, a <projectInformer>, and a <recorder> for
-// event recording. It creates a new Gardener controller.
-func NewProjectController(k8sGardenClient kubernetes.Interface, gardenInformerFactory gardeninformers.SharedInformerFactory, kubeInformerFactory kubeinformers.SharedInformerFactory, recorder record.EventRecorder) *Controller {
+// holding information about the acting Gardener, a <projectInformer>, the controller manager <config>, and a
+// <recorder> for event recording. It creates a new Gardener controller.
+func NewProjectController(k8sGardenClient kubernetes.Interface, gardenInformerFactory gardeninformers.SharedInformerFactory, gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory, kubeInformerFactory kubeinformers.SharedInformerFactory, config *config.ControllerManagerConfiguration, recorder record.EventRecorder) *Controller {
 	var (
 		gardenv1beta1Informer = gardenInformerFactory.Garden().V1beta1()
 		corev1Informer        = kubeInformerFactory.Core().V1()
@@ -72,7 +76,7 @@ func NewProjectController(k8sGardenClient kubernetes.Interface, gardenInformerFa
 	projectController := &Controller{
 		k8sGardenClient:    k8sGardenClient,
 		k8sGardenInformers: gardenInformerFactory,
-		control:            NewDefaultControl(k8sGardenClient, gardenInformerFactory, recorder, projectUpdater, namespaceLister),
+		control:            NewDefaultControl(k8sGardenClient, gardenInformerFactory, gardenCoreInformerFactory, config.Controllers.Project, recorder, projectUpdater, namespaceLister),
 		recorder:           recorder,
 		projectLister:      projectLister,
 		projectQueue:       workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "Project"),
@@ -81,6 +85,10 @@ func NewProjectController(k8sGardenClient kubernetes.Interface, gardenInformerFa
 		workerCh:           make(chan int),
 	}
 
+	if syncPeriod := config.Controllers.Project.StaleSyncPeriod; syncPeriod != nil {
+		projectController.staleSyncPeriod = syncPeriod.Duration
+	}
+
 	projectInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    projectController.projectAdd,
 		UpdateFunc: projectController.projectUpdate,
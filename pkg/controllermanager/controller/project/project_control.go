@@ -18,8 +18,10 @@ import (
 	"time"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/logger"
 	kutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 
@@ -82,6 +84,9 @@ func (c *Controller) reconcileProjectKey(key string) error {
 		return err
 	} else if needsRequeue {
 		c.projectQueue.AddAfter(key, time.Minute)
+	} else if project.DeletionTimestamp == nil && c.staleSyncPeriod > 0 {
+		// Periodically reconcile the project to re-evaluate its staleness.
+		c.projectQueue.AddAfter(key, c.staleSyncPeriod)
 	}
 
 	return nil
@@ -101,16 +106,18 @@ type ControlInterface interface {
 // implements the documented semantics for Projects. updater is the UpdaterInterface used
 // to update the status of Projects. You should use an instance returned from NewDefaultControl() for any
 // scenario other than testing.
-func NewDefaultControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, recorder record.EventRecorder, updater UpdaterInterface, namespaceLister kubecorev1listers.NamespaceLister) ControlInterface {
-	return &defaultControl{k8sGardenClient, k8sGardenInformers, recorder, updater, namespaceLister}
+func NewDefaultControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory, config *config.ProjectControllerConfiguration, recorder record.EventRecorder, updater UpdaterInterface, namespaceLister kubecorev1listers.NamespaceLister) ControlInterface {
+	return &defaultControl{k8sGardenClient, k8sGardenInformers, k8sGardenCoreInformers, config, recorder, updater, namespaceLister}
 }
 
 type defaultControl struct {
-	k8sGardenClient    kubernetes.Interface
-	k8sGardenInformers gardeninformers.SharedInformerFactory
-	recorder           record.EventRecorder
-	updater            UpdaterInterface
-	namespaceLister    kubecorev1listers.NamespaceLister
+	k8sGardenClient        kubernetes.Interface
+	k8sGardenInformers     gardeninformers.SharedInformerFactory
+	k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory
+	config                 *config.ProjectControllerConfiguration
+	recorder               record.EventRecorder
+	updater                UpdaterInterface
+	namespaceLister        kubecorev1listers.NamespaceLister
 }
 
 func newProjectLogger(project *gardenv1beta1.Project) logrus.FieldLogger {
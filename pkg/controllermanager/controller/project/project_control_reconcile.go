@@ -18,11 +18,13 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"strings"
 	"time"
 
 	utilretry "github.com/gardener/gardener/pkg/utils/retry"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/chartrenderer"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/operation/common"
@@ -30,10 +32,13 @@ import (
 	kutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/sirupsen/logrus"
 )
@@ -116,31 +121,66 @@ func (c *defaultControl) reconcile(project *gardenv1beta1.Project, projectLogger
 	chartApplier := kubernetes.NewChartApplier(chartRenderer, applier)
 
 	// Create RBAC rules to allow project owner and project members to read, update, and delete the project.
-	// We also create a RoleBinding in the namespace that binds all members to the garden.sapcloud.io:system:project-member
-	// role to ensure access for listing shoots, creating secrets, etc.
-	if err := chartApplier.ApplyChart(context.TODO(), filepath.Join(common.ChartPath, "garden-project", "charts", "project-rbac"), namespace.Name, "project-rbac", map[string]interface{}{
-		"project": map[string]interface{}{
-			"name":    project.Name,
-			"uid":     project.UID,
-			"owner":   project.Spec.Owner,
-			"members": project.Spec.Members,
-			"viewers": project.Spec.Viewers,
-		},
-	}, nil); err != nil {
+	// We also create RoleBindings in the namespace that bind the project members to the ClusterRoles of their roles
+	// (e.g., garden.sapcloud.io:system:project-member for admins) to ensure access for listing shoots, creating secrets, etc.
+	if err := chartApplier.ApplyChart(context.TODO(), filepath.Join(common.ChartPath, "garden-project", "charts", "project-rbac"), namespace.Name, "project-rbac", projectRBACValues(project), nil); err != nil {
 		c.reportEvent(project, true, gardenv1beta1.ProjectEventNamespaceReconcileFailed, "Error while creating RBAC rules for namespace %q: %+v", namespace.Name, err)
 		c.updateProjectStatus(project.ObjectMeta, setProjectPhase(gardenv1beta1.ProjectFailed))
 		return err
 	}
 
+	// Remove the RBAC rules of custom extension roles which are no longer used by any project member.
+	if err := c.deleteStaleExtensionRoles(ctx, project, namespace.Name); err != nil {
+		c.reportEvent(project, true, gardenv1beta1.ProjectEventNamespaceReconcileFailed, "Error while deleting stale RBAC rules for namespace %q: %+v", namespace.Name, err)
+		c.updateProjectStatus(project.ObjectMeta, setProjectPhase(gardenv1beta1.ProjectFailed))
+		return err
+	}
+
+	// Create the ServiceAccounts and kubeconfig secrets for the service accounts of the project.
+	serviceAccountStatuses, err := c.reconcileServiceAccounts(ctx, project, namespace.Name)
+	if err != nil {
+		c.reportEvent(project, true, gardenv1beta1.ProjectEventNamespaceReconcileFailed, "Error while reconciling service accounts for namespace %q: %+v", namespace.Name, err)
+		c.updateProjectStatus(project.ObjectMeta, setProjectPhase(gardenv1beta1.ProjectFailed))
+		return err
+	}
+
+	// Compute the last activity in the project. Changes of the project specification (e.g., of its members) count as
+	// activity as well.
+	shoots, err := c.listShoots(namespace.Name)
+	if err != nil {
+		c.reportEvent(project, true, gardenv1beta1.ProjectEventNamespaceReconcileFailed, "Error while listing shoots of project: %+v", err)
+		return err
+	}
+	now := metav1.Now()
+	activity := LastActivity(project, shoots)
+	if project.Status.ObservedGeneration != generation {
+		activity = now
+	}
+	wasStale := project.Status.StaleSinceTimestamp != nil
+
 	// Update the project status to mark it as 'ready'.
-	if _, err := c.updateProjectStatus(project.ObjectMeta, func(project *gardenv1beta1.Project) (*gardenv1beta1.Project, error) {
+	updatedProject, err := c.updateProjectStatus(project.ObjectMeta, func(project *gardenv1beta1.Project) (*gardenv1beta1.Project, error) {
 		project.Status.Phase = gardenv1beta1.ProjectReady
 		project.Status.ObservedGeneration = generation
+		project.Status.LastActivityTimestamp = &activity
+		project.Status.ServiceAccounts = serviceAccountStatuses
+		UpdateStaleness(&project.Status, c.config, now.Time)
 		return project, nil
-	}); err != nil {
+	})
+	if err != nil {
 		c.reportEvent(project, true, gardenv1beta1.ProjectEventNamespaceReconcileFailed, "Error while trying to mark project as ready: %+v", err)
 		return err
 	}
+	project = updatedProject
+
+	if !wasStale && project.Status.StaleSinceTimestamp != nil {
+		c.reportEvent(project, true, gardenv1beta1.ProjectEventStale, "There was no activity in the project since %s, it is considered to be stale now.", activity.UTC().Format(time.RFC3339))
+	}
+
+	if err := c.deleteStaleProject(project, now.Time); err != nil {
+		c.reportEvent(project, true, gardenv1beta1.ProjectEventStaleAutoDeletion, "Error while trying to delete stale project: %+v", err)
+		return err
+	}
 
 	return nil
 }
@@ -200,3 +240,43 @@ func (c *defaultControl) reconcileNamespaceForProject(project *gardenv1beta1.Pro
 
 	return namespace, nil
 }
+
+func (c *defaultControl) deleteStaleExtensionRoles(ctx context.Context, project *gardenv1beta1.Project, namespace string) error {
+	wantedRoleBindings, wantedClusterRoles := sets.NewString(), sets.NewString()
+	for role := range helper.GetProjectMemberSubjectsByRole(project) {
+		if !strings.HasPrefix(role, gardenv1beta1.ProjectMemberExtensionPrefix) {
+			continue
+		}
+		name := strings.TrimPrefix(role, gardenv1beta1.ProjectMemberExtensionPrefix)
+		wantedRoleBindings.Insert(fmt.Sprintf("garden.sapcloud.io:system:project-extension:%s", name))
+		wantedClusterRoles.Insert(fmt.Sprintf("garden.sapcloud.io:system:project-extension:%s:%s", project.Name, name))
+	}
+
+	roleBindingList := &rbacv1.RoleBindingList{}
+	if err := c.k8sGardenClient.Client().List(ctx, roleBindingList, client.InNamespace(namespace), client.MatchingLabels(map[string]string{common.GardenRole: common.GardenRoleProjectExtensionRole})); err != nil {
+		return err
+	}
+	for _, roleBinding := range roleBindingList.Items {
+		if wantedRoleBindings.Has(roleBinding.Name) {
+			continue
+		}
+		if err := c.k8sGardenClient.Client().Delete(ctx, roleBinding.DeepCopy()); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	clusterRoleList := &rbacv1.ClusterRoleList{}
+	if err := c.k8sGardenClient.Client().List(ctx, clusterRoleList, client.MatchingLabels(map[string]string{common.GardenRole: common.GardenRoleProjectExtensionRole, common.ProjectName: project.Name})); err != nil {
+		return err
+	}
+	for _, clusterRole := range clusterRoleList.Items {
+		if wantedClusterRoles.Has(clusterRole.Name) {
+			continue
+		}
+		if err := c.k8sGardenClient.Client().Delete(ctx, clusterRole.DeepCopy()); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	return nil
+}
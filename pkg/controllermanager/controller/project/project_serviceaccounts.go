@@ -0,0 +1,298 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
+	kutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	utilretry "github.com/gardener/gardener/pkg/utils/retry"
+	secretutils "github.com/gardener/gardener/pkg/utils/secrets"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// serviceAccountKubeconfigSecretSuffix is the suffix of the names of the secrets containing the kubeconfigs of
+	// project service accounts. Shoot names cannot contain dots, hence, the names do not conflict with the kubeconfig
+	// secrets of shoots.
+	serviceAccountKubeconfigSecretSuffix = ".serviceaccount.kubeconfig"
+	// annotationTokenSecretName is the key of an annotation on kubeconfig secrets of project service accounts whose
+	// value is the name of the token secret used in the kubeconfig.
+	annotationTokenSecretName = "project.garden.sapcloud.io/token-secret-name"
+	// annotationTokenRotationTime is the key of an annotation on kubeconfig secrets of project service accounts whose
+	// value is the time when the token has been issued.
+	annotationTokenRotationTime = "project.garden.sapcloud.io/token-rotation-time"
+)
+
+// reconcileServiceAccounts creates the ServiceAccounts and kubeconfig secrets of the service accounts of the given
+// project, rotates their tokens if required, and deletes the ones which have been removed from the project.
+func (c *defaultControl) reconcileServiceAccounts(ctx context.Context, project *gardenv1beta1.Project, namespace string) ([]gardenv1beta1.ProjectServiceAccountStatus, error) {
+	var (
+		statuses []gardenv1beta1.ProjectServiceAccountStatus
+		wanted   = sets.NewString()
+	)
+
+	for _, serviceAccount := range project.Spec.ServiceAccounts {
+		wanted.Insert(serviceAccount.Name)
+
+		status, err := c.reconcileServiceAccount(ctx, project, namespace, serviceAccount.Name)
+		if err != nil {
+			return nil, fmt.Errorf("could not reconcile service account %q: %v", serviceAccount.Name, err)
+		}
+		statuses = append(statuses, *status)
+	}
+
+	serviceAccountList := &corev1.ServiceAccountList{}
+	if err := c.k8sGardenClient.Client().List(ctx, serviceAccountList, client.InNamespace(namespace), client.MatchingLabels(map[string]string{common.GardenRole: common.GardenRoleProjectServiceAccount})); err != nil {
+		return nil, err
+	}
+	for _, serviceAccount := range serviceAccountList.Items {
+		if wanted.Has(serviceAccount.Name) {
+			continue
+		}
+		// The token secrets are deleted by the token controller and the kubeconfig secret by the garbage collector.
+		if err := c.k8sGardenClient.Client().Delete(ctx, serviceAccount.DeepCopy()); client.IgnoreNotFound(err) != nil {
+			return nil, err
+		}
+	}
+
+	return statuses, nil
+}
+
+func (c *defaultControl) reconcileServiceAccount(ctx context.Context, project *gardenv1beta1.Project, namespace, name string) (*gardenv1beta1.ProjectServiceAccountStatus, error) {
+	serviceAccount := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	if err := kutils.CreateOrUpdate(ctx, c.k8sGardenClient.Client(), serviceAccount, func() error {
+		if serviceAccount.Labels == nil {
+			serviceAccount.Labels = map[string]string{}
+		}
+		serviceAccount.Labels[common.GardenRole] = common.GardenRoleProjectServiceAccount
+		serviceAccount.OwnerReferences = common.MergeOwnerReferences(serviceAccount.OwnerReferences, *metav1.NewControllerRef(project, gardenv1beta1.SchemeGroupVersion.WithKind("Project")))
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	status := &gardenv1beta1.ProjectServiceAccountStatus{
+		Name:                 name,
+		KubeconfigSecretName: name + serviceAccountKubeconfigSecretSuffix,
+	}
+
+	kubeconfigSecret := &corev1.Secret{}
+	if err := c.k8sGardenClient.Client().Get(ctx, kutils.Key(namespace, status.KubeconfigSecretName), kubeconfigSecret); err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	if rotationTime, err := time.Parse(time.RFC3339, kubeconfigSecret.Annotations[annotationTokenRotationTime]); err == nil && time.Since(rotationTime) < c.serviceAccountTokenRotationPeriod() {
+		status.LastTokenRotationTime = &metav1.Time{Time: rotationTime}
+		if err := CleanupServiceAccountTokens(ctx, c.k8sGardenClient.Client(), serviceAccount, kubeconfigSecret.Annotations[annotationTokenSecretName], rotationTime, c.serviceAccountTokenGracePeriod(), time.Now()); err != nil {
+			return nil, err
+		}
+		return status, nil
+	}
+
+	// Issue a new token and update the kubeconfig before deleting the old token to not interrupt running automation.
+	tokenSecret, err := c.issueServiceAccountToken(ctx, serviceAccount)
+	if err != nil {
+		return nil, err
+	}
+	kubeconfig, err := c.serviceAccountKubeconfig(namespace, name, tokenSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	now := metav1.Now().Rfc3339Copy()
+	kubeconfigSecret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: status.KubeconfigSecretName, Namespace: namespace}}
+	if err := kutils.CreateOrUpdate(ctx, c.k8sGardenClient.Client(), kubeconfigSecret, func() error {
+		if kubeconfigSecret.Labels == nil {
+			kubeconfigSecret.Labels = map[string]string{}
+		}
+		kubeconfigSecret.Labels[common.ProjectServiceAccountName] = name
+		if kubeconfigSecret.Annotations == nil {
+			kubeconfigSecret.Annotations = map[string]string{}
+		}
+		kubeconfigSecret.Annotations[annotationTokenSecretName] = tokenSecret.Name
+		kubeconfigSecret.Annotations[annotationTokenRotationTime] = now.UTC().Format(time.RFC3339)
+		kubeconfigSecret.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(serviceAccount, corev1.SchemeGroupVersion.WithKind("ServiceAccount"))}
+		kubeconfigSecret.Type = corev1.SecretTypeOpaque
+		kubeconfigSecret.Data = map[string][]byte{
+			secretutils.DataKeyKubeconfig: kubeconfig,
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := CleanupServiceAccountTokens(ctx, c.k8sGardenClient.Client(), serviceAccount, tokenSecret.Name, now.Time, c.serviceAccountTokenGracePeriod(), now.Time); err != nil {
+		return nil, err
+	}
+
+	status.LastTokenRotationTime = &now
+	return status, nil
+}
+
+// CleanupServiceAccountTokens makes the given token secret the only token referenced by the given ServiceAccount and
+// deletes all other tokens of the ServiceAccount once the grace period after the last rotation has passed. This
+// includes the token created automatically by the token controller, which is not referenced by the ServiceAccount
+// anymore and hence not created again.
+func CleanupServiceAccountTokens(ctx context.Context, c client.Client, serviceAccount *corev1.ServiceAccount, tokenSecretName string, rotationTime time.Time, gracePeriod time.Duration, now time.Time) error {
+	if len(tokenSecretName) == 0 {
+		return nil
+	}
+
+	secretList := &corev1.SecretList{}
+	if err := c.List(ctx, secretList, client.InNamespace(serviceAccount.Namespace)); err != nil {
+		return err
+	}
+	var oldTokenSecrets []corev1.Secret
+	for _, secret := range secretList.Items {
+		if secret.Type == corev1.SecretTypeServiceAccountToken && secret.Annotations[corev1.ServiceAccountNameKey] == serviceAccount.Name && secret.Name != tokenSecretName {
+			oldTokenSecrets = append(oldTokenSecrets, secret)
+		}
+	}
+
+	if err := kutils.CreateOrUpdate(ctx, c, serviceAccount, func() error {
+		secrets := []corev1.ObjectReference{{Name: tokenSecretName}}
+		for _, reference := range serviceAccount.Secrets {
+			if reference.Name != tokenSecretName && !containsSecret(oldTokenSecrets, reference.Name) {
+				secrets = append(secrets, reference)
+			}
+		}
+		serviceAccount.Secrets = secrets
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if now.Before(rotationTime.Add(gracePeriod)) {
+		return nil
+	}
+	for _, secret := range oldTokenSecrets {
+		if err := c.Delete(ctx, secret.DeepCopy()); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func containsSecret(secrets []corev1.Secret, name string) bool {
+	for _, secret := range secrets {
+		if secret.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// issueServiceAccountToken creates a new token secret for the given ServiceAccount and waits until the token
+// controller has populated it.
+func (c *defaultControl) issueServiceAccountToken(ctx context.Context, serviceAccount *corev1.ServiceAccount) (*corev1.Secret, error) {
+	tokenSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: serviceAccount.Name + "-token-",
+			Namespace:    serviceAccount.Namespace,
+			Labels: map[string]string{
+				common.ProjectServiceAccountName: serviceAccount.Name,
+			},
+			Annotations: map[string]string{
+				corev1.ServiceAccountNameKey: serviceAccount.Name,
+				corev1.ServiceAccountUIDKey:  string(serviceAccount.UID),
+			},
+		},
+		Type: corev1.SecretTypeServiceAccountToken,
+	}
+	if err := c.k8sGardenClient.Client().Create(ctx, tokenSecret); err != nil {
+		return nil, err
+	}
+
+	if err := utilretry.UntilTimeout(ctx, time.Second, 30*time.Second, func(ctx context.Context) (bool, error) {
+		if err := c.k8sGardenClient.Client().Get(ctx, kutils.Key(tokenSecret.Namespace, tokenSecret.Name), tokenSecret); err != nil {
+			return utilretry.SevereError(err)
+		}
+		if len(tokenSecret.Data[corev1.ServiceAccountTokenKey]) == 0 {
+			return utilretry.MinorError(fmt.Errorf("token secret %q has not been populated yet", tokenSecret.Name))
+		}
+		return utilretry.Ok()
+	}); err != nil {
+		return nil, err
+	}
+
+	return tokenSecret, nil
+}
+
+// serviceAccountKubeconfig renders a kubeconfig for the given project service account using the token of the given
+// token secret.
+func (c *defaultControl) serviceAccountKubeconfig(namespace, name string, tokenSecret *corev1.Secret) ([]byte, error) {
+	server := c.k8sGardenClient.RESTConfig().Host
+	if c.config != nil && c.config.GardenAPIServerURL != nil {
+		server = *c.config.GardenAPIServerURL
+	}
+
+	values := map[string]interface{}{
+		"Name":      fmt.Sprintf("%s-%s", namespace, name),
+		"Server":    server,
+		"Namespace": namespace,
+		"Token":     string(tokenSecret.Data[corev1.ServiceAccountTokenKey]),
+	}
+	if caCert := tokenSecret.Data[corev1.ServiceAccountRootCAKey]; len(caCert) > 0 {
+		values["CACertificate"] = utils.EncodeBase64(caCert)
+	}
+
+	return utils.RenderLocalTemplate(serviceAccountKubeconfigTemplate, values)
+}
+
+func (c *defaultControl) serviceAccountTokenRotationPeriod() time.Duration {
+	if c.config != nil && c.config.ServiceAccountTokenRotationPeriod != nil {
+		return c.config.ServiceAccountTokenRotationPeriod.Duration
+	}
+	return 30 * day
+}
+
+func (c *defaultControl) serviceAccountTokenGracePeriod() time.Duration {
+	if c.config != nil && c.config.ServiceAccountTokenGracePeriod != nil {
+		return c.config.ServiceAccountTokenGracePeriod.Duration
+	}
+	return day
+}
+
+const serviceAccountKubeconfigTemplate = `---
+apiVersion: v1
+kind: Config
+current-context: {{ .Name }}
+clusters:
+- name: {{ .Name }}
+  cluster:
+{{- if .CACertificate }}
+    certificate-authority-data: {{ .CACertificate }}
+{{- end }}
+    server: {{ .Server }}
+contexts:
+- name: {{ .Name }}
+  context:
+    cluster: {{ .Name }}
+    namespace: {{ .Namespace }}
+    user: {{ .Name }}
+users:
+- name: {{ .Name }}
+  user:
+    token: {{ .Token }}`
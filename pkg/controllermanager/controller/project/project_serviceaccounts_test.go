@@ -0,0 +1,130 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project"
+	"github.com/gardener/gardener/pkg/operation/common"
+	kutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Service Accounts", func() {
+	Describe("#CleanupServiceAccountTokens", func() {
+		const namespace = "garden-foo"
+
+		var (
+			ctx          = context.TODO()
+			rotationTime = time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+			gracePeriod  = 24 * time.Hour
+
+			c              client.Client
+			serviceAccount *corev1.ServiceAccount
+
+			tokenSecret = func(name, serviceAccountName string, labels map[string]string) *corev1.Secret {
+				return &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        name,
+						Namespace:   namespace,
+						Labels:      labels,
+						Annotations: map[string]string{corev1.ServiceAccountNameKey: serviceAccountName},
+					},
+					Type: corev1.SecretTypeServiceAccountToken,
+				}
+			}
+			labels = map[string]string{common.ProjectServiceAccountName: "robot"}
+
+			expectSecrets = func(names ...string) {
+				secretList := &corev1.SecretList{}
+				Expect(c.List(ctx, secretList, client.InNamespace(namespace))).To(Succeed())
+
+				var actual []string
+				for _, secret := range secretList.Items {
+					actual = append(actual, secret.Name)
+				}
+				Expect(actual).To(ConsistOf(names))
+			}
+		)
+
+		BeforeEach(func() {
+			serviceAccount = &corev1.ServiceAccount{
+				ObjectMeta: metav1.ObjectMeta{Name: "robot", Namespace: namespace},
+				Secrets: []corev1.ObjectReference{
+					{Name: "robot-token-auto"},
+					{Name: "robot-dockercfg"},
+				},
+			}
+			c = fake.NewFakeClient(
+				serviceAccount.DeepCopy(),
+				tokenSecret("robot-token-auto", "robot", nil),
+				tokenSecret("robot-token-old", "robot", labels),
+				tokenSecret("robot-token-new", "robot", labels),
+				tokenSecret("other-token", "other", nil),
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "robot-dockercfg", Namespace: namespace}, Type: corev1.SecretTypeDockercfg},
+			)
+		})
+
+		It("should only reference the current token in the service account", func() {
+			Expect(CleanupServiceAccountTokens(ctx, c, serviceAccount, "robot-token-new", rotationTime, gracePeriod, rotationTime)).To(Succeed())
+
+			actual := &corev1.ServiceAccount{}
+			Expect(c.Get(ctx, kutils.Key(namespace, "robot"), actual)).To(Succeed())
+			Expect(actual.Secrets).To(Equal([]corev1.ObjectReference{
+				{Name: "robot-token-new"},
+				{Name: "robot-dockercfg"},
+			}))
+		})
+
+		It("should keep the previous tokens during the grace period", func() {
+			Expect(CleanupServiceAccountTokens(ctx, c, serviceAccount, "robot-token-new", rotationTime, gracePeriod, rotationTime.Add(gracePeriod-time.Second))).To(Succeed())
+
+			expectSecrets("robot-token-auto", "robot-token-old", "robot-token-new", "other-token", "robot-dockercfg")
+		})
+
+		It("should delete the previous and the automatically created tokens after the grace period", func() {
+			Expect(CleanupServiceAccountTokens(ctx, c, serviceAccount, "robot-token-new", rotationTime, gracePeriod, rotationTime.Add(gracePeriod))).To(Succeed())
+
+			expectSecrets("robot-token-new", "other-token", "robot-dockercfg")
+		})
+
+		It("should do nothing if the current token is unknown", func() {
+			Expect(CleanupServiceAccountTokens(ctx, c, serviceAccount, "", rotationTime, gracePeriod, rotationTime.Add(gracePeriod))).To(Succeed())
+
+			expectSecrets("robot-token-auto", "robot-token-old", "robot-token-new", "other-token", "robot-dockercfg")
+			actual := &corev1.ServiceAccount{}
+			Expect(c.Get(ctx, kutils.Key(namespace, "robot"), actual)).To(Succeed())
+			Expect(actual.Secrets).To(HaveLen(2))
+		})
+
+		It("should tolerate tokens which have been deleted already", func() {
+			Expect(c.Delete(ctx, tokenSecret("robot-token-old", "robot", labels))).To(Succeed())
+
+			Expect(CleanupServiceAccountTokens(ctx, c, serviceAccount, "robot-token-new", rotationTime, gracePeriod, rotationTime.Add(gracePeriod))).To(Succeed())
+
+			err := c.Get(ctx, kutils.Key(namespace, "robot-token-auto"), &corev1.Secret{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,162 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	"fmt"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/operation/common"
+	kutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/util/retry"
+)
+
+const day = 24 * time.Hour
+
+// LastActivity returns the time of the last activity in the project, i.e., the latest of the creation of the project,
+// the previously recorded activity, and the creation, last operation, and deletion of its shoots.
+func LastActivity(project *gardenv1beta1.Project, shoots []*gardenv1beta1.Shoot) metav1.Time {
+	last := project.CreationTimestamp
+
+	latest := func(t *metav1.Time) {
+		if t != nil && last.Before(t) {
+			last = *t
+		}
+	}
+
+	latest(project.Status.LastActivityTimestamp)
+	for _, shoot := range shoots {
+		latest(&shoot.CreationTimestamp)
+		latest(shoot.DeletionTimestamp)
+		if lastOperation := shoot.Status.LastOperation; lastOperation != nil {
+			latest(&lastOperation.LastUpdateTime)
+		}
+	}
+
+	return last
+}
+
+// UpdateStaleness computes the staleness of the project based on its last activity and the given configuration, and
+// updates the respective fields and the 'Stale' condition in the given project status.
+func UpdateStaleness(status *gardenv1beta1.ProjectStatus, cfg *config.ProjectControllerConfiguration, now time.Time) {
+	if cfg == nil || cfg.StaleGracePeriodDays == nil || status.LastActivityTimestamp == nil {
+		var conditions []gardencorev1alpha1.Condition
+		for _, condition := range status.Conditions {
+			if condition.Type != gardenv1beta1.ProjectStale {
+				conditions = append(conditions, condition)
+			}
+		}
+		status.Conditions = conditions
+		status.StaleSinceTimestamp = nil
+		status.StaleAutoDeleteTimestamp = nil
+		return
+	}
+
+	condition := gardencorev1alpha1helper.GetOrInitCondition(status.Conditions, gardenv1beta1.ProjectStale)
+
+	if now.Before(status.LastActivityTimestamp.Add(time.Duration(*cfg.StaleGracePeriodDays) * day)) {
+		status.StaleSinceTimestamp = nil
+		status.StaleAutoDeleteTimestamp = nil
+		condition = gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "ProjectActive", fmt.Sprintf("The last activity in the project was at %s.", status.LastActivityTimestamp.UTC().Format(time.RFC3339)))
+		status.Conditions = gardencorev1alpha1helper.MergeConditions(status.Conditions, condition)
+		return
+	}
+
+	// The staleness is measured from the time it was detected first, i.e., projects which have been inactive for a long
+	// time already are not deleted right away when the staleness or auto deletion is enabled.
+	if status.StaleSinceTimestamp == nil {
+		status.StaleSinceTimestamp = &metav1.Time{Time: now}
+	}
+	staleSince := status.StaleSinceTimestamp.Time
+
+	status.StaleAutoDeleteTimestamp = nil
+	message := fmt.Sprintf("There was no activity in the project since %s.", status.LastActivityTimestamp.UTC().Format(time.RFC3339))
+	if cfg.StaleExpirationDays != nil {
+		autoDelete := staleSince.Add(time.Duration(*cfg.StaleExpirationDays) * day)
+		status.StaleAutoDeleteTimestamp = &metav1.Time{Time: autoDelete}
+		message += fmt.Sprintf(" It will be deleted automatically after %s if it does not contain any shoots, plants, or backup infrastructures anymore.", autoDelete.UTC().Format(time.RFC3339))
+	}
+	condition = gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "ProjectStale", message)
+	status.Conditions = gardencorev1alpha1helper.MergeConditions(status.Conditions, condition)
+}
+
+// IsStaleProjectDueForDeletion checks whether the stale project with the given status is due for its automatic
+// deletion at the given time. Projects whose staleness has been detected just now are never due for deletion.
+func IsStaleProjectDueForDeletion(status *gardenv1beta1.ProjectStatus, now time.Time) bool {
+	staleSince, autoDelete := status.StaleSinceTimestamp, status.StaleAutoDeleteTimestamp
+	if staleSince == nil || autoDelete == nil {
+		return false
+	}
+	return staleSince.Time.Before(now) && !now.Before(autoDelete.Time)
+}
+
+// listShoots returns the shoots in the given project namespace.
+func (c *defaultControl) listShoots(namespace string) ([]*gardenv1beta1.Shoot, error) {
+	return c.k8sGardenInformers.Garden().V1beta1().Shoots().Lister().Shoots(namespace).List(labels.Everything())
+}
+
+// isProjectEmpty checks whether the given project namespace does not contain any shoots, plants, or backup
+// infrastructures. Backup infrastructures of deleted shoots are kept for their deletion grace period, hence, a project
+// is not considered to be empty before it has passed.
+func (c *defaultControl) isProjectEmpty(namespace string) (bool, error) {
+	shoots, err := c.listShoots(namespace)
+	if err != nil || len(shoots) > 0 {
+		return false, err
+	}
+
+	backupInfrastructures, err := c.k8sGardenInformers.Garden().V1beta1().BackupInfrastructures().Lister().BackupInfrastructures(namespace).List(labels.Everything())
+	if err != nil || len(backupInfrastructures) > 0 {
+		return false, err
+	}
+
+	plants, err := c.k8sGardenCoreInformers.Core().V1alpha1().Plants().Lister().Plants(namespace).List(labels.Everything())
+	if err != nil || len(plants) > 0 {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// deleteStaleProject deletes the given project if it is stale, its auto deletion time has passed, and it is empty.
+func (c *defaultControl) deleteStaleProject(project *gardenv1beta1.Project, now time.Time) error {
+	if !IsStaleProjectDueForDeletion(&project.Status, now) || project.Spec.Namespace == nil {
+		return nil
+	}
+
+	empty, err := c.isProjectEmpty(*project.Spec.Namespace)
+	if err != nil || !empty {
+		return err
+	}
+
+	c.reportEvent(project, false, gardenv1beta1.ProjectEventStaleAutoDeletion, "Project has been stale since %s and is empty, deleting it.", project.Status.StaleSinceTimestamp.UTC().Format(time.RFC3339))
+
+	// We have to annotate the project to confirm the deletion.
+	project, err = kutils.TryUpdateProject(c.k8sGardenClient.Garden(), retry.DefaultBackoff, project.ObjectMeta, func(project *gardenv1beta1.Project) (*gardenv1beta1.Project, error) {
+		metav1.SetMetaDataAnnotation(&project.ObjectMeta, common.ConfirmationDeletion, "true")
+		return project, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.k8sGardenClient.Garden().GardenV1beta1().Projects().Delete(project.Name, &metav1.DeleteOptions{})
+}
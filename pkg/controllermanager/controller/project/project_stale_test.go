@@ -0,0 +1,206 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project_test
+
+import (
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Staleness", func() {
+	var (
+		now = time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+		day = 24 * time.Hour
+
+		timeAgo = func(d time.Duration) metav1.Time {
+			return metav1.Time{Time: now.Add(-d)}
+		}
+		intPtr = func(i int) *int {
+			return &i
+		}
+	)
+
+	Describe("#LastActivity", func() {
+		var project *gardenv1beta1.Project
+
+		BeforeEach(func() {
+			project = &gardenv1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: timeAgo(100 * day),
+				},
+			}
+		})
+
+		It("should return the creation timestamp for projects without activity", func() {
+			Expect(LastActivity(project, nil)).To(Equal(project.CreationTimestamp))
+		})
+
+		It("should return the previously recorded activity", func() {
+			recorded := timeAgo(50 * day)
+			project.Status.LastActivityTimestamp = &recorded
+
+			Expect(LastActivity(project, nil)).To(Equal(recorded))
+		})
+
+		It("should consider the shoots of the project", func() {
+			recorded, deleted := timeAgo(50*day), timeAgo(10*day)
+			project.Status.LastActivityTimestamp = &recorded
+			shoots := []*gardenv1beta1.Shoot{
+				{
+					ObjectMeta: metav1.ObjectMeta{CreationTimestamp: timeAgo(60 * day)},
+					Status: gardenv1beta1.ShootStatus{
+						LastOperation: &gardencorev1alpha1.LastOperation{LastUpdateTime: timeAgo(20 * day)},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{CreationTimestamp: timeAgo(30 * day), DeletionTimestamp: &deleted},
+				},
+			}
+
+			Expect(LastActivity(project, shoots)).To(Equal(deleted))
+		})
+	})
+
+	Describe("#UpdateStaleness", func() {
+		var (
+			status *gardenv1beta1.ProjectStatus
+			cfg    *config.ProjectControllerConfiguration
+		)
+
+		BeforeEach(func() {
+			lastActivity := timeAgo(100 * day)
+			status = &gardenv1beta1.ProjectStatus{LastActivityTimestamp: &lastActivity}
+			cfg = &config.ProjectControllerConfiguration{
+				StaleGracePeriodDays: intPtr(90),
+				StaleExpirationDays:  intPtr(30),
+			}
+		})
+
+		It("should not mark projects as stale if it is disabled", func() {
+			cfg.StaleGracePeriodDays = nil
+			status.Conditions = []gardencorev1alpha1.Condition{{Type: gardenv1beta1.ProjectStale, Status: gardencorev1alpha1.ConditionTrue}}
+
+			UpdateStaleness(status, cfg, now)
+
+			Expect(status.StaleSinceTimestamp).To(BeNil())
+			Expect(status.StaleAutoDeleteTimestamp).To(BeNil())
+			Expect(status.Conditions).To(BeEmpty())
+		})
+
+		It("should not mark projects with recent activity as stale", func() {
+			cfg.StaleGracePeriodDays = intPtr(120)
+
+			UpdateStaleness(status, cfg, now)
+
+			Expect(status.StaleSinceTimestamp).To(BeNil())
+			Expect(status.StaleAutoDeleteTimestamp).To(BeNil())
+			Expect(gardencorev1alpha1helper.GetCondition(status.Conditions, gardenv1beta1.ProjectStale).Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+		})
+
+		It("should mark projects without recent activity as stale since now", func() {
+			UpdateStaleness(status, cfg, now)
+
+			Expect(status.StaleSinceTimestamp).To(Equal(&metav1.Time{Time: now}))
+			Expect(status.StaleAutoDeleteTimestamp).To(Equal(&metav1.Time{Time: now.Add(30 * day)}))
+			Expect(gardencorev1alpha1helper.GetCondition(status.Conditions, gardenv1beta1.ProjectStale).Status).To(Equal(gardencorev1alpha1.ConditionTrue))
+		})
+
+		It("should keep the time since when the project is stale", func() {
+			staleSince := timeAgo(10 * day)
+			status.StaleSinceTimestamp = &staleSince
+
+			UpdateStaleness(status, cfg, now)
+
+			Expect(status.StaleSinceTimestamp).To(Equal(&staleSince))
+			Expect(status.StaleAutoDeleteTimestamp).To(Equal(&metav1.Time{Time: now.Add(20 * day)}))
+		})
+
+		It("should not compute an auto deletion time if auto deletion is disabled", func() {
+			cfg.StaleExpirationDays = nil
+
+			UpdateStaleness(status, cfg, now)
+
+			Expect(status.StaleSinceTimestamp).To(Equal(&metav1.Time{Time: now}))
+			Expect(status.StaleAutoDeleteTimestamp).To(BeNil())
+		})
+
+		It("should reset the staleness after new activity", func() {
+			lastActivity := timeAgo(day)
+			status.StaleSinceTimestamp = &lastActivity
+			status.StaleAutoDeleteTimestamp = &lastActivity
+			status.LastActivityTimestamp = &lastActivity
+
+			UpdateStaleness(status, cfg, now)
+
+			Expect(status.StaleSinceTimestamp).To(BeNil())
+			Expect(status.StaleAutoDeleteTimestamp).To(BeNil())
+		})
+	})
+
+	Describe("#IsStaleProjectDueForDeletion", func() {
+		var (
+			status *gardenv1beta1.ProjectStatus
+			cfg    *config.ProjectControllerConfiguration
+		)
+
+		BeforeEach(func() {
+			lastActivity := timeAgo(1000 * day)
+			status = &gardenv1beta1.ProjectStatus{LastActivityTimestamp: &lastActivity}
+			cfg = &config.ProjectControllerConfiguration{
+				StaleGracePeriodDays: intPtr(90),
+				StaleExpirationDays:  intPtr(0),
+			}
+		})
+
+		It("should never delete projects whose staleness is detected for the first time", func() {
+			UpdateStaleness(status, cfg, now)
+
+			Expect(IsStaleProjectDueForDeletion(status, now)).To(BeFalse())
+		})
+
+		It("should not delete stale projects before their auto deletion time", func() {
+			cfg.StaleExpirationDays = intPtr(30)
+			staleSince := timeAgo(29 * day)
+			status.StaleSinceTimestamp = &staleSince
+
+			UpdateStaleness(status, cfg, now)
+
+			Expect(IsStaleProjectDueForDeletion(status, now)).To(BeFalse())
+		})
+
+		It("should delete stale projects after their auto deletion time", func() {
+			cfg.StaleExpirationDays = intPtr(30)
+			staleSince := timeAgo(30 * day)
+			status.StaleSinceTimestamp = &staleSince
+
+			UpdateStaleness(status, cfg, now)
+
+			Expect(IsStaleProjectDueForDeletion(status, now)).To(BeTrue())
+		})
+
+		It("should not delete projects which are not stale", func() {
+			Expect(IsStaleProjectDueForDeletion(status, now)).To(BeFalse())
+		})
+	})
+})
@@ -15,8 +15,14 @@
 package project
 
 import (
+	"sort"
+	"strings"
+
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/operation/common"
+
+	rbacv1 "k8s.io/api/rbac/v1"
 )
 
 func setProjectPhase(phase gardenv1beta1.ProjectPhase) func(*gardenv1beta1.Project) (*gardenv1beta1.Project, error) {
@@ -38,3 +44,54 @@ func namespaceAnnotationsFromProject(project *gardenv1beta1.Project) map[string]
 		common.NamespaceProject: string(project.UID),
 	}
 }
+
+// projectRBACValues computes the values for the project-rbac chart. The subjects are grouped by their project roles,
+// and all subjects which are no admins but have any other role get read access to the project resource.
+func projectRBACValues(project *gardenv1beta1.Project) map[string]interface{} {
+	var (
+		subjectsByRole = helper.GetProjectMemberSubjectsByRole(project)
+		readers        []rbacv1.Subject
+		knownReaders   = map[rbacv1.Subject]struct{}{}
+		extensionRoles []map[string]interface{}
+	)
+
+	roles := make([]string, 0, len(subjectsByRole))
+	for role := range subjectsByRole {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+
+	for _, role := range roles {
+		subjects := subjectsByRole[role]
+
+		if role != gardenv1beta1.ProjectMemberAdmin {
+			for _, subject := range subjects {
+				if _, ok := knownReaders[subject]; !ok {
+					knownReaders[subject] = struct{}{}
+					readers = append(readers, subject)
+				}
+			}
+		}
+
+		if strings.HasPrefix(role, gardenv1beta1.ProjectMemberExtensionPrefix) {
+			extensionRoles = append(extensionRoles, map[string]interface{}{
+				"name":     strings.TrimPrefix(role, gardenv1beta1.ProjectMemberExtensionPrefix),
+				"subjects": subjects,
+			})
+		}
+	}
+
+	return map[string]interface{}{
+		"project": map[string]interface{}{
+			"name":           project.Name,
+			"uid":            project.UID,
+			"owner":          project.Spec.Owner,
+			"members":        subjectsByRole[gardenv1beta1.ProjectMemberAdmin],
+			"viewers":        subjectsByRole[gardenv1beta1.ProjectMemberViewer],
+			"shootOperators": subjectsByRole[gardenv1beta1.ProjectMemberShootOperator],
+			"secretManagers": subjectsByRole[gardenv1beta1.ProjectMemberSecretManager],
+			"extensionRoles": extensionRoles,
+			"readers":        readers,
+		},
+	}
+}
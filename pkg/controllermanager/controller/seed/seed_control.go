@@ -91,6 +91,16 @@ func (c *Controller) reconcileSeedKey(key string) error {
 		return err
 	}
 
+	responsible, err := controllerutils.ResponsibleForSeed(c.config, c.seedLister, seed.Name)
+	if err != nil {
+		return err
+	}
+	if !responsible {
+		logger.Logger.Debugf("[SEED RECONCILE] %s - skipping because Seed is reconciled by another Gardener component", key)
+		c.seedQueue.AddAfter(key, c.config.Controllers.Seed.SyncPeriod.Duration)
+		return nil
+	}
+
 	if err := c.control.ReconcileSeed(seed, key); err != nil {
 		c.seedQueue.AddAfter(key, 15*time.Second)
 	} else {
@@ -24,6 +24,7 @@ import (
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions/core/v1alpha1"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation/common"
 
@@ -86,6 +87,14 @@ func (c *Controller) reconcileControllerInstallationKey(key string) error {
 		return err
 	}
 
+	responsible, err := controllerutils.ResponsibleForSeed(c.config, c.seedLister, controllerInstallation.Spec.SeedRef.Name)
+	if err != nil {
+		return err
+	}
+	if !responsible {
+		return nil
+	}
+
 	shootsRequiringEnqueueing, err := c.controllerInstallationControl.Reconcile(controllerInstallation)
 	if err != nil {
 		return err
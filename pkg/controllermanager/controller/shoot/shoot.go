@@ -17,6 +17,7 @@ package shoot
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"sync"
 	"time"
 
@@ -30,6 +31,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	gardenmetrics "github.com/gardener/gardener/pkg/controllermanager/metrics"
+	"github.com/gardener/gardener/pkg/controllermanager/sharding"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 
@@ -61,6 +63,7 @@ type Controller struct {
 	secrets                       map[string]*corev1.Secret
 	imageVector                   imagevector.ImageVector
 	hibernationScheduleRegistry   HibernationScheduleRegistry
+	shard                         sharding.Interface
 
 	seedLister                   gardenlisters.SeedLister
 	shootLister                  gardenlisters.ShootLister
@@ -95,8 +98,9 @@ type Controller struct {
 
 // NewShootController takes a Kubernetes client for the Garden clusters <k8sGardenClient>, a struct
 // holding information about the acting Gardener, a <shootInformer>, and a <recorder> for
-// event recording. It creates a new Gardener controller.
-func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory, kubeInformerFactory kubeinformers.SharedInformerFactory, config *config.ControllerManagerConfiguration, identity *gardenv1beta1.Gardener, gardenNamespace string, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, recorder record.EventRecorder) *Controller {
+// event recording. If a <shard> is given, only the Shoots the running replica is responsible for
+// are reconciled. It creates a new Gardener controller.
+func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory, kubeInformerFactory kubeinformers.SharedInformerFactory, config *config.ControllerManagerConfiguration, identity *gardenv1beta1.Gardener, gardenNamespace string, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, recorder record.EventRecorder, shard sharding.Interface) *Controller {
 	var (
 		gardenV1beta1Informer      = k8sGardenInformers.Garden().V1beta1()
 		gardenCoreV1alpha1Informer = k8sGardenCoreInformers.Core().V1alpha1()
@@ -114,9 +118,6 @@ func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenInformers
 		namespaceInformer = corev1Informer.Namespaces()
 		namespaceLister   = namespaceInformer.Lister()
 
-		configMapInformer = corev1Informer.ConfigMaps()
-		configMapLister   = configMapInformer.Lister()
-
 		controllerInstallationInformer = gardenCoreV1alpha1Informer.ControllerInstallations()
 		controllerInstallationLister   = controllerInstallationInformer.Lister()
 	)
@@ -136,12 +137,12 @@ func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenInformers
 		secrets:                       secrets,
 		imageVector:                   imageVector,
 		hibernationScheduleRegistry:   NewHibernationScheduleRegistry(),
+		shard:                         shard,
 
 		seedLister:                   seedLister,
 		shootLister:                  shootLister,
 		projectLister:                projectLister,
 		namespaceLister:              namespaceLister,
-		configMapLister:              configMapLister,
 		controllerInstallationLister: controllerInstallationLister,
 
 		seedQueue:                   workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "seed"),
@@ -167,33 +168,49 @@ func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenInformers
 		AddFunc: shootController.shootCareAdd,
 	})
 
-	shootInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc:    shootController.shootMaintenanceAdd,
-		UpdateFunc: shootController.shootMaintenanceUpdate,
-		DeleteFunc: shootController.shootMaintenanceDelete,
-	})
-
-	shootInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc:    shootController.shootQuotaAdd,
-		DeleteFunc: shootController.shootQuotaDelete,
-	})
-
-	shootInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc:    shootController.shootHibernationAdd,
-		UpdateFunc: shootController.shootHibernationUpdate,
-		DeleteFunc: shootController.shootHibernationDelete,
-	})
+	// Maintenance, quota and hibernation are garden-level tasks which are always executed by the Gardener controller
+	// manager, even for Shoots whose Seed is managed by a gardener-seed-agent.
+	if !controllerutils.IsSeedAgent(config) {
+		shootInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    shootController.shootMaintenanceAdd,
+			UpdateFunc: shootController.shootMaintenanceUpdate,
+			DeleteFunc: shootController.shootMaintenanceDelete,
+		})
+
+		shootInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    shootController.shootQuotaAdd,
+			DeleteFunc: shootController.shootQuotaDelete,
+		})
+
+		shootInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    shootController.shootHibernationAdd,
+			UpdateFunc: shootController.shootHibernationUpdate,
+			DeleteFunc: shootController.shootHibernationDelete,
+		})
+	}
 
-	configMapInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc:    shootController.configMapAdd,
-		UpdateFunc: shootController.configMapUpdate,
-	})
+	// The audit policy ConfigMaps are watched by the Gardener controller manager only, the seed authorizer does not allow
+	// gardener-seed-agents to watch all ConfigMaps.
+	shootController.configMapSynced = func() bool { return true }
+	if !controllerutils.IsSeedAgent(config) {
+		configMapInformer := corev1Informer.ConfigMaps()
+		shootController.configMapLister = configMapInformer.Lister()
+		configMapInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    shootController.configMapAdd,
+			UpdateFunc: shootController.configMapUpdate,
+		})
+		shootController.configMapSynced = configMapInformer.Informer().HasSynced
+	}
 
 	controllerInstallationInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    shootController.controllerInstallationAdd,
 		UpdateFunc: shootController.controllerInstallationUpdate,
 	})
 
+	if shard != nil {
+		shard.AddRebalanceHandler(shootController.rebalance)
+	}
+
 	shootController.seedSynced = seedInformer.Informer().HasSynced
 	shootController.shootSynced = shootInformer.Informer().HasSynced
 	shootController.cloudProfileSynced = gardenV1beta1Informer.CloudProfiles().Informer().HasSynced
@@ -201,7 +218,6 @@ func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenInformers
 	shootController.quotaSynced = gardenV1beta1Informer.Quotas().Informer().HasSynced
 	shootController.projectSynced = projectInformer.Informer().HasSynced
 	shootController.namespaceSynced = namespaceInformer.Informer().HasSynced
-	shootController.configMapSynced = configMapInformer.Informer().HasSynced
 	shootController.controllerInstallationSynced = controllerInstallationInformer.Informer().HasSynced
 
 	return shootController
@@ -234,6 +250,13 @@ func (c *Controller) Run(ctx context.Context, shootWorkers, shootCareWorkers, sh
 		return
 	}
 	for _, shoot := range shoots {
+		if responsible, err := controllerutils.ResponsibleForShoot(c.config, c.seedLister, shoot); err != nil || !responsible {
+			continue
+		}
+		if key, err := cache.MetaNamespaceKeyFunc(shoot); err != nil || !c.responsibleForShard(key) || c.processedByOtherShardMember(shoot) {
+			continue
+		}
+
 		newShoot := shoot.DeepCopy()
 
 		// Check if the status indicates that an operation is processing and mark it as "aborted".
@@ -318,6 +341,30 @@ func (c *Controller) CollectMetrics(ch chan<- prometheus.Metric) {
 		return
 	}
 	ch <- metric
+
+	shoots, err := c.shootLister.List(labels.Everything())
+	if err != nil {
+		gardenmetrics.ScrapeFailures.With(prometheus.Labels{"kind": "shoot-controller"}).Inc()
+		return
+	}
+	now := time.Now()
+	for _, shoot := range shoots {
+		// The availability in the status is only updated together with the conditions, hence, it is recomputed
+		// for the current point in time.
+		for _, availability := range shoot.Status.Availability {
+			availability = ComputeAvailability(shoot.Status.ConditionHistory, []gardencorev1alpha1.ConditionType{availability.Type}, now, availability.Window.Duration)[0]
+			percentage, err := strconv.ParseFloat(availability.Percentage, 64)
+			if err != nil {
+				continue
+			}
+			metric, err := prometheus.NewConstMetric(gardenmetrics.ShootConditionAvailability, prometheus.GaugeValue, percentage, shoot.Name, shoot.Namespace, string(availability.Type), availability.Window.Duration.String())
+			if err != nil {
+				gardenmetrics.ScrapeFailures.With(prometheus.Labels{"kind": "shoot-controller"}).Inc()
+				continue
+			}
+			ch <- metric
+		}
+	}
 }
 
 func (c *Controller) getShootQueue(obj interface{}) workqueue.RateLimitingInterface {
@@ -0,0 +1,153 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RecordConditionTransitions records a transition for every given condition whose status differs from the latest
+// recorded status of its type. Transitions which happened before the availability window are dropped, except for
+// the latest transition per type before the window (it determines the status at the start of the window). If the
+// history exceeds <limit> transitions then the oldest ones are dropped.
+func RecordConditionTransitions(history []gardenv1beta1.ConditionTransition, conditions []gardencorev1alpha1.Condition, now time.Time, window time.Duration, limit int) []gardenv1beta1.ConditionTransition {
+	latest := make(map[gardencorev1alpha1.ConditionType]gardenv1beta1.ConditionTransition, len(conditions))
+	for _, transition := range history {
+		latest[transition.Type] = transition
+	}
+
+	out := append([]gardenv1beta1.ConditionTransition{}, history...)
+	for _, condition := range conditions {
+		if transition, ok := latest[condition.Type]; ok && transition.Status == condition.Status {
+			continue
+		}
+
+		transitionTime := condition.LastTransitionTime
+		if transitionTime.IsZero() || transitionTime.Time.After(now) {
+			transitionTime = metav1.NewTime(now)
+		}
+		if transition, ok := latest[condition.Type]; ok && transitionTime.Before(&transition.Time) {
+			transitionTime = transition.Time
+		}
+
+		out = append(out, gardenv1beta1.ConditionTransition{
+			Type:   condition.Type,
+			Status: condition.Status,
+			Reason: condition.Reason,
+			Time:   transitionTime,
+		})
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Time.Before(&out[j].Time) })
+
+	var (
+		windowStart = now.Add(-window)
+		pruned      = make([]gardenv1beta1.ConditionTransition, 0, len(out))
+	)
+	for i, transition := range out {
+		if transition.Time.Time.Before(windowStart) && hasTransitionBefore(out[i+1:], transition.Type, windowStart) {
+			continue
+		}
+		pruned = append(pruned, transition)
+	}
+
+	if limit > 0 && len(pruned) > limit {
+		pruned = pruned[len(pruned)-limit:]
+	}
+	return pruned
+}
+
+// hasTransitionBefore checks whether the given transitions contain a transition of the given type before <t>.
+func hasTransitionBefore(transitions []gardenv1beta1.ConditionTransition, conditionType gardencorev1alpha1.ConditionType, t time.Time) bool {
+	for _, transition := range transitions {
+		if transition.Type == conditionType && !transition.Time.Time.After(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// ComputeAvailability computes the availability of the given condition types within the window ending at <now>
+// based on the given chronologically ordered history. A condition is unavailable while its status is False or
+// Unknown. The availability is only computed for the observed period, i.e. the time before the first recorded
+// transition of a type is not taken into account.
+func ComputeAvailability(history []gardenv1beta1.ConditionTransition, conditionTypes []gardencorev1alpha1.ConditionType, now time.Time, window time.Duration) []gardenv1beta1.ConditionAvailability {
+	var (
+		windowStart  = now.Add(-window)
+		availability = make([]gardenv1beta1.ConditionAvailability, 0, len(conditionTypes))
+	)
+
+	for _, conditionType := range conditionTypes {
+		var (
+			downtime      time.Duration
+			observedStart *time.Time
+			current       *gardenv1beta1.ConditionTransition
+		)
+
+		accumulate := func(until time.Time) {
+			if current == nil {
+				return
+			}
+			from := current.Time.Time
+			if from.Before(windowStart) {
+				from = windowStart
+			}
+			if observedStart == nil {
+				observedStart = &from
+			}
+			if isUnavailable(current.Status) && until.After(from) {
+				downtime += until.Sub(from)
+			}
+		}
+
+		for i := range history {
+			transition := history[i]
+			if transition.Type != conditionType {
+				continue
+			}
+			if transition.Time.Time.After(windowStart) {
+				accumulate(transition.Time.Time)
+			}
+			current = &transition
+		}
+		accumulate(now)
+
+		percentage := 100.0
+		if observedStart != nil {
+			if observed := now.Sub(*observedStart); observed > 0 {
+				percentage = 100 * (1 - float64(downtime)/float64(observed))
+			}
+		}
+
+		availability = append(availability, gardenv1beta1.ConditionAvailability{
+			Type:       conditionType,
+			Window:     metav1.Duration{Duration: window},
+			Downtime:   metav1.Duration{Duration: downtime},
+			Percentage: strconv.FormatFloat(percentage, 'f', 3, 64),
+		})
+	}
+
+	return availability
+}
+
+func isUnavailable(status gardencorev1alpha1.ConditionStatus) bool {
+	return status == gardencorev1alpha1.ConditionFalse || status == gardencorev1alpha1.ConditionUnknown
+}
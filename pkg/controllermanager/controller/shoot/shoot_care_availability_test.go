@@ -0,0 +1,154 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot_test
+
+import (
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Shoot Care Availability", func() {
+	var (
+		now    = time.Date(2019, 6, 30, 0, 0, 0, 0, time.UTC)
+		window = 10 * time.Hour
+
+		apiServerAvailable = gardenv1beta1.ShootAPIServerAvailable
+		everyNodeReady     = gardenv1beta1.ShootEveryNodeReady
+
+		transition = func(conditionType gardencorev1alpha1.ConditionType, status gardencorev1alpha1.ConditionStatus, hoursAgo int) gardenv1beta1.ConditionTransition {
+			return gardenv1beta1.ConditionTransition{
+				Type:   conditionType,
+				Status: status,
+				Time:   metav1.NewTime(now.Add(-time.Duration(hoursAgo) * time.Hour)),
+			}
+		}
+	)
+
+	Describe("#RecordConditionTransitions", func() {
+		It("should record the initial status of every condition", func() {
+			conditions := []gardencorev1alpha1.Condition{
+				{Type: apiServerAvailable, Status: gardencorev1alpha1.ConditionTrue, LastTransitionTime: metav1.NewTime(now.Add(-time.Hour))},
+				{Type: everyNodeReady, Status: gardencorev1alpha1.ConditionFalse},
+			}
+
+			Expect(RecordConditionTransitions(nil, conditions, now, window, 10)).To(Equal([]gardenv1beta1.ConditionTransition{
+				transition(apiServerAvailable, gardencorev1alpha1.ConditionTrue, 1),
+				transition(everyNodeReady, gardencorev1alpha1.ConditionFalse, 0),
+			}))
+		})
+
+		It("should only record changed statuses", func() {
+			history := []gardenv1beta1.ConditionTransition{
+				transition(apiServerAvailable, gardencorev1alpha1.ConditionTrue, 3),
+				transition(everyNodeReady, gardencorev1alpha1.ConditionTrue, 2),
+			}
+			conditions := []gardencorev1alpha1.Condition{
+				{Type: apiServerAvailable, Status: gardencorev1alpha1.ConditionTrue, LastTransitionTime: metav1.NewTime(now.Add(-3 * time.Hour))},
+				{Type: everyNodeReady, Status: gardencorev1alpha1.ConditionFalse, LastTransitionTime: metav1.NewTime(now.Add(-time.Hour))},
+			}
+
+			Expect(RecordConditionTransitions(history, conditions, now, window, 10)).To(Equal([]gardenv1beta1.ConditionTransition{
+				transition(apiServerAvailable, gardencorev1alpha1.ConditionTrue, 3),
+				transition(everyNodeReady, gardencorev1alpha1.ConditionTrue, 2),
+				transition(everyNodeReady, gardencorev1alpha1.ConditionFalse, 1),
+			}))
+		})
+
+		It("should drop transitions outside of the window but keep the status at the window start", func() {
+			history := []gardenv1beta1.ConditionTransition{
+				transition(apiServerAvailable, gardencorev1alpha1.ConditionTrue, 30),
+				transition(apiServerAvailable, gardencorev1alpha1.ConditionFalse, 20),
+				transition(apiServerAvailable, gardencorev1alpha1.ConditionTrue, 5),
+			}
+			conditions := []gardencorev1alpha1.Condition{
+				{Type: apiServerAvailable, Status: gardencorev1alpha1.ConditionTrue},
+			}
+
+			Expect(RecordConditionTransitions(history, conditions, now, window, 10)).To(Equal([]gardenv1beta1.ConditionTransition{
+				transition(apiServerAvailable, gardencorev1alpha1.ConditionFalse, 20),
+				transition(apiServerAvailable, gardencorev1alpha1.ConditionTrue, 5),
+			}))
+		})
+
+		It("should respect the limit", func() {
+			history := []gardenv1beta1.ConditionTransition{
+				transition(apiServerAvailable, gardencorev1alpha1.ConditionTrue, 3),
+				transition(apiServerAvailable, gardencorev1alpha1.ConditionFalse, 2),
+			}
+			conditions := []gardencorev1alpha1.Condition{
+				{Type: apiServerAvailable, Status: gardencorev1alpha1.ConditionTrue, LastTransitionTime: metav1.NewTime(now.Add(-time.Hour))},
+			}
+
+			Expect(RecordConditionTransitions(history, conditions, now, window, 2)).To(Equal([]gardenv1beta1.ConditionTransition{
+				transition(apiServerAvailable, gardencorev1alpha1.ConditionFalse, 2),
+				transition(apiServerAvailable, gardencorev1alpha1.ConditionTrue, 1),
+			}))
+		})
+	})
+
+	Describe("#ComputeAvailability", func() {
+		It("should compute the availability within the window", func() {
+			history := []gardenv1beta1.ConditionTransition{
+				transition(apiServerAvailable, gardencorev1alpha1.ConditionFalse, 12),
+				transition(apiServerAvailable, gardencorev1alpha1.ConditionTrue, 9),
+				transition(everyNodeReady, gardencorev1alpha1.ConditionTrue, 4),
+				transition(apiServerAvailable, gardencorev1alpha1.ConditionUnknown, 2),
+				transition(apiServerAvailable, gardencorev1alpha1.ConditionProgressing, 1),
+			}
+
+			Expect(ComputeAvailability(history, []gardencorev1alpha1.ConditionType{apiServerAvailable, everyNodeReady, gardenv1beta1.ShootControlPlaneHealthy}, now, window)).To(Equal([]gardenv1beta1.ConditionAvailability{
+				{
+					Type:       apiServerAvailable,
+					Window:     metav1.Duration{Duration: window},
+					Downtime:   metav1.Duration{Duration: 2 * time.Hour},
+					Percentage: "80.000",
+				},
+				{
+					Type:       everyNodeReady,
+					Window:     metav1.Duration{Duration: window},
+					Percentage: "100.000",
+				},
+				{
+					Type:       gardenv1beta1.ShootControlPlaneHealthy,
+					Window:     metav1.Duration{Duration: window},
+					Percentage: "100.000",
+				},
+			}))
+		})
+
+		It("should only consider the observed period", func() {
+			history := []gardenv1beta1.ConditionTransition{
+				transition(apiServerAvailable, gardencorev1alpha1.ConditionTrue, 4),
+				transition(apiServerAvailable, gardencorev1alpha1.ConditionFalse, 1),
+			}
+
+			Expect(ComputeAvailability(history, []gardencorev1alpha1.ConditionType{apiServerAvailable}, now, window)).To(Equal([]gardenv1beta1.ConditionAvailability{
+				{
+					Type:       apiServerAvailable,
+					Window:     metav1.Duration{Duration: window},
+					Downtime:   metav1.Duration{Duration: time.Hour},
+					Percentage: "75.000",
+				},
+			}))
+		})
+	})
+})
@@ -15,6 +15,7 @@
 package shoot
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"time"
@@ -25,12 +26,16 @@ import (
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation"
 	botanistpkg "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
+	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/tools/cache"
@@ -61,11 +66,24 @@ func (c *Controller) reconcileShootCareKey(key string) error {
 		return err
 	}
 
+	if !c.responsibleForShard(key) {
+		return nil
+	}
+
 	// if shoot has not been scheduled, requeue
 	if shoot.Spec.Cloud.Seed == nil {
 		return fmt.Errorf("shoot %s has not yet been scheduled on a Seed", key)
 	}
 
+	responsible, err := controllerutils.ResponsibleForShoot(c.config, c.seedLister, shoot)
+	if err != nil {
+		return err
+	}
+	if !responsible {
+		c.shootCareQueue.AddAfter(key, c.config.Controllers.ShootCare.SyncPeriod.Duration)
+		return nil
+	}
+
 	if err := c.careControl.Care(shoot, key); err != nil {
 		return err
 	}
@@ -105,6 +123,36 @@ func (c *defaultCareControl) conditionThresholdsToProgressingMapping() map[garde
 	return out
 }
 
+// healthChecks returns the additional health checks for the given Shoot. These are the globally configured
+// checks merged with the checks of the ConfigMap referenced by the Shoot (if any).
+func (c *defaultCareControl) healthChecks(shoot *gardenv1beta1.Shoot, shootLogger logrus.FieldLogger) []config.HealthCheck {
+	healthChecks := c.config.Controllers.ShootCare.HealthChecks
+
+	name, ok := shoot.Annotations[common.ShootHealthChecks]
+	if !ok {
+		return healthChecks
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := c.k8sGardenClient.Client().Get(context.TODO(), kutil.Key(shoot.Namespace, name), configMap); err != nil {
+		shootLogger.Errorf("Could not read health check configmap %s/%s, ignoring it: %+v", shoot.Namespace, name, err)
+		return healthChecks
+	}
+
+	shootHealthChecks, err := ReadHealthChecks(configMap)
+	if err != nil {
+		shootLogger.Errorf("Could not read health checks, ignoring them: %+v", err)
+		return healthChecks
+	}
+
+	mergedHealthChecks, err := MergeHealthChecks(healthChecks, shootHealthChecks)
+	if err != nil {
+		shootLogger.Errorf("Could not merge health checks of configmap %s/%s, ignoring them: %+v", shoot.Namespace, name, err)
+		return healthChecks
+	}
+	return mergedHealthChecks
+}
+
 func shootClientInitializer(b *botanistpkg.Botanist) func() error {
 	var (
 		once sync.Once
@@ -167,8 +215,21 @@ func (c *defaultCareControl) Care(shootObj *gardenv1beta1.Shoot, key string) err
 		conditionSystemComponentsHealthy,
 	)
 
+	// Trigger additional health checks
+	conditions := botanist.CustomHealthChecks(
+		initializeShootClients,
+		c.conditionThresholdsToProgressingMapping(),
+		c.healthChecks(shoot, botanist.Logger),
+		[]gardencorev1alpha1.Condition{
+			conditionAPIServerAvailable,
+			conditionControlPlaneHealthy,
+			conditionEveryNodeReady,
+			conditionSystemComponentsHealthy,
+		},
+	)
+
 	// Update Shoot status
-	shoot, err = c.updateShootConditions(shoot, conditionAPIServerAvailable, conditionControlPlaneHealthy, conditionEveryNodeReady, conditionSystemComponentsHealthy)
+	shoot, err = c.updateShootConditions(shoot, conditions...)
 	if err != nil {
 		botanist.Logger.Errorf("Could not update Shoot conditions: %+v", err)
 		return nil // We do not want to run in the exponential backoff for the condition checks.
@@ -182,10 +243,7 @@ func (c *defaultCareControl) Care(shootObj *gardenv1beta1.Shoot, key string) err
 			ComputeStatus(
 				shoot.Status.LastOperation,
 				shoot.Status.LastError,
-				conditionAPIServerAvailable,
-				conditionControlPlaneHealthy,
-				conditionEveryNodeReady,
-				conditionSystemComponentsHealthy,
+				conditions...,
 			),
 		),
 	)
@@ -193,9 +251,28 @@ func (c *defaultCareControl) Care(shootObj *gardenv1beta1.Shoot, key string) err
 }
 
 func (c *defaultCareControl) updateShootConditions(shoot *gardenv1beta1.Shoot, conditions ...gardencorev1alpha1.Condition) (*gardenv1beta1.Shoot, error) {
+	var (
+		now                   = time.Now()
+		conditionHistoryLimit = v1alpha1.DefaultShootConditionHistoryLimit
+		availabilityWindow    = v1alpha1.DefaultShootAvailabilityWindow
+		conditionTypes        = make([]gardencorev1alpha1.ConditionType, 0, len(conditions))
+	)
+
+	if limit := c.config.Controllers.ShootCare.ConditionHistoryLimit; limit != nil {
+		conditionHistoryLimit = *limit
+	}
+	if window := c.config.Controllers.ShootCare.AvailabilityWindow; window != nil {
+		availabilityWindow = window.Duration
+	}
+	for _, condition := range conditions {
+		conditionTypes = append(conditionTypes, condition.Type)
+	}
+
 	newShoot, err := kutil.TryUpdateShootConditions(c.k8sGardenClient.Garden(), retry.DefaultBackoff, shoot.ObjectMeta,
 		func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
 			shoot.Status.Conditions = conditions
+			shoot.Status.ConditionHistory = RecordConditionTransitions(shoot.Status.ConditionHistory, conditions, now, availabilityWindow, conditionHistoryLimit)
+			shoot.Status.Availability = ComputeAvailability(shoot.Status.ConditionHistory, conditionTypes, now, availabilityWindow)
 			return shoot, nil
 		})
 
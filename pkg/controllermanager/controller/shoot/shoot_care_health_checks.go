@@ -0,0 +1,86 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config/validation"
+
+	"github.com/ghodss/yaml"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// HealthChecksConfigMapDataKey is the key in the data of a ConfigMap referenced by a Shoot which contains
+// additional health checks.
+const HealthChecksConfigMapDataKey = "healthChecks"
+
+// ReadHealthChecks reads and validates the health checks stored in the given ConfigMap. The checks are
+// expected in the same format as in the component configuration of the Gardener controller manager. As the
+// ConfigMap is supplied by the Shoot owner, workload checks in the Seed cluster are restricted to the namespace
+// of the Shoot.
+func ReadHealthChecks(configMap *corev1.ConfigMap) ([]config.HealthCheck, error) {
+	data, ok := configMap.Data[HealthChecksConfigMapDataKey]
+	if !ok {
+		return nil, fmt.Errorf("missing '.data.%s' in health check configmap %s/%s", HealthChecksConfigMapDataKey, configMap.Namespace, configMap.Name)
+	}
+
+	var versionedHealthChecks []v1alpha1.HealthCheck
+	if err := yaml.Unmarshal([]byte(data), &versionedHealthChecks); err != nil {
+		return nil, fmt.Errorf("failed to decode health checks of configmap %s/%s: %v", configMap.Namespace, configMap.Name, err)
+	}
+
+	healthChecks := make([]config.HealthCheck, 0, len(versionedHealthChecks))
+	for _, versionedHealthCheck := range versionedHealthChecks {
+		var healthCheck config.HealthCheck
+		if err := v1alpha1.Convert_v1alpha1_HealthCheck_To_config_HealthCheck(&versionedHealthCheck, &healthCheck, nil); err != nil {
+			return nil, err
+		}
+		if workload := healthCheck.Workload; workload != nil && workload.Cluster == config.HealthCheckClusterSeed && workload.Namespace != nil {
+			return nil, fmt.Errorf("invalid health check in configmap %s/%s: health check %s: namespace must not be set for workloads in the seed cluster", configMap.Namespace, configMap.Name, healthCheck.Name)
+		}
+		healthChecks = append(healthChecks, healthCheck)
+	}
+
+	if err := validation.ValidateHealthChecks(healthChecks); err != nil {
+		return nil, fmt.Errorf("invalid health check in configmap %s/%s: %v", configMap.Namespace, configMap.Name, err)
+	}
+	return healthChecks, nil
+}
+
+// MergeHealthChecks appends the <shootHealthChecks> to the <healthChecks> configured by the operator. The checks of the
+// operator cannot be overwritten, hence, an error is returned if one of the <shootHealthChecks> has the same name.
+func MergeHealthChecks(healthChecks, shootHealthChecks []config.HealthCheck) ([]config.HealthCheck, error) {
+	var (
+		out   = make([]config.HealthCheck, 0, len(healthChecks)+len(shootHealthChecks))
+		names = sets.NewString()
+	)
+
+	for _, healthCheck := range healthChecks {
+		names.Insert(healthCheck.Name)
+		out = append(out, healthCheck)
+	}
+	for _, healthCheck := range shootHealthChecks {
+		if names.Has(healthCheck.Name) {
+			return nil, fmt.Errorf("health check %s is configured globally and cannot be overwritten", healthCheck.Name)
+		}
+		out = append(out, healthCheck)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot_test
+
+import (
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("Shoot Care Health Checks", func() {
+	var (
+		workloadCheck = config.HealthCheck{
+			Name:      "foo",
+			Condition: "ControlPlaneHealthy",
+			Workload: &config.WorkloadHealthCheck{
+				Cluster: "seed",
+				Kind:    "Deployment",
+				Name:    "foo",
+			},
+		}
+		endpointCheck = config.HealthCheck{
+			Name:      "bar",
+			Condition: "BarHealthy",
+			Endpoint:  &config.EndpointHealthCheck{URL: "https://bar.example.com/healthz"},
+		}
+		promQLCheck = config.HealthCheck{
+			Name:      "baz",
+			Condition: "BazHealthy",
+			PromQL:    &config.PromQLHealthCheck{Query: "up == 0"},
+		}
+	)
+
+	Describe("#ReadHealthChecks", func() {
+		It("should read the health checks", func() {
+			configMap := &corev1.ConfigMap{
+				Data: map[string]string{
+					HealthChecksConfigMapDataKey: `
+- name: foo
+  condition: ControlPlaneHealthy
+  workload:
+    cluster: seed
+    kind: Deployment
+    name: foo
+- name: baz
+  condition: BazHealthy
+  promQL:
+    query: up == 0
+`,
+				},
+			}
+
+			healthChecks, err := ReadHealthChecks(configMap)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(healthChecks).To(Equal([]config.HealthCheck{workloadCheck, promQLCheck}))
+		})
+
+		It("should fail if the data key is missing", func() {
+			_, err := ReadHealthChecks(&corev1.ConfigMap{})
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail if a seed workload check sets a namespace", func() {
+			configMap := &corev1.ConfigMap{
+				Data: map[string]string{
+					HealthChecksConfigMapDataKey: `
+- name: foo
+  condition: ControlPlaneHealthy
+  workload:
+    cluster: seed
+    kind: Deployment
+    namespace: garden
+    name: gardener-controller-manager
+`,
+				},
+			}
+
+			_, err := ReadHealthChecks(configMap)
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail if health check names are not unique", func() {
+			configMap := &corev1.ConfigMap{
+				Data: map[string]string{
+					HealthChecksConfigMapDataKey: `
+- name: foo
+  condition: FooHealthy
+  promQL:
+    query: up == 0
+- name: foo
+  condition: BarHealthy
+  promQL:
+    query: up == 0
+`,
+				},
+			}
+
+			_, err := ReadHealthChecks(configMap)
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail if a health check is invalid", func() {
+			configMap := &corev1.ConfigMap{
+				Data: map[string]string{
+					HealthChecksConfigMapDataKey: `
+- name: foo
+  condition: Foo
+`,
+				},
+			}
+
+			_, err := ReadHealthChecks(configMap)
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#MergeHealthChecks", func() {
+		It("should append the checks of the shoot", func() {
+			Expect(MergeHealthChecks(
+				[]config.HealthCheck{workloadCheck, endpointCheck},
+				[]config.HealthCheck{promQLCheck},
+			)).To(Equal([]config.HealthCheck{workloadCheck, endpointCheck, promQLCheck}))
+		})
+
+		It("should refuse to overwrite globally configured checks", func() {
+			overwrittenCheck := workloadCheck
+			overwrittenCheck.Condition = "FooHealthy"
+
+			_, err := MergeHealthChecks(
+				[]config.HealthCheck{workloadCheck, endpointCheck},
+				[]config.HealthCheck{promQLCheck, overwrittenCheck},
+			)
+
+			Expect(err).To(MatchError(ContainSubstring(workloadCheck.Name)))
+		})
+	})
+})
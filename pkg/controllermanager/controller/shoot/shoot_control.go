@@ -127,6 +127,24 @@ func (c *Controller) reconcileShootRequest(req reconcile.Request) (reconcile.Res
 		return reconcile.Result{}, err
 	}
 
+	if !c.responsibleForShard(req.String()) {
+		log.Debug("Skipping because Shoot is reconciled by another replica")
+		return reconcile.Result{}, nil
+	}
+	if c.processedByOtherShardMember(shoot) {
+		log.Infof("Waiting for replica %s to finish the last operation", shoot.Status.Gardener.ID)
+		return reconcile.Result{RequeueAfter: time.Minute}, nil
+	}
+
+	responsible, err := utils.ResponsibleForShoot(c.config, c.seedLister, shoot)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if !responsible {
+		log.Debug("Skipping because Shoot is reconciled by another Gardener component responsible for its Seed")
+		return reconcile.Result{RequeueAfter: c.config.Controllers.Shoot.SyncPeriod.Duration}, nil
+	}
+
 	o, err := operation.New(shoot, log, c.k8sGardenClient, c.k8sGardenInformers.Garden().V1beta1(), c.identity, c.secrets, c.imageVector, c.config.ShootBackup)
 	if err != nil {
 		return reconcile.Result{}, err
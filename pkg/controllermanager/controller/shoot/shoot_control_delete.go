@@ -310,6 +310,11 @@ func (c *Controller) runDeleteShootFlow(o *operation.Operation) *gardencorev1alp
 			Fn:           botanist.WaitUntilInfrastructureDeleted,
 			Dependencies: flow.NewTaskIDs(destroyInfrastructure),
 		})
+		destroyAdditionalDNSProviders = g.Add(flow.Task{
+			Name:         "Destroying additional DNS providers",
+			Fn:           flow.TaskFn(botanist.DestroyAdditionalDNSProviders).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(syncPointCleaned),
+		})
 		destroyExternalDomainDNSRecord = g.Add(flow.Task{
 			Name:         "Destroying external domain DNS record",
 			Fn:           botanist.DestroyExternalDomainDNSRecord,
@@ -323,6 +328,7 @@ func (c *Controller) runDeleteShootFlow(o *operation.Operation) *gardencorev1alp
 			destroyNginxIngressResources,
 			destroyKube2IAMResources,
 			destroyExternalDomainDNSRecord,
+			destroyAdditionalDNSProviders,
 			waitUntilInfrastructureDeleted,
 		)
 
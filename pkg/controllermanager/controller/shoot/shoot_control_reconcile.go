@@ -80,6 +80,7 @@ func (c *Controller) runReconcileShootFlow(o *operation.Operation, operationType
 		managedExternalDNS        = o.Shoot.ExternalDomain != nil && o.Shoot.ExternalDomain.Provider != gardenv1beta1.DNSUnmanaged
 		managedInternalDNS        = o.Garden.InternalDomain != nil && o.Garden.InternalDomain.Provider != gardenv1beta1.DNSUnmanaged
 		creationPhase             = operationType == gardencorev1alpha1.LastOperationTypeCreate
+		cloneFromSource           = creationPhase && len(o.Shoot.Info.Annotations[common.ShootCloneSource]) > 0
 		requireKube2IAMDeployment = o.Shoot.CloudProvider == gardenv1beta1.CloudProviderAWS && (creationPhase || controllerutils.HasTask(o.Shoot.Info.Annotations, common.ShootTaskDeployKube2IAMResource))
 
 		g                         = flow.NewGraph("Shoot cluster reconciliation")
@@ -146,10 +147,15 @@ func (c *Controller) runReconcileShootFlow(o *operation.Operation, operationType
 			Fn:           flow.TaskFn(botanist.WaitUntilBackupInfrastructureReconciled),
 			Dependencies: flow.NewTaskIDs(deployBackupInfrastructure),
 		})
+		restoreETCDFromCloneSource = g.Add(flow.Task{
+			Name:         "Restoring main etcd from the backup of the clone source",
+			Fn:           flow.TaskFn(hybridBotanist.RestoreETCDFromCloneSource).DoIf(cloneFromSource),
+			Dependencies: flow.NewTaskIDs(deployNamespace),
+		})
 		deployETCD = g.Add(flow.Task{
 			Name:         "Deploying main and events etcd",
 			Fn:           flow.SimpleTaskFn(hybridBotanist.DeployETCD).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			Dependencies: flow.NewTaskIDs(deploySecrets, deployCloudProviderSecret, waitUntilBackupInfrastructureReconciled),
+			Dependencies: flow.NewTaskIDs(deploySecrets, deployCloudProviderSecret, waitUntilBackupInfrastructureReconciled, restoreETCDFromCloneSource),
 		})
 		waitUntilEtcdReady = g.Add(flow.Task{
 			Name:         "Waiting until main and event etcd report readiness",
@@ -166,10 +172,15 @@ func (c *Controller) runReconcileShootFlow(o *operation.Operation, operationType
 			Fn:           flow.TaskFn(botanist.WaitUntilControlPlaneReady),
 			Dependencies: flow.NewTaskIDs(deployControlPlane),
 		})
+		copyEtcdEncryptionConfigurationFromCloneSource = g.Add(flow.Task{
+			Name:         "Copying etcd encryption configuration of the clone source",
+			Fn:           flow.TaskFn(botanist.CopyEncryptionConfigurationFromCloneSource).RetryUntilTimeout(defaultInterval, defaultTimeout).DoIf(enableEtcdEncryption && cloneFromSource),
+			Dependencies: flow.NewTaskIDs(deployNamespace),
+		})
 		createOrUpdateEtcdEncryptionConfiguration = g.Add(flow.Task{
 			Name:         "Applying etcd encryption configuration",
 			Fn:           flow.TaskFn(botanist.ApplyEncryptionConfiguration).DoIf(enableEtcdEncryption),
-			Dependencies: flow.NewTaskIDs(deployNamespace),
+			Dependencies: flow.NewTaskIDs(deployNamespace, copyEtcdEncryptionConfigurationFromCloneSource),
 		})
 		deployKubeAPIServer = g.Add(flow.Task{
 			Name:         "Deploying Kubernetes API server",
@@ -226,10 +237,15 @@ func (c *Controller) runReconcileShootFlow(o *operation.Operation, operationType
 			Fn:           flow.TaskFn(hybridBotanist.DeployManagedResources).RetryUntilTimeout(defaultInterval, defaultTimeout).SkipIf(o.Shoot.IsHibernated),
 			Dependencies: flow.NewTaskIDs(deployGardenerResourceManager, computeShootOSConfig),
 		})
+		cleanupCloneSourceObjects = g.Add(flow.Task{
+			Name:         "Cleaning up objects restored from the clone source",
+			Fn:           flow.TaskFn(botanist.CleanupCloneSourceObjects).RetryUntilTimeout(defaultInterval, defaultTimeout).DoIf(cloneFromSource),
+			Dependencies: flow.NewTaskIDs(initializeShootClients),
+		})
 		deployWorker = g.Add(flow.Task{
 			Name:         "Configuring shoot worker pools",
 			Fn:           flow.TaskFn(botanist.DeployWorker).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			Dependencies: flow.NewTaskIDs(deployCloudProviderSecret, waitUntilInfrastructureReady, initializeShootClients, computeShootOSConfig),
+			Dependencies: flow.NewTaskIDs(deployCloudProviderSecret, waitUntilInfrastructureReady, initializeShootClients, computeShootOSConfig, cleanupCloneSourceObjects),
 		})
 		waitUntilWorkerReady = g.Add(flow.Task{
 			Name:         "Waiting until shoot worker nodes have been reconciled",
@@ -263,6 +279,11 @@ func (c *Controller) runReconcileShootFlow(o *operation.Operation, operationType
 			Fn:           flow.TaskFn(botanist.DeploySeedLogging).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			Dependencies: flow.NewTaskIDs(waitUntilKubeAPIServerIsReady, initializeShootClients, waitUntilVPNConnectionExists, waitUntilWorkerReady),
 		})
+		_ = g.Add(flow.Task{
+			Name:         "Deploying additional DNS providers",
+			Fn:           flow.TaskFn(botanist.DeployAdditionalDNSProviders).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(deploySecrets, initializeShootClients, deployManagedResources),
+		})
 		deployClusterAutoscaler = g.Add(flow.Task{
 			Name:         "Deploying cluster autoscaler",
 			Fn:           flow.TaskFn(botanist.DeployClusterAutoscaler).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -202,7 +202,7 @@ func (c *Controller) reconcileShootHibernationKey(key string) error {
 		return err
 	}
 
-	if shoot.DeletionTimestamp != nil {
+	if shoot.DeletionTimestamp != nil || !c.responsibleForShard(key) {
 		c.deleteShootCron(logger, key)
 		return nil
 	}
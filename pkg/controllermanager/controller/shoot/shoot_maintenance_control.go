@@ -90,6 +90,11 @@ func (c *Controller) reconcileShootMaintenanceKey(key string) error {
 		return nil
 	}
 
+	if !c.responsibleForShard(key) {
+		log.Debug("[SHOOT MAINTENANCE] - skipping because Shoot is maintained by another replica")
+		return nil
+	}
+
 	defer c.shootMaintenanceRequeue(key, shoot)
 
 	if common.ShouldIgnoreShoot(c.respectSyncPeriodOverwrite(), shoot) || !mustMaintainNow(shoot) {
@@ -49,6 +49,10 @@ func (c *Controller) reconcileShootQuotaKey(key string) error {
 		return err
 	}
 
+	if !c.responsibleForShard(key) {
+		return nil
+	}
+
 	if err := c.quotaControl.CheckQuota(shoot, key); err != nil {
 		c.shootQuotaQueue.AddAfter(key, 2*time.Minute)
 		return nil
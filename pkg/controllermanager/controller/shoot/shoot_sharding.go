@@ -0,0 +1,61 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
+	"github.com/gardener/gardener/pkg/logger"
+
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// responsibleForShard returns true if the running replica is responsible for the Shoot with the given key. Without
+// sharding, the replica is responsible for all Shoots.
+func (c *Controller) responsibleForShard(key string) bool {
+	return c.shard == nil || c.shard.IsResponsible(key)
+}
+
+// processedByOtherShardMember returns true if the last operation of the given Shoot is still being processed by
+// another replica which is alive. This happens if the Shoot has been moved to this replica due to rebalancing.
+func (c *Controller) processedByOtherShardMember(shoot *gardenv1beta1.Shoot) bool {
+	if c.shard == nil || shoot.Status.LastOperation == nil || shoot.Status.LastOperation.State != gardencorev1alpha1.LastOperationStateProcessing {
+		return false
+	}
+	return shoot.Status.Gardener.ID != c.identity.ID && c.shard.IsMember(shoot.Status.Gardener.ID)
+}
+
+// rebalance enqueues all Shoots again after the members of the shard have changed and again after the grace period of
+// the change has passed. Shoots the running replica is no longer responsible for are dropped by the workers, Shoots
+// which have been moved to it are only picked up after the grace period.
+func (c *Controller) rebalance() {
+	shoots, err := c.shootLister.List(labels.Everything())
+	if err != nil {
+		logger.Logger.Errorf("Failed to list Shoots for rebalancing: %v", err)
+		return
+	}
+
+	for _, shoot := range shoots {
+		c.shootAdd(shoot)
+		c.shootCareAdd(shoot)
+
+		if !controllerutils.IsSeedAgent(c.config) {
+			c.shootMaintenanceAdd(shoot)
+			c.shootQuotaAdd(shoot)
+			c.shootHibernationAdd(shoot)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// IsSeedAgentManaged returns true if the objects belonging to the given Seed are reconciled by a gardener-seed-agent.
+func IsSeedAgentManaged(seed *gardenv1beta1.Seed) bool {
+	return seed.Labels[common.SeedAgentManaged] == "true"
+}
+
+// IsSeedAgent returns true if the given configuration belongs to a gardener-seed-agent.
+func IsSeedAgent(cfg *config.ControllerManagerConfiguration) bool {
+	return cfg.SeedAgent != nil
+}
+
+// ResponsibleForSeed returns true if the process running with the given configuration is responsible for objects
+// belonging to the Seed with the given name. A gardener-seed-agent is only responsible for its own Seed while the
+// Gardener controller manager is responsible for all Seeds which are not managed by an agent.
+func ResponsibleForSeed(cfg *config.ControllerManagerConfiguration, seedLister gardenlisters.SeedLister, seedName string) (bool, error) {
+	if IsSeedAgent(cfg) {
+		return seedName == cfg.SeedAgent.SeedName, nil
+	}
+
+	seed, err := seedLister.Get(seedName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	return !IsSeedAgentManaged(seed), nil
+}
+
+// ResponsibleForShoot returns true if the process running with the given configuration is responsible for the given
+// Shoot. Shoots which are not yet scheduled and Shoots in the garden namespace (whose secrets must not be accessed by
+// gardener-seed-agents) are handled by the Gardener controller manager.
+func ResponsibleForShoot(cfg *config.ControllerManagerConfiguration, seedLister gardenlisters.SeedLister, shoot *gardenv1beta1.Shoot) (bool, error) {
+	if shoot.Spec.Cloud.Seed == nil || shoot.Namespace == common.GardenNamespace {
+		return !IsSeedAgent(cfg), nil
+	}
+	return ResponsibleForSeed(cfg, seedLister, *shoot.Spec.Cloud.Seed)
+}
+
+// ResponsibleForBackupInfrastructure returns true if the process running with the given configuration is responsible
+// for the given BackupInfrastructure. Like their Shoots, BackupInfrastructures in the garden namespace are handled by
+// the Gardener controller manager.
+func ResponsibleForBackupInfrastructure(cfg *config.ControllerManagerConfiguration, seedLister gardenlisters.SeedLister, backupInfrastructure *gardenv1beta1.BackupInfrastructure) (bool, error) {
+	if backupInfrastructure.Namespace == common.GardenNamespace {
+		return !IsSeedAgent(cfg), nil
+	}
+	return ResponsibleForSeed(cfg, seedLister, backupInfrastructure.Spec.Seed)
+}
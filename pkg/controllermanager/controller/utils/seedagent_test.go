@@ -0,0 +1,116 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+var _ = Describe("seedagent", func() {
+	var (
+		seedLister gardenlisters.SeedLister
+
+		controllerManagerConfig *config.ControllerManagerConfiguration
+		seedAgentConfig         *config.ControllerManagerConfiguration
+	)
+
+	BeforeEach(func() {
+		indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+		Expect(indexer.Add(&gardenv1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "central"}})).To(Succeed())
+		Expect(indexer.Add(&gardenv1beta1.Seed{ObjectMeta: metav1.ObjectMeta{
+			Name:   "agent",
+			Labels: map[string]string{common.SeedAgentManaged: "true"},
+		}})).To(Succeed())
+		seedLister = gardenlisters.NewSeedLister(indexer)
+
+		controllerManagerConfig = &config.ControllerManagerConfiguration{}
+		seedAgentConfig = &config.ControllerManagerConfiguration{SeedAgent: &config.SeedAgentConfiguration{SeedName: "agent"}}
+	})
+
+	Describe("#ResponsibleForSeed", func() {
+		It("should make the controller manager responsible for seeds without an agent", func() {
+			Expect(ResponsibleForSeed(controllerManagerConfig, seedLister, "central")).To(BeTrue())
+			Expect(ResponsibleForSeed(controllerManagerConfig, seedLister, "agent")).To(BeFalse())
+		})
+
+		It("should make the controller manager responsible for unknown seeds", func() {
+			Expect(ResponsibleForSeed(controllerManagerConfig, seedLister, "unknown")).To(BeTrue())
+		})
+
+		It("should make the seed agent responsible for its own seed only", func() {
+			Expect(ResponsibleForSeed(seedAgentConfig, seedLister, "agent")).To(BeTrue())
+			Expect(ResponsibleForSeed(seedAgentConfig, seedLister, "central")).To(BeFalse())
+			Expect(ResponsibleForSeed(seedAgentConfig, seedLister, "unknown")).To(BeFalse())
+		})
+	})
+
+	Describe("#ResponsibleForShoot", func() {
+		It("should make the controller manager responsible for unscheduled shoots", func() {
+			shoot := &gardenv1beta1.Shoot{}
+
+			Expect(ResponsibleForShoot(controllerManagerConfig, seedLister, shoot)).To(BeTrue())
+			Expect(ResponsibleForShoot(seedAgentConfig, seedLister, shoot)).To(BeFalse())
+		})
+
+		It("should make the seed agent responsible for shoots on its seed", func() {
+			seedName := "agent"
+			shoot := &gardenv1beta1.Shoot{Spec: gardenv1beta1.ShootSpec{Cloud: gardenv1beta1.Cloud{Seed: &seedName}}}
+
+			Expect(ResponsibleForShoot(controllerManagerConfig, seedLister, shoot)).To(BeFalse())
+			Expect(ResponsibleForShoot(seedAgentConfig, seedLister, shoot)).To(BeTrue())
+		})
+
+		It("should make the controller manager responsible for shoots in the garden namespace", func() {
+			seedName := "agent"
+			shoot := &gardenv1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Namespace: common.GardenNamespace},
+				Spec:       gardenv1beta1.ShootSpec{Cloud: gardenv1beta1.Cloud{Seed: &seedName}},
+			}
+
+			Expect(ResponsibleForShoot(controllerManagerConfig, seedLister, shoot)).To(BeTrue())
+			Expect(ResponsibleForShoot(seedAgentConfig, seedLister, shoot)).To(BeFalse())
+		})
+	})
+
+	Describe("#ResponsibleForBackupInfrastructure", func() {
+		It("should make the seed agent responsible for backup infrastructures on its seed", func() {
+			backupInfrastructure := &gardenv1beta1.BackupInfrastructure{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "garden-foo"},
+				Spec:       gardenv1beta1.BackupInfrastructureSpec{Seed: "agent"},
+			}
+
+			Expect(ResponsibleForBackupInfrastructure(controllerManagerConfig, seedLister, backupInfrastructure)).To(BeFalse())
+			Expect(ResponsibleForBackupInfrastructure(seedAgentConfig, seedLister, backupInfrastructure)).To(BeTrue())
+		})
+
+		It("should make the controller manager responsible for backup infrastructures in the garden namespace", func() {
+			backupInfrastructure := &gardenv1beta1.BackupInfrastructure{
+				ObjectMeta: metav1.ObjectMeta{Namespace: common.GardenNamespace},
+				Spec:       gardenv1beta1.BackupInfrastructureSpec{Seed: "agent"},
+			}
+
+			Expect(ResponsibleForBackupInfrastructure(controllerManagerConfig, seedLister, backupInfrastructure)).To(BeTrue())
+			Expect(ResponsibleForBackupInfrastructure(seedAgentConfig, seedLister, backupInfrastructure)).To(BeFalse())
+		})
+	})
+})
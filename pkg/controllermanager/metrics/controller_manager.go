@@ -25,6 +25,25 @@ var (
 	// ControllerWorkerSum is a metric descriptor which collects the current amount of workers per controller.
 	ControllerWorkerSum = prometheus.NewDesc("garden_cm_worker_amount", "Count of currently running controller workers", []string{"controller"}, nil)
 
+	// ShootConditionAvailability is a metric descriptor which collects the availability of the Shoot conditions in percent.
+	ShootConditionAvailability = prometheus.NewDesc("garden_shoot_condition_availability_percent", "Availability of a Shoot condition within the availability window", []string{"name", "namespace", "condition", "window"}, nil)
+
+	// BackupInfrastructureVerificationSuccess is a metric descriptor which collects whether the last verification of
+	// the backups of a BackupInfrastructure has been successful.
+	BackupInfrastructureVerificationSuccess = prometheus.NewDesc("garden_backupinfrastructure_verification_success", "Whether the last verification of the backups was successful (1) or not (0)", []string{"name", "namespace", "seed"}, nil)
+
+	// BackupInfrastructureVerificationTimestamp is a metric descriptor which collects the time of the last verification
+	// of the backups of a BackupInfrastructure.
+	BackupInfrastructureVerificationTimestamp = prometheus.NewDesc("garden_backupinfrastructure_verification_timestamp_seconds", "Time of the last verification of the backups as Unix timestamp", []string{"name", "namespace", "seed"}, nil)
+
+	// BackupInfrastructureVerificationRevision is a metric descriptor which collects the etcd revision of the backup
+	// restored during the last successful verification.
+	BackupInfrastructureVerificationRevision = prometheus.NewDesc("garden_backupinfrastructure_verification_revision", "Etcd revision of the backup restored during the last successful verification", []string{"name", "namespace", "seed"}, nil)
+
+	// BackupInfrastructureVerificationKeys is a metric descriptor which collects the number of keys of the backup
+	// restored during the last successful verification.
+	BackupInfrastructureVerificationKeys = prometheus.NewDesc("garden_backupinfrastructure_verification_keys", "Number of keys of the backup restored during the last successful verification", []string{"name", "namespace", "seed"}, nil)
+
 	// ScrapeFailures is a metric descriptor which counts the amount scrape issues grouped by kind.
 	ScrapeFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
 		Name: "garden_scrape_failure_total",
@@ -46,7 +65,14 @@ func RegisterControllerMetrics(controllers ...ControllerMetricsCollector) {
 	// and the collectors which should collect the metrics. At the end register the collector.
 	collector = controllerCollector{
 		controllers: controllers,
-		metricDescs: []*prometheus.Desc{ControllerWorkerSum},
+		metricDescs: []*prometheus.Desc{
+			ControllerWorkerSum,
+			ShootConditionAvailability,
+			BackupInfrastructureVerificationSuccess,
+			BackupInfrastructureVerificationTimestamp,
+			BackupInfrastructureVerificationRevision,
+			BackupInfrastructureVerificationKeys,
+		},
 	}
 	prometheus.MustRegister(collector)
 
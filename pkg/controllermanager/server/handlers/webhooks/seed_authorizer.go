@@ -0,0 +1,449 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhooks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardencorelisters "github.com/gardener/gardener/pkg/client/core/listers/core/v1alpha1"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	authorizationv1beta1 "k8s.io/api/authorization/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	kubecorev1listers "k8s.io/client-go/listers/core/v1"
+)
+
+var (
+	readVerbs  = sets.NewString("get", "list", "watch")
+	writeVerbs = sets.NewString("create", "update", "patch", "delete")
+	leaseVerbs = sets.NewString("get", "update", "patch", "delete")
+
+	eventsResource                  = schema.GroupResource{Resource: "events"}
+	eventsV1beta1Resource           = schema.GroupResource{Group: "events.k8s.io", Resource: "events"}
+	namespacesResource              = schema.GroupResource{Resource: "namespaces"}
+	secretsResource                 = schema.GroupResource{Resource: "secrets"}
+	configMapsResource              = schema.GroupResource{Resource: "configmaps"}
+	leasesResource                  = schema.GroupResource{Group: "coordination.k8s.io", Resource: "leases"}
+	seedsResource                   = gardenv1beta1.Resource("seeds")
+	shootsResource                  = gardenv1beta1.Resource("shoots")
+	backupInfrastructuresResource   = gardenv1beta1.Resource("backupinfrastructures")
+	controllerInstallationsResource = gardencorev1alpha1.Resource("controllerinstallations")
+
+	// readOnlyResources are the resources which the gardener-seed-agents may read but never modify.
+	readOnlyResources = map[schema.GroupResource]struct{}{
+		namespacesResource:                                     {},
+		gardenv1beta1.Resource("cloudprofiles"):                {},
+		gardenv1beta1.Resource("projects"):                     {},
+		gardenv1beta1.Resource("quotas"):                       {},
+		gardenv1beta1.Resource("secretbindings"):               {},
+		gardencorev1alpha1.Resource("controllerregistrations"): {},
+	}
+
+	// gardenSecretRoles are the roles of the secrets in the garden namespace which the gardener-seed-agents need for
+	// reconciling the Shoots.
+	gardenSecretRoles = sets.NewString(
+		common.GardenRoleInternalDomain,
+		common.GardenRoleDefaultDomain,
+		common.GardenRoleAlertingSMTP,
+		common.GardenRoleOpenVPNDiffieHellman,
+	)
+)
+
+// SeedAuthorizer authorizes the requests of the seed-scoped identities used by gardener-seed-agents. It confines
+// every identity to the objects belonging to its own Seed, similar to the node authorizer of Kubernetes. It has no
+// opinion about requests of all other users.
+type SeedAuthorizer struct {
+	seedLister                   gardenlisters.SeedLister
+	shootLister                  gardenlisters.ShootLister
+	backupInfrastructureLister   gardenlisters.BackupInfrastructureLister
+	secretBindingLister          gardenlisters.SecretBindingLister
+	controllerInstallationLister gardencorelisters.ControllerInstallationLister
+	secretLister                 kubecorev1listers.SecretLister
+}
+
+var _ authorizer.Authorizer = &SeedAuthorizer{}
+
+// NewSeedAuthorizer creates a new authorizer for the seed-scoped identities used by gardener-seed-agents.
+func NewSeedAuthorizer(seedLister gardenlisters.SeedLister, shootLister gardenlisters.ShootLister, backupInfrastructureLister gardenlisters.BackupInfrastructureLister, secretBindingLister gardenlisters.SecretBindingLister, controllerInstallationLister gardencorelisters.ControllerInstallationLister, secretLister kubecorev1listers.SecretLister) *SeedAuthorizer {
+	return &SeedAuthorizer{seedLister, shootLister, backupInfrastructureLister, secretBindingLister, controllerInstallationLister, secretLister}
+}
+
+// Authorize allows requests of seed-scoped identities if they
+// * read the Seeds, Shoots, BackupInfrastructures, and ControllerInstallations or one of the read-only resources,
+// * modify the own Seed or the Shoots, BackupInfrastructures, and ControllerInstallations belonging to the own Seed,
+// * read the secrets in the garden namespace which are required for the own Seed by name,
+// * access secrets and configmaps in namespaces related to the own Seed,
+// * use the own lease in the garden namespace for the leader election, or
+// * emit events.
+// All other requests of seed-scoped identities are denied.
+func (a *SeedAuthorizer) Authorize(attrs authorizer.Attributes) (authorizer.Decision, string, error) {
+	seedName, ok := common.SeedNameForUser(attrs.GetUser())
+	if !ok {
+		return authorizer.DecisionNoOpinion, "", nil
+	}
+
+	if !attrs.IsResourceRequest() {
+		if attrs.GetVerb() == "get" {
+			return authorizer.DecisionAllow, "", nil
+		}
+		return deny("only non-resource get requests are allowed")
+	}
+
+	var (
+		verb     = attrs.GetVerb()
+		resource = schema.GroupResource{Group: attrs.GetAPIGroup(), Resource: attrs.GetResource()}
+	)
+
+	switch resource {
+	case eventsResource, eventsV1beta1Resource:
+		if verb == "create" || verb == "patch" || verb == "update" {
+			return authorizer.DecisionAllow, "", nil
+		}
+		return deny("events can only be created, patched, and updated")
+
+	case leasesResource:
+		if attrs.GetNamespace() == common.GardenNamespace {
+			// The name of created objects is not known to the authorizer, creating a lease which already exists fails.
+			if verb == "create" {
+				return authorizer.DecisionAllow, "", nil
+			}
+			if leaseVerbs.Has(verb) && attrs.GetName() == common.SeedAgentLeaseName(seedName) {
+				return authorizer.DecisionAllow, "", nil
+			}
+		}
+		return deny(fmt.Sprintf("only lease %q can be used in the %q namespace", common.SeedAgentLeaseName(seedName), common.GardenNamespace))
+
+	case secretsResource, configMapsResource:
+		if !readVerbs.Has(verb) && !writeVerbs.Has(verb) {
+			return deny(fmt.Sprintf("%s cannot be accessed with verb %q", resource.Resource, verb))
+		}
+		if attrs.GetNamespace() == common.GardenNamespace {
+			return a.authorizeGardenNamespace(seedName, resource, verb, attrs.GetName())
+		}
+		namespaces, err := a.relatedNamespaces(seedName)
+		if err != nil {
+			return authorizer.DecisionNoOpinion, "", err
+		}
+		if namespaces.Has(attrs.GetNamespace()) {
+			return authorizer.DecisionAllow, "", nil
+		}
+		return deny(fmt.Sprintf("%s can only be accessed in namespaces related to seed %q", resource.Resource, seedName))
+	}
+
+	if _, ok := readOnlyResources[resource]; ok {
+		if readVerbs.Has(verb) && len(attrs.GetSubresource()) == 0 {
+			return authorizer.DecisionAllow, "", nil
+		}
+		return deny(fmt.Sprintf("%s can only be read", resource.String()))
+	}
+
+	switch resource {
+	case seedsResource, shootsResource, backupInfrastructuresResource, controllerInstallationsResource:
+	default:
+		return deny(fmt.Sprintf("%s cannot be accessed", resource.String()))
+	}
+
+	if readVerbs.Has(verb) {
+		return authorizer.DecisionAllow, "", nil
+	}
+	if !writeVerbs.Has(verb) {
+		return deny(fmt.Sprintf("%s cannot be accessed with verb %q", resource.String(), verb))
+	}
+
+	// BackupInfrastructures are created by the Shoot reconciliation, their names are not known to the authorizer.
+	if resource == backupInfrastructuresResource && verb == "create" {
+		namespaces, err := a.shootNamespaces(seedName)
+		if err != nil {
+			return authorizer.DecisionNoOpinion, "", err
+		}
+		if namespaces.Has(attrs.GetNamespace()) {
+			return authorizer.DecisionAllow, "", nil
+		}
+		return deny(fmt.Sprintf("backupinfrastructures can only be created in namespaces containing shoots of seed %q", seedName))
+	}
+	if verb == "create" || len(attrs.GetName()) == 0 {
+		return deny(fmt.Sprintf("%s can only be modified by name", resource.String()))
+	}
+
+	objectSeedName, err := a.seedNameForObject(resource, attrs.GetNamespace(), attrs.GetName())
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return deny(fmt.Sprintf("%s %q is unknown", resource.String(), attrs.GetName()))
+		}
+		return authorizer.DecisionNoOpinion, "", err
+	}
+	if objectSeedName == seedName {
+		return authorizer.DecisionAllow, "", nil
+	}
+	return deny(fmt.Sprintf("%s %q does not belong to seed %q", resource.String(), attrs.GetName(), seedName))
+}
+
+func deny(reason string) (authorizer.Decision, string, error) {
+	return authorizer.DecisionDeny, reason, nil
+}
+
+// authorizeGardenNamespace allows to read the secrets in the garden namespace which are required for the given Seed,
+// i.e., the Seed secret, the cloud provider credentials of its Shoots, and the secrets with one of the garden roles
+// needed for reconciling Shoots. They can only be read by name (lists and watches must select the name with a field
+// selector), all other secrets and all configmaps in the garden namespace cannot be accessed.
+func (a *SeedAuthorizer) authorizeGardenNamespace(seedName string, resource schema.GroupResource, verb, name string) (authorizer.Decision, string, error) {
+	if resource != secretsResource || !readVerbs.Has(verb) || len(name) == 0 {
+		return deny(fmt.Sprintf("only required secrets can be read by name in the %q namespace", common.GardenNamespace))
+	}
+
+	names, err := a.gardenSecretNames(seedName)
+	if err != nil {
+		return authorizer.DecisionNoOpinion, "", err
+	}
+	if names.Has(name) {
+		return authorizer.DecisionAllow, "", nil
+	}
+
+	secret, err := a.secretLister.Secrets(common.GardenNamespace).Get(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return deny(fmt.Sprintf("secret %q is unknown", name))
+		}
+		return authorizer.DecisionNoOpinion, "", err
+	}
+	if gardenSecretRoles.Has(secret.Labels[common.GardenRole]) {
+		return authorizer.DecisionAllow, "", nil
+	}
+	return deny(fmt.Sprintf("secret %q is not required for seed %q", name, seedName))
+}
+
+// gardenSecretNames returns the names of the secrets in the garden namespace which are referenced by the given Seed or
+// by the SecretBindings of its Shoots.
+func (a *SeedAuthorizer) gardenSecretNames(seedName string) (sets.String, error) {
+	names := sets.NewString()
+
+	seed, err := a.seedLister.Get(seedName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	if seed != nil && seed.Spec.SecretRef.Namespace == common.GardenNamespace {
+		names.Insert(seed.Spec.SecretRef.Name)
+	}
+
+	bindings, err := a.secretBindingsOfSeed(seedName)
+	if err != nil {
+		return nil, err
+	}
+	for _, binding := range bindings {
+		if binding.SecretRef.Namespace == common.GardenNamespace {
+			names.Insert(binding.SecretRef.Name)
+		}
+	}
+
+	return names, nil
+}
+
+// seedNameForObject returns the name of the Seed the given object belongs to.
+func (a *SeedAuthorizer) seedNameForObject(resource schema.GroupResource, namespace, name string) (string, error) {
+	switch resource {
+	case seedsResource:
+		seed, err := a.seedLister.Get(name)
+		if err != nil {
+			return "", err
+		}
+		return seed.Name, nil
+
+	case shootsResource:
+		shoot, err := a.shootLister.Shoots(namespace).Get(name)
+		if err != nil {
+			return "", err
+		}
+		if shoot.Spec.Cloud.Seed == nil {
+			return "", nil
+		}
+		return *shoot.Spec.Cloud.Seed, nil
+
+	case backupInfrastructuresResource:
+		backupInfrastructure, err := a.backupInfrastructureLister.BackupInfrastructures(namespace).Get(name)
+		if err != nil {
+			return "", err
+		}
+		return backupInfrastructure.Spec.Seed, nil
+
+	case controllerInstallationsResource:
+		controllerInstallation, err := a.controllerInstallationLister.Get(name)
+		if err != nil {
+			return "", err
+		}
+		return controllerInstallation.Spec.SeedRef.Name, nil
+	}
+
+	return "", fmt.Errorf("unsupported resource %s", resource.String())
+}
+
+// shootNamespaces returns the namespaces containing Shoots or BackupInfrastructures of the given Seed except for the
+// garden namespace. Shoots in the garden namespace are always reconciled by the Gardener controller manager.
+func (a *SeedAuthorizer) shootNamespaces(seedName string) (sets.String, error) {
+	namespaces := sets.NewString()
+
+	shoots, err := a.shootLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, shoot := range shoots {
+		if shoot.Spec.Cloud.Seed != nil && *shoot.Spec.Cloud.Seed == seedName {
+			namespaces.Insert(shoot.Namespace)
+		}
+	}
+
+	backupInfrastructures, err := a.backupInfrastructureLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, backupInfrastructure := range backupInfrastructures {
+		if backupInfrastructure.Spec.Seed == seedName {
+			namespaces.Insert(backupInfrastructure.Namespace)
+		}
+	}
+
+	namespaces.Delete(common.GardenNamespace)
+	return namespaces, nil
+}
+
+// relatedNamespaces returns the namespaces whose secrets and configmaps are required to reconcile the given Seed and
+// the objects belonging to it, i.e., the namespace of the Seed secret, the namespaces containing Shoots or
+// BackupInfrastructures of the Seed, and the namespaces of the cloud provider credentials used by these Shoots. The
+// garden namespace is never a related namespace.
+func (a *SeedAuthorizer) relatedNamespaces(seedName string) (sets.String, error) {
+	namespaces, err := a.shootNamespaces(seedName)
+	if err != nil {
+		return nil, err
+	}
+
+	seed, err := a.seedLister.Get(seedName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	if seed != nil && len(seed.Spec.SecretRef.Namespace) > 0 {
+		namespaces.Insert(seed.Spec.SecretRef.Namespace)
+	}
+
+	bindings, err := a.secretBindingsOfSeed(seedName)
+	if err != nil {
+		return nil, err
+	}
+	for _, binding := range bindings {
+		if len(binding.SecretRef.Namespace) > 0 {
+			namespaces.Insert(binding.SecretRef.Namespace)
+		}
+	}
+
+	namespaces.Delete(common.GardenNamespace)
+	return namespaces, nil
+}
+
+// secretBindingsOfSeed returns the SecretBindings used by the Shoots of the given Seed.
+func (a *SeedAuthorizer) secretBindingsOfSeed(seedName string) ([]*gardenv1beta1.SecretBinding, error) {
+	var bindings []*gardenv1beta1.SecretBinding
+
+	shoots, err := a.shootLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, shoot := range shoots {
+		if shoot.Spec.Cloud.Seed == nil || *shoot.Spec.Cloud.Seed != seedName {
+			continue
+		}
+		binding, err := a.secretBindingLister.SecretBindings(shoot.Namespace).Get(shoot.Spec.Cloud.SecretBindingRef.Name)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		bindings = append(bindings, binding)
+	}
+
+	return bindings, nil
+}
+
+// NewSeedAuthorizerHandler creates a new handler for the SubjectAccessReviews sent by the webhook authorizer of the
+// API server of the Garden cluster.
+func NewSeedAuthorizerHandler(a authorizer.Authorizer) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		review := &authorizationv1beta1.SubjectAccessReview{}
+		if err := json.NewDecoder(r.Body).Decode(review); err != nil {
+			logger.Logger.Errorf("Could not decode SubjectAccessReview: %v", err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		decision, reason, err := a.Authorize(attributesFromSubjectAccessReview(review.Spec))
+		review.Status = authorizationv1beta1.SubjectAccessReviewStatus{
+			Allowed: decision == authorizer.DecisionAllow,
+			Denied:  decision == authorizer.DecisionDeny,
+			Reason:  reason,
+		}
+		if err != nil {
+			review.Status.EvaluationError = err.Error()
+		}
+		if review.Status.Denied {
+			logger.Logger.Infof("Denied request of user '%s': %s", review.Spec.User, reason)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(review); err != nil {
+			logger.Logger.Error(err)
+		}
+	}
+}
+
+func attributesFromSubjectAccessReview(spec authorizationv1beta1.SubjectAccessReviewSpec) authorizer.Attributes {
+	extra := make(map[string][]string, len(spec.Extra))
+	for key, value := range spec.Extra {
+		extra[key] = value
+	}
+
+	attrs := authorizer.AttributesRecord{
+		User: &user.DefaultInfo{
+			Name:   spec.User,
+			UID:    spec.UID,
+			Groups: spec.Groups,
+			Extra:  extra,
+		},
+	}
+
+	if resourceAttributes := spec.ResourceAttributes; resourceAttributes != nil {
+		attrs.ResourceRequest = true
+		attrs.Verb = resourceAttributes.Verb
+		attrs.Namespace = resourceAttributes.Namespace
+		attrs.APIGroup = resourceAttributes.Group
+		attrs.APIVersion = resourceAttributes.Version
+		attrs.Resource = resourceAttributes.Resource
+		attrs.Subresource = resourceAttributes.Subresource
+		attrs.Name = resourceAttributes.Name
+	} else if nonResourceAttributes := spec.NonResourceAttributes; nonResourceAttributes != nil {
+		attrs.Verb = nonResourceAttributes.Verb
+		attrs.Path = nonResourceAttributes.Path
+	}
+
+	return attrs
+}
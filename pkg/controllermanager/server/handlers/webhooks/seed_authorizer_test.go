@@ -0,0 +1,189 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhooks_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardencorelisters "github.com/gardener/gardener/pkg/client/core/listers/core/v1alpha1"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/server/handlers/webhooks"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	authorizationv1beta1 "k8s.io/api/authorization/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	kubecorev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+var _ = Describe("SeedAuthorizer", func() {
+	var (
+		seedAuthorizer *SeedAuthorizer
+
+		seedUser  = &user.DefaultInfo{Name: "garden.sapcloud.io:system:seed:seed-1", Groups: []string{"garden.sapcloud.io:system:seeds"}}
+		otherUser = &user.DefaultInfo{Name: "alice"}
+
+		seed1, seed2 = "seed-1", "seed-2"
+	)
+
+	BeforeEach(func() {
+		newIndexer := func(objects ...interface{}) cache.Indexer {
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			for _, obj := range objects {
+				Expect(indexer.Add(obj)).To(Succeed())
+			}
+			return indexer
+		}
+
+		seedAuthorizer = NewSeedAuthorizer(
+			gardenlisters.NewSeedLister(newIndexer(
+				&gardenv1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: seed1}, Spec: gardenv1beta1.SeedSpec{SecretRef: corev1.SecretReference{Name: "seed-1", Namespace: "seed-secrets"}}},
+				&gardenv1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: seed2}},
+			)),
+			gardenlisters.NewShootLister(newIndexer(
+				&gardenv1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "own", Namespace: "garden-foo"}, Spec: gardenv1beta1.ShootSpec{Cloud: gardenv1beta1.Cloud{Seed: &seed1, SecretBindingRef: corev1.LocalObjectReference{Name: "shared"}}}},
+				&gardenv1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "foreign", Namespace: "garden-bar"}, Spec: gardenv1beta1.ShootSpec{Cloud: gardenv1beta1.Cloud{Seed: &seed2}}},
+				&gardenv1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "unscheduled", Namespace: "garden-foo"}},
+				&gardenv1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "central-credentials", Namespace: "garden-qux"}, Spec: gardenv1beta1.ShootSpec{Cloud: gardenv1beta1.Cloud{Seed: &seed1, SecretBindingRef: corev1.LocalObjectReference{Name: "central"}}}},
+				&gardenv1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shooted-seed", Namespace: "garden"}, Spec: gardenv1beta1.ShootSpec{Cloud: gardenv1beta1.Cloud{Seed: &seed1}}},
+			)),
+			gardenlisters.NewBackupInfrastructureLister(newIndexer(
+				&gardenv1beta1.BackupInfrastructure{ObjectMeta: metav1.ObjectMeta{Name: "own", Namespace: "garden-baz"}, Spec: gardenv1beta1.BackupInfrastructureSpec{Seed: seed1}},
+				&gardenv1beta1.BackupInfrastructure{ObjectMeta: metav1.ObjectMeta{Name: "foreign", Namespace: "garden-bar"}, Spec: gardenv1beta1.BackupInfrastructureSpec{Seed: seed2}},
+			)),
+			gardenlisters.NewSecretBindingLister(newIndexer(
+				&gardenv1beta1.SecretBinding{ObjectMeta: metav1.ObjectMeta{Name: "shared", Namespace: "garden-foo"}, SecretRef: corev1.SecretReference{Name: "credentials", Namespace: "garden-shared"}},
+				&gardenv1beta1.SecretBinding{ObjectMeta: metav1.ObjectMeta{Name: "central", Namespace: "garden-qux"}, SecretRef: corev1.SecretReference{Name: "central-credentials", Namespace: "garden"}},
+			)),
+			gardencorelisters.NewControllerInstallationLister(newIndexer(
+				&gardencorev1alpha1.ControllerInstallation{ObjectMeta: metav1.ObjectMeta{Name: "own"}, Spec: gardencorev1alpha1.ControllerInstallationSpec{SeedRef: corev1.ObjectReference{Name: seed1}}},
+				&gardencorev1alpha1.ControllerInstallation{ObjectMeta: metav1.ObjectMeta{Name: "foreign"}, Spec: gardencorev1alpha1.ControllerInstallationSpec{SeedRef: corev1.ObjectReference{Name: seed2}}},
+			)),
+			kubecorev1listers.NewSecretLister(newIndexer(
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "internal-domain", Namespace: "garden", Labels: map[string]string{common.GardenRole: common.GardenRoleInternalDomain}}},
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "garden"}},
+			)),
+		)
+	})
+
+	request := func(verb, group, resource, namespace, name string) authorizer.AttributesRecord {
+		return authorizer.AttributesRecord{User: seedUser, ResourceRequest: true, Verb: verb, APIGroup: group, Resource: resource, Namespace: namespace, Name: name}
+	}
+
+	DescribeTable("#Authorize",
+		func(attrs authorizer.AttributesRecord, expected authorizer.Decision) {
+			decision, _, err := seedAuthorizer.Authorize(attrs)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decision).To(Equal(expected))
+		},
+
+		Entry("no opinion about other users", authorizer.AttributesRecord{User: otherUser, ResourceRequest: true, Verb: "delete", Resource: "secrets", Namespace: "garden"}, authorizer.DecisionNoOpinion),
+		Entry("no opinion about seed user names without the seeds group", authorizer.AttributesRecord{User: &user.DefaultInfo{Name: "garden.sapcloud.io:system:seed:seed-1"}, ResourceRequest: true, Verb: "get", Resource: "secrets"}, authorizer.DecisionNoOpinion),
+		Entry("allow non-resource get requests", authorizer.AttributesRecord{User: seedUser, Verb: "get", Path: "/apis"}, authorizer.DecisionAllow),
+
+		Entry("allow listing all shoots", request("list", "garden.sapcloud.io", "shoots", "", ""), authorizer.DecisionAllow),
+		Entry("allow updating own shoots", request("update", "garden.sapcloud.io", "shoots", "garden-foo", "own"), authorizer.DecisionAllow),
+		Entry("deny updating foreign shoots", request("update", "garden.sapcloud.io", "shoots", "garden-bar", "foreign"), authorizer.DecisionDeny),
+		Entry("deny updating unscheduled shoots", request("patch", "garden.sapcloud.io", "shoots", "garden-foo", "unscheduled"), authorizer.DecisionDeny),
+		Entry("deny updating unknown shoots", request("update", "garden.sapcloud.io", "shoots", "garden-foo", "unknown"), authorizer.DecisionDeny),
+		Entry("deny creating shoots", request("create", "garden.sapcloud.io", "shoots", "garden-foo", ""), authorizer.DecisionDeny),
+		Entry("deny deleting collections of shoots", request("deletecollection", "garden.sapcloud.io", "shoots", "garden-foo", ""), authorizer.DecisionDeny),
+
+		Entry("allow updating the own seed", request("update", "garden.sapcloud.io", "seeds", "", seed1), authorizer.DecisionAllow),
+		Entry("deny updating other seeds", request("update", "garden.sapcloud.io", "seeds", "", seed2), authorizer.DecisionDeny),
+
+		Entry("allow updating own backup infrastructures", request("update", "garden.sapcloud.io", "backupinfrastructures", "garden-baz", "own"), authorizer.DecisionAllow),
+		Entry("deny deleting foreign backup infrastructures", request("delete", "garden.sapcloud.io", "backupinfrastructures", "garden-bar", "foreign"), authorizer.DecisionDeny),
+		Entry("allow creating backup infrastructures in namespaces of own shoots", request("create", "garden.sapcloud.io", "backupinfrastructures", "garden-foo", ""), authorizer.DecisionAllow),
+		Entry("deny creating backup infrastructures in other namespaces", request("create", "garden.sapcloud.io", "backupinfrastructures", "garden-bar", ""), authorizer.DecisionDeny),
+		Entry("deny creating backup infrastructures in the garden namespace", request("create", "garden.sapcloud.io", "backupinfrastructures", "garden", ""), authorizer.DecisionDeny),
+
+		Entry("allow updating own controller installations", request("update", "core.gardener.cloud", "controllerinstallations", "", "own"), authorizer.DecisionAllow),
+		Entry("deny updating foreign controller installations", request("update", "core.gardener.cloud", "controllerinstallations", "", "foreign"), authorizer.DecisionDeny),
+
+		Entry("allow reading projects", request("get", "garden.sapcloud.io", "projects", "", "foo"), authorizer.DecisionAllow),
+		Entry("deny modifying projects", request("update", "garden.sapcloud.io", "projects", "", "foo"), authorizer.DecisionDeny),
+		Entry("deny modifying secret bindings", request("delete", "garden.sapcloud.io", "secretbindings", "garden-foo", "shared"), authorizer.DecisionDeny),
+		Entry("deny accessing other resources", request("get", "rbac.authorization.k8s.io", "clusterroles", "", "cluster-admin"), authorizer.DecisionDeny),
+
+		Entry("deny listing secrets in the garden namespace", request("list", "", "secrets", "garden", ""), authorizer.DecisionDeny),
+		Entry("allow reading secrets with garden roles by name", request("get", "", "secrets", "garden", "internal-domain"), authorizer.DecisionAllow),
+		Entry("allow watching secrets with garden roles by name", request("watch", "", "secrets", "garden", "internal-domain"), authorizer.DecisionAllow),
+		Entry("allow reading cloud provider credentials of own shoots in the garden namespace", request("get", "", "secrets", "garden", "central-credentials"), authorizer.DecisionAllow),
+		Entry("deny modifying secrets with garden roles", request("update", "", "secrets", "garden", "internal-domain"), authorizer.DecisionDeny),
+		Entry("deny reading other secrets in the garden namespace", request("get", "", "secrets", "garden", "other"), authorizer.DecisionDeny),
+		Entry("deny reading unknown secrets in the garden namespace", request("get", "", "secrets", "garden", "unknown"), authorizer.DecisionDeny),
+		Entry("deny writing secrets of own shoots in the garden namespace", request("update", "", "secrets", "garden", "shooted-seed.kubeconfig"), authorizer.DecisionDeny),
+		Entry("deny reading configmaps in the garden namespace", request("get", "", "configmaps", "garden", "gardener-controller-manager-internal-config"), authorizer.DecisionDeny),
+		Entry("allow reading the seed secret", request("get", "", "secrets", "seed-secrets", "seed-1"), authorizer.DecisionAllow),
+		Entry("allow writing secrets in namespaces of own shoots", request("update", "", "secrets", "garden-foo", "own.kubeconfig"), authorizer.DecisionAllow),
+		Entry("allow writing secrets in namespaces of own backup infrastructures", request("delete", "", "secrets", "garden-baz", "foo"), authorizer.DecisionAllow),
+		Entry("allow reading the cloud provider credentials of own shoots", request("get", "", "secrets", "garden-shared", "credentials"), authorizer.DecisionAllow),
+		Entry("deny reading secrets in namespaces of foreign shoots", request("get", "", "secrets", "garden-bar", "foreign.kubeconfig"), authorizer.DecisionDeny),
+		Entry("deny listing secrets in all namespaces", request("list", "", "secrets", "", ""), authorizer.DecisionDeny),
+		Entry("deny writing configmaps in other namespaces", request("create", "", "configmaps", "kube-system", ""), authorizer.DecisionDeny),
+
+		Entry("allow leases in the garden namespace", request("update", "coordination.k8s.io", "leases", "garden", "gardener-seed-agent-seed-1"), authorizer.DecisionAllow),
+		Entry("allow creating leases in the garden namespace", request("create", "coordination.k8s.io", "leases", "garden", ""), authorizer.DecisionAllow),
+		Entry("deny leases of other seeds", request("update", "coordination.k8s.io", "leases", "garden", "gardener-seed-agent-seed-2"), authorizer.DecisionDeny),
+		Entry("deny the lease of the controller manager", request("get", "coordination.k8s.io", "leases", "garden", "gardener-controller-manager"), authorizer.DecisionDeny),
+		Entry("deny listing leases in the garden namespace", request("list", "coordination.k8s.io", "leases", "garden", ""), authorizer.DecisionDeny),
+		Entry("deny leases in other namespaces", request("update", "coordination.k8s.io", "leases", "kube-system", "kube-controller-manager"), authorizer.DecisionDeny),
+		Entry("allow creating events", request("create", "", "events", "garden-bar", ""), authorizer.DecisionAllow),
+		Entry("deny deleting events", request("delete", "", "events", "garden-bar", "foo"), authorizer.DecisionDeny),
+	)
+
+	Describe("#NewSeedAuthorizerHandler", func() {
+		It("should answer SubjectAccessReviews", func() {
+			review := &authorizationv1beta1.SubjectAccessReview{
+				Spec: authorizationv1beta1.SubjectAccessReviewSpec{
+					User:   seedUser.Name,
+					Groups: seedUser.Groups,
+					ResourceAttributes: &authorizationv1beta1.ResourceAttributes{
+						Verb:      "update",
+						Group:     "garden.sapcloud.io",
+						Resource:  "shoots",
+						Namespace: "garden-bar",
+						Name:      "foreign",
+					},
+				},
+			}
+			body, err := json.Marshal(review)
+			Expect(err).NotTo(HaveOccurred())
+
+			recorder := httptest.NewRecorder()
+			NewSeedAuthorizerHandler(seedAuthorizer)(recorder, httptest.NewRequest(http.MethodPost, "/webhooks/authorize-seeds", bytes.NewReader(body)))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			response := &authorizationv1beta1.SubjectAccessReview{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), response)).To(Succeed())
+			Expect(response.Status.Allowed).To(BeFalse())
+			Expect(response.Status.Denied).To(BeTrue())
+			Expect(response.Status.Reason).To(ContainSubstring(`does not belong to seed "seed-1"`))
+		})
+	})
+})
@@ -15,6 +15,11 @@
 package webhooks
 
 import (
+	"fmt"
+	"net/http"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+
 	"k8s.io/api/admission/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -36,3 +41,27 @@ func admissionResponse(allowed bool, msg string) *v1beta1.AdmissionResponse {
 func errToAdmissionResponse(err error) *v1beta1.AdmissionResponse {
 	return admissionResponse(false, err.Error())
 }
+
+// BlockingObjectCauseType is the type of the status causes describing objects which block a namespace deletion.
+const BlockingObjectCauseType metav1.CauseType = "BlockingObject"
+
+func blockingObjectsToAdmissionResponse(namespace string, blockingObjects []common.BlockingObject) *v1beta1.AdmissionResponse {
+	causes := make([]metav1.StatusCause, 0, len(blockingObjects))
+	for _, obj := range blockingObjects {
+		causes = append(causes, metav1.StatusCause{
+			Type:    BlockingObjectCauseType,
+			Message: obj.String(),
+		})
+	}
+
+	response := admissionResponse(false, fmt.Sprintf("Deletion of namespace %q is not permitted (there are still %d objects managed by Gardener)", namespace, len(blockingObjects)))
+	response.Result.Status = metav1.StatusFailure
+	response.Result.Code = http.StatusForbidden
+	response.Result.Reason = metav1.StatusReasonForbidden
+	response.Result.Details = &metav1.StatusDetails{
+		Name:   namespace,
+		Kind:   "namespaces",
+		Causes: causes,
+	}
+	return response
+}
@@ -23,6 +23,7 @@ import (
 
 	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -35,27 +36,37 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 )
 
 type namespaceDeletionHandler struct {
 	k8sGardenClient kubernetes.Interface
 
-	projectLister              gardenlisters.ProjectLister
-	backupInfrastructureLister gardenlisters.BackupInfrastructureLister
-	shootLister                gardenlisters.ShootLister
+	projectLister       gardenlisters.ProjectLister
+	secretBindingLister gardenlisters.SecretBindingLister
+	shootLister         gardenlisters.ShootLister
+
+	protectedKinds []schema.GroupVersionKind
 
 	scheme *runtime.Scheme
 	codecs serializer.CodecFactory
 }
 
 // NewValidateNamespaceDeletionHandler creates a new handler for validating namespace deletions.
-func NewValidateNamespaceDeletionHandler(k8sGardenClient kubernetes.Interface, projectLister gardenlisters.ProjectLister, backupInfrastructureLister gardenlisters.BackupInfrastructureLister, shootLister gardenlisters.ShootLister) func(http.ResponseWriter, *http.Request) {
+func NewValidateNamespaceDeletionHandler(k8sGardenClient kubernetes.Interface, projectLister gardenlisters.ProjectLister, secretBindingLister gardenlisters.SecretBindingLister, shootLister gardenlisters.ShootLister, protection *config.NamespaceDeletionProtection) func(http.ResponseWriter, *http.Request) {
 	scheme := runtime.NewScheme()
 	corev1.AddToScheme(scheme)
 	admissionregistrationv1beta1.AddToScheme(scheme)
 
-	h := &namespaceDeletionHandler{k8sGardenClient, projectLister, backupInfrastructureLister, shootLister, scheme, serializer.NewCodecFactory(scheme)}
+	var protectedKinds []schema.GroupVersionKind
+	if protection != nil {
+		for _, kind := range protection.ProtectedKinds {
+			protectedKinds = append(protectedKinds, schema.FromAPIVersionAndKind(kind.APIVersion, kind.Kind))
+		}
+	}
+
+	h := &namespaceDeletionHandler{k8sGardenClient, projectLister, secretBindingLister, shootLister, protectedKinds, scheme, serializer.NewCodecFactory(scheme)}
 	return h.ValidateNamespaceDeletion
 }
 
@@ -114,73 +125,87 @@ func (h *namespaceDeletionHandler) ValidateNamespaceDeletion(w http.ResponseWrit
 	respond(w, reviewResponse)
 }
 
-// admitNamespaces does only allow the request if no Shoots and no BackupInfrastructures exist in this
-// specific namespace anymore.
+// admitNamespaces does only allow the request if the namespace does not contain any objects which are still managed by
+// Gardener anymore. Project namespaces may only be deleted via their project.
 func (h *namespaceDeletionHandler) admitNamespaces(request *v1beta1.AdmissionRequest) *v1beta1.AdmissionResponse {
 	namespaceResource := metav1.GroupVersionResource{Group: "", Version: "v1", Resource: "namespaces"}
 	if request.Resource != namespaceResource {
 		return errToAdmissionResponse(fmt.Errorf("expect resource to be %s", namespaceResource))
 	}
 
-	// Determine project object for given namespace.
-	project, err := common.ProjectForNamespace(h.projectLister, request.Name)
-	if err != nil {
-		if apierrors.IsNotFound(err) {
-			// Namespace does not belong to a project. Deletion is allowed.
-			return admissionResponse(true, "")
-		}
-		return errToAdmissionResponse(err)
-	}
+	ctx := context.TODO()
 
 	// We do not receive the namespace object in the `.object` field of the admission request. Hence, we need to get it ourselves.
 	namespace := &corev1.Namespace{}
-	err = h.k8sGardenClient.Client().Get(context.TODO(), client.ObjectKey{Name: request.Name}, namespace)
-	if err != nil {
+	if err := h.k8sGardenClient.Client().Get(ctx, client.ObjectKey{Name: request.Name}, namespace); err != nil {
 		if apierrors.IsNotFound(err) {
 			return admissionResponse(true, "")
 		}
 		return errToAdmissionResponse(err)
 	}
 
-	switch {
-	case namespace.DeletionTimestamp != nil:
+	if namespace.DeletionTimestamp != nil {
 		// Namespace is already marked to be deleted so we can allow the request.
 		return admissionResponse(true, "")
-	case project.DeletionTimestamp != nil:
-		namespaceEmpty, err := h.isNamespaceEmpty(namespace.Name)
-		if err != nil {
-			return errToAdmissionResponse(err)
-		}
+	}
 
-		if namespaceEmpty {
-			return admissionResponse(true, "")
-		}
-		return admissionResponse(false, fmt.Sprintf("Deletion of namespace %q is not permitted (there are still Shoots or BackupInfrastructures).", namespace.Name))
+	// Determine project object for given namespace.
+	project, err := common.ProjectForNamespace(h.projectLister, namespace.Name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errToAdmissionResponse(err)
 	}
 
-	// Namespace is not yet marked to be deleted and project is not marked as well. We do not admit and respond that namespace deletion is only
-	// allowed via project deletion.
-	return admissionResponse(false, fmt.Sprintf("Direct deletion of namespace %q is not permitted (you must delete the corresponding project %q).", namespace.Name, project.Name))
-}
+	if project != nil && project.DeletionTimestamp == nil {
+		// Namespace is not yet marked to be deleted and project is not marked as well. We do not admit and respond that namespace deletion is only
+		// allowed via project deletion.
+		return admissionResponse(false, fmt.Sprintf("Direct deletion of namespace %q is not permitted (you must delete the corresponding project %q).", namespace.Name, project.Name))
+	}
 
-func (h *namespaceDeletionHandler) isNamespaceEmpty(namespace string) (bool, error) {
-	backupInfrastructureList, err := h.backupInfrastructureLister.BackupInfrastructures(namespace).List(labels.Everything())
+	blockingObjects, err := h.blockingObjects(ctx, namespace.Name)
 	if err != nil {
-		return false, err
+		return errToAdmissionResponse(err)
+	}
+
+	if len(blockingObjects) == 0 {
+		return admissionResponse(true, "")
+	}
+	return blockingObjectsToAdmissionResponse(namespace.Name, blockingObjects)
+}
+
+// blockingObjects computes the list of objects preventing the deletion of the given namespace. These are the objects
+// of the protected kinds which still carry a Gardener finalizer, and the secrets used by Shoots via SecretBindings.
+// Gardener removes the finalizers of SecretBindings and their secrets as soon as they are deleted and not used anymore,
+// hence, these kinds are only blocking while they are used.
+func (h *namespaceDeletionHandler) blockingObjects(ctx context.Context, namespace string) ([]common.BlockingObject, error) {
+	var kinds []schema.GroupVersionKind
+	for _, gvk := range h.protectedKinds {
+		if gvk.GroupKind() != secretGroupKind && gvk.GroupKind() != secretBindingGroupKind {
+			kinds = append(kinds, gvk)
+		}
 	}
 
-	if len(backupInfrastructureList) != 0 {
-		return false, nil
+	blockingObjects, err := common.FinalizerBlockingObjects(ctx, h.k8sGardenClient.Client(), namespace, kinds)
+	if err != nil {
+		return nil, err
 	}
 
-	shootList, err := h.shootLister.Shoots(namespace).List(labels.Everything())
+	secretBindings, err := h.secretBindingLister.List(labels.Everything())
 	if err != nil {
-		return false, err
+		return nil, err
+	}
+	shoots, err := h.shootLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
 	}
 
-	return len(shootList) == 0, nil
+	return append(blockingObjects, common.CredentialsBlockingObjects(namespace, secretBindings, shoots)...), nil
 }
 
+var (
+	secretGroupKind        = schema.GroupKind{Kind: "Secret"}
+	secretBindingGroupKind = schema.GroupKind{Group: "garden.sapcloud.io", Kind: "SecretBinding"}
+)
+
 func respond(w http.ResponseWriter, response *v1beta1.AdmissionResponse) {
 	responseObj := v1beta1.AdmissionReview{}
 	if response != nil {
@@ -0,0 +1,50 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sharding
+
+import (
+	"fmt"
+	"os"
+
+	"k8s.io/apimachinery/pkg/util/uuid"
+)
+
+// EnvPodName is the name of the environment variable which contains the name of the pod of the running replica. It is
+// populated via the downward API.
+const EnvPodName = "POD_NAME"
+
+var (
+	getenv   = os.Getenv
+	hostname = os.Hostname
+)
+
+// DetermineIdentity returns the identity with which the running replica announces its membership. The pod name is
+// unique within the namespace of the Gardener controller manager, hence, it is used if available. Otherwise, the
+// hostname is suffixed with a random UUID.
+func DetermineIdentity() (string, error) {
+	if podName := getenv(EnvPodName); podName != "" {
+		return podName, nil
+	}
+
+	name, err := hostname()
+	if err != nil {
+		return "", fmt.Errorf("unable to get hostname: %v", err)
+	}
+	if name == "" {
+		return "", fmt.Errorf("unable to determine the shard identity: neither %s nor the hostname is set", EnvPodName)
+	}
+
+	return name + "_" + string(uuid.NewUUID()), nil
+}
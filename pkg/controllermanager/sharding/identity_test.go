@@ -0,0 +1,85 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sharding_test
+
+import (
+	"fmt"
+
+	. "github.com/gardener/gardener/pkg/controllermanager/sharding"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Identity", func() {
+	Describe("#DetermineIdentity", func() {
+		var (
+			env     map[string]string
+			host    string
+			hostErr error
+			restore func()
+		)
+
+		BeforeEach(func() {
+			env = map[string]string{}
+			host = "gardener-controller-manager"
+			hostErr = nil
+			restore = SetIdentitySources(
+				func(key string) string { return env[key] },
+				func() (string, error) { return host, hostErr },
+			)
+		})
+
+		AfterEach(func() {
+			restore()
+		})
+
+		It("should return the pod name if set", func() {
+			env[EnvPodName] = "gardener-controller-manager-5d8f9c-abcde"
+
+			identity, err := DetermineIdentity()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(identity).To(Equal("gardener-controller-manager-5d8f9c-abcde"))
+		})
+
+		It("should return unique identities based on the hostname if the pod name is not set", func() {
+			identity1, err := DetermineIdentity()
+			Expect(err).NotTo(HaveOccurred())
+			identity2, err := DetermineIdentity()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(identity1).To(HavePrefix("gardener-controller-manager_"))
+			Expect(identity2).To(HavePrefix("gardener-controller-manager_"))
+			Expect(identity1).NotTo(Equal(identity2))
+		})
+
+		It("should fail if the hostname cannot be determined", func() {
+			hostErr = fmt.Errorf("fake")
+
+			_, err := DetermineIdentity()
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail if neither the pod name nor the hostname is set", func() {
+			host = ""
+
+			_, err := DetermineIdentity()
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,302 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sharding
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/logger"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	k8s "k8s.io/client-go/kubernetes"
+)
+
+const (
+	// LabelShardMember is the label key of the Leases by which the replicas of the Gardener controller manager
+	// announce their membership.
+	LabelShardMember = "controllermanager.gardener.cloud/shard-member"
+
+	leaseNamePrefix = "gardener-controller-manager-shard-"
+)
+
+// Interface determines which replica of the Gardener controller manager is responsible for which keys.
+type Interface interface {
+	// IsResponsible returns true if the running replica is responsible for the given key.
+	IsResponsible(key string) bool
+	// IsMember returns true if the given identity belongs to a replica which is currently alive.
+	IsMember(identity string) bool
+	// AddRebalanceHandler registers a function which is called whenever the set of members has changed.
+	AddRebalanceHandler(handler func())
+}
+
+// Membership maintains the Lease of the running replica and distributes keys consistently across all replicas whose
+// Leases have been renewed in time.
+//
+// The replicas do not observe a change of the members at the same time. To prevent that two replicas act on the same
+// key, a replica only takes over a key after a grace period of one lease duration, i.e., only if all rings which were
+// active within the grace period assigned the key to it. The previous owner notices that it lost the key within one
+// renew period, which is shorter than the lease duration.
+type Membership struct {
+	client        k8s.Interface
+	identity      string
+	namespace     string
+	leaseDuration time.Duration
+	renewPeriod   time.Duration
+	virtualNodes  int
+	now           func() time.Time
+
+	lock          sync.RWMutex
+	members       sets.String
+	ring          *Ring
+	previousRings []previousRing
+	handlers      []func()
+}
+
+// previousRing is a ring which was active until the given time.
+type previousRing struct {
+	ring  *Ring
+	until time.Time
+}
+
+var _ Interface = &Membership{}
+
+// NewMembership creates a new Membership for the replica with the given identity.
+func NewMembership(client k8s.Interface, identity string, cfg *config.ShardingConfiguration) *Membership {
+	m := &Membership{
+		client:        client,
+		identity:      identity,
+		namespace:     cfg.LeaseNamespace,
+		leaseDuration: 40 * time.Second,
+		renewPeriod:   10 * time.Second,
+		virtualNodes:  100,
+		now:           time.Now,
+		members:       sets.NewString(),
+		ring:          NewRing(nil, 0),
+	}
+
+	if cfg.LeaseDuration != nil {
+		m.leaseDuration = cfg.LeaseDuration.Duration
+	}
+	if cfg.RenewPeriod != nil {
+		m.renewPeriod = cfg.RenewPeriod.Duration
+	}
+	if cfg.VirtualNodes != nil {
+		m.virtualNodes = *cfg.VirtualNodes
+	}
+
+	return m
+}
+
+// Start announces the membership of the running replica and computes the initial set of members. Afterwards, it
+// periodically renews the Lease and refreshes the members until the given context is cancelled. The Lease is
+// released on shutdown so that the remaining replicas take over immediately.
+func (m *Membership) Start(ctx context.Context) error {
+	if err := m.Sync(); err != nil {
+		return err
+	}
+
+	go wait.Until(func() {
+		if err := m.Sync(); err != nil {
+			logger.Logger.Errorf("Failed to refresh the shard members: %v", err)
+		}
+	}, m.renewPeriod, ctx.Done())
+
+	go func() {
+		<-ctx.Done()
+		if err := m.client.CoordinationV1().Leases(m.namespace).Delete(LeaseName(m.identity), &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			logger.Logger.Errorf("Failed to release the shard Lease: %v", err)
+		}
+	}()
+
+	return nil
+}
+
+// Sync renews the Lease of the running replica and recomputes the members. If the set of members has changed or the
+// grace period of a previous change has passed, all registered rebalance handlers are called.
+func (m *Membership) Sync() error {
+	now := m.now()
+
+	if err := m.renew(now); err != nil {
+		return err
+	}
+
+	leases, err := m.client.CoordinationV1().Leases(m.namespace).List(metav1.ListOptions{LabelSelector: LabelShardMember + "=true"})
+	if err != nil {
+		return err
+	}
+
+	members := sets.NewString(m.identity)
+	for _, lease := range leases.Items {
+		if lease.Spec.HolderIdentity == nil {
+			continue
+		}
+
+		expiry := m.expiry(lease)
+		if now.Before(expiry) {
+			members.Insert(*lease.Spec.HolderIdentity)
+			continue
+		}
+
+		// Leases of replicas which are gone for a longer time are cleaned up by the remaining members.
+		if now.After(expiry.Add(m.leaseDuration)) {
+			if err := m.client.CoordinationV1().Leases(m.namespace).Delete(lease.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+
+	m.lock.Lock()
+	expired := m.pruneRings(now)
+	changed := !m.members.Equal(members)
+	if changed {
+		previous := m.ring
+		if m.members.Len() == 0 {
+			// Until the running replica has joined, the keys are distributed across the other members.
+			previous = NewRing(members.Difference(sets.NewString(m.identity)).List(), m.virtualNodes)
+		}
+		m.previousRings = append(m.previousRings, previousRing{previous, now})
+		m.members = members
+		m.ring = NewRing(members.List(), m.virtualNodes)
+	}
+	handlers := append([]func(){}, m.handlers...)
+	m.lock.Unlock()
+
+	if changed {
+		logger.Logger.Infof("Shard members have changed, rebalancing Shoots across %v", members.List())
+	} else if expired {
+		logger.Logger.Infof("Grace period of the last change of the shard members has passed, taking over Shoots")
+	}
+	if changed || expired {
+		for _, handler := range handlers {
+			handler()
+		}
+	}
+	return nil
+}
+
+// pruneRings removes the previous rings whose grace period has passed. It returns true if a ring was removed.
+func (m *Membership) pruneRings(now time.Time) bool {
+	var rings []previousRing
+	for _, r := range m.previousRings {
+		if now.Before(r.until.Add(m.leaseDuration)) {
+			rings = append(rings, r)
+		}
+	}
+
+	expired := len(rings) != len(m.previousRings)
+	m.previousRings = rings
+	return expired
+}
+
+// IsResponsible returns true if the running replica is responsible for the given key. Keys which another replica was
+// responsible for within the grace period are not taken over yet.
+func (m *Membership) IsResponsible(key string) bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	if m.ring.Owner(key) != m.identity {
+		return false
+	}
+
+	now := m.now()
+	for _, r := range m.previousRings {
+		if !now.Before(r.until.Add(m.leaseDuration)) {
+			continue
+		}
+		if owner := r.ring.Owner(key); owner != "" && owner != m.identity {
+			return false
+		}
+	}
+	return true
+}
+
+// IsMember returns true if the given identity belongs to a replica which is currently alive.
+func (m *Membership) IsMember(identity string) bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.members.Has(identity)
+}
+
+// AddRebalanceHandler registers a function which is called whenever the set of members has changed.
+func (m *Membership) AddRebalanceHandler(handler func()) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.handlers = append(m.handlers, handler)
+}
+
+func (m *Membership) renew(now time.Time) error {
+	var (
+		leases               = m.client.CoordinationV1().Leases(m.namespace)
+		name                 = LeaseName(m.identity)
+		renewTime            = metav1.NewMicroTime(now)
+		leaseDurationSeconds = int32(m.leaseDuration / time.Second)
+	)
+
+	lease, err := leases.Get(name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		_, err := leases.Create(&coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: m.namespace,
+				Labels:    map[string]string{LabelShardMember: "true"},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &m.identity,
+				LeaseDurationSeconds: &leaseDurationSeconds,
+				AcquireTime:          &renewTime,
+				RenewTime:            &renewTime,
+			},
+		})
+		return err
+	}
+
+	lease = lease.DeepCopy()
+	lease.Spec.HolderIdentity = &m.identity
+	lease.Spec.LeaseDurationSeconds = &leaseDurationSeconds
+	lease.Spec.RenewTime = &renewTime
+	_, err = leases.Update(lease)
+	return err
+}
+
+func (m *Membership) expiry(lease coordinationv1.Lease) time.Time {
+	if lease.Spec.RenewTime == nil {
+		return time.Time{}
+	}
+
+	duration := m.leaseDuration
+	if lease.Spec.LeaseDurationSeconds != nil {
+		duration = time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second
+	}
+	return lease.Spec.RenewTime.Add(duration)
+}
+
+// LeaseName returns the name of the membership Lease of the replica with the given identity.
+func LeaseName(identity string) string {
+	sum := sha256.Sum256([]byte(identity))
+	return leaseNamePrefix + hex.EncodeToString(sum[:])[:16]
+}
@@ -0,0 +1,227 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sharding_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/sharding"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+var _ = Describe("Membership", func() {
+	const namespace = "garden"
+
+	var (
+		client *fake.Clientset
+		cfg    *config.ShardingConfiguration
+		now    time.Time
+		clock  = func() time.Time { return now }
+
+		keys = []string{"a/a", "a/b", "a/c", "b/a", "b/b", "b/c", "c/a", "c/b", "c/c", "d/a", "d/b", "d/c"}
+
+		newLease = func(identity string, renewTime time.Time) *coordinationv1.Lease {
+			var (
+				microTime            = metav1.NewMicroTime(renewTime)
+				leaseDurationSeconds = int32(40)
+			)
+
+			return &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      LeaseName(identity),
+					Namespace: namespace,
+					Labels:    map[string]string{LabelShardMember: "true"},
+				},
+				Spec: coordinationv1.LeaseSpec{
+					HolderIdentity:       &identity,
+					LeaseDurationSeconds: &leaseDurationSeconds,
+					RenewTime:            &microTime,
+				},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		now = time.Now()
+		client = fake.NewSimpleClientset()
+		cfg = &config.ShardingConfiguration{
+			Enabled:        true,
+			LeaseNamespace: namespace,
+			LeaseDuration:  &metav1.Duration{Duration: 40 * time.Second},
+			RenewPeriod:    &metav1.Duration{Duration: 10 * time.Second},
+		}
+	})
+
+	It("should create its own Lease and be responsible for all keys if it is alone", func() {
+		membership := NewMembership(client, "replica-1", cfg)
+
+		Expect(membership.Sync()).To(Succeed())
+
+		lease, err := client.CoordinationV1().Leases(namespace).Get(LeaseName("replica-1"), metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lease.Labels).To(HaveKeyWithValue(LabelShardMember, "true"))
+		Expect(*lease.Spec.HolderIdentity).To(Equal("replica-1"))
+		Expect(lease.Spec.RenewTime).NotTo(BeNil())
+
+		Expect(membership.IsMember("replica-1")).To(BeTrue())
+		Expect(membership.IsResponsible("foo/bar")).To(BeTrue())
+	})
+
+	It("should share the keys with other alive replicas", func() {
+		_, err := client.CoordinationV1().Leases(namespace).Create(newLease("replica-2", time.Now()))
+		Expect(err).NotTo(HaveOccurred())
+
+		var (
+			membership1 = NewMembership(client, "replica-1", cfg)
+			membership2 = NewMembership(client, "replica-2", cfg)
+		)
+		membership1.SetNow(clock)
+		membership2.SetNow(clock)
+		Expect(membership1.Sync()).To(Succeed())
+		Expect(membership2.Sync()).To(Succeed())
+
+		Expect(membership1.IsMember("replica-2")).To(BeTrue())
+
+		now = now.Add(cfg.LeaseDuration.Duration)
+		Expect(membership1.Sync()).To(Succeed())
+		Expect(membership2.Sync()).To(Succeed())
+
+		var responsible1, responsible2 int
+		for _, key := range keys {
+			Expect(membership1.IsResponsible(key)).NotTo(Equal(membership2.IsResponsible(key)))
+			if membership1.IsResponsible(key) {
+				responsible1++
+			} else {
+				responsible2++
+			}
+		}
+		Expect(responsible1).To(BeNumerically(">", 0))
+		Expect(responsible2).To(BeNumerically(">", 0))
+	})
+
+	It("should ignore expired Leases and delete long expired ones", func() {
+		_, err := client.CoordinationV1().Leases(namespace).Create(newLease("replica-2", time.Now().Add(-time.Minute)))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = client.CoordinationV1().Leases(namespace).Create(newLease("replica-3", time.Now().Add(-time.Hour)))
+		Expect(err).NotTo(HaveOccurred())
+
+		membership := NewMembership(client, "replica-1", cfg)
+		Expect(membership.Sync()).To(Succeed())
+
+		Expect(membership.IsMember("replica-2")).To(BeFalse())
+		Expect(membership.IsMember("replica-3")).To(BeFalse())
+
+		_, err = client.CoordinationV1().Leases(namespace).Get(LeaseName("replica-2"), metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = client.CoordinationV1().Leases(namespace).Get(LeaseName("replica-3"), metav1.GetOptions{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should call the rebalance handlers if the members change", func() {
+		var (
+			membership = NewMembership(client, "replica-1", cfg)
+			calls      int
+		)
+		membership.AddRebalanceHandler(func() { calls++ })
+
+		Expect(membership.Sync()).To(Succeed())
+		Expect(calls).To(Equal(1))
+
+		Expect(membership.Sync()).To(Succeed())
+		Expect(calls).To(Equal(1))
+
+		_, err := client.CoordinationV1().Leases(namespace).Create(newLease("replica-2", time.Now()))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(membership.Sync()).To(Succeed())
+		Expect(calls).To(Equal(2))
+	})
+
+	It("should not let two replicas be responsible for the same key while rebalancing", func() {
+		var (
+			membership1 = NewMembership(client, "replica-1", cfg)
+			membership2 = NewMembership(client, "replica-2", cfg)
+			calls2      int
+
+			responsibleOnce = func() {
+				for _, key := range keys {
+					Expect(membership1.IsResponsible(key) && membership2.IsResponsible(key)).To(BeFalse(), key)
+				}
+			}
+		)
+		membership1.SetNow(clock)
+		membership2.SetNow(clock)
+		membership2.AddRebalanceHandler(func() { calls2++ })
+
+		Expect(membership1.Sync()).To(Succeed())
+		for _, key := range keys {
+			Expect(membership1.IsResponsible(key)).To(BeTrue())
+		}
+
+		// replica-2 joins while replica-1 has not yet observed the new member.
+		now = now.Add(time.Second)
+		Expect(membership2.Sync()).To(Succeed())
+		Expect(calls2).To(Equal(1))
+		responsibleOnce()
+		for _, key := range keys {
+			Expect(membership2.IsResponsible(key)).To(BeFalse())
+		}
+
+		// replica-1 observes the new member within its renew period and releases the moved keys.
+		now = now.Add(cfg.RenewPeriod.Duration)
+		Expect(membership1.Sync()).To(Succeed())
+		responsibleOnce()
+
+		// replica-2 takes over the moved keys after the grace period.
+		now = now.Add(cfg.LeaseDuration.Duration - cfg.RenewPeriod.Duration)
+		Expect(membership1.Sync()).To(Succeed())
+		Expect(membership2.Sync()).To(Succeed())
+		Expect(calls2).To(Equal(2))
+		Expect(membership2.IsMember("replica-1")).To(BeTrue())
+		responsibleOnce()
+
+		var responsible2 int
+		for _, key := range keys {
+			Expect(membership1.IsResponsible(key)).NotTo(Equal(membership2.IsResponsible(key)), key)
+			if membership2.IsResponsible(key) {
+				responsible2++
+			}
+		}
+		Expect(responsible2).To(BeNumerically(">", 0))
+	})
+
+	It("should release its Lease when stopped", func() {
+		var (
+			membership  = NewMembership(client, "replica-1", cfg)
+			ctx, cancel = context.WithCancel(context.Background())
+		)
+
+		Expect(membership.Start(ctx)).To(Succeed())
+		cancel()
+
+		Eventually(func() bool {
+			_, err := client.CoordinationV1().Leases(namespace).Get(LeaseName("replica-1"), metav1.GetOptions{})
+			return apierrors.IsNotFound(err)
+		}).Should(BeTrue())
+	})
+})
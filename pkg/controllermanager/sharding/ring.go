@@ -0,0 +1,90 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sharding
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+	"strconv"
+)
+
+// Ring is a consistent hash ring which distributes keys across a set of members. Every member occupies a number of
+// virtual nodes on the ring so that the keys are spread evenly and only the keys of a lost member are moved to the
+// remaining ones.
+type Ring struct {
+	members []string
+	hashes  []uint32
+	owners  map[uint32]string
+}
+
+// NewRing creates a new consistent hash ring for the given members, each of them occupying <virtualNodes> points.
+func NewRing(members []string, virtualNodes int) *Ring {
+	if virtualNodes < 1 {
+		virtualNodes = 1
+	}
+
+	r := &Ring{
+		owners: make(map[uint32]string, len(members)*virtualNodes),
+	}
+
+	sortedMembers := append([]string{}, members...)
+	sort.Strings(sortedMembers)
+
+	for _, member := range sortedMembers {
+		if len(r.members) > 0 && r.members[len(r.members)-1] == member {
+			continue
+		}
+		r.members = append(r.members, member)
+
+		for i := 0; i < virtualNodes; i++ {
+			h := hash(member + "#" + strconv.Itoa(i))
+			// In the unlikely case of a collision the lexicographically smaller member keeps the point.
+			if _, ok := r.owners[h]; ok {
+				continue
+			}
+			r.owners[h] = member
+			r.hashes = append(r.hashes, h)
+		}
+	}
+
+	sort.Slice(r.hashes, func(i, j int) bool { return r.hashes[i] < r.hashes[j] })
+	return r
+}
+
+// Members returns the sorted list of members of the ring.
+func (r *Ring) Members() []string {
+	return append([]string{}, r.members...)
+}
+
+// Owner returns the member which is responsible for the given key. It returns an empty string if the ring does not
+// have any members.
+func (r *Ring) Owner(key string) string {
+	if len(r.hashes) == 0 {
+		return ""
+	}
+
+	h := hash(key)
+	idx := sort.Search(len(r.hashes), func(i int) bool { return r.hashes[i] >= h })
+	if idx == len(r.hashes) {
+		idx = 0
+	}
+	return r.owners[r.hashes[idx]]
+}
+
+func hash(s string) uint32 {
+	sum := sha256.Sum256([]byte(s))
+	return binary.BigEndian.Uint32(sum[:4])
+}
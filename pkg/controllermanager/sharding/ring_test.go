@@ -0,0 +1,88 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sharding_test
+
+import (
+	"fmt"
+
+	. "github.com/gardener/gardener/pkg/controllermanager/sharding"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ring", func() {
+	keys := make([]string, 0, 1000)
+	for i := 0; i < 1000; i++ {
+		keys = append(keys, fmt.Sprintf("garden-project-%d/shoot-%d", i%37, i))
+	}
+
+	It("should not return an owner if there are no members", func() {
+		Expect(NewRing(nil, 100).Owner("foo/bar")).To(BeEmpty())
+	})
+
+	It("should deduplicate and sort the members", func() {
+		Expect(NewRing([]string{"b", "a", "b"}, 10).Members()).To(Equal([]string{"a", "b"}))
+	})
+
+	It("should assign all keys to the only member", func() {
+		ring := NewRing([]string{"a"}, 100)
+		for _, key := range keys {
+			Expect(ring.Owner(key)).To(Equal("a"))
+		}
+	})
+
+	It("should distribute the keys across all members", func() {
+		var (
+			ring   = NewRing([]string{"a", "b", "c"}, 100)
+			counts = map[string]int{}
+		)
+
+		for _, key := range keys {
+			counts[ring.Owner(key)]++
+		}
+
+		Expect(counts).To(HaveLen(3))
+		for _, count := range counts {
+			Expect(count).To(BeNumerically(">", 200))
+		}
+	})
+
+	It("should not depend on the order of the members", func() {
+		var (
+			ring1 = NewRing([]string{"a", "b", "c"}, 100)
+			ring2 = NewRing([]string{"c", "a", "b"}, 100)
+		)
+
+		for _, key := range keys {
+			Expect(ring1.Owner(key)).To(Equal(ring2.Owner(key)))
+		}
+	})
+
+	It("should only move the keys of a lost member", func() {
+		var (
+			before = NewRing([]string{"a", "b", "c"}, 100)
+			after  = NewRing([]string{"a", "c"}, 100)
+		)
+
+		for _, key := range keys {
+			if owner := before.Owner(key); owner != "b" {
+				Expect(after.Owner(key)).To(Equal(owner))
+			} else {
+				Expect(after.Owner(key)).To(Or(Equal("a"), Equal("c")))
+			}
+		}
+	})
+})
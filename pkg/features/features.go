@@ -30,4 +30,9 @@ const (
 	// owner @mvladev, @ialidzhikov
 	// alpha: v0.13.0
 	Logging utilfeature.Feature = "Logging"
+
+	// BackupExtensions enables the management of backups via the `BackupBucket` and `BackupEntry` extension resources
+	// instead of Terraform.
+	// alpha: v0.27.0
+	BackupExtensions utilfeature.Feature = "BackupExtensions"
 )
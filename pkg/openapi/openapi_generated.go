@@ -34,396 +34,418 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Cloud":                          schema_pkg_apis_core_v1alpha1_Cloud(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ClusterInfo":                    schema_pkg_apis_core_v1alpha1_ClusterInfo(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition":                      schema_pkg_apis_core_v1alpha1_Condition(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerDeployment":           schema_pkg_apis_core_v1alpha1_ControllerDeployment(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallation":         schema_pkg_apis_core_v1alpha1_ControllerInstallation(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallationList":     schema_pkg_apis_core_v1alpha1_ControllerInstallationList(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallationSpec":     schema_pkg_apis_core_v1alpha1_ControllerInstallationSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallationStatus":   schema_pkg_apis_core_v1alpha1_ControllerInstallationStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistration":         schema_pkg_apis_core_v1alpha1_ControllerRegistration(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistrationList":     schema_pkg_apis_core_v1alpha1_ControllerRegistrationList(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistrationSpec":     schema_pkg_apis_core_v1alpha1_ControllerRegistrationSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerResource":             schema_pkg_apis_core_v1alpha1_ControllerResource(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Endpoint":                       schema_pkg_apis_core_v1alpha1_Endpoint(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.K8SNetworks":                    schema_pkg_apis_core_v1alpha1_K8SNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Kubernetes":                     schema_pkg_apis_core_v1alpha1_Kubernetes(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError":                      schema_pkg_apis_core_v1alpha1_LastError(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation":                  schema_pkg_apis_core_v1alpha1_LastOperation(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Plant":                          schema_pkg_apis_core_v1alpha1_Plant(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantList":                      schema_pkg_apis_core_v1alpha1_PlantList(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantSpec":                      schema_pkg_apis_core_v1alpha1_PlantSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantStatus":                    schema_pkg_apis_core_v1alpha1_PlantStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig":                 schema_pkg_apis_core_v1alpha1_ProviderConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSCloud":                      schema_pkg_apis_garden_v1beta1_AWSCloud(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSConstraints":                schema_pkg_apis_garden_v1beta1_AWSConstraints(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSNetworks":                   schema_pkg_apis_garden_v1beta1_AWSNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSProfile":                    schema_pkg_apis_garden_v1beta1_AWSProfile(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSVPC":                        schema_pkg_apis_garden_v1beta1_AWSVPC(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSWorker":                     schema_pkg_apis_garden_v1beta1_AWSWorker(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addon":                         schema_pkg_apis_garden_v1beta1_Addon(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AddonClusterAutoscaler":        schema_pkg_apis_garden_v1beta1_AddonClusterAutoscaler(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons":                        schema_pkg_apis_garden_v1beta1_Addons(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AdmissionPlugin":               schema_pkg_apis_garden_v1beta1_AdmissionPlugin(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Alicloud":                      schema_pkg_apis_garden_v1beta1_Alicloud(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudConstraints":           schema_pkg_apis_garden_v1beta1_AlicloudConstraints(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudMachineType":           schema_pkg_apis_garden_v1beta1_AlicloudMachineType(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudNetworks":              schema_pkg_apis_garden_v1beta1_AlicloudNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudProfile":               schema_pkg_apis_garden_v1beta1_AlicloudProfile(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudVPC":                   schema_pkg_apis_garden_v1beta1_AlicloudVPC(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudVolumeType":            schema_pkg_apis_garden_v1beta1_AlicloudVolumeType(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudWorker":                schema_pkg_apis_garden_v1beta1_AlicloudWorker(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditConfig":                   schema_pkg_apis_garden_v1beta1_AuditConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditPolicy":                   schema_pkg_apis_garden_v1beta1_AuditPolicy(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureCloud":                    schema_pkg_apis_garden_v1beta1_AzureCloud(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureConstraints":              schema_pkg_apis_garden_v1beta1_AzureConstraints(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureDomainCount":              schema_pkg_apis_garden_v1beta1_AzureDomainCount(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureNetworks":                 schema_pkg_apis_garden_v1beta1_AzureNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureProfile":                  schema_pkg_apis_garden_v1beta1_AzureProfile(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureResourceGroup":            schema_pkg_apis_garden_v1beta1_AzureResourceGroup(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureVNet":                     schema_pkg_apis_garden_v1beta1_AzureVNet(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureWorker":                   schema_pkg_apis_garden_v1beta1_AzureWorker(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Backup":                        schema_pkg_apis_garden_v1beta1_Backup(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructure":          schema_pkg_apis_garden_v1beta1_BackupInfrastructure(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureList":      schema_pkg_apis_garden_v1beta1_BackupInfrastructureList(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureSpec":      schema_pkg_apis_garden_v1beta1_BackupInfrastructureSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureStatus":    schema_pkg_apis_garden_v1beta1_BackupInfrastructureStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud":                         schema_pkg_apis_garden_v1beta1_Cloud(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudControllerManagerConfig":  schema_pkg_apis_garden_v1beta1_CloudControllerManagerConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfile":                  schema_pkg_apis_garden_v1beta1_CloudProfile(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfileList":              schema_pkg_apis_garden_v1beta1_CloudProfileList(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfileSpec":              schema_pkg_apis_garden_v1beta1_CloudProfileSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ClusterAutoscaler":             schema_pkg_apis_garden_v1beta1_ClusterAutoscaler(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS":                           schema_pkg_apis_garden_v1beta1_DNS(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint":         schema_pkg_apis_garden_v1beta1_DNSProviderConstraint(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Extension":                     schema_pkg_apis_garden_v1beta1_Extension(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPCloud":                      schema_pkg_apis_garden_v1beta1_GCPCloud(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPConstraints":                schema_pkg_apis_garden_v1beta1_GCPConstraints(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPNetworks":                   schema_pkg_apis_garden_v1beta1_GCPNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPProfile":                    schema_pkg_apis_garden_v1beta1_GCPProfile(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPVPC":                        schema_pkg_apis_garden_v1beta1_GCPVPC(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPWorker":                     schema_pkg_apis_garden_v1beta1_GCPWorker(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener":                      schema_pkg_apis_garden_v1beta1_Gardener(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration":              schema_pkg_apis_garden_v1beta1_GardenerDuration(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Heapster":                      schema_pkg_apis_garden_v1beta1_Heapster(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HelmTiller":                    schema_pkg_apis_garden_v1beta1_HelmTiller(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation":                   schema_pkg_apis_garden_v1beta1_Hibernation(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HibernationSchedule":           schema_pkg_apis_garden_v1beta1_HibernationSchedule(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HorizontalPodAutoscalerConfig": schema_pkg_apis_garden_v1beta1_HorizontalPodAutoscalerConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAM":                      schema_pkg_apis_garden_v1beta1_Kube2IAM(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAMRole":                  schema_pkg_apis_garden_v1beta1_Kube2IAMRole(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeAPIServerConfig":           schema_pkg_apis_garden_v1beta1_KubeAPIServerConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeControllerManagerConfig":   schema_pkg_apis_garden_v1beta1_KubeControllerManagerConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeLego":                      schema_pkg_apis_garden_v1beta1_KubeLego(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeProxyConfig":               schema_pkg_apis_garden_v1beta1_KubeProxyConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeSchedulerConfig":           schema_pkg_apis_garden_v1beta1_KubeSchedulerConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig":                 schema_pkg_apis_garden_v1beta1_KubeletConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kubernetes":                    schema_pkg_apis_garden_v1beta1_Kubernetes(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConfig":              schema_pkg_apis_garden_v1beta1_KubernetesConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints":         schema_pkg_apis_garden_v1beta1_KubernetesConstraints(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesDashboard":           schema_pkg_apis_garden_v1beta1_KubernetesDashboard(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImage":                  schema_pkg_apis_garden_v1beta1_MachineImage(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType":                   schema_pkg_apis_garden_v1beta1_MachineType(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance":                   schema_pkg_apis_garden_v1beta1_Maintenance(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceAutoUpdate":         schema_pkg_apis_garden_v1beta1_MaintenanceAutoUpdate(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceTimeWindow":         schema_pkg_apis_garden_v1beta1_MaintenanceTimeWindow(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Monocular":                     schema_pkg_apis_garden_v1beta1_Monocular(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.NginxIngress":                  schema_pkg_apis_garden_v1beta1_NginxIngress(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OIDCConfig":                    schema_pkg_apis_garden_v1beta1_OIDCConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackCloud":                schema_pkg_apis_garden_v1beta1_OpenStackCloud(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackConstraints":          schema_pkg_apis_garden_v1beta1_OpenStackConstraints(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackFloatingPool":         schema_pkg_apis_garden_v1beta1_OpenStackFloatingPool(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackLoadBalancerProvider": schema_pkg_apis_garden_v1beta1_OpenStackLoadBalancerProvider(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineType":          schema_pkg_apis_garden_v1beta1_OpenStackMachineType(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackNetworks":             schema_pkg_apis_garden_v1beta1_OpenStackNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackProfile":              schema_pkg_apis_garden_v1beta1_OpenStackProfile(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackRouter":               schema_pkg_apis_garden_v1beta1_OpenStackRouter(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackWorker":               schema_pkg_apis_garden_v1beta1_OpenStackWorker(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketCloud":                   schema_pkg_apis_garden_v1beta1_PacketCloud(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketConstraints":             schema_pkg_apis_garden_v1beta1_PacketConstraints(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketNetworks":                schema_pkg_apis_garden_v1beta1_PacketNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketProfile":                 schema_pkg_apis_garden_v1beta1_PacketProfile(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketWorker":                  schema_pkg_apis_garden_v1beta1_PacketWorker(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Project":                       schema_pkg_apis_garden_v1beta1_Project(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectList":                   schema_pkg_apis_garden_v1beta1_ProjectList(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectSpec":                   schema_pkg_apis_garden_v1beta1_ProjectSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectStatus":                 schema_pkg_apis_garden_v1beta1_ProjectStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Quota":                         schema_pkg_apis_garden_v1beta1_Quota(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaList":                     schema_pkg_apis_garden_v1beta1_QuotaList(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaSpec":                     schema_pkg_apis_garden_v1beta1_QuotaSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBinding":                 schema_pkg_apis_garden_v1beta1_SecretBinding(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBindingList":             schema_pkg_apis_garden_v1beta1_SecretBindingList(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Seed":                          schema_pkg_apis_garden_v1beta1_Seed(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedCloud":                     schema_pkg_apis_garden_v1beta1_SeedCloud(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedList":                      schema_pkg_apis_garden_v1beta1_SeedList(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedNetworks":                  schema_pkg_apis_garden_v1beta1_SeedNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSpec":                      schema_pkg_apis_garden_v1beta1_SeedSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedStatus":                    schema_pkg_apis_garden_v1beta1_SeedStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Shoot":                         schema_pkg_apis_garden_v1beta1_Shoot(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootList":                     schema_pkg_apis_garden_v1beta1_ShootList(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootSpec":                     schema_pkg_apis_garden_v1beta1_ShootSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootStatus":                   schema_pkg_apis_garden_v1beta1_ShootStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType":                    schema_pkg_apis_garden_v1beta1_VolumeType(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Worker":                        schema_pkg_apis_garden_v1beta1_Worker(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone":                          schema_pkg_apis_garden_v1beta1_Zone(ref),
-		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
-		"k8s.io/api/core/v1.Affinity":                                                        schema_k8sio_api_core_v1_Affinity(ref),
-		"k8s.io/api/core/v1.AttachedVolume":                                                  schema_k8sio_api_core_v1_AttachedVolume(ref),
-		"k8s.io/api/core/v1.AvoidPods":                                                       schema_k8sio_api_core_v1_AvoidPods(ref),
-		"k8s.io/api/core/v1.AzureDiskVolumeSource":                                           schema_k8sio_api_core_v1_AzureDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.AzureFilePersistentVolumeSource":                                 schema_k8sio_api_core_v1_AzureFilePersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.AzureFileVolumeSource":                                           schema_k8sio_api_core_v1_AzureFileVolumeSource(ref),
-		"k8s.io/api/core/v1.Binding":                                                         schema_k8sio_api_core_v1_Binding(ref),
-		"k8s.io/api/core/v1.CSIPersistentVolumeSource":                                       schema_k8sio_api_core_v1_CSIPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.CSIVolumeSource":                                                 schema_k8sio_api_core_v1_CSIVolumeSource(ref),
-		"k8s.io/api/core/v1.Capabilities":                                                    schema_k8sio_api_core_v1_Capabilities(ref),
-		"k8s.io/api/core/v1.CephFSPersistentVolumeSource":                                    schema_k8sio_api_core_v1_CephFSPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.CephFSVolumeSource":                                              schema_k8sio_api_core_v1_CephFSVolumeSource(ref),
-		"k8s.io/api/core/v1.CinderPersistentVolumeSource":                                    schema_k8sio_api_core_v1_CinderPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.CinderVolumeSource":                                              schema_k8sio_api_core_v1_CinderVolumeSource(ref),
-		"k8s.io/api/core/v1.ClientIPConfig":                                                  schema_k8sio_api_core_v1_ClientIPConfig(ref),
-		"k8s.io/api/core/v1.ComponentCondition":                                              schema_k8sio_api_core_v1_ComponentCondition(ref),
-		"k8s.io/api/core/v1.ComponentStatus":                                                 schema_k8sio_api_core_v1_ComponentStatus(ref),
-		"k8s.io/api/core/v1.ComponentStatusList":                                             schema_k8sio_api_core_v1_ComponentStatusList(ref),
-		"k8s.io/api/core/v1.ConfigMap":                                                       schema_k8sio_api_core_v1_ConfigMap(ref),
-		"k8s.io/api/core/v1.ConfigMapEnvSource":                                              schema_k8sio_api_core_v1_ConfigMapEnvSource(ref),
-		"k8s.io/api/core/v1.ConfigMapKeySelector":                                            schema_k8sio_api_core_v1_ConfigMapKeySelector(ref),
-		"k8s.io/api/core/v1.ConfigMapList":                                                   schema_k8sio_api_core_v1_ConfigMapList(ref),
-		"k8s.io/api/core/v1.ConfigMapNodeConfigSource":                                       schema_k8sio_api_core_v1_ConfigMapNodeConfigSource(ref),
-		"k8s.io/api/core/v1.ConfigMapProjection":                                             schema_k8sio_api_core_v1_ConfigMapProjection(ref),
-		"k8s.io/api/core/v1.ConfigMapVolumeSource":                                           schema_k8sio_api_core_v1_ConfigMapVolumeSource(ref),
-		"k8s.io/api/core/v1.Container":                                                       schema_k8sio_api_core_v1_Container(ref),
-		"k8s.io/api/core/v1.ContainerImage":                                                  schema_k8sio_api_core_v1_ContainerImage(ref),
-		"k8s.io/api/core/v1.ContainerPort":                                                   schema_k8sio_api_core_v1_ContainerPort(ref),
-		"k8s.io/api/core/v1.ContainerState":                                                  schema_k8sio_api_core_v1_ContainerState(ref),
-		"k8s.io/api/core/v1.ContainerStateRunning":                                           schema_k8sio_api_core_v1_ContainerStateRunning(ref),
-		"k8s.io/api/core/v1.ContainerStateTerminated":                                        schema_k8sio_api_core_v1_ContainerStateTerminated(ref),
-		"k8s.io/api/core/v1.ContainerStateWaiting":                                           schema_k8sio_api_core_v1_ContainerStateWaiting(ref),
-		"k8s.io/api/core/v1.ContainerStatus":                                                 schema_k8sio_api_core_v1_ContainerStatus(ref),
-		"k8s.io/api/core/v1.DaemonEndpoint":                                                  schema_k8sio_api_core_v1_DaemonEndpoint(ref),
-		"k8s.io/api/core/v1.DownwardAPIProjection":                                           schema_k8sio_api_core_v1_DownwardAPIProjection(ref),
-		"k8s.io/api/core/v1.DownwardAPIVolumeFile":                                           schema_k8sio_api_core_v1_DownwardAPIVolumeFile(ref),
-		"k8s.io/api/core/v1.DownwardAPIVolumeSource":                                         schema_k8sio_api_core_v1_DownwardAPIVolumeSource(ref),
-		"k8s.io/api/core/v1.EmptyDirVolumeSource":                                            schema_k8sio_api_core_v1_EmptyDirVolumeSource(ref),
-		"k8s.io/api/core/v1.EndpointAddress":                                                 schema_k8sio_api_core_v1_EndpointAddress(ref),
-		"k8s.io/api/core/v1.EndpointPort":                                                    schema_k8sio_api_core_v1_EndpointPort(ref),
-		"k8s.io/api/core/v1.EndpointSubset":                                                  schema_k8sio_api_core_v1_EndpointSubset(ref),
-		"k8s.io/api/core/v1.Endpoints":                                                       schema_k8sio_api_core_v1_Endpoints(ref),
-		"k8s.io/api/core/v1.EndpointsList":                                                   schema_k8sio_api_core_v1_EndpointsList(ref),
-		"k8s.io/api/core/v1.EnvFromSource":                                                   schema_k8sio_api_core_v1_EnvFromSource(ref),
-		"k8s.io/api/core/v1.EnvVar":                                                          schema_k8sio_api_core_v1_EnvVar(ref),
-		"k8s.io/api/core/v1.EnvVarSource":                                                    schema_k8sio_api_core_v1_EnvVarSource(ref),
-		"k8s.io/api/core/v1.Event":                                                           schema_k8sio_api_core_v1_Event(ref),
-		"k8s.io/api/core/v1.EventList":                                                       schema_k8sio_api_core_v1_EventList(ref),
-		"k8s.io/api/core/v1.EventSeries":                                                     schema_k8sio_api_core_v1_EventSeries(ref),
-		"k8s.io/api/core/v1.EventSource":                                                     schema_k8sio_api_core_v1_EventSource(ref),
-		"k8s.io/api/core/v1.ExecAction":                                                      schema_k8sio_api_core_v1_ExecAction(ref),
-		"k8s.io/api/core/v1.FCVolumeSource":                                                  schema_k8sio_api_core_v1_FCVolumeSource(ref),
-		"k8s.io/api/core/v1.FlexPersistentVolumeSource":                                      schema_k8sio_api_core_v1_FlexPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.FlexVolumeSource":                                                schema_k8sio_api_core_v1_FlexVolumeSource(ref),
-		"k8s.io/api/core/v1.FlockerVolumeSource":                                             schema_k8sio_api_core_v1_FlockerVolumeSource(ref),
-		"k8s.io/api/core/v1.GCEPersistentDiskVolumeSource":                                   schema_k8sio_api_core_v1_GCEPersistentDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.GitRepoVolumeSource":                                             schema_k8sio_api_core_v1_GitRepoVolumeSource(ref),
-		"k8s.io/api/core/v1.GlusterfsPersistentVolumeSource":                                 schema_k8sio_api_core_v1_GlusterfsPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.GlusterfsVolumeSource":                                           schema_k8sio_api_core_v1_GlusterfsVolumeSource(ref),
-		"k8s.io/api/core/v1.HTTPGetAction":                                                   schema_k8sio_api_core_v1_HTTPGetAction(ref),
-		"k8s.io/api/core/v1.HTTPHeader":                                                      schema_k8sio_api_core_v1_HTTPHeader(ref),
-		"k8s.io/api/core/v1.Handler":                                                         schema_k8sio_api_core_v1_Handler(ref),
-		"k8s.io/api/core/v1.HostAlias":                                                       schema_k8sio_api_core_v1_HostAlias(ref),
-		"k8s.io/api/core/v1.HostPathVolumeSource":                                            schema_k8sio_api_core_v1_HostPathVolumeSource(ref),
-		"k8s.io/api/core/v1.ISCSIPersistentVolumeSource":                                     schema_k8sio_api_core_v1_ISCSIPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.ISCSIVolumeSource":                                               schema_k8sio_api_core_v1_ISCSIVolumeSource(ref),
-		"k8s.io/api/core/v1.KeyToPath":                                                       schema_k8sio_api_core_v1_KeyToPath(ref),
-		"k8s.io/api/core/v1.Lifecycle":                                                       schema_k8sio_api_core_v1_Lifecycle(ref),
-		"k8s.io/api/core/v1.LimitRange":                                                      schema_k8sio_api_core_v1_LimitRange(ref),
-		"k8s.io/api/core/v1.LimitRangeItem":                                                  schema_k8sio_api_core_v1_LimitRangeItem(ref),
-		"k8s.io/api/core/v1.LimitRangeList":                                                  schema_k8sio_api_core_v1_LimitRangeList(ref),
-		"k8s.io/api/core/v1.LimitRangeSpec":                                                  schema_k8sio_api_core_v1_LimitRangeSpec(ref),
-		"k8s.io/api/core/v1.List":                                                            schema_k8sio_api_core_v1_List(ref),
-		"k8s.io/api/core/v1.LoadBalancerIngress":                                             schema_k8sio_api_core_v1_LoadBalancerIngress(ref),
-		"k8s.io/api/core/v1.LoadBalancerStatus":                                              schema_k8sio_api_core_v1_LoadBalancerStatus(ref),
-		"k8s.io/api/core/v1.LocalObjectReference":                                            schema_k8sio_api_core_v1_LocalObjectReference(ref),
-		"k8s.io/api/core/v1.LocalVolumeSource":                                               schema_k8sio_api_core_v1_LocalVolumeSource(ref),
-		"k8s.io/api/core/v1.NFSVolumeSource":                                                 schema_k8sio_api_core_v1_NFSVolumeSource(ref),
-		"k8s.io/api/core/v1.Namespace":                                                       schema_k8sio_api_core_v1_Namespace(ref),
-		"k8s.io/api/core/v1.NamespaceList":                                                   schema_k8sio_api_core_v1_NamespaceList(ref),
-		"k8s.io/api/core/v1.NamespaceSpec":                                                   schema_k8sio_api_core_v1_NamespaceSpec(ref),
-		"k8s.io/api/core/v1.NamespaceStatus":                                                 schema_k8sio_api_core_v1_NamespaceStatus(ref),
-		"k8s.io/api/core/v1.Node":                                                            schema_k8sio_api_core_v1_Node(ref),
-		"k8s.io/api/core/v1.NodeAddress":                                                     schema_k8sio_api_core_v1_NodeAddress(ref),
-		"k8s.io/api/core/v1.NodeAffinity":                                                    schema_k8sio_api_core_v1_NodeAffinity(ref),
-		"k8s.io/api/core/v1.NodeCondition":                                                   schema_k8sio_api_core_v1_NodeCondition(ref),
-		"k8s.io/api/core/v1.NodeConfigSource":                                                schema_k8sio_api_core_v1_NodeConfigSource(ref),
-		"k8s.io/api/core/v1.NodeConfigStatus":                                                schema_k8sio_api_core_v1_NodeConfigStatus(ref),
-		"k8s.io/api/core/v1.NodeDaemonEndpoints":                                             schema_k8sio_api_core_v1_NodeDaemonEndpoints(ref),
-		"k8s.io/api/core/v1.NodeList":                                                        schema_k8sio_api_core_v1_NodeList(ref),
-		"k8s.io/api/core/v1.NodeProxyOptions":                                                schema_k8sio_api_core_v1_NodeProxyOptions(ref),
-		"k8s.io/api/core/v1.NodeResources":                                                   schema_k8sio_api_core_v1_NodeResources(ref),
-		"k8s.io/api/core/v1.NodeSelector":                                                    schema_k8sio_api_core_v1_NodeSelector(ref),
-		"k8s.io/api/core/v1.NodeSelectorRequirement":                                         schema_k8sio_api_core_v1_NodeSelectorRequirement(ref),
-		"k8s.io/api/core/v1.NodeSelectorTerm":                                                schema_k8sio_api_core_v1_NodeSelectorTerm(ref),
-		"k8s.io/api/core/v1.NodeSpec":                                                        schema_k8sio_api_core_v1_NodeSpec(ref),
-		"k8s.io/api/core/v1.NodeStatus":                                                      schema_k8sio_api_core_v1_NodeStatus(ref),
-		"k8s.io/api/core/v1.NodeSystemInfo":                                                  schema_k8sio_api_core_v1_NodeSystemInfo(ref),
-		"k8s.io/api/core/v1.ObjectFieldSelector":                                             schema_k8sio_api_core_v1_ObjectFieldSelector(ref),
-		"k8s.io/api/core/v1.ObjectReference":                                                 schema_k8sio_api_core_v1_ObjectReference(ref),
-		"k8s.io/api/core/v1.PersistentVolume":                                                schema_k8sio_api_core_v1_PersistentVolume(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaim":                                           schema_k8sio_api_core_v1_PersistentVolumeClaim(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimCondition":                                  schema_k8sio_api_core_v1_PersistentVolumeClaimCondition(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimList":                                       schema_k8sio_api_core_v1_PersistentVolumeClaimList(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimSpec":                                       schema_k8sio_api_core_v1_PersistentVolumeClaimSpec(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimStatus":                                     schema_k8sio_api_core_v1_PersistentVolumeClaimStatus(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource":                               schema_k8sio_api_core_v1_PersistentVolumeClaimVolumeSource(ref),
-		"k8s.io/api/core/v1.PersistentVolumeList":                                            schema_k8sio_api_core_v1_PersistentVolumeList(ref),
-		"k8s.io/api/core/v1.PersistentVolumeSource":                                          schema_k8sio_api_core_v1_PersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.PersistentVolumeSpec":                                            schema_k8sio_api_core_v1_PersistentVolumeSpec(ref),
-		"k8s.io/api/core/v1.PersistentVolumeStatus":                                          schema_k8sio_api_core_v1_PersistentVolumeStatus(ref),
-		"k8s.io/api/core/v1.PhotonPersistentDiskVolumeSource":                                schema_k8sio_api_core_v1_PhotonPersistentDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.Pod":                                                             schema_k8sio_api_core_v1_Pod(ref),
-		"k8s.io/api/core/v1.PodAffinity":                                                     schema_k8sio_api_core_v1_PodAffinity(ref),
-		"k8s.io/api/core/v1.PodAffinityTerm":                                                 schema_k8sio_api_core_v1_PodAffinityTerm(ref),
-		"k8s.io/api/core/v1.PodAntiAffinity":                                                 schema_k8sio_api_core_v1_PodAntiAffinity(ref),
-		"k8s.io/api/core/v1.PodAttachOptions":                                                schema_k8sio_api_core_v1_PodAttachOptions(ref),
-		"k8s.io/api/core/v1.PodCondition":                                                    schema_k8sio_api_core_v1_PodCondition(ref),
-		"k8s.io/api/core/v1.PodDNSConfig":                                                    schema_k8sio_api_core_v1_PodDNSConfig(ref),
-		"k8s.io/api/core/v1.PodDNSConfigOption":                                              schema_k8sio_api_core_v1_PodDNSConfigOption(ref),
-		"k8s.io/api/core/v1.PodExecOptions":                                                  schema_k8sio_api_core_v1_PodExecOptions(ref),
-		"k8s.io/api/core/v1.PodList":                                                         schema_k8sio_api_core_v1_PodList(ref),
-		"k8s.io/api/core/v1.PodLogOptions":                                                   schema_k8sio_api_core_v1_PodLogOptions(ref),
-		"k8s.io/api/core/v1.PodPortForwardOptions":                                           schema_k8sio_api_core_v1_PodPortForwardOptions(ref),
-		"k8s.io/api/core/v1.PodProxyOptions":                                                 schema_k8sio_api_core_v1_PodProxyOptions(ref),
-		"k8s.io/api/core/v1.PodReadinessGate":                                                schema_k8sio_api_core_v1_PodReadinessGate(ref),
-		"k8s.io/api/core/v1.PodSecurityContext":                                              schema_k8sio_api_core_v1_PodSecurityContext(ref),
-		"k8s.io/api/core/v1.PodSignature":                                                    schema_k8sio_api_core_v1_PodSignature(ref),
-		"k8s.io/api/core/v1.PodSpec":                                                         schema_k8sio_api_core_v1_PodSpec(ref),
-		"k8s.io/api/core/v1.PodStatus":                                                       schema_k8sio_api_core_v1_PodStatus(ref),
-		"k8s.io/api/core/v1.PodStatusResult":                                                 schema_k8sio_api_core_v1_PodStatusResult(ref),
-		"k8s.io/api/core/v1.PodTemplate":                                                     schema_k8sio_api_core_v1_PodTemplate(ref),
-		"k8s.io/api/core/v1.PodTemplateList":                                                 schema_k8sio_api_core_v1_PodTemplateList(ref),
-		"k8s.io/api/core/v1.PodTemplateSpec":                                                 schema_k8sio_api_core_v1_PodTemplateSpec(ref),
-		"k8s.io/api/core/v1.PortworxVolumeSource":                                            schema_k8sio_api_core_v1_PortworxVolumeSource(ref),
-		"k8s.io/api/core/v1.PreferAvoidPodsEntry":                                            schema_k8sio_api_core_v1_PreferAvoidPodsEntry(ref),
-		"k8s.io/api/core/v1.PreferredSchedulingTerm":                                         schema_k8sio_api_core_v1_PreferredSchedulingTerm(ref),
-		"k8s.io/api/core/v1.Probe":                                                           schema_k8sio_api_core_v1_Probe(ref),
-		"k8s.io/api/core/v1.ProjectedVolumeSource":                                           schema_k8sio_api_core_v1_ProjectedVolumeSource(ref),
-		"k8s.io/api/core/v1.QuobyteVolumeSource":                                             schema_k8sio_api_core_v1_QuobyteVolumeSource(ref),
-		"k8s.io/api/core/v1.RBDPersistentVolumeSource":                                       schema_k8sio_api_core_v1_RBDPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.RBDVolumeSource":                                                 schema_k8sio_api_core_v1_RBDVolumeSource(ref),
-		"k8s.io/api/core/v1.RangeAllocation":                                                 schema_k8sio_api_core_v1_RangeAllocation(ref),
-		"k8s.io/api/core/v1.ReplicationController":                                           schema_k8sio_api_core_v1_ReplicationController(ref),
-		"k8s.io/api/core/v1.ReplicationControllerCondition":                                  schema_k8sio_api_core_v1_ReplicationControllerCondition(ref),
-		"k8s.io/api/core/v1.ReplicationControllerList":                                       schema_k8sio_api_core_v1_ReplicationControllerList(ref),
-		"k8s.io/api/core/v1.ReplicationControllerSpec":                                       schema_k8sio_api_core_v1_ReplicationControllerSpec(ref),
-		"k8s.io/api/core/v1.ReplicationControllerStatus":                                     schema_k8sio_api_core_v1_ReplicationControllerStatus(ref),
-		"k8s.io/api/core/v1.ResourceFieldSelector":                                           schema_k8sio_api_core_v1_ResourceFieldSelector(ref),
-		"k8s.io/api/core/v1.ResourceQuota":                                                   schema_k8sio_api_core_v1_ResourceQuota(ref),
-		"k8s.io/api/core/v1.ResourceQuotaList":                                               schema_k8sio_api_core_v1_ResourceQuotaList(ref),
-		"k8s.io/api/core/v1.ResourceQuotaSpec":                                               schema_k8sio_api_core_v1_ResourceQuotaSpec(ref),
-		"k8s.io/api/core/v1.ResourceQuotaStatus":                                             schema_k8sio_api_core_v1_ResourceQuotaStatus(ref),
-		"k8s.io/api/core/v1.ResourceRequirements":                                            schema_k8sio_api_core_v1_ResourceRequirements(ref),
-		"k8s.io/api/core/v1.SELinuxOptions":                                                  schema_k8sio_api_core_v1_SELinuxOptions(ref),
-		"k8s.io/api/core/v1.ScaleIOPersistentVolumeSource":                                   schema_k8sio_api_core_v1_ScaleIOPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.ScaleIOVolumeSource":                                             schema_k8sio_api_core_v1_ScaleIOVolumeSource(ref),
-		"k8s.io/api/core/v1.ScopeSelector":                                                   schema_k8sio_api_core_v1_ScopeSelector(ref),
-		"k8s.io/api/core/v1.ScopedResourceSelectorRequirement":                               schema_k8sio_api_core_v1_ScopedResourceSelectorRequirement(ref),
-		"k8s.io/api/core/v1.Secret":                                                          schema_k8sio_api_core_v1_Secret(ref),
-		"k8s.io/api/core/v1.SecretEnvSource":                                                 schema_k8sio_api_core_v1_SecretEnvSource(ref),
-		"k8s.io/api/core/v1.SecretKeySelector":                                               schema_k8sio_api_core_v1_SecretKeySelector(ref),
-		"k8s.io/api/core/v1.SecretList":                                                      schema_k8sio_api_core_v1_SecretList(ref),
-		"k8s.io/api/core/v1.SecretProjection":                                                schema_k8sio_api_core_v1_SecretProjection(ref),
-		"k8s.io/api/core/v1.SecretReference":                                                 schema_k8sio_api_core_v1_SecretReference(ref),
-		"k8s.io/api/core/v1.SecretVolumeSource":                                              schema_k8sio_api_core_v1_SecretVolumeSource(ref),
-		"k8s.io/api/core/v1.SecurityContext":                                                 schema_k8sio_api_core_v1_SecurityContext(ref),
-		"k8s.io/api/core/v1.SerializedReference":                                             schema_k8sio_api_core_v1_SerializedReference(ref),
-		"k8s.io/api/core/v1.Service":                                                         schema_k8sio_api_core_v1_Service(ref),
-		"k8s.io/api/core/v1.ServiceAccount":                                                  schema_k8sio_api_core_v1_ServiceAccount(ref),
-		"k8s.io/api/core/v1.ServiceAccountList":                                              schema_k8sio_api_core_v1_ServiceAccountList(ref),
-		"k8s.io/api/core/v1.ServiceAccountTokenProjection":                                   schema_k8sio_api_core_v1_ServiceAccountTokenProjection(ref),
-		"k8s.io/api/core/v1.ServiceList":                                                     schema_k8sio_api_core_v1_ServiceList(ref),
-		"k8s.io/api/core/v1.ServicePort":                                                     schema_k8sio_api_core_v1_ServicePort(ref),
-		"k8s.io/api/core/v1.ServiceProxyOptions":                                             schema_k8sio_api_core_v1_ServiceProxyOptions(ref),
-		"k8s.io/api/core/v1.ServiceSpec":                                                     schema_k8sio_api_core_v1_ServiceSpec(ref),
-		"k8s.io/api/core/v1.ServiceStatus":                                                   schema_k8sio_api_core_v1_ServiceStatus(ref),
-		"k8s.io/api/core/v1.SessionAffinityConfig":                                           schema_k8sio_api_core_v1_SessionAffinityConfig(ref),
-		"k8s.io/api/core/v1.StorageOSPersistentVolumeSource":                                 schema_k8sio_api_core_v1_StorageOSPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.StorageOSVolumeSource":                                           schema_k8sio_api_core_v1_StorageOSVolumeSource(ref),
-		"k8s.io/api/core/v1.Sysctl":                                                          schema_k8sio_api_core_v1_Sysctl(ref),
-		"k8s.io/api/core/v1.TCPSocketAction":                                                 schema_k8sio_api_core_v1_TCPSocketAction(ref),
-		"k8s.io/api/core/v1.Taint":                                                           schema_k8sio_api_core_v1_Taint(ref),
-		"k8s.io/api/core/v1.Toleration":                                                      schema_k8sio_api_core_v1_Toleration(ref),
-		"k8s.io/api/core/v1.TopologySelectorLabelRequirement":                                schema_k8sio_api_core_v1_TopologySelectorLabelRequirement(ref),
-		"k8s.io/api/core/v1.TopologySelectorTerm":                                            schema_k8sio_api_core_v1_TopologySelectorTerm(ref),
-		"k8s.io/api/core/v1.TypedLocalObjectReference":                                       schema_k8sio_api_core_v1_TypedLocalObjectReference(ref),
-		"k8s.io/api/core/v1.Volume":                                                          schema_k8sio_api_core_v1_Volume(ref),
-		"k8s.io/api/core/v1.VolumeDevice":                                                    schema_k8sio_api_core_v1_VolumeDevice(ref),
-		"k8s.io/api/core/v1.VolumeMount":                                                     schema_k8sio_api_core_v1_VolumeMount(ref),
-		"k8s.io/api/core/v1.VolumeNodeAffinity":                                              schema_k8sio_api_core_v1_VolumeNodeAffinity(ref),
-		"k8s.io/api/core/v1.VolumeProjection":                                                schema_k8sio_api_core_v1_VolumeProjection(ref),
-		"k8s.io/api/core/v1.VolumeSource":                                                    schema_k8sio_api_core_v1_VolumeSource(ref),
-		"k8s.io/api/core/v1.VsphereVirtualDiskVolumeSource":                                  schema_k8sio_api_core_v1_VsphereVirtualDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.WeightedPodAffinityTerm":                                         schema_k8sio_api_core_v1_WeightedPodAffinityTerm(ref),
-		"k8s.io/api/rbac/v1.AggregationRule":                                                 schema_k8sio_api_rbac_v1_AggregationRule(ref),
-		"k8s.io/api/rbac/v1.ClusterRole":                                                     schema_k8sio_api_rbac_v1_ClusterRole(ref),
-		"k8s.io/api/rbac/v1.ClusterRoleBinding":                                              schema_k8sio_api_rbac_v1_ClusterRoleBinding(ref),
-		"k8s.io/api/rbac/v1.ClusterRoleBindingList":                                          schema_k8sio_api_rbac_v1_ClusterRoleBindingList(ref),
-		"k8s.io/api/rbac/v1.ClusterRoleList":                                                 schema_k8sio_api_rbac_v1_ClusterRoleList(ref),
-		"k8s.io/api/rbac/v1.PolicyRule":                                                      schema_k8sio_api_rbac_v1_PolicyRule(ref),
-		"k8s.io/api/rbac/v1.Role":                                                            schema_k8sio_api_rbac_v1_Role(ref),
-		"k8s.io/api/rbac/v1.RoleBinding":                                                     schema_k8sio_api_rbac_v1_RoleBinding(ref),
-		"k8s.io/api/rbac/v1.RoleBindingList":                                                 schema_k8sio_api_rbac_v1_RoleBindingList(ref),
-		"k8s.io/api/rbac/v1.RoleList":                                                        schema_k8sio_api_rbac_v1_RoleList(ref),
-		"k8s.io/api/rbac/v1.RoleRef":                                                         schema_k8sio_api_rbac_v1_RoleRef(ref),
-		"k8s.io/api/rbac/v1.Subject":                                                         schema_k8sio_api_rbac_v1_Subject(ref),
-		"k8s.io/apimachinery/pkg/api/resource.Quantity":                                      schema_apimachinery_pkg_api_resource_Quantity(ref),
-		"k8s.io/apimachinery/pkg/api/resource.int64Amount":                                   schema_apimachinery_pkg_api_resource_int64Amount(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                      schema_pkg_apis_meta_v1_APIGroup(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                                  schema_pkg_apis_meta_v1_APIGroupList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                                   schema_pkg_apis_meta_v1_APIResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                               schema_pkg_apis_meta_v1_APIResourceList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                                   schema_pkg_apis_meta_v1_APIVersions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":                                 schema_pkg_apis_meta_v1_CreateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                                 schema_pkg_apis_meta_v1_DeleteOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                                      schema_pkg_apis_meta_v1_Duration(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ExportOptions":                                 schema_pkg_apis_meta_v1_ExportOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Fields":                                        schema_pkg_apis_meta_v1_Fields(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                                    schema_pkg_apis_meta_v1_GetOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                                     schema_pkg_apis_meta_v1_GroupKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                                 schema_pkg_apis_meta_v1_GroupResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                                  schema_pkg_apis_meta_v1_GroupVersion(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":                      schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                              schema_pkg_apis_meta_v1_GroupVersionKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                          schema_pkg_apis_meta_v1_GroupVersionResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Initializer":                                   schema_pkg_apis_meta_v1_Initializer(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Initializers":                                  schema_pkg_apis_meta_v1_Initializers(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                                 schema_pkg_apis_meta_v1_InternalEvent(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                                 schema_pkg_apis_meta_v1_LabelSelector(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":                      schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                          schema_pkg_apis_meta_v1_List(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                                      schema_pkg_apis_meta_v1_ListMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                                   schema_pkg_apis_meta_v1_ListOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry":                            schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                                     schema_pkg_apis_meta_v1_MicroTime(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                                    schema_pkg_apis_meta_v1_ObjectMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                                schema_pkg_apis_meta_v1_OwnerReference(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                         schema_pkg_apis_meta_v1_Patch(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PatchOptions":                                  schema_pkg_apis_meta_v1_PatchOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                                 schema_pkg_apis_meta_v1_Preconditions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                                     schema_pkg_apis_meta_v1_RootPaths(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":                     schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                        schema_pkg_apis_meta_v1_Status(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                                   schema_pkg_apis_meta_v1_StatusCause(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                                 schema_pkg_apis_meta_v1_StatusDetails(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                          schema_pkg_apis_meta_v1_Time(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                                     schema_pkg_apis_meta_v1_Timestamp(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                                      schema_pkg_apis_meta_v1_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":                                 schema_pkg_apis_meta_v1_UpdateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                                    schema_pkg_apis_meta_v1_WatchEvent(ref),
-		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                       schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
-		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                           schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/runtime.Unknown":                                            schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
-		"k8s.io/apimachinery/pkg/util/intstr.IntOrString":                                    schema_apimachinery_pkg_util_intstr_IntOrString(ref),
-		"k8s.io/apimachinery/pkg/version.Info":                                               schema_k8sio_apimachinery_pkg_version_Info(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Cloud":                            schema_pkg_apis_core_v1alpha1_Cloud(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ClusterInfo":                      schema_pkg_apis_core_v1alpha1_ClusterInfo(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ClusterInventory":                 schema_pkg_apis_core_v1alpha1_ClusterInventory(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition":                        schema_pkg_apis_core_v1alpha1_Condition(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerCompatibility":          schema_pkg_apis_core_v1alpha1_ControllerCompatibility(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerDeployment":             schema_pkg_apis_core_v1alpha1_ControllerDeployment(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallation":           schema_pkg_apis_core_v1alpha1_ControllerInstallation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallationList":       schema_pkg_apis_core_v1alpha1_ControllerInstallationList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallationSpec":       schema_pkg_apis_core_v1alpha1_ControllerInstallationSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallationStatus":     schema_pkg_apis_core_v1alpha1_ControllerInstallationStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistration":           schema_pkg_apis_core_v1alpha1_ControllerRegistration(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistrationList":       schema_pkg_apis_core_v1alpha1_ControllerRegistrationList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistrationSeedStatus": schema_pkg_apis_core_v1alpha1_ControllerRegistrationSeedStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistrationSpec":       schema_pkg_apis_core_v1alpha1_ControllerRegistrationSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistrationStatus":     schema_pkg_apis_core_v1alpha1_ControllerRegistrationStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerResource":               schema_pkg_apis_core_v1alpha1_ControllerResource(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRolloutStrategy":        schema_pkg_apis_core_v1alpha1_ControllerRolloutStrategy(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.DualStackNetworks":                schema_pkg_apis_core_v1alpha1_DualStackNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Endpoint":                         schema_pkg_apis_core_v1alpha1_Endpoint(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.EndpointProbe":                    schema_pkg_apis_core_v1alpha1_EndpointProbe(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.K8SNetworks":                      schema_pkg_apis_core_v1alpha1_K8SNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Kubernetes":                       schema_pkg_apis_core_v1alpha1_Kubernetes(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError":                        schema_pkg_apis_core_v1alpha1_LastError(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation":                    schema_pkg_apis_core_v1alpha1_LastOperation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Plant":                            schema_pkg_apis_core_v1alpha1_Plant(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantAdoptionInfrastructure":      schema_pkg_apis_core_v1alpha1_PlantAdoptionInfrastructure(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantAdoptionMachineImage":        schema_pkg_apis_core_v1alpha1_PlantAdoptionMachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantAdoptionReport":              schema_pkg_apis_core_v1alpha1_PlantAdoptionReport(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantAdoptionWorker":              schema_pkg_apis_core_v1alpha1_PlantAdoptionWorker(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantList":                        schema_pkg_apis_core_v1alpha1_PlantList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantSpec":                        schema_pkg_apis_core_v1alpha1_PlantSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantStatus":                      schema_pkg_apis_core_v1alpha1_PlantStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig":                   schema_pkg_apis_core_v1alpha1_ProviderConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSCloud":                        schema_pkg_apis_garden_v1beta1_AWSCloud(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSConstraints":                  schema_pkg_apis_garden_v1beta1_AWSConstraints(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSNetworks":                     schema_pkg_apis_garden_v1beta1_AWSNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSProfile":                      schema_pkg_apis_garden_v1beta1_AWSProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSVPC":                          schema_pkg_apis_garden_v1beta1_AWSVPC(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSWorker":                       schema_pkg_apis_garden_v1beta1_AWSWorker(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addon":                           schema_pkg_apis_garden_v1beta1_Addon(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AddonClusterAutoscaler":          schema_pkg_apis_garden_v1beta1_AddonClusterAutoscaler(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons":                          schema_pkg_apis_garden_v1beta1_Addons(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AdmissionPlugin":                 schema_pkg_apis_garden_v1beta1_AdmissionPlugin(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Alicloud":                        schema_pkg_apis_garden_v1beta1_Alicloud(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudConstraints":             schema_pkg_apis_garden_v1beta1_AlicloudConstraints(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudMachineType":             schema_pkg_apis_garden_v1beta1_AlicloudMachineType(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudNetworks":                schema_pkg_apis_garden_v1beta1_AlicloudNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudProfile":                 schema_pkg_apis_garden_v1beta1_AlicloudProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudVPC":                     schema_pkg_apis_garden_v1beta1_AlicloudVPC(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudVolumeType":              schema_pkg_apis_garden_v1beta1_AlicloudVolumeType(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudWorker":                  schema_pkg_apis_garden_v1beta1_AlicloudWorker(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditConfig":                     schema_pkg_apis_garden_v1beta1_AuditConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditPolicy":                     schema_pkg_apis_garden_v1beta1_AuditPolicy(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureCloud":                      schema_pkg_apis_garden_v1beta1_AzureCloud(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureConstraints":                schema_pkg_apis_garden_v1beta1_AzureConstraints(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureDomainCount":                schema_pkg_apis_garden_v1beta1_AzureDomainCount(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureNetworks":                   schema_pkg_apis_garden_v1beta1_AzureNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureProfile":                    schema_pkg_apis_garden_v1beta1_AzureProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureResourceGroup":              schema_pkg_apis_garden_v1beta1_AzureResourceGroup(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureVNet":                       schema_pkg_apis_garden_v1beta1_AzureVNet(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureWorker":                     schema_pkg_apis_garden_v1beta1_AzureWorker(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Backup":                          schema_pkg_apis_garden_v1beta1_Backup(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructure":            schema_pkg_apis_garden_v1beta1_BackupInfrastructure(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureList":        schema_pkg_apis_garden_v1beta1_BackupInfrastructureList(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureSpec":        schema_pkg_apis_garden_v1beta1_BackupInfrastructureSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureStatus":      schema_pkg_apis_garden_v1beta1_BackupInfrastructureStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud":                           schema_pkg_apis_garden_v1beta1_Cloud(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudControllerManagerConfig":    schema_pkg_apis_garden_v1beta1_CloudControllerManagerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfile":                    schema_pkg_apis_garden_v1beta1_CloudProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfileList":                schema_pkg_apis_garden_v1beta1_CloudProfileList(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfileSpec":                schema_pkg_apis_garden_v1beta1_CloudProfileSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ClusterAutoscaler":               schema_pkg_apis_garden_v1beta1_ClusterAutoscaler(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ConditionAvailability":           schema_pkg_apis_garden_v1beta1_ConditionAvailability(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ConditionTransition":             schema_pkg_apis_garden_v1beta1_ConditionTransition(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ControlPlane":                    schema_pkg_apis_garden_v1beta1_ControlPlane(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS":                             schema_pkg_apis_garden_v1beta1_DNS(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSIncludeExclude":               schema_pkg_apis_garden_v1beta1_DNSIncludeExclude(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProvider":                     schema_pkg_apis_garden_v1beta1_DNSProvider(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint":           schema_pkg_apis_garden_v1beta1_DNSProviderConstraint(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.EgressDestination":               schema_pkg_apis_garden_v1beta1_EgressDestination(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Extension":                       schema_pkg_apis_garden_v1beta1_Extension(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPCloud":                        schema_pkg_apis_garden_v1beta1_GCPCloud(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPConstraints":                  schema_pkg_apis_garden_v1beta1_GCPConstraints(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPNetworks":                     schema_pkg_apis_garden_v1beta1_GCPNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPProfile":                      schema_pkg_apis_garden_v1beta1_GCPProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPVPC":                          schema_pkg_apis_garden_v1beta1_GCPVPC(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPWorker":                       schema_pkg_apis_garden_v1beta1_GCPWorker(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener":                        schema_pkg_apis_garden_v1beta1_Gardener(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration":                schema_pkg_apis_garden_v1beta1_GardenerDuration(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Heapster":                        schema_pkg_apis_garden_v1beta1_Heapster(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HelmTiller":                      schema_pkg_apis_garden_v1beta1_HelmTiller(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation":                     schema_pkg_apis_garden_v1beta1_Hibernation(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HibernationSchedule":             schema_pkg_apis_garden_v1beta1_HibernationSchedule(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HighAvailability":                schema_pkg_apis_garden_v1beta1_HighAvailability(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HorizontalPodAutoscalerConfig":   schema_pkg_apis_garden_v1beta1_HorizontalPodAutoscalerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAM":                        schema_pkg_apis_garden_v1beta1_Kube2IAM(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAMRole":                    schema_pkg_apis_garden_v1beta1_Kube2IAMRole(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeAPIServerConfig":             schema_pkg_apis_garden_v1beta1_KubeAPIServerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeControllerManagerConfig":     schema_pkg_apis_garden_v1beta1_KubeControllerManagerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeLego":                        schema_pkg_apis_garden_v1beta1_KubeLego(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeProxyConfig":                 schema_pkg_apis_garden_v1beta1_KubeProxyConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeSchedulerConfig":             schema_pkg_apis_garden_v1beta1_KubeSchedulerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig":                   schema_pkg_apis_garden_v1beta1_KubeletConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kubernetes":                      schema_pkg_apis_garden_v1beta1_Kubernetes(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConfig":                schema_pkg_apis_garden_v1beta1_KubernetesConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints":           schema_pkg_apis_garden_v1beta1_KubernetesConstraints(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesDashboard":             schema_pkg_apis_garden_v1beta1_KubernetesDashboard(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImage":                    schema_pkg_apis_garden_v1beta1_MachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType":                     schema_pkg_apis_garden_v1beta1_MachineType(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance":                     schema_pkg_apis_garden_v1beta1_Maintenance(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceAutoUpdate":           schema_pkg_apis_garden_v1beta1_MaintenanceAutoUpdate(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceTimeWindow":           schema_pkg_apis_garden_v1beta1_MaintenanceTimeWindow(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Monocular":                       schema_pkg_apis_garden_v1beta1_Monocular(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.NginxIngress":                    schema_pkg_apis_garden_v1beta1_NginxIngress(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OIDCConfig":                      schema_pkg_apis_garden_v1beta1_OIDCConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackCloud":                  schema_pkg_apis_garden_v1beta1_OpenStackCloud(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackConstraints":            schema_pkg_apis_garden_v1beta1_OpenStackConstraints(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackFloatingPool":           schema_pkg_apis_garden_v1beta1_OpenStackFloatingPool(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackLoadBalancerProvider":   schema_pkg_apis_garden_v1beta1_OpenStackLoadBalancerProvider(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineType":            schema_pkg_apis_garden_v1beta1_OpenStackMachineType(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackNetworks":               schema_pkg_apis_garden_v1beta1_OpenStackNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackProfile":                schema_pkg_apis_garden_v1beta1_OpenStackProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackRouter":                 schema_pkg_apis_garden_v1beta1_OpenStackRouter(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackWorker":                 schema_pkg_apis_garden_v1beta1_OpenStackWorker(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketCloud":                     schema_pkg_apis_garden_v1beta1_PacketCloud(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketConstraints":               schema_pkg_apis_garden_v1beta1_PacketConstraints(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketNetworks":                  schema_pkg_apis_garden_v1beta1_PacketNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketProfile":                   schema_pkg_apis_garden_v1beta1_PacketProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketWorker":                    schema_pkg_apis_garden_v1beta1_PacketWorker(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Project":                         schema_pkg_apis_garden_v1beta1_Project(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectList":                     schema_pkg_apis_garden_v1beta1_ProjectList(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectMember":                   schema_pkg_apis_garden_v1beta1_ProjectMember(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectPolicy":                   schema_pkg_apis_garden_v1beta1_ProjectPolicy(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectServiceAccount":           schema_pkg_apis_garden_v1beta1_ProjectServiceAccount(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectServiceAccountStatus":     schema_pkg_apis_garden_v1beta1_ProjectServiceAccountStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectSpec":                     schema_pkg_apis_garden_v1beta1_ProjectSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectStatus":                   schema_pkg_apis_garden_v1beta1_ProjectStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Quota":                           schema_pkg_apis_garden_v1beta1_Quota(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaList":                       schema_pkg_apis_garden_v1beta1_QuotaList(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaSpec":                       schema_pkg_apis_garden_v1beta1_QuotaSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBinding":                   schema_pkg_apis_garden_v1beta1_SecretBinding(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBindingList":               schema_pkg_apis_garden_v1beta1_SecretBindingList(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Seed":                            schema_pkg_apis_garden_v1beta1_Seed(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedCloud":                       schema_pkg_apis_garden_v1beta1_SeedCloud(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedList":                        schema_pkg_apis_garden_v1beta1_SeedList(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedNetworks":                    schema_pkg_apis_garden_v1beta1_SeedNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSpec":                        schema_pkg_apis_garden_v1beta1_SeedSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedStatus":                      schema_pkg_apis_garden_v1beta1_SeedStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Shoot":                           schema_pkg_apis_garden_v1beta1_Shoot(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootList":                       schema_pkg_apis_garden_v1beta1_ShootList(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootSpec":                       schema_pkg_apis_garden_v1beta1_ShootSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootStatus":                     schema_pkg_apis_garden_v1beta1_ShootStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType":                      schema_pkg_apis_garden_v1beta1_VolumeType(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Worker":                          schema_pkg_apis_garden_v1beta1_Worker(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone":                            schema_pkg_apis_garden_v1beta1_Zone(ref),
+		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                  schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
+		"k8s.io/api/core/v1.Affinity":                                                          schema_k8sio_api_core_v1_Affinity(ref),
+		"k8s.io/api/core/v1.AttachedVolume":                                                    schema_k8sio_api_core_v1_AttachedVolume(ref),
+		"k8s.io/api/core/v1.AvoidPods":                                                         schema_k8sio_api_core_v1_AvoidPods(ref),
+		"k8s.io/api/core/v1.AzureDiskVolumeSource":                                             schema_k8sio_api_core_v1_AzureDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.AzureFilePersistentVolumeSource":                                   schema_k8sio_api_core_v1_AzureFilePersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.AzureFileVolumeSource":                                             schema_k8sio_api_core_v1_AzureFileVolumeSource(ref),
+		"k8s.io/api/core/v1.Binding":                                                           schema_k8sio_api_core_v1_Binding(ref),
+		"k8s.io/api/core/v1.CSIPersistentVolumeSource":                                         schema_k8sio_api_core_v1_CSIPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.CSIVolumeSource":                                                   schema_k8sio_api_core_v1_CSIVolumeSource(ref),
+		"k8s.io/api/core/v1.Capabilities":                                                      schema_k8sio_api_core_v1_Capabilities(ref),
+		"k8s.io/api/core/v1.CephFSPersistentVolumeSource":                                      schema_k8sio_api_core_v1_CephFSPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.CephFSVolumeSource":                                                schema_k8sio_api_core_v1_CephFSVolumeSource(ref),
+		"k8s.io/api/core/v1.CinderPersistentVolumeSource":                                      schema_k8sio_api_core_v1_CinderPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.CinderVolumeSource":                                                schema_k8sio_api_core_v1_CinderVolumeSource(ref),
+		"k8s.io/api/core/v1.ClientIPConfig":                                                    schema_k8sio_api_core_v1_ClientIPConfig(ref),
+		"k8s.io/api/core/v1.ComponentCondition":                                                schema_k8sio_api_core_v1_ComponentCondition(ref),
+		"k8s.io/api/core/v1.ComponentStatus":                                                   schema_k8sio_api_core_v1_ComponentStatus(ref),
+		"k8s.io/api/core/v1.ComponentStatusList":                                               schema_k8sio_api_core_v1_ComponentStatusList(ref),
+		"k8s.io/api/core/v1.ConfigMap":                                                         schema_k8sio_api_core_v1_ConfigMap(ref),
+		"k8s.io/api/core/v1.ConfigMapEnvSource":                                                schema_k8sio_api_core_v1_ConfigMapEnvSource(ref),
+		"k8s.io/api/core/v1.ConfigMapKeySelector":                                              schema_k8sio_api_core_v1_ConfigMapKeySelector(ref),
+		"k8s.io/api/core/v1.ConfigMapList":                                                     schema_k8sio_api_core_v1_ConfigMapList(ref),
+		"k8s.io/api/core/v1.ConfigMapNodeConfigSource":                                         schema_k8sio_api_core_v1_ConfigMapNodeConfigSource(ref),
+		"k8s.io/api/core/v1.ConfigMapProjection":                                               schema_k8sio_api_core_v1_ConfigMapProjection(ref),
+		"k8s.io/api/core/v1.ConfigMapVolumeSource":                                             schema_k8sio_api_core_v1_ConfigMapVolumeSource(ref),
+		"k8s.io/api/core/v1.Container":                                                         schema_k8sio_api_core_v1_Container(ref),
+		"k8s.io/api/core/v1.ContainerImage":                                                    schema_k8sio_api_core_v1_ContainerImage(ref),
+		"k8s.io/api/core/v1.ContainerPort":                                                     schema_k8sio_api_core_v1_ContainerPort(ref),
+		"k8s.io/api/core/v1.ContainerState":                                                    schema_k8sio_api_core_v1_ContainerState(ref),
+		"k8s.io/api/core/v1.ContainerStateRunning":                                             schema_k8sio_api_core_v1_ContainerStateRunning(ref),
+		"k8s.io/api/core/v1.ContainerStateTerminated":                                          schema_k8sio_api_core_v1_ContainerStateTerminated(ref),
+		"k8s.io/api/core/v1.ContainerStateWaiting":                                             schema_k8sio_api_core_v1_ContainerStateWaiting(ref),
+		"k8s.io/api/core/v1.ContainerStatus":                                                   schema_k8sio_api_core_v1_ContainerStatus(ref),
+		"k8s.io/api/core/v1.DaemonEndpoint":                                                    schema_k8sio_api_core_v1_DaemonEndpoint(ref),
+		"k8s.io/api/core/v1.DownwardAPIProjection":                                             schema_k8sio_api_core_v1_DownwardAPIProjection(ref),
+		"k8s.io/api/core/v1.DownwardAPIVolumeFile":                                             schema_k8sio_api_core_v1_DownwardAPIVolumeFile(ref),
+		"k8s.io/api/core/v1.DownwardAPIVolumeSource":                                           schema_k8sio_api_core_v1_DownwardAPIVolumeSource(ref),
+		"k8s.io/api/core/v1.EmptyDirVolumeSource":                                              schema_k8sio_api_core_v1_EmptyDirVolumeSource(ref),
+		"k8s.io/api/core/v1.EndpointAddress":                                                   schema_k8sio_api_core_v1_EndpointAddress(ref),
+		"k8s.io/api/core/v1.EndpointPort":                                                      schema_k8sio_api_core_v1_EndpointPort(ref),
+		"k8s.io/api/core/v1.EndpointSubset":                                                    schema_k8sio_api_core_v1_EndpointSubset(ref),
+		"k8s.io/api/core/v1.Endpoints":                                                         schema_k8sio_api_core_v1_Endpoints(ref),
+		"k8s.io/api/core/v1.EndpointsList":                                                     schema_k8sio_api_core_v1_EndpointsList(ref),
+		"k8s.io/api/core/v1.EnvFromSource":                                                     schema_k8sio_api_core_v1_EnvFromSource(ref),
+		"k8s.io/api/core/v1.EnvVar":                                                            schema_k8sio_api_core_v1_EnvVar(ref),
+		"k8s.io/api/core/v1.EnvVarSource":                                                      schema_k8sio_api_core_v1_EnvVarSource(ref),
+		"k8s.io/api/core/v1.Event":                                                             schema_k8sio_api_core_v1_Event(ref),
+		"k8s.io/api/core/v1.EventList":                                                         schema_k8sio_api_core_v1_EventList(ref),
+		"k8s.io/api/core/v1.EventSeries":                                                       schema_k8sio_api_core_v1_EventSeries(ref),
+		"k8s.io/api/core/v1.EventSource":                                                       schema_k8sio_api_core_v1_EventSource(ref),
+		"k8s.io/api/core/v1.ExecAction":                                                        schema_k8sio_api_core_v1_ExecAction(ref),
+		"k8s.io/api/core/v1.FCVolumeSource":                                                    schema_k8sio_api_core_v1_FCVolumeSource(ref),
+		"k8s.io/api/core/v1.FlexPersistentVolumeSource":                                        schema_k8sio_api_core_v1_FlexPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.FlexVolumeSource":                                                  schema_k8sio_api_core_v1_FlexVolumeSource(ref),
+		"k8s.io/api/core/v1.FlockerVolumeSource":                                               schema_k8sio_api_core_v1_FlockerVolumeSource(ref),
+		"k8s.io/api/core/v1.GCEPersistentDiskVolumeSource":                                     schema_k8sio_api_core_v1_GCEPersistentDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.GitRepoVolumeSource":                                               schema_k8sio_api_core_v1_GitRepoVolumeSource(ref),
+		"k8s.io/api/core/v1.GlusterfsPersistentVolumeSource":                                   schema_k8sio_api_core_v1_GlusterfsPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.GlusterfsVolumeSource":                                             schema_k8sio_api_core_v1_GlusterfsVolumeSource(ref),
+		"k8s.io/api/core/v1.HTTPGetAction":                                                     schema_k8sio_api_core_v1_HTTPGetAction(ref),
+		"k8s.io/api/core/v1.HTTPHeader":                                                        schema_k8sio_api_core_v1_HTTPHeader(ref),
+		"k8s.io/api/core/v1.Handler":                                                           schema_k8sio_api_core_v1_Handler(ref),
+		"k8s.io/api/core/v1.HostAlias":                                                         schema_k8sio_api_core_v1_HostAlias(ref),
+		"k8s.io/api/core/v1.HostPathVolumeSource":                                              schema_k8sio_api_core_v1_HostPathVolumeSource(ref),
+		"k8s.io/api/core/v1.ISCSIPersistentVolumeSource":                                       schema_k8sio_api_core_v1_ISCSIPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.ISCSIVolumeSource":                                                 schema_k8sio_api_core_v1_ISCSIVolumeSource(ref),
+		"k8s.io/api/core/v1.KeyToPath":                                                         schema_k8sio_api_core_v1_KeyToPath(ref),
+		"k8s.io/api/core/v1.Lifecycle":                                                         schema_k8sio_api_core_v1_Lifecycle(ref),
+		"k8s.io/api/core/v1.LimitRange":                                                        schema_k8sio_api_core_v1_LimitRange(ref),
+		"k8s.io/api/core/v1.LimitRangeItem":                                                    schema_k8sio_api_core_v1_LimitRangeItem(ref),
+		"k8s.io/api/core/v1.LimitRangeList":                                                    schema_k8sio_api_core_v1_LimitRangeList(ref),
+		"k8s.io/api/core/v1.LimitRangeSpec":                                                    schema_k8sio_api_core_v1_LimitRangeSpec(ref),
+		"k8s.io/api/core/v1.List":                                                              schema_k8sio_api_core_v1_List(ref),
+		"k8s.io/api/core/v1.LoadBalancerIngress":                                               schema_k8sio_api_core_v1_LoadBalancerIngress(ref),
+		"k8s.io/api/core/v1.LoadBalancerStatus":                                                schema_k8sio_api_core_v1_LoadBalancerStatus(ref),
+		"k8s.io/api/core/v1.LocalObjectReference":                                              schema_k8sio_api_core_v1_LocalObjectReference(ref),
+		"k8s.io/api/core/v1.LocalVolumeSource":                                                 schema_k8sio_api_core_v1_LocalVolumeSource(ref),
+		"k8s.io/api/core/v1.NFSVolumeSource":                                                   schema_k8sio_api_core_v1_NFSVolumeSource(ref),
+		"k8s.io/api/core/v1.Namespace":                                                         schema_k8sio_api_core_v1_Namespace(ref),
+		"k8s.io/api/core/v1.NamespaceList":                                                     schema_k8sio_api_core_v1_NamespaceList(ref),
+		"k8s.io/api/core/v1.NamespaceSpec":                                                     schema_k8sio_api_core_v1_NamespaceSpec(ref),
+		"k8s.io/api/core/v1.NamespaceStatus":                                                   schema_k8sio_api_core_v1_NamespaceStatus(ref),
+		"k8s.io/api/core/v1.Node":                                                              schema_k8sio_api_core_v1_Node(ref),
+		"k8s.io/api/core/v1.NodeAddress":                                                       schema_k8sio_api_core_v1_NodeAddress(ref),
+		"k8s.io/api/core/v1.NodeAffinity":                                                      schema_k8sio_api_core_v1_NodeAffinity(ref),
+		"k8s.io/api/core/v1.NodeCondition":                                                     schema_k8sio_api_core_v1_NodeCondition(ref),
+		"k8s.io/api/core/v1.NodeConfigSource":                                                  schema_k8sio_api_core_v1_NodeConfigSource(ref),
+		"k8s.io/api/core/v1.NodeConfigStatus":                                                  schema_k8sio_api_core_v1_NodeConfigStatus(ref),
+		"k8s.io/api/core/v1.NodeDaemonEndpoints":                                               schema_k8sio_api_core_v1_NodeDaemonEndpoints(ref),
+		"k8s.io/api/core/v1.NodeList":                                                          schema_k8sio_api_core_v1_NodeList(ref),
+		"k8s.io/api/core/v1.NodeProxyOptions":                                                  schema_k8sio_api_core_v1_NodeProxyOptions(ref),
+		"k8s.io/api/core/v1.NodeResources":                                                     schema_k8sio_api_core_v1_NodeResources(ref),
+		"k8s.io/api/core/v1.NodeSelector":                                                      schema_k8sio_api_core_v1_NodeSelector(ref),
+		"k8s.io/api/core/v1.NodeSelectorRequirement":                                           schema_k8sio_api_core_v1_NodeSelectorRequirement(ref),
+		"k8s.io/api/core/v1.NodeSelectorTerm":                                                  schema_k8sio_api_core_v1_NodeSelectorTerm(ref),
+		"k8s.io/api/core/v1.NodeSpec":                                                          schema_k8sio_api_core_v1_NodeSpec(ref),
+		"k8s.io/api/core/v1.NodeStatus":                                                        schema_k8sio_api_core_v1_NodeStatus(ref),
+		"k8s.io/api/core/v1.NodeSystemInfo":                                                    schema_k8sio_api_core_v1_NodeSystemInfo(ref),
+		"k8s.io/api/core/v1.ObjectFieldSelector":                                               schema_k8sio_api_core_v1_ObjectFieldSelector(ref),
+		"k8s.io/api/core/v1.ObjectReference":                                                   schema_k8sio_api_core_v1_ObjectReference(ref),
+		"k8s.io/api/core/v1.PersistentVolume":                                                  schema_k8sio_api_core_v1_PersistentVolume(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaim":                                             schema_k8sio_api_core_v1_PersistentVolumeClaim(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimCondition":                                    schema_k8sio_api_core_v1_PersistentVolumeClaimCondition(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimList":                                         schema_k8sio_api_core_v1_PersistentVolumeClaimList(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimSpec":                                         schema_k8sio_api_core_v1_PersistentVolumeClaimSpec(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimStatus":                                       schema_k8sio_api_core_v1_PersistentVolumeClaimStatus(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource":                                 schema_k8sio_api_core_v1_PersistentVolumeClaimVolumeSource(ref),
+		"k8s.io/api/core/v1.PersistentVolumeList":                                              schema_k8sio_api_core_v1_PersistentVolumeList(ref),
+		"k8s.io/api/core/v1.PersistentVolumeSource":                                            schema_k8sio_api_core_v1_PersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.PersistentVolumeSpec":                                              schema_k8sio_api_core_v1_PersistentVolumeSpec(ref),
+		"k8s.io/api/core/v1.PersistentVolumeStatus":                                            schema_k8sio_api_core_v1_PersistentVolumeStatus(ref),
+		"k8s.io/api/core/v1.PhotonPersistentDiskVolumeSource":                                  schema_k8sio_api_core_v1_PhotonPersistentDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.Pod":                                                               schema_k8sio_api_core_v1_Pod(ref),
+		"k8s.io/api/core/v1.PodAffinity":                                                       schema_k8sio_api_core_v1_PodAffinity(ref),
+		"k8s.io/api/core/v1.PodAffinityTerm":                                                   schema_k8sio_api_core_v1_PodAffinityTerm(ref),
+		"k8s.io/api/core/v1.PodAntiAffinity":                                                   schema_k8sio_api_core_v1_PodAntiAffinity(ref),
+		"k8s.io/api/core/v1.PodAttachOptions":                                                  schema_k8sio_api_core_v1_PodAttachOptions(ref),
+		"k8s.io/api/core/v1.PodCondition":                                                      schema_k8sio_api_core_v1_PodCondition(ref),
+		"k8s.io/api/core/v1.PodDNSConfig":                                                      schema_k8sio_api_core_v1_PodDNSConfig(ref),
+		"k8s.io/api/core/v1.PodDNSConfigOption":                                                schema_k8sio_api_core_v1_PodDNSConfigOption(ref),
+		"k8s.io/api/core/v1.PodExecOptions":                                                    schema_k8sio_api_core_v1_PodExecOptions(ref),
+		"k8s.io/api/core/v1.PodList":                                                           schema_k8sio_api_core_v1_PodList(ref),
+		"k8s.io/api/core/v1.PodLogOptions":                                                     schema_k8sio_api_core_v1_PodLogOptions(ref),
+		"k8s.io/api/core/v1.PodPortForwardOptions":                                             schema_k8sio_api_core_v1_PodPortForwardOptions(ref),
+		"k8s.io/api/core/v1.PodProxyOptions":                                                   schema_k8sio_api_core_v1_PodProxyOptions(ref),
+		"k8s.io/api/core/v1.PodReadinessGate":                                                  schema_k8sio_api_core_v1_PodReadinessGate(ref),
+		"k8s.io/api/core/v1.PodSecurityContext":                                                schema_k8sio_api_core_v1_PodSecurityContext(ref),
+		"k8s.io/api/core/v1.PodSignature":                                                      schema_k8sio_api_core_v1_PodSignature(ref),
+		"k8s.io/api/core/v1.PodSpec":                                                           schema_k8sio_api_core_v1_PodSpec(ref),
+		"k8s.io/api/core/v1.PodStatus":                                                         schema_k8sio_api_core_v1_PodStatus(ref),
+		"k8s.io/api/core/v1.PodStatusResult":                                                   schema_k8sio_api_core_v1_PodStatusResult(ref),
+		"k8s.io/api/core/v1.PodTemplate":                                                       schema_k8sio_api_core_v1_PodTemplate(ref),
+		"k8s.io/api/core/v1.PodTemplateList":                                                   schema_k8sio_api_core_v1_PodTemplateList(ref),
+		"k8s.io/api/core/v1.PodTemplateSpec":                                                   schema_k8sio_api_core_v1_PodTemplateSpec(ref),
+		"k8s.io/api/core/v1.PortworxVolumeSource":                                              schema_k8sio_api_core_v1_PortworxVolumeSource(ref),
+		"k8s.io/api/core/v1.PreferAvoidPodsEntry":                                              schema_k8sio_api_core_v1_PreferAvoidPodsEntry(ref),
+		"k8s.io/api/core/v1.PreferredSchedulingTerm":                                           schema_k8sio_api_core_v1_PreferredSchedulingTerm(ref),
+		"k8s.io/api/core/v1.Probe":                                                             schema_k8sio_api_core_v1_Probe(ref),
+		"k8s.io/api/core/v1.ProjectedVolumeSource":                                             schema_k8sio_api_core_v1_ProjectedVolumeSource(ref),
+		"k8s.io/api/core/v1.QuobyteVolumeSource":                                               schema_k8sio_api_core_v1_QuobyteVolumeSource(ref),
+		"k8s.io/api/core/v1.RBDPersistentVolumeSource":                                         schema_k8sio_api_core_v1_RBDPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.RBDVolumeSource":                                                   schema_k8sio_api_core_v1_RBDVolumeSource(ref),
+		"k8s.io/api/core/v1.RangeAllocation":                                                   schema_k8sio_api_core_v1_RangeAllocation(ref),
+		"k8s.io/api/core/v1.ReplicationController":                                             schema_k8sio_api_core_v1_ReplicationController(ref),
+		"k8s.io/api/core/v1.ReplicationControllerCondition":                                    schema_k8sio_api_core_v1_ReplicationControllerCondition(ref),
+		"k8s.io/api/core/v1.ReplicationControllerList":                                         schema_k8sio_api_core_v1_ReplicationControllerList(ref),
+		"k8s.io/api/core/v1.ReplicationControllerSpec":                                         schema_k8sio_api_core_v1_ReplicationControllerSpec(ref),
+		"k8s.io/api/core/v1.ReplicationControllerStatus":                                       schema_k8sio_api_core_v1_ReplicationControllerStatus(ref),
+		"k8s.io/api/core/v1.ResourceFieldSelector":                                             schema_k8sio_api_core_v1_ResourceFieldSelector(ref),
+		"k8s.io/api/core/v1.ResourceQuota":                                                     schema_k8sio_api_core_v1_ResourceQuota(ref),
+		"k8s.io/api/core/v1.ResourceQuotaList":                                                 schema_k8sio_api_core_v1_ResourceQuotaList(ref),
+		"k8s.io/api/core/v1.ResourceQuotaSpec":                                                 schema_k8sio_api_core_v1_ResourceQuotaSpec(ref),
+		"k8s.io/api/core/v1.ResourceQuotaStatus":                                               schema_k8sio_api_core_v1_ResourceQuotaStatus(ref),
+		"k8s.io/api/core/v1.ResourceRequirements":                                              schema_k8sio_api_core_v1_ResourceRequirements(ref),
+		"k8s.io/api/core/v1.SELinuxOptions":                                                    schema_k8sio_api_core_v1_SELinuxOptions(ref),
+		"k8s.io/api/core/v1.ScaleIOPersistentVolumeSource":                                     schema_k8sio_api_core_v1_ScaleIOPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.ScaleIOVolumeSource":                                               schema_k8sio_api_core_v1_ScaleIOVolumeSource(ref),
+		"k8s.io/api/core/v1.ScopeSelector":                                                     schema_k8sio_api_core_v1_ScopeSelector(ref),
+		"k8s.io/api/core/v1.ScopedResourceSelectorRequirement":                                 schema_k8sio_api_core_v1_ScopedResourceSelectorRequirement(ref),
+		"k8s.io/api/core/v1.Secret":                                                            schema_k8sio_api_core_v1_Secret(ref),
+		"k8s.io/api/core/v1.SecretEnvSource":                                                   schema_k8sio_api_core_v1_SecretEnvSource(ref),
+		"k8s.io/api/core/v1.SecretKeySelector":                                                 schema_k8sio_api_core_v1_SecretKeySelector(ref),
+		"k8s.io/api/core/v1.SecretList":                                                        schema_k8sio_api_core_v1_SecretList(ref),
+		"k8s.io/api/core/v1.SecretProjection":                                                  schema_k8sio_api_core_v1_SecretProjection(ref),
+		"k8s.io/api/core/v1.SecretReference":                                                   schema_k8sio_api_core_v1_SecretReference(ref),
+		"k8s.io/api/core/v1.SecretVolumeSource":                                                schema_k8sio_api_core_v1_SecretVolumeSource(ref),
+		"k8s.io/api/core/v1.SecurityContext":                                                   schema_k8sio_api_core_v1_SecurityContext(ref),
+		"k8s.io/api/core/v1.SerializedReference":                                               schema_k8sio_api_core_v1_SerializedReference(ref),
+		"k8s.io/api/core/v1.Service":                                                           schema_k8sio_api_core_v1_Service(ref),
+		"k8s.io/api/core/v1.ServiceAccount":                                                    schema_k8sio_api_core_v1_ServiceAccount(ref),
+		"k8s.io/api/core/v1.ServiceAccountList":                                                schema_k8sio_api_core_v1_ServiceAccountList(ref),
+		"k8s.io/api/core/v1.ServiceAccountTokenProjection":                                     schema_k8sio_api_core_v1_ServiceAccountTokenProjection(ref),
+		"k8s.io/api/core/v1.ServiceList":                                                       schema_k8sio_api_core_v1_ServiceList(ref),
+		"k8s.io/api/core/v1.ServicePort":                                                       schema_k8sio_api_core_v1_ServicePort(ref),
+		"k8s.io/api/core/v1.ServiceProxyOptions":                                               schema_k8sio_api_core_v1_ServiceProxyOptions(ref),
+		"k8s.io/api/core/v1.ServiceSpec":                                                       schema_k8sio_api_core_v1_ServiceSpec(ref),
+		"k8s.io/api/core/v1.ServiceStatus":                                                     schema_k8sio_api_core_v1_ServiceStatus(ref),
+		"k8s.io/api/core/v1.SessionAffinityConfig":                                             schema_k8sio_api_core_v1_SessionAffinityConfig(ref),
+		"k8s.io/api/core/v1.StorageOSPersistentVolumeSource":                                   schema_k8sio_api_core_v1_StorageOSPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.StorageOSVolumeSource":                                             schema_k8sio_api_core_v1_StorageOSVolumeSource(ref),
+		"k8s.io/api/core/v1.Sysctl":                                                            schema_k8sio_api_core_v1_Sysctl(ref),
+		"k8s.io/api/core/v1.TCPSocketAction":                                                   schema_k8sio_api_core_v1_TCPSocketAction(ref),
+		"k8s.io/api/core/v1.Taint":                                                             schema_k8sio_api_core_v1_Taint(ref),
+		"k8s.io/api/core/v1.Toleration":                                                        schema_k8sio_api_core_v1_Toleration(ref),
+		"k8s.io/api/core/v1.TopologySelectorLabelRequirement":                                  schema_k8sio_api_core_v1_TopologySelectorLabelRequirement(ref),
+		"k8s.io/api/core/v1.TopologySelectorTerm":                                              schema_k8sio_api_core_v1_TopologySelectorTerm(ref),
+		"k8s.io/api/core/v1.TypedLocalObjectReference":                                         schema_k8sio_api_core_v1_TypedLocalObjectReference(ref),
+		"k8s.io/api/core/v1.Volume":                                                            schema_k8sio_api_core_v1_Volume(ref),
+		"k8s.io/api/core/v1.VolumeDevice":                                                      schema_k8sio_api_core_v1_VolumeDevice(ref),
+		"k8s.io/api/core/v1.VolumeMount":                                                       schema_k8sio_api_core_v1_VolumeMount(ref),
+		"k8s.io/api/core/v1.VolumeNodeAffinity":                                                schema_k8sio_api_core_v1_VolumeNodeAffinity(ref),
+		"k8s.io/api/core/v1.VolumeProjection":                                                  schema_k8sio_api_core_v1_VolumeProjection(ref),
+		"k8s.io/api/core/v1.VolumeSource":                                                      schema_k8sio_api_core_v1_VolumeSource(ref),
+		"k8s.io/api/core/v1.VsphereVirtualDiskVolumeSource":                                    schema_k8sio_api_core_v1_VsphereVirtualDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.WeightedPodAffinityTerm":                                           schema_k8sio_api_core_v1_WeightedPodAffinityTerm(ref),
+		"k8s.io/api/rbac/v1.AggregationRule":                                                   schema_k8sio_api_rbac_v1_AggregationRule(ref),
+		"k8s.io/api/rbac/v1.ClusterRole":                                                       schema_k8sio_api_rbac_v1_ClusterRole(ref),
+		"k8s.io/api/rbac/v1.ClusterRoleBinding":                                                schema_k8sio_api_rbac_v1_ClusterRoleBinding(ref),
+		"k8s.io/api/rbac/v1.ClusterRoleBindingList":                                            schema_k8sio_api_rbac_v1_ClusterRoleBindingList(ref),
+		"k8s.io/api/rbac/v1.ClusterRoleList":                                                   schema_k8sio_api_rbac_v1_ClusterRoleList(ref),
+		"k8s.io/api/rbac/v1.PolicyRule":                                                        schema_k8sio_api_rbac_v1_PolicyRule(ref),
+		"k8s.io/api/rbac/v1.Role":                                                              schema_k8sio_api_rbac_v1_Role(ref),
+		"k8s.io/api/rbac/v1.RoleBinding":                                                       schema_k8sio_api_rbac_v1_RoleBinding(ref),
+		"k8s.io/api/rbac/v1.RoleBindingList":                                                   schema_k8sio_api_rbac_v1_RoleBindingList(ref),
+		"k8s.io/api/rbac/v1.RoleList":                                                          schema_k8sio_api_rbac_v1_RoleList(ref),
+		"k8s.io/api/rbac/v1.RoleRef":                                                           schema_k8sio_api_rbac_v1_RoleRef(ref),
+		"k8s.io/api/rbac/v1.Subject":                                                           schema_k8sio_api_rbac_v1_Subject(ref),
+		"k8s.io/apimachinery/pkg/api/resource.Quantity":                                        schema_apimachinery_pkg_api_resource_Quantity(ref),
+		"k8s.io/apimachinery/pkg/api/resource.int64Amount":                                     schema_apimachinery_pkg_api_resource_int64Amount(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                        schema_pkg_apis_meta_v1_APIGroup(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                                    schema_pkg_apis_meta_v1_APIGroupList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                                     schema_pkg_apis_meta_v1_APIResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                                 schema_pkg_apis_meta_v1_APIResourceList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                                     schema_pkg_apis_meta_v1_APIVersions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":                                   schema_pkg_apis_meta_v1_CreateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                                   schema_pkg_apis_meta_v1_DeleteOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                                        schema_pkg_apis_meta_v1_Duration(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ExportOptions":                                   schema_pkg_apis_meta_v1_ExportOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Fields":                                          schema_pkg_apis_meta_v1_Fields(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                                      schema_pkg_apis_meta_v1_GetOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                                       schema_pkg_apis_meta_v1_GroupKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                                   schema_pkg_apis_meta_v1_GroupResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                                    schema_pkg_apis_meta_v1_GroupVersion(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":                        schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                                schema_pkg_apis_meta_v1_GroupVersionKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                            schema_pkg_apis_meta_v1_GroupVersionResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Initializer":                                     schema_pkg_apis_meta_v1_Initializer(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Initializers":                                    schema_pkg_apis_meta_v1_Initializers(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                                   schema_pkg_apis_meta_v1_InternalEvent(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                                   schema_pkg_apis_meta_v1_LabelSelector(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":                        schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                            schema_pkg_apis_meta_v1_List(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                                        schema_pkg_apis_meta_v1_ListMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                                     schema_pkg_apis_meta_v1_ListOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry":                              schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                                       schema_pkg_apis_meta_v1_MicroTime(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                                      schema_pkg_apis_meta_v1_ObjectMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                                  schema_pkg_apis_meta_v1_OwnerReference(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                           schema_pkg_apis_meta_v1_Patch(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PatchOptions":                                    schema_pkg_apis_meta_v1_PatchOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                                   schema_pkg_apis_meta_v1_Preconditions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                                       schema_pkg_apis_meta_v1_RootPaths(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":                       schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                          schema_pkg_apis_meta_v1_Status(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                                     schema_pkg_apis_meta_v1_StatusCause(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                                   schema_pkg_apis_meta_v1_StatusDetails(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                            schema_pkg_apis_meta_v1_Time(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                                       schema_pkg_apis_meta_v1_Timestamp(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                                        schema_pkg_apis_meta_v1_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":                                   schema_pkg_apis_meta_v1_UpdateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                                      schema_pkg_apis_meta_v1_WatchEvent(ref),
+		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                         schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
+		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                             schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/runtime.Unknown":                                              schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
+		"k8s.io/apimachinery/pkg/util/intstr.IntOrString":                                      schema_apimachinery_pkg_util_intstr_IntOrString(ref),
+		"k8s.io/apimachinery/pkg/version.Info":                                                 schema_k8sio_apimachinery_pkg_version_Info(ref),
 	}
 }
 
@@ -474,12 +496,46 @@ func schema_pkg_apis_core_v1alpha1_ClusterInfo(ref common.ReferenceCallback) com
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Kubernetes"),
 						},
 					},
+					"inventory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Inventory contains the number of nodes and pods of the Plant cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ClusterInventory"),
+						},
+					},
 				},
 				Required: []string{"cloud", "kubernetes"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Cloud", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Kubernetes"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Cloud", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ClusterInventory", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Kubernetes"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_ClusterInventory(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterInventory contains the number of resources in the Plant cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes is the number of nodes registered to the cluster.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"pods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pods is the number of non-terminated pods in the cluster.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"nodes", "pods"},
+			},
+		},
 	}
 }
 
@@ -539,6 +595,33 @@ func schema_pkg_apis_core_v1alpha1_Condition(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_pkg_apis_core_v1alpha1_ControllerCompatibility(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControllerCompatibility contains the version ranges supported by a controller. The ranges are semantic version constraints, e.g. \">= 0.28, < 0.30\".",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"gardener": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Gardener is the range of supported Gardener versions. The controller is not deployed by incompatible Gardener versions.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kubernetes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubernetes is the range of supported Kubernetes versions of seed clusters. The controller is not deployed to seeds with incompatible versions.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1alpha1_ControllerDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -559,12 +642,31 @@ func schema_pkg_apis_core_v1alpha1_ControllerDeployment(ref common.ReferenceCall
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"),
 						},
 					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy controls when the controller is deployed to a seed. It defaults to 'Always'.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"seedSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SeedSelector contains an optional label selector for seeds. The controller is only deployed to seeds whose labels match the selector.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout contains the strategy for rolling out changes of the registration to the seeds. If it is not set then changes are rolled out to all seeds at once.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRolloutStrategy"),
+						},
+					},
 				},
 				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRolloutStrategy", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -683,12 +785,24 @@ func schema_pkg_apis_core_v1alpha1_ControllerInstallationSpec(ref common.Referen
 							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
 						},
 					},
+					"deployment": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deployment is the deployment configuration of the referenced registration which has been rolled out to the seed. Installations keep it while a newer version of the registration is being rolled out to other seeds. It is only set if the registration specifies a rollout strategy.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerDeployment"),
+						},
+					},
+					"compatibility": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Compatibility contains the version ranges of the referenced registration which have been rolled out to the seed. It is only considered if the deployment configuration is set.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerCompatibility"),
+						},
+					},
 				},
 				Required: []string{"registrationRef", "seedRef"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ObjectReference"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerCompatibility", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerDeployment", "k8s.io/api/core/v1.ObjectReference"},
 	}
 }
 
@@ -712,6 +826,13 @@ func schema_pkg_apis_core_v1alpha1_ControllerInstallationStatus(ref common.Refer
 							},
 						},
 					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this ControllerInstallation.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 					"providerStatus": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ProviderStatus contains type-specific status.",
@@ -759,11 +880,17 @@ func schema_pkg_apis_core_v1alpha1_ControllerRegistration(ref common.ReferenceCa
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistrationSpec"),
 						},
 					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the status of this registration.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistrationStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistrationSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistrationSpec", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistrationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -816,6 +943,49 @@ func schema_pkg_apis_core_v1alpha1_ControllerRegistrationList(ref common.Referen
 	}
 }
 
+func schema_pkg_apis_core_v1alpha1_ControllerRegistrationSeedStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControllerRegistrationSeedStatus contains the rollout progress of a registration on a seed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the seed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is the rollout state of the current registration specification on the seed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message contains details about the state.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time when the state was updated the last time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"name", "state", "lastUpdateTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_core_v1alpha1_ControllerRegistrationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -842,12 +1012,66 @@ func schema_pkg_apis_core_v1alpha1_ControllerRegistrationSpec(ref common.Referen
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerDeployment"),
 						},
 					},
+					"compatibility": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Compatibility contains the version ranges supported by the controller.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerCompatibility"),
+						},
+					},
 				},
 				Required: []string{"resources"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerDeployment", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerResource"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerCompatibility", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerDeployment", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerResource"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_ControllerRegistrationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControllerRegistrationStatus is the status of a ControllerRegistration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this registration.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represents the latest available observations of a ControllerRegistration's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"seeds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Seeds contains the rollout progress of the registration per seed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistrationSeedStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistrationSeedStatus"},
 	}
 }
 
@@ -894,6 +1118,74 @@ func schema_pkg_apis_core_v1alpha1_ControllerResource(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_core_v1alpha1_ControllerRolloutStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControllerRolloutStrategy contains the strategy for rolling out changes of a registration to the seeds.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxParallelSeeds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxParallelSeeds is the maximum number of seeds to which changes are rolled out at the same time. It defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"waitForHealthy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WaitForHealthy specifies whether the rollout to a seed is only finished once the controller is healthy on it. It defaults to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"haltOnFailure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HaltOnFailure specifies whether the rollout is halted if it failed on a seed. It defaults to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_DualStackNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DualStackNetworks contains CIDRs of the second IP family for the pod, service and node networks of a dual-stack Kubernetes cluster. Each of them must belong to another IP family than the respective primary network.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes is the CIDR of the node network.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pods is the CIDR of the pod network.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"services": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Services is the CIDR of the service network.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1alpha1_Endpoint(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -922,10 +1214,53 @@ func schema_pkg_apis_core_v1alpha1_Endpoint(ref common.ReferenceCallback) common
 							Format:      "",
 						},
 					},
+					"probe": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Probe configures the probing of the endpoint by the Plant health checks. Endpoints without probe configuration are not probed. Only endpoints resolving to public addresses are probed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.EndpointProbe"),
+						},
+					},
 				},
 				Required: []string{"name", "url", "purpose"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.EndpointProbe"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_EndpointProbe(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EndpointProbe configures the probing of a Plant endpoint.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"expectedStatusCodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpectedStatusCodes is the list of HTTP status codes which are considered healthy. Defaults to [200].",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"integer"},
+										Format: "int32",
+									},
+								},
+							},
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the timeout of a single probe. Defaults to 10s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -977,6 +1312,20 @@ func schema_pkg_apis_core_v1alpha1_Kubernetes(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"nodeVersions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeVersions is the list of distinct kubelet versions of the nodes of the Plant cluster.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"version"},
 			},
@@ -1103,23 +1452,219 @@ func schema_pkg_apis_core_v1alpha1_Plant(ref common.ReferenceCallback) common.Op
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"spec": {
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification of this Plant.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the status of this Plant.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantSpec", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_PlantAdoptionInfrastructure(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PlantAdoptionInfrastructure is the infrastructure configuration derived from a Plant cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider is the type of the infrastructure provider (e.g. aws, gcp).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region is the region of the infrastructure.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones is the list of availability zones of the nodes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"kubernetesVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubernetesVersion is the Kubernetes version of the control plane.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"provider", "region", "kubernetesVersion"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_PlantAdoptionMachineImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PlantAdoptionMachineImage is the machine image derived from the operating system of the nodes of a worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the logical name of the machine image.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version of the machine image.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "version"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_PlantAdoptionReport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PlantAdoptionReport is the result of an adoption dry-run of a Plant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time when the report has been computed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"adoptable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Adoptable indicates whether the Plant can be adopted as Shoot, i.e. whether no issues have been found.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"infrastructure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Infrastructure is the infrastructure configuration derived from the Plant cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantAdoptionInfrastructure"),
+						},
+					},
+					"workers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workers is the list of worker pools derived from the nodes of the Plant cluster.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantAdoptionWorker"),
+									},
+								},
+							},
+						},
+					},
+					"issues": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Issues is the list of reasons why the Plant cannot be adopted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"lastUpdateTime", "adoptable", "infrastructure"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantAdoptionInfrastructure", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantAdoptionWorker", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_PlantAdoptionWorker(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PlantAdoptionWorker is a worker pool derived from the nodes of a Plant cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the worker pool.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"machineType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineType is the machine type of the nodes of the worker pool.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones is the list of availability zones of the nodes of the worker pool.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"nodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec contains the specification of this Plant.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantSpec"),
+							Description: "Nodes is the number of nodes of the worker pool.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"status": {
+					"machineImage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the status of this Plant.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantStatus"),
+							Description: "MachineImage is the machine image the nodes of the worker pool are running.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantAdoptionMachineImage"),
 						},
 					},
 				},
+				Required: []string{"name", "machineType", "nodes"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantSpec", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantAdoptionMachineImage"},
 	}
 }
 
@@ -1240,11 +1785,17 @@ func schema_pkg_apis_core_v1alpha1_PlantStatus(ref common.ReferenceCallback) com
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ClusterInfo"),
 						},
 					},
+					"adoption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Adoption is the report of the latest adoption dry-run of the Plant. It describes how the cluster would be mapped to a Shoot and which parts of it cannot be adopted.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantAdoptionReport"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ClusterInfo", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ClusterInfo", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.PlantAdoptionReport"},
 	}
 }
 
@@ -2878,11 +3429,24 @@ func schema_pkg_apis_garden_v1beta1_BackupInfrastructureStatus(ref common.Refere
 							Format:      "int64",
 						},
 					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represents the latest available observations of a BackupInfrastructure's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation"},
 	}
 }
 
@@ -3193,6 +3757,125 @@ func schema_pkg_apis_garden_v1beta1_ClusterAutoscaler(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_ConditionAvailability(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConditionAvailability is the availability of a condition of a Shoot within a time window. A condition is considered unavailable while its status is False or Unknown.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the condition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"window": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Window is the time window the availability is computed for.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"downtime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Downtime is the total time the condition was unavailable within the window.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"percentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Percentage is the availability of the condition within the window in percent (e.g. \"99.95\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "window", "downtime", "percentage"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_ConditionTransition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConditionTransition is a transition of a condition of a Shoot to a new status.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the condition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the new status of the condition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is the reason of the transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time is the point in time of the transition.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"type", "status", "time"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_ControlPlane(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControlPlane contains settings that apply to the control plane components of the Shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"highAvailability": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HighAvailability configures the control plane components to tolerate the failure of a single seed zone.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.HighAvailability"),
+						},
+					},
+					"additionalEgress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdditionalEgress is a list of destinations outside of the seed cluster that the shoot's kube-apiserver is allowed to reach in addition to the default ones, e.g. an OIDC issuer or admission webhooks in a private network.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.EgressDestination"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.EgressDestination", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.HighAvailability"},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_DNS(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3228,24 +3911,179 @@ func schema_pkg_apis_garden_v1beta1_DNS(ref common.ReferenceCallback) common.Ope
 							Format:      "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_DNSProviderConstraint(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "DNSProviderConstraint contains constraints regarding allowed values of the 'dns.provider' block in the Shoot specification.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"providers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Providers is a list of additional DNS providers. Gardener registers them for the Shoot and deploys a DNS controller which creates DNS records in their domains for annotated Services and Ingresses of the Shoot cluster.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProvider"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProvider"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_DNSIncludeExclude(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNSIncludeExclude contains information about which domains shall be included/excluded.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"include": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Include is a list of domains that shall be included.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"exclude": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exclude is a list of domains that shall be excluded.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_DNSProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNSProvider contains information about an additional DNS provider of a Shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the DNS provider type (e.g., aws-route53, google-clouddns, ...).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretName is the name of a secret in the namespace of the Shoot containing the credentials for the provider.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"domains": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Domains contains information about which domains shall be included/excluded for this provider.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSIncludeExclude"),
+						},
+					},
+				},
+				Required: []string{"type", "secretName"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSIncludeExclude"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_DNSProviderConstraint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNSProviderConstraint contains constraints regarding allowed values of the 'dns.provider' block in the Shoot specification.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the DNS provider.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_EgressDestination(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EgressDestination describes a destination which the control plane of a shoot may reach.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is a unique name for this destination.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cidrs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CIDRs is a list of network addresses of the destination.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"fqdns": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FQDNs is a list of fully qualified domain names of the destination. They are resolved to IP addresses whenever the shoot is reconciled.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"ports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the DNS provider.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Ports is a list of TCP ports of the destination. If empty, all ports are allowed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"integer"},
+										Format: "int32",
+									},
+								},
+							},
 						},
 					},
 				},
@@ -3805,6 +4643,27 @@ func schema_pkg_apis_garden_v1beta1_HibernationSchedule(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_HighAvailability(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HighAvailability contains information whether the control plane is highly available.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled is true if the control plane runs with multiple replicas spread across the seed zones. The etcd clusters are deployed with three members and the kube-apiserver, kube-controller-manager and kube-scheduler are deployed with three (leader-elected) replicas. The etcd members never share a zone, hence, the seed must have nodes in at least three zones. This setting cannot be changed after creation.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"enabled"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_HorizontalPodAutoscalerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5353,45 +6212,276 @@ func schema_pkg_apis_garden_v1beta1_PacketWorker(ref common.ReferenceCallback) c
 							Format:      "",
 						},
 					},
-					"machineType": {
+					"machineType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineType is the machine type of the worker group.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"autoScalerMin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoScalerMin is the minimum number of VMs to create.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"autoScalerMax": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoScalerMin is the maximum number of VMs to create.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxSurge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSurge is maximum number of VMs that are created during an update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during an update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"taints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Taint"),
+									},
+								},
+							},
+						},
+					},
+					"volumeType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeType is the type of the root volumes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeSize is the size of the root volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax", "volumeType", "volumeSize"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_Project(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Project holds certain properties about a Gardener project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the project properties.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Most recently observed status of the Project.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectSpec", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_ProjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectList is a collection of Projects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of Projects.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Project"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Project", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_ProjectMember(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectMember is a subject together with the roles it has in a project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind of object being referenced. Values defined by this API group are \"User\", \"Group\", and \"ServiceAccount\". If the Authorizer does not recognized the kind value, the Authorizer should report an error.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiGroup": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineType is the machine type of the worker group.",
+							Description: "APIGroup holds the API group of the referenced subject. Defaults to \"\" for ServiceAccount subjects. Defaults to \"rbac.authorization.k8s.io\" for User and Group subjects.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"autoScalerMin": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AutoScalerMin is the minimum number of VMs to create.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Name of the object being referenced.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"autoScalerMax": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AutoScalerMin is the maximum number of VMs to create.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Namespace of the referenced object.  If the object kind is non-namespace, such as \"User\" or \"Group\", and this value is not empty the Authorizer should report an error.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"maxSurge": {
+					"roles": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxSurge is maximum number of VMs that are created during an update.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							Description: "Roles is a list of roles of this member. Besides the predefined roles \"admin\", \"shoot-operator\", \"secret-manager\", and \"viewer\", custom roles prefixed with \"extension:\" can be used. The permissions of a custom role are the aggregation of all ClusterRoles labelled with \"garden.sapcloud.io/project-extension-role: <name>\".",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"maxUnavailable": {
+				},
+				Required: []string{"kind", "name", "roles"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_ProjectPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectPolicy contains restrictions for the shoots of a project. Unset fields do not restrict the shoots.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxShoots": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during an update.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							Description: "MaxShoots is the maximum number of shoots in the project.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"annotations": {
+					"allowedCloudProfiles": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "AllowedCloudProfiles is a list of names of cloud profiles the shoots may use.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Type:   []string{"string"},
@@ -5401,12 +6491,11 @@ func schema_pkg_apis_garden_v1beta1_PacketWorker(ref common.ReferenceCallback) c
 							},
 						},
 					},
-					"labels": {
+					"allowedRegions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "AllowedRegions is a list of regions the shoots may use.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Type:   []string{"string"},
@@ -5416,135 +6505,136 @@ func schema_pkg_apis_garden_v1beta1_PacketWorker(ref common.ReferenceCallback) c
 							},
 						},
 					},
-					"taints": {
+					"allowedSeeds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
+							Description: "AllowedSeeds is a list of names of seeds the shoots may be scheduled to.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.Taint"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"volumeType": {
+					"allowedKubernetesVersions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeType is the type of the root volumes.",
+							Description: "AllowedKubernetesVersions is a semantic version constraint (e.g., \">= 1.14, < 1.16\") which the Kubernetes versions of the shoots must meet.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"volumeSize": {
+					"requiredLabels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeSize is the size of the root volume.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "RequiredLabels is a list of label keys which the shoots must have.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"requiredAnnotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequiredAnnotations is a list of annotation keys which the shoots must have.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"maxWorkerPoolSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxWorkerPoolSize is the maximum number of machines of a worker pool of the shoots (maximum of the autoscaler).",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax", "volumeType", "volumeSize"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Project(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_ProjectServiceAccount(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Project holds certain properties about a Gardener project.",
+				Description: "ProjectServiceAccount is a service account for automation in the project namespace.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Description: "Name is the name of the ServiceAccount in the project namespace.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Standard object metadata.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the project properties.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectSpec"),
-						},
-					},
-					"status": {
+					"roles": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Most recently observed status of the Project.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectStatus"),
+							Description: "Roles is a list of project roles of the service account. The same roles as for project members can be used.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"name", "roles"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectSpec", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_ProjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_ProjectServiceAccountStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProjectList is a collection of Projects.",
+				Description: "ProjectServiceAccountStatus is the status of a service account of a project.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Description: "Name is the name of the ServiceAccount in the project namespace.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"kubeconfigSecretName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Description: "KubeconfigSecretName is the name of the secret in the project namespace containing the kubeconfig of the service account (data key \"kubeconfig\").",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Standard list object metadata.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
+					"lastTokenRotationTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of Projects.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Project"),
-									},
-								},
-							},
+							Description: "LastTokenRotationTime is the time when the token of the service account has been issued.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"name", "kubeconfigSecretName"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Project", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -5583,7 +6673,7 @@ func schema_pkg_apis_garden_v1beta1_ProjectSpec(ref common.ReferenceCallback) co
 					},
 					"members": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Members is a list of subjects representing a user name, an email address, or any other identifier of a user that should be part of this project with full permissions to manage it.",
+							Description: "Members is a list of subjects representing a user name, an email address, or any other identifier of a user that should be part of this project with full permissions to manage it. The subjects are moved into ProjectMembers with the \"admin\" role by the API server. Deprecated: Use ProjectMembers instead.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -5603,7 +6693,7 @@ func schema_pkg_apis_garden_v1beta1_ProjectSpec(ref common.ReferenceCallback) co
 					},
 					"viewers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Viewers is a list of subjects representing a user name, an email address, or any other identifier of a user that should be part of this project with limited permissions to only view some resources.",
+							Description: "Viewers is a list of subjects representing a user name, an email address, or any other identifier of a user that should be part of this project with limited permissions to only view some resources. The subjects are moved into ProjectMembers with the \"viewer\" role by the API server. Deprecated: Use ProjectMembers instead.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -5614,11 +6704,43 @@ func schema_pkg_apis_garden_v1beta1_ProjectSpec(ref common.ReferenceCallback) co
 							},
 						},
 					},
+					"projectMembers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProjectMembers is a list of subjects together with the roles they have in this project.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectMember"),
+									},
+								},
+							},
+						},
+					},
+					"serviceAccounts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccounts is a list of service accounts for automation (e.g., CI pipelines) in the project namespace. For every service account, a ServiceAccount bound to the given project roles and a secret containing a kubeconfig with a regularly rotated token are created.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectServiceAccount"),
+									},
+								},
+							},
+						},
+					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy contains restrictions for the shoots of the project which are enforced during admission.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectPolicy"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/rbac/v1.Subject"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectMember", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectPolicy", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectServiceAccount", "k8s.io/api/rbac/v1.Subject"},
 	}
 }
 
@@ -5643,9 +6765,55 @@ func schema_pkg_apis_garden_v1beta1_ProjectStatus(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represents the latest available observations of a Project's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"lastActivityTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastActivityTimestamp is the time of the last activity in the project, i.e., the last operation of one of its shoots or the last change of its specification (e.g., of its members).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"staleSinceTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StaleSinceTimestamp is the time since when the project is considered to be stale, i.e., since when there was no activity in the project for the configured stale period. It is unset if the project is not stale.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"staleAutoDeleteTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StaleAutoDeleteTimestamp is the time after which the project will be deleted automatically if it is still stale and does not contain any shoots, plants, or backup infrastructures anymore.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"serviceAccounts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccounts contains the most recently observed status of the service accounts of the project.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectServiceAccountStatus"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectServiceAccountStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -6042,10 +7210,18 @@ func schema_pkg_apis_garden_v1beta1_SeedNetworks(ref common.ReferenceCallback) c
 							Format:      "",
 						},
 					},
+					"dualStack": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DualStack contains the CIDRs of the networks of the second IP family if the seed cluster is dual-stack.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.DualStackNetworks"),
+						},
+					},
 				},
 				Required: []string{"nodes", "pods", "services"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.DualStackNetworks"},
 	}
 }
 
@@ -6284,6 +7460,12 @@ func schema_pkg_apis_garden_v1beta1_ShootSpec(ref common.ReferenceCallback) comm
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud"),
 						},
 					},
+					"controlPlane": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControlPlane contains settings that apply to the control plane components of the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ControlPlane"),
+						},
+					},
 					"dns": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DNS contains information about the DNS settings of the Shoot.",
@@ -6325,7 +7507,7 @@ func schema_pkg_apis_garden_v1beta1_ShootSpec(ref common.ReferenceCallback) comm
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Backup", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Extension", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kubernetes", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Backup", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ControlPlane", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Extension", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kubernetes", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance"},
 	}
 }
 
@@ -6349,6 +7531,32 @@ func schema_pkg_apis_garden_v1beta1_ShootStatus(ref common.ReferenceCallback) co
 							},
 						},
 					},
+					"conditionHistory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConditionHistory contains the most recent transitions of the Shoot's conditions. It is bounded in size and only covers the configured availability window.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ConditionTransition"),
+									},
+								},
+							},
+						},
+					},
+					"availability": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Availability contains the availability of the Shoot per condition type computed from the condition history.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ConditionAvailability"),
+									},
+								},
+							},
+						},
+					},
 					"gardener": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Gardener holds information about the Gardener which last acted on the Shoot.",
@@ -6406,7 +7614,7 @@ func schema_pkg_apis_garden_v1beta1_ShootStatus(ref common.ReferenceCallback) co
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ConditionAvailability", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ConditionTransition", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -0,0 +1,229 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/operation/common"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/gardener/gardener/pkg/utils/retry"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// BackupDefaultTimeout is the default timeout and defines how long Gardener should wait
+// for a successful reconciliation or deletion of a backup bucket or backup entry resource.
+const BackupDefaultTimeout = 10 * time.Minute
+
+// DeployBackupBucket creates the `BackupBucket` extension resource shared by all Shoots of the Seed as well as the
+// secret containing the credentials of the Seed it references.
+func (b *Botanist) DeployBackupBucket(ctx context.Context) error {
+	var (
+		name   = common.GenerateBackupBucketName(b.Seed.Info)
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      common.GenerateBackupBucketSecretName(name),
+				Namespace: common.GardenNamespace,
+			},
+		}
+		backupBucket = &extensionsv1alpha1.BackupBucket{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		}
+	)
+
+	if err := kutil.CreateOrUpdate(ctx, b.K8sSeedClient.Client(), secret, func() error {
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = make(map[string][]byte, len(b.Seed.Secret.Data))
+		for key, value := range b.Seed.Secret.Data {
+			// The kubeconfig of the Seed cluster is not required to access the bucket.
+			if key != kubernetes.KubeConfig {
+				secret.Data[key] = value
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return kutil.CreateOrUpdate(ctx, b.K8sSeedClient.Client(), backupBucket, func() error {
+		backupBucket.Spec = extensionsv1alpha1.BackupBucketSpec{
+			DefaultSpec: extensionsv1alpha1.DefaultSpec{
+				Type: string(b.Seed.CloudProvider),
+			},
+			Region: b.Seed.Info.Spec.Cloud.Region,
+			SecretRef: corev1.SecretReference{
+				Name:      secret.Name,
+				Namespace: secret.Namespace,
+			},
+		}
+		return nil
+	})
+}
+
+// WaitUntilBackupBucketReady waits until the backup bucket resource of the Seed has been reconciled successfully.
+func (b *Botanist) WaitUntilBackupBucketReady(ctx context.Context) error {
+	if err := retry.UntilTimeout(ctx, DefaultInterval, BackupDefaultTimeout, func(ctx context.Context) (bool, error) {
+		backupBucket := &extensionsv1alpha1.BackupBucket{}
+		if err := b.K8sSeedClient.Client().Get(ctx, client.ObjectKey{Name: common.GenerateBackupBucketName(b.Seed.Info)}, backupBucket); err != nil {
+			return retry.SevereError(err)
+		}
+
+		if err := health.CheckExtensionObject(backupBucket); err != nil {
+			b.Logger.WithError(err).Error("Backup bucket did not get ready yet")
+			return retry.MinorError(err)
+		}
+		return retry.Ok()
+	}); err != nil {
+		return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("failed to create backup bucket: %v", err))
+	}
+	return nil
+}
+
+// DeployBackupEntry creates the `BackupEntry` extension resource for the BackupInfrastructure in the backup bucket
+// of the Seed. Gardener waits until an external controller did reconcile it successfully.
+func (b *Botanist) DeployBackupEntry(ctx context.Context) error {
+	backupBucket := &extensionsv1alpha1.BackupBucket{}
+	if err := b.K8sSeedClient.Client().Get(ctx, client.ObjectKey{Name: common.GenerateBackupBucketName(b.Seed.Info)}, backupBucket); err != nil {
+		return err
+	}
+
+	backupEntry := &extensionsv1alpha1.BackupEntry{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: b.BackupInfrastructure.Name,
+		},
+	}
+
+	return kutil.CreateOrUpdate(ctx, b.K8sSeedClient.Client(), backupEntry, func() error {
+		metav1.SetMetaDataAnnotation(&backupEntry.ObjectMeta, gardencorev1alpha1.GardenerOperation, gardencorev1alpha1.GardenerOperationReconcile)
+
+		backupEntry.Spec = extensionsv1alpha1.BackupEntrySpec{
+			DefaultSpec: extensionsv1alpha1.DefaultSpec{
+				Type: backupBucket.Spec.Type,
+			},
+			Region:     backupBucket.Spec.Region,
+			BucketName: backupBucket.Name,
+			SecretRef:  backupBucketSecretRef(backupBucket),
+		}
+		return nil
+	})
+}
+
+// WaitUntilBackupEntryReady waits until the backup entry resource has been reconciled successfully.
+func (b *Botanist) WaitUntilBackupEntryReady(ctx context.Context) error {
+	if err := retry.UntilTimeout(ctx, DefaultInterval, BackupDefaultTimeout, func(ctx context.Context) (bool, error) {
+		backupEntry := &extensionsv1alpha1.BackupEntry{}
+		if err := b.K8sSeedClient.Client().Get(ctx, client.ObjectKey{Name: b.BackupInfrastructure.Name}, backupEntry); err != nil {
+			return retry.SevereError(err)
+		}
+
+		if err := health.CheckExtensionObject(backupEntry); err != nil {
+			b.Logger.WithError(err).Error("Backup entry did not get ready yet")
+			return retry.MinorError(err)
+		}
+		return retry.Ok()
+	}); err != nil {
+		return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("failed to create backup entry: %v", err))
+	}
+	return nil
+}
+
+// DestroyBackupEntry deletes the `BackupEntry` extension resource of the BackupInfrastructure. The external controller
+// deletes all backups of the Shoot in the backup bucket of the Seed before it releases the resource.
+func (b *Botanist) DestroyBackupEntry(ctx context.Context) error {
+	if err := b.K8sSeedClient.Client().Delete(ctx, &extensionsv1alpha1.BackupEntry{ObjectMeta: metav1.ObjectMeta{Name: b.BackupInfrastructure.Name}}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// WaitUntilBackupEntryDeleted waits until the backup entry resource has been deleted.
+func (b *Botanist) WaitUntilBackupEntryDeleted(ctx context.Context) error {
+	var lastError *gardencorev1alpha1.LastError
+
+	if err := retry.UntilTimeout(ctx, DefaultInterval, BackupDefaultTimeout, func(ctx context.Context) (bool, error) {
+		backupEntry := &extensionsv1alpha1.BackupEntry{}
+		if err := b.K8sSeedClient.Client().Get(ctx, client.ObjectKey{Name: b.BackupInfrastructure.Name}, backupEntry); err != nil {
+			if apierrors.IsNotFound(err) {
+				return retry.Ok()
+			}
+			return retry.SevereError(err)
+		}
+
+		if lastErr := backupEntry.Status.LastError; lastErr != nil {
+			b.Logger.Errorf("Backup entry did not get deleted yet, lastError is: %s", lastErr.Description)
+			lastError = lastErr
+		}
+
+		b.Logger.Infof("Waiting for backup entry to be deleted...")
+		return retry.MinorError(wrapWithLastError(fmt.Errorf("backup entry is still present"), lastError))
+	}); err != nil {
+		message := "Failed to delete backup entry"
+		if lastError != nil {
+			return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("%s: %s", message, lastError.Description))
+		}
+		return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("%s: %s", message, err.Error()))
+	}
+
+	return nil
+}
+
+// GenerateEtcdBackupConfigFromBackupEntry returns the data of the etcd backup secret of the Shoot if its backups are
+// managed by a `BackupEntry` extension resource. It consists of the credentials for the backup bucket, the name of the
+// bucket and the prefix of the objects of the Shoot in the bucket. If there is no backup entry for the Shoot, nil is
+// returned.
+func (b *Botanist) GenerateEtcdBackupConfigFromBackupEntry(ctx context.Context) (map[string][]byte, error) {
+	backupEntry := &extensionsv1alpha1.BackupEntry{}
+	if err := b.K8sSeedClient.Client().Get(ctx, client.ObjectKey{Name: common.GenerateBackupInfrastructureName(b.Shoot.SeedNamespace, b.Shoot.Info.Status.UID)}, backupEntry); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	credentials := &corev1.Secret{}
+	if err := b.K8sSeedClient.Client().Get(ctx, kutil.Key(backupEntry.Spec.SecretRef.Namespace, backupEntry.Spec.SecretRef.Name), credentials); err != nil {
+		return nil, err
+	}
+
+	secretData := make(map[string][]byte, len(credentials.Data)+2)
+	for key, value := range credentials.Data {
+		secretData[key] = value
+	}
+	secretData[common.BackupBucketName] = []byte(backupEntry.Spec.BucketName)
+	secretData[common.BackupStorePrefix] = []byte(backupEntry.Name)
+
+	return secretData, nil
+}
+
+// backupBucketSecretRef returns the reference to the secret containing the credentials for the given <backupBucket>.
+func backupBucketSecretRef(backupBucket *extensionsv1alpha1.BackupBucket) corev1.SecretReference {
+	if backupBucket.Status.GeneratedSecretRef != nil {
+		return *backupBucket.Status.GeneratedSecretRef
+	}
+	return backupBucket.Spec.SecretRef
+}
@@ -191,7 +191,10 @@ func (b *Botanist) RequiredExtensionsExist() error {
 		return err
 	}
 
-	requiredExtensions := b.computeRequiredExtensions()
+	var (
+		requiredExtensions  = b.computeRequiredExtensions()
+		unhealthyExtensions []string
+	)
 
 	for _, controllerInstallation := range controllerInstallationList.Items {
 		if controllerInstallation.Spec.SeedRef.Name != b.Seed.Info.Name {
@@ -205,9 +208,14 @@ func (b *Botanist) RequiredExtensionsExist() error {
 
 		for extensionKind, extensionTypes := range requiredExtensions {
 			for extensionType := range extensionTypes {
-				if helper.IsResourceSupported(controllerRegistration.Spec.Resources, extensionKind, extensionType) && helper.IsControllerInstallationSuccessful(controllerInstallation) {
-					extensionTypes.Delete(extensionType)
+				if !helper.IsResourceSupported(controllerRegistration.Spec.Resources, extensionKind, extensionType) || !helper.IsControllerInstallationSuccessful(controllerInstallation) {
+					continue
 				}
+				if !helper.IsControllerInstallationHealthy(controllerInstallation) {
+					unhealthyExtensions = append(unhealthyExtensions, fmt.Sprintf("%s/%s (%s)", extensionKind, extensionType, controllerInstallation.Name))
+					continue
+				}
+				extensionTypes.Delete(extensionType)
 			}
 			if extensionTypes.Len() == 0 {
 				delete(requiredExtensions, extensionKind)
@@ -216,6 +224,9 @@ func (b *Botanist) RequiredExtensionsExist() error {
 	}
 
 	if len(requiredExtensions) > 0 {
+		if len(unhealthyExtensions) > 0 {
+			return fmt.Errorf("extension controllers missing or unready: %+v, unhealthy: %s", requiredExtensions, strings.Join(unhealthyExtensions, ", "))
+		}
 		return fmt.Errorf("extension controllers missing or unready: %+v", requiredExtensions)
 	}
 
@@ -15,12 +15,14 @@
 package botanist_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/operation/botanist"
 	"github.com/gardener/gardener/pkg/operation/common"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -30,6 +32,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -118,6 +121,13 @@ func newStatefulSet(namespace, name, role string, healthy bool) *appsv1.Stateful
 	return statefulSet
 }
 
+func newMultiMemberStatefulSet(namespace, name, role string, replicas, readyReplicas int32) *appsv1.StatefulSet {
+	statefulSet := newStatefulSet(namespace, name, role, false)
+	statefulSet.Spec.Replicas = &replicas
+	statefulSet.Status.ReadyReplicas = readyReplicas
+	return statefulSet
+}
+
 func newDaemonSet(namespace, name, role string, healthy bool) *appsv1.DaemonSet {
 	daemonSet := &appsv1.DaemonSet{
 		ObjectMeta: metav1.ObjectMeta{
@@ -193,6 +203,16 @@ var _ = Describe("health check", func() {
 				},
 			},
 		}
+		gcpShootWithHighAvailability = &gardenv1beta1.Shoot{
+			Spec: gardenv1beta1.ShootSpec{
+				Cloud: gardenv1beta1.Cloud{
+					GCP: &gardenv1beta1.GCPCloud{},
+				},
+				ControlPlane: &gardenv1beta1.ControlPlane{
+					HighAvailability: &gardenv1beta1.HighAvailability{Enabled: true},
+				},
+			},
+		}
 		gcpShootWithAutoscaler = &gardenv1beta1.Shoot{
 			Spec: gardenv1beta1.ShootSpec{
 				Cloud: gardenv1beta1.Cloud{
@@ -401,6 +421,46 @@ var _ = Describe("health check", func() {
 			},
 			nil,
 			beConditionWithStatus(gardencorev1alpha1.ConditionFalse)),
+		Entry("all healthy (with high availability)",
+			gcpShootWithHighAvailability,
+			gardenv1beta1.CloudProviderGCP,
+			requiredControlPlaneDeployments,
+			[]*appsv1.StatefulSet{
+				newMultiMemberStatefulSet(seedNamespace, gardencorev1alpha1.StatefulSetNameETCDMain, common.GardenRoleControlPlane, 3, 3),
+				newMultiMemberStatefulSet(seedNamespace, gardencorev1alpha1.StatefulSetNameETCDEvents, common.GardenRoleControlPlane, 3, 3),
+			},
+			nil,
+			BeNil()),
+		Entry("single etcd member unavailable (with high availability)",
+			gcpShootWithHighAvailability,
+			gardenv1beta1.CloudProviderGCP,
+			requiredControlPlaneDeployments,
+			[]*appsv1.StatefulSet{
+				newMultiMemberStatefulSet(seedNamespace, gardencorev1alpha1.StatefulSetNameETCDMain, common.GardenRoleControlPlane, 3, 2),
+				newMultiMemberStatefulSet(seedNamespace, gardencorev1alpha1.StatefulSetNameETCDEvents, common.GardenRoleControlPlane, 3, 3),
+			},
+			nil,
+			BeNil()),
+		Entry("etcd quorum lost (with high availability)",
+			gcpShootWithHighAvailability,
+			gardenv1beta1.CloudProviderGCP,
+			requiredControlPlaneDeployments,
+			[]*appsv1.StatefulSet{
+				newMultiMemberStatefulSet(seedNamespace, gardencorev1alpha1.StatefulSetNameETCDMain, common.GardenRoleControlPlane, 3, 1),
+				newMultiMemberStatefulSet(seedNamespace, gardencorev1alpha1.StatefulSetNameETCDEvents, common.GardenRoleControlPlane, 3, 3),
+			},
+			nil,
+			beConditionWithStatus(gardencorev1alpha1.ConditionFalse)),
+		Entry("single etcd member unavailable (without high availability)",
+			gcpShoot,
+			gardenv1beta1.CloudProviderGCP,
+			requiredControlPlaneDeployments,
+			[]*appsv1.StatefulSet{
+				newMultiMemberStatefulSet(seedNamespace, gardencorev1alpha1.StatefulSetNameETCDMain, common.GardenRoleControlPlane, 3, 2),
+				etcdEventsStatefulSet,
+			},
+			nil,
+			beConditionWithStatus(gardencorev1alpha1.ConditionFalse)),
 		Entry("rolling update ongoing (with autoscaler)",
 			gcpShootWithAutoscaler,
 			gardenv1beta1.CloudProviderGCP,
@@ -419,6 +479,48 @@ var _ = Describe("health check", func() {
 			BeNil()),
 	)
 
+	DescribeTable("#CheckCustomCondition",
+		func(condition gardencorev1alpha1.Condition, builtin bool, failing sets.String, conditionMatcher types.GomegaMatcher) {
+			var (
+				checker      = botanist.NewHealthChecker(map[gardencorev1alpha1.ConditionType]time.Duration{})
+				healthChecks = []config.HealthCheck{{Name: "foo"}, {Name: "bar"}}
+			)
+
+			exitCondition := checker.CheckCustomCondition(condition, builtin, healthChecks, func(healthCheck config.HealthCheck) error {
+				if failing.Has(healthCheck.Name) {
+					return fmt.Errorf("%s failed", healthCheck.Name)
+				}
+				return nil
+			})
+			Expect(&exitCondition).To(conditionMatcher)
+		},
+		Entry("all checks passed",
+			condition,
+			false,
+			sets.NewString(),
+			beConditionWithStatus(gardencorev1alpha1.ConditionTrue)),
+		Entry("check failed",
+			condition,
+			false,
+			sets.NewString("bar"),
+			beConditionWithStatus(gardencorev1alpha1.ConditionFalse)),
+		Entry("all checks passed (builtin)",
+			gardencorev1alpha1.Condition{Type: condition.Type, Status: gardencorev1alpha1.ConditionTrue, Reason: "ControlPlaneRunning"},
+			true,
+			sets.NewString(),
+			PointTo(Equal(gardencorev1alpha1.Condition{Type: condition.Type, Status: gardencorev1alpha1.ConditionTrue, Reason: "ControlPlaneRunning"}))),
+		Entry("check failed (builtin)",
+			gardencorev1alpha1.Condition{Type: condition.Type, Status: gardencorev1alpha1.ConditionTrue},
+			true,
+			sets.NewString("foo"),
+			beConditionWithStatus(gardencorev1alpha1.ConditionFalse)),
+		Entry("builtin condition already unhealthy",
+			gardencorev1alpha1.Condition{Type: condition.Type, Status: gardencorev1alpha1.ConditionFalse, Reason: "DeploymentUnhealthy"},
+			true,
+			sets.NewString("foo"),
+			PointTo(Equal(gardencorev1alpha1.Condition{Type: condition.Type, Status: gardencorev1alpha1.ConditionFalse, Reason: "DeploymentUnhealthy"}))),
+	)
+
 	DescribeTable("#CheckSystemComponents",
 		func(deployments []*appsv1.Deployment, daemonSets []*appsv1.DaemonSet, conditionMatcher types.GomegaMatcher) {
 			var (
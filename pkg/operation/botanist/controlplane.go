@@ -633,10 +633,21 @@ func (b *Botanist) DeployDependencyWatchdog(ctx context.Context) error {
 // * kube-apiserver
 // * kube-controller-manager
 func (b *Botanist) WakeUpControlPlane(ctx context.Context) error {
-	client := b.K8sSeedClient.Client()
+	var (
+		client                          = b.K8sSeedClient.Client()
+		etcdReplicas              int32 = 1
+		apiServerReplicas         int32 = 1
+		controllerManagerReplicas int32 = 1
+	)
+
+	if b.Shoot.WantsHighAvailability {
+		etcdReplicas = common.EtcdHighAvailabilityMembers
+		apiServerReplicas = common.KubeAPIServerHighAvailabilityMinReplicas
+		controllerManagerReplicas = common.ControlPlaneHighAvailabilityReplicas
+	}
 
 	for _, statefulset := range []string{gardencorev1alpha1.StatefulSetNameETCDEvents, gardencorev1alpha1.StatefulSetNameETCDMain} {
-		if err := kubernetes.ScaleStatefulSet(ctx, client, kutil.Key(b.Shoot.SeedNamespace, statefulset), 1); err != nil {
+		if err := kubernetes.ScaleStatefulSet(ctx, client, kutil.Key(b.Shoot.SeedNamespace, statefulset), etcdReplicas); err != nil {
 			return err
 		}
 	}
@@ -644,18 +655,18 @@ func (b *Botanist) WakeUpControlPlane(ctx context.Context) error {
 		return err
 	}
 
-	if err := kubernetes.ScaleDeployment(ctx, client, kutil.Key(b.Shoot.SeedNamespace, gardencorev1alpha1.DeploymentNameKubeAPIServer), 1); err != nil {
+	if err := kubernetes.ScaleDeployment(ctx, client, kutil.Key(b.Shoot.SeedNamespace, gardencorev1alpha1.DeploymentNameKubeAPIServer), apiServerReplicas); err != nil {
 		return err
 	}
 	if err := b.WaitUntilKubeAPIServerReady(ctx); err != nil {
 		return err
 	}
 
-	for _, deployment := range []string{
-		gardencorev1alpha1.DeploymentNameKubeControllerManager,
-		gardencorev1alpha1.DeploymentNameGardenerResourceManager,
+	for deployment, replicas := range map[string]int32{
+		gardencorev1alpha1.DeploymentNameKubeControllerManager:   controllerManagerReplicas,
+		gardencorev1alpha1.DeploymentNameGardenerResourceManager: 1,
 	} {
-		if err := kubernetes.ScaleDeployment(ctx, client, kutil.Key(b.Shoot.SeedNamespace, deployment), 1); err != nil {
+		if err := kubernetes.ScaleDeployment(ctx, client, kutil.Key(b.Shoot.SeedNamespace, deployment), replicas); err != nil {
 			return err
 		}
 	}
@@ -46,7 +46,7 @@ const (
 
 // DeployInternalDomainDNSRecord deploys the DNS record for the internal cluster domain.
 func (b *Botanist) DeployInternalDomainDNSRecord(ctx context.Context) error {
-	if err := b.deployDNSProvider(ctx, DNSPurposeInternal, b.Garden.InternalDomain.Provider, b.Garden.InternalDomain.SecretData, b.Shoot.InternalClusterDomain); err != nil {
+	if err := b.deployDNSProvider(ctx, DNSPurposeInternal, b.Garden.InternalDomain.Provider, "", b.Garden.InternalDomain.SecretData, []string{b.Shoot.InternalClusterDomain}, nil); err != nil {
 		return err
 	}
 	if err := b.deployDNSEntry(ctx, DNSPurposeInternal, b.Shoot.InternalClusterDomain, b.APIServerAddress); err != nil {
@@ -69,7 +69,7 @@ func (b *Botanist) DeployExternalDomainDNSRecord(ctx context.Context) error {
 		return nil
 	}
 
-	if err := b.deployDNSProvider(ctx, DNSPurposeExternal, b.Shoot.ExternalDomain.Provider, b.Shoot.ExternalDomain.SecretData, *b.Shoot.Info.Spec.DNS.Domain); err != nil {
+	if err := b.deployDNSProvider(ctx, DNSPurposeExternal, b.Shoot.ExternalDomain.Provider, "", b.Shoot.ExternalDomain.SecretData, []string{*b.Shoot.Info.Spec.DNS.Domain}, nil); err != nil {
 		return err
 	}
 	if err := b.deployDNSEntry(ctx, DNSPurposeExternal, *b.Shoot.ExternalClusterDomain, b.Shoot.InternalClusterDomain); err != nil {
@@ -86,13 +86,17 @@ func (b *Botanist) DestroyExternalDomainDNSRecord(ctx context.Context) error {
 	return b.deleteDNSProvider(ctx, DNSPurposeExternal)
 }
 
-func (b *Botanist) deployDNSProvider(ctx context.Context, name, provider string, secretData map[string][]byte, includedDomains ...string) error {
+// deployDNSProvider deploys a DNSProvider and its secret. If <class> is empty, the DNSProvider has the default class
+// and is handled by the DNS controller of the Seed.
+func (b *Botanist) deployDNSProvider(ctx context.Context, name, provider, class string, secretData map[string][]byte, includedDomains, excludedDomains []string) error {
 	values := map[string]interface{}{
 		"name":       name,
 		"provider":   provider,
+		"class":      class,
 		"secretData": secretData,
 		"domains": map[string]interface{}{
 			"include": includedDomains,
+			"exclude": excludedDomains,
 		},
 	}
 
@@ -0,0 +1,202 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/operation/common"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/retry"
+
+	dnsv1alpha1 "github.com/gardener/external-dns-management/pkg/apis/dns/v1alpha1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// dnsAdditionalProviderPrefix is the prefix of the names of the DNSProviders for the additional DNS providers of
+	// a Shoot.
+	dnsAdditionalProviderPrefix = "additional-"
+	// dnsSourceCreatorLabel is the label the dns-controller-manager adds to the DNSEntries it creates for annotated
+	// Services and Ingresses of the Shoot cluster.
+	dnsSourceCreatorLabel = "gardener.cloud/shoot-dns"
+)
+
+// DeployAdditionalDNSProviders deploys the additional DNS providers of the Shoot and the dns-controller-manager which
+// creates DNS records in their domains for annotated Services and Ingresses of the Shoot cluster. DNS providers which
+// have been removed from the Shoot specification are deleted.
+func (b *Botanist) DeployAdditionalDNSProviders(ctx context.Context) error {
+	providers := b.Shoot.Info.Spec.DNS.Providers
+	if len(providers) == 0 {
+		return b.DestroyAdditionalDNSProviders(ctx)
+	}
+
+	// The DNSProviders are handled by the dns-controller-manager only, hence, it must be running before their
+	// readiness can be awaited. The DNSProviders of a hibernated Shoot are updated when it is woken up.
+	if err := b.deployDNSControllerManager(true, b.Shoot.GetReplicas(1)); err != nil {
+		return err
+	}
+	if b.Shoot.IsHibernated {
+		return nil
+	}
+
+	wantedProviders := sets.NewString()
+	for _, provider := range providers {
+		secret := &corev1.Secret{}
+		if err := b.K8sGardenClient.Client().Get(ctx, kutil.Key(b.Shoot.Info.Namespace, provider.SecretName), secret); err != nil {
+			return fmt.Errorf("could not get secret %q of additional DNS provider: %v", provider.SecretName, err)
+		}
+
+		var includedDomains, excludedDomains []string
+		if provider.Domains != nil {
+			includedDomains, excludedDomains = provider.Domains.Include, provider.Domains.Exclude
+		}
+
+		name := dnsAdditionalProviderPrefix + provider.SecretName
+		if err := b.deployDNSProvider(ctx, name, provider.Type, b.additionalDNSClass(), secret.Data, includedDomains, excludedDomains); err != nil {
+			return err
+		}
+		wantedProviders.Insert(name)
+	}
+
+	return b.deleteAdditionalDNSProviders(ctx, wantedProviders)
+}
+
+// DestroyAdditionalDNSProviders deletes the DNS records created by the dns-controller-manager, the additional DNS
+// providers of the Shoot, and the dns-controller-manager. The dns-controller-manager is the only controller handling
+// the DNS records and providers, hence, it stops watching the Shoot cluster first so that no records are re-created,
+// and it is deleted last.
+func (b *Botanist) DestroyAdditionalDNSProviders(ctx context.Context) error {
+	deployment := &appsv1.Deployment{}
+	if err := b.K8sSeedClient.Client().Get(ctx, kutil.Key(b.Shoot.SeedNamespace, common.DNSControllerManagerDeploymentName), deployment); err == nil {
+		if err := b.deployDNSControllerManager(false, 1); err != nil {
+			return err
+		}
+		if err := b.waitUntilDNSControllerManagerRolledOut(ctx); err != nil {
+			return err
+		}
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	entryList := &dnsv1alpha1.DNSEntryList{}
+	if err := b.K8sSeedClient.Client().List(ctx, entryList, client.UseListOptions(&client.ListOptions{
+		Namespace:     b.Shoot.SeedNamespace,
+		LabelSelector: labels.NewSelector().Add(MustNewRequirement(dnsSourceCreatorLabel, selection.Exists)),
+	})); err != nil {
+		return err
+	}
+	for _, entry := range entryList.Items {
+		if err := b.deleteDNSEntry(ctx, entry.Name); err != nil {
+			return err
+		}
+	}
+
+	if err := b.deleteAdditionalDNSProviders(ctx, sets.NewString()); err != nil {
+		return err
+	}
+
+	deployment = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: common.DNSControllerManagerDeploymentName, Namespace: b.Shoot.SeedNamespace}}
+	if err := b.K8sSeedClient.Client().Delete(ctx, deployment, kubernetes.DefaultDeleteOptionFuncs...); client.IgnoreNotFound(err) != nil {
+		return err
+	}
+	return kutil.WaitUntilResourceDeletedWithDefaults(ctx, b.K8sSeedClient.Client(), deployment)
+}
+
+// additionalDNSClass returns the DNS class of the additional DNS providers of the Shoot and the DNS records for its
+// Services and Ingresses. The DNS controller of the Seed looks up the providers for a record across all namespaces,
+// hence, the class is unique per Shoot so that neither the DNS controller of the Seed nor the one of another Shoot
+// creates these records, and the records are only created with the additional DNS providers of the Shoot.
+func (b *Botanist) additionalDNSClass() string {
+	return b.Shoot.SeedNamespace
+}
+
+// deployDNSControllerManager deploys the dns-controller-manager handling the additional DNS providers of the Shoot.
+// If <sources> is false, it does not watch the Services and Ingresses of the Shoot cluster.
+func (b *Botanist) deployDNSControllerManager(sources bool, replicas int) error {
+	defaultValues := map[string]interface{}{
+		"podAnnotations": map[string]interface{}{
+			"checksum/secret-" + common.DNSControllerManagerDeploymentName: b.CheckSums[common.DNSControllerManagerDeploymentName],
+		},
+		"replicas":   replicas,
+		"sources":    sources,
+		"dnsClass":   b.additionalDNSClass(),
+		"identifier": b.Shoot.SeedNamespace,
+		"creatorLabel": map[string]interface{}{
+			"name":  dnsSourceCreatorLabel,
+			"value": b.Shoot.SeedNamespace,
+		},
+	}
+
+	values, err := b.InjectSeedShootImages(defaultValues, common.DNSControllerManagerImageName)
+	if err != nil {
+		return err
+	}
+
+	return b.ApplyChartSeed(filepath.Join(chartPathControlPlane, common.DNSControllerManagerDeploymentName), b.Shoot.SeedNamespace, common.DNSControllerManagerDeploymentName, nil, values)
+}
+
+// waitUntilDNSControllerManagerRolledOut waits until all replicas of the dns-controller-manager have been updated.
+func (b *Botanist) waitUntilDNSControllerManagerRolledOut(ctx context.Context) error {
+	return retry.UntilTimeout(ctx, 5*time.Second, 5*time.Minute, func(ctx context.Context) (done bool, err error) {
+		deployment := &appsv1.Deployment{}
+		if err := b.K8sSeedClient.Client().Get(ctx, kutil.Key(b.Shoot.SeedNamespace, common.DNSControllerManagerDeploymentName), deployment); err != nil {
+			return retry.SevereError(err)
+		}
+		if deployment.Status.ObservedGeneration < deployment.Generation || deployment.Status.UpdatedReplicas != deployment.Status.Replicas {
+			b.Logger.Info("Waiting until the dns-controller-manager has been rolled out...")
+			return retry.MinorError(fmt.Errorf("deployment %s has not been rolled out yet", common.DNSControllerManagerDeploymentName))
+		}
+		return retry.Ok()
+	})
+}
+
+// deleteAdditionalDNSProviders deletes all DNSProviders for additional DNS providers (and their secrets) in the Shoot
+// namespace whose names are not contained in <wantedProviders>.
+func (b *Botanist) deleteAdditionalDNSProviders(ctx context.Context, wantedProviders sets.String) error {
+	providerList := &dnsv1alpha1.DNSProviderList{}
+	if err := b.K8sSeedClient.Client().List(ctx, providerList, client.InNamespace(b.Shoot.SeedNamespace)); err != nil {
+		return err
+	}
+
+	for _, provider := range providerList.Items {
+		if !strings.HasPrefix(provider.Name, dnsAdditionalProviderPrefix) || wantedProviders.Has(provider.Name) {
+			continue
+		}
+
+		if err := b.deleteDNSProvider(ctx, provider.Name); err != nil {
+			return err
+		}
+		if err := b.K8sSeedClient.Client().Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "extensions-dns-" + provider.Name, Namespace: b.Shoot.SeedNamespace}}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"context"
+	"path/filepath"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/logger"
+	mockkubernetes "github.com/gardener/gardener/pkg/mock/gardener/kubernetes"
+	"github.com/gardener/gardener/pkg/operation"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+
+	dnsv1alpha1 "github.com/gardener/external-dns-management/pkg/apis/dns/v1alpha1"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// fakeChartApplier records the values of the applied charts. The DNSProviders are marked as ready and the
+// dns-controller-manager Deployment is created so that the waits of the Botanist succeed.
+type fakeChartApplier struct {
+	kubernetes.ChartApplier
+
+	client client.Client
+	values map[string]map[string]interface{}
+}
+
+func (f *fakeChartApplier) ApplyChart(ctx context.Context, chartPath, namespace, name string, defaultValues, additionalValues map[string]interface{}) error {
+	f.values[name] = additionalValues
+
+	switch filepath.Base(chartPath) {
+	case "provider":
+		provider := &dnsv1alpha1.DNSProvider{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+		provider.Status.State = dnsv1alpha1.STATE_READY
+		return f.client.Create(ctx, provider)
+	case common.DNSControllerManagerDeploymentName:
+		if err := f.client.Create(ctx, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}); err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+var _ = Describe("Additional DNS providers", func() {
+	const (
+		namespace     = "garden-dev"
+		seedNamespace = "shoot--dev--foo"
+	)
+
+	var (
+		ctx  = context.TODO()
+		ctrl *gomock.Controller
+
+		gardenClient client.Client
+		seedClient   client.Client
+		chartApplier *fakeChartApplier
+		shoot        *gardenv1beta1.Shoot
+		botanist     *Botanist
+
+		tag = "v0.7.1"
+
+		newProvider = func(name string) *dnsv1alpha1.DNSProvider {
+			return &dnsv1alpha1.DNSProvider{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: seedNamespace}}
+		}
+		newEntry = func(name string, labels map[string]string) *dnsv1alpha1.DNSEntry {
+			return &dnsv1alpha1.DNSEntry{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: seedNamespace, Labels: labels}}
+		}
+		exists = func(obj runtime.Object) bool {
+			key, err := client.ObjectKeyFromObject(obj)
+			Expect(err).NotTo(HaveOccurred())
+			err = seedClient.Get(ctx, key, obj)
+			if apierrors.IsNotFound(err) {
+				return false
+			}
+			Expect(err).NotTo(HaveOccurred())
+			return true
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		gardenClient = fake.NewFakeClient(
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "route53", Namespace: namespace}, Data: map[string][]byte{"accessKeyID": []byte("foo")}},
+		)
+		seedClient = fake.NewFakeClientWithScheme(kubernetes.SeedScheme)
+		chartApplier = &fakeChartApplier{client: seedClient, values: map[string]map[string]interface{}{}}
+
+		k8sGardenClient := mockkubernetes.NewMockInterface(ctrl)
+		k8sGardenClient.EXPECT().Client().Return(gardenClient).AnyTimes()
+		k8sSeedClient := mockkubernetes.NewMockInterface(ctrl)
+		k8sSeedClient.EXPECT().Client().Return(seedClient).AnyTimes()
+		k8sSeedClient.EXPECT().Version().Return("1.14.4").AnyTimes()
+
+		shoot = &gardenv1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: namespace},
+			Spec: gardenv1beta1.ShootSpec{
+				DNS: gardenv1beta1.DNS{
+					Providers: []gardenv1beta1.DNSProvider{{
+						Type:       "aws-route53",
+						SecretName: "route53",
+						Domains:    &gardenv1beta1.DNSIncludeExclude{Include: []string{"apps.example.com"}},
+					}},
+				},
+				Kubernetes: gardenv1beta1.Kubernetes{Version: "1.14.4"},
+			},
+		}
+
+		botanist = &Botanist{Operation: &operation.Operation{
+			Logger:           logger.NewFieldLogger(logger.NewLogger("info"), "shoot", seedNamespace),
+			CheckSums:        map[string]string{},
+			ImageVector:      imagevector.ImageVector{{Name: common.DNSControllerManagerImageName, Repository: "dns-controller-manager", Tag: &tag}},
+			K8sGardenClient:  k8sGardenClient,
+			K8sSeedClient:    k8sSeedClient,
+			ChartApplierSeed: chartApplier,
+			Shoot: &shootpkg.Shoot{
+				Info:          shoot,
+				SeedNamespace: seedNamespace,
+			},
+		}}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#DeployAdditionalDNSProviders", func() {
+		It("should deploy the dns-controller-manager and the providers with the DNS class of the shoot", func() {
+			Expect(botanist.DeployAdditionalDNSProviders(ctx)).To(Succeed())
+
+			Expect(chartApplier.values).To(HaveKey(common.DNSControllerManagerDeploymentName))
+			controllerValues := chartApplier.values[common.DNSControllerManagerDeploymentName]
+			Expect(controllerValues).To(HaveKeyWithValue("sources", true))
+			Expect(controllerValues).To(HaveKeyWithValue("dnsClass", seedNamespace))
+			Expect(controllerValues).To(HaveKeyWithValue("replicas", 1))
+
+			Expect(chartApplier.values).To(HaveKey("additional-route53"))
+			providerValues := chartApplier.values["additional-route53"]
+			Expect(providerValues).To(HaveKeyWithValue("class", seedNamespace))
+			Expect(providerValues).To(HaveKeyWithValue("provider", "aws-route53"))
+			Expect(providerValues).To(HaveKeyWithValue("secretData", map[string][]byte{"accessKeyID": []byte("foo")}))
+		})
+
+		It("should delete providers which have been removed from the shoot but keep the other providers", func() {
+			Expect(seedClient.Create(ctx, newProvider("additional-old"))).To(Succeed())
+			Expect(seedClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "extensions-dns-additional-old", Namespace: seedNamespace}})).To(Succeed())
+			Expect(seedClient.Create(ctx, newProvider(DNSPurposeExternal))).To(Succeed())
+
+			Expect(botanist.DeployAdditionalDNSProviders(ctx)).To(Succeed())
+
+			Expect(exists(newProvider("additional-old"))).To(BeFalse())
+			Expect(exists(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "extensions-dns-additional-old", Namespace: seedNamespace}})).To(BeFalse())
+			Expect(exists(newProvider("additional-route53"))).To(BeTrue())
+			Expect(exists(newProvider(DNSPurposeExternal))).To(BeTrue())
+		})
+
+		It("should only scale down the dns-controller-manager if the shoot is hibernated", func() {
+			botanist.Shoot.IsHibernated = true
+
+			Expect(botanist.DeployAdditionalDNSProviders(ctx)).To(Succeed())
+
+			Expect(chartApplier.values).To(HaveLen(1))
+			Expect(chartApplier.values[common.DNSControllerManagerDeploymentName]).To(HaveKeyWithValue("replicas", 0))
+		})
+
+		It("should fail if the secret of a provider does not exist", func() {
+			shoot.Spec.DNS.Providers[0].SecretName = "unknown"
+
+			Expect(botanist.DeployAdditionalDNSProviders(ctx)).NotTo(Succeed())
+		})
+	})
+
+	Describe("#DestroyAdditionalDNSProviders", func() {
+		It("should stop watching the sources before deleting the records, the providers and the dns-controller-manager", func() {
+			deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: common.DNSControllerManagerDeploymentName, Namespace: seedNamespace}}
+			Expect(seedClient.Create(ctx, deployment.DeepCopy())).To(Succeed())
+			Expect(seedClient.Create(ctx, newProvider("additional-route53"))).To(Succeed())
+			Expect(seedClient.Create(ctx, newProvider(DNSPurposeExternal))).To(Succeed())
+			Expect(seedClient.Create(ctx, newEntry("echo", map[string]string{"gardener.cloud/shoot-dns": seedNamespace}))).To(Succeed())
+			Expect(seedClient.Create(ctx, newEntry(DNSPurposeExternal, nil))).To(Succeed())
+
+			Expect(botanist.DestroyAdditionalDNSProviders(ctx)).To(Succeed())
+
+			Expect(chartApplier.values[common.DNSControllerManagerDeploymentName]).To(HaveKeyWithValue("sources", false))
+			Expect(exists(newEntry("echo", nil))).To(BeFalse())
+			Expect(exists(newEntry(DNSPurposeExternal, nil))).To(BeTrue())
+			Expect(exists(newProvider("additional-route53"))).To(BeFalse())
+			Expect(exists(newProvider(DNSPurposeExternal))).To(BeTrue())
+			Expect(exists(deployment)).To(BeFalse())
+		})
+
+		It("should not deploy the dns-controller-manager if it does not exist", func() {
+			Expect(botanist.DestroyAdditionalDNSProviders(ctx)).To(Succeed())
+
+			Expect(chartApplier.values).To(BeEmpty())
+		})
+	})
+})
@@ -125,6 +125,17 @@ func (b *HealthChecker) checkStatefulSets(condition gardencorev1alpha1.Condition
 	return nil
 }
 
+func (b *HealthChecker) checkStatefulSetsQuorum(condition gardencorev1alpha1.Condition, objects []*appsv1.StatefulSet) *gardencorev1alpha1.Condition {
+	for _, object := range objects {
+		if err := health.CheckStatefulSetQuorum(object); err != nil {
+			c := b.FailedCondition(condition, "StatefulSetQuorumLost", fmt.Sprintf("Stateful set %s is unhealthy: %v", object.Name, err.Error()))
+			return &c
+		}
+	}
+
+	return nil
+}
+
 func (b *HealthChecker) checkNodes(condition gardencorev1alpha1.Condition, objects []*corev1.Node) *gardencorev1alpha1.Condition {
 	for _, object := range objects {
 		if err := health.CheckNode(object); err != nil {
@@ -208,6 +219,10 @@ func computeRequiredControlPlaneDeployments(
 		requiredControlPlaneDeployments.Insert(common.AWSLBReadvertiserDeploymentName)
 	}
 
+	if len(shoot.Spec.DNS.Providers) > 0 {
+		requiredControlPlaneDeployments.Insert(common.DNSControllerManagerDeploymentName)
+	}
+
 	if shootWantsClusterAutoscaler {
 		rollingUpdateOngoing, err := isRollingUpdateOngoing(machineDeploymentLister)
 		if err != nil {
@@ -268,6 +283,14 @@ func (b *HealthChecker) CheckControlPlane(
 	if exitCondition := b.checkRequiredStatefulSets(condition, common.RequiredControlPlaneStatefulSets, statefulSets); exitCondition != nil {
 		return exitCondition, nil
 	}
+
+	// Multi-member etcd clusters of highly available control planes stay operational as long as they have quorum.
+	if gardenv1beta1helper.ShootWantsHighAvailableControlPlane(shoot) {
+		if exitCondition := b.checkStatefulSetsQuorum(condition, statefulSets); exitCondition != nil {
+			return exitCondition, nil
+		}
+		return nil, nil
+	}
 	if exitCondition := b.checkStatefulSets(condition, statefulSets); exitCondition != nil {
 		return exitCondition, nil
 	}
@@ -0,0 +1,200 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+
+	prometheusmodel "github.com/prometheus/common/model"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const defaultEndpointHealthCheckTimeout = 10 * time.Second
+
+// CheckCustomCondition updates the given condition based on the given health checks which are executed with <run>.
+// If <builtin> is true then the condition is maintained by Gardener itself: in this case the health checks are only
+// executed if the condition is healthy, and a successful execution leaves the condition untouched.
+func (b *HealthChecker) CheckCustomCondition(condition gardencorev1alpha1.Condition, builtin bool, healthChecks []config.HealthCheck, run func(config.HealthCheck) error) gardencorev1alpha1.Condition {
+	if builtin && condition.Status != gardencorev1alpha1.ConditionTrue {
+		return condition
+	}
+
+	for _, healthCheck := range healthChecks {
+		if err := run(healthCheck); err != nil {
+			return b.FailedCondition(condition, "HealthCheckFailed", fmt.Sprintf("Health check %s failed: %v", healthCheck.Name, err))
+		}
+	}
+
+	if builtin {
+		return condition
+	}
+	return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "HealthChecksPassed", "All health checks passed.")
+}
+
+// CustomHealthChecks executes the given additional health checks and reports their results to the conditions they
+// refer to. Conditions which do not exist in <conditions> yet are initialized. Conditions maintained by Gardener
+// are only changed if a health check fails. It returns the updated list of conditions.
+func (b *Botanist) CustomHealthChecks(initializeShootClients func() error, thresholdMappings map[gardencorev1alpha1.ConditionType]time.Duration, healthChecks []config.HealthCheck, conditions []gardencorev1alpha1.Condition) []gardencorev1alpha1.Condition {
+	var (
+		checker = NewHealthChecker(thresholdMappings)
+		builtin = make(map[gardencorev1alpha1.ConditionType]bool, len(conditions))
+		checks  = map[gardencorev1alpha1.ConditionType][]config.HealthCheck{}
+		order   []gardencorev1alpha1.ConditionType
+	)
+
+	for _, condition := range conditions {
+		builtin[condition.Type] = true
+	}
+	for _, healthCheck := range healthChecks {
+		conditionType := gardencorev1alpha1.ConditionType(healthCheck.Condition)
+		if _, ok := checks[conditionType]; !ok {
+			order = append(order, conditionType)
+		}
+		checks[conditionType] = append(checks[conditionType], healthCheck)
+	}
+
+	run := func(healthCheck config.HealthCheck) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		return b.runHealthCheck(ctx, initializeShootClients, healthCheck)
+	}
+
+	for _, conditionType := range order {
+		var (
+			isBuiltin = builtin[conditionType]
+			condition = gardencorev1alpha1helper.GetOrInitCondition(b.Shoot.Info.Status.Conditions, conditionType)
+		)
+
+		if b.Shoot.IsHibernated {
+			if !isBuiltin {
+				conditions = append(conditions, shootHibernatedCondition(condition))
+			}
+			continue
+		}
+
+		if isBuiltin {
+			for i := range conditions {
+				if conditions[i].Type == conditionType {
+					conditions[i] = checker.CheckCustomCondition(conditions[i], true, checks[conditionType], run)
+				}
+			}
+			continue
+		}
+
+		conditions = append(conditions, b.pardonCondition(checker.CheckCustomCondition(condition, false, checks[conditionType], run)))
+	}
+
+	return conditions
+}
+
+func (b *Botanist) runHealthCheck(ctx context.Context, initializeShootClients func() error, healthCheck config.HealthCheck) error {
+	switch {
+	case healthCheck.Workload != nil:
+		return b.checkWorkload(ctx, initializeShootClients, healthCheck.Workload)
+	case healthCheck.Endpoint != nil:
+		return checkEndpoint(ctx, healthCheck.Endpoint)
+	case healthCheck.PromQL != nil:
+		return b.checkPromQL(ctx, healthCheck.PromQL)
+	}
+	return fmt.Errorf("health check does not define a workload, endpoint or PromQL check")
+}
+
+func (b *Botanist) checkWorkload(ctx context.Context, initializeShootClients func() error, workload *config.WorkloadHealthCheck) error {
+	var (
+		c         client.Client
+		namespace string
+	)
+
+	switch workload.Cluster {
+	case config.HealthCheckClusterSeed:
+		c, namespace = b.K8sSeedClient.Client(), b.Shoot.SeedNamespace
+	case config.HealthCheckClusterShoot:
+		if err := initializeShootClients(); err != nil {
+			return fmt.Errorf("could not initialize Shoot client: %v", err)
+		}
+		c, namespace = b.K8sShootClient.Client(), metav1.NamespaceSystem
+	default:
+		return fmt.Errorf("unsupported cluster %q", workload.Cluster)
+	}
+
+	if workload.Namespace != nil {
+		namespace = *workload.Namespace
+	}
+	key := kutil.Key(namespace, workload.Name)
+
+	switch workload.Kind {
+	case "Deployment":
+		deployment := &appsv1.Deployment{}
+		if err := c.Get(ctx, key, deployment); err != nil {
+			return err
+		}
+		return health.CheckDeployment(deployment)
+	case "StatefulSet":
+		statefulSet := &appsv1.StatefulSet{}
+		if err := c.Get(ctx, key, statefulSet); err != nil {
+			return err
+		}
+		return health.CheckStatefulSet(statefulSet)
+	case "DaemonSet":
+		daemonSet := &appsv1.DaemonSet{}
+		if err := c.Get(ctx, key, daemonSet); err != nil {
+			return err
+		}
+		return health.CheckDaemonSet(daemonSet)
+	}
+	return fmt.Errorf("unsupported workload kind %q", workload.Kind)
+}
+
+// checkEndpoint probes the given endpoint. Only public addresses are probed so that health checks cannot be used to
+// reach into the networks of the Garden or Seed clusters.
+func checkEndpoint(ctx context.Context, endpoint *config.EndpointHealthCheck) error {
+	timeout := defaultEndpointHealthCheckTimeout
+	if endpoint.Timeout != nil {
+		timeout = endpoint.Timeout.Duration
+	}
+
+	return health.CheckPublicHTTPEndpoint(ctx, endpoint.URL, timeout, endpoint.ExpectedStatusCodes)
+}
+
+func (b *Botanist) checkPromQL(ctx context.Context, promQL *config.PromQLHealthCheck) error {
+	if err := b.InitializeMonitoringClient(); err != nil {
+		return fmt.Errorf("could not initialize Shoot monitoring API client: %v", err)
+	}
+
+	result, err := b.MonitoringClient.Query(ctx, promQL.Query, Now())
+	if err != nil {
+		return fmt.Errorf("query can't be evaluated by Shoot Prometheus (%v)", err)
+	}
+
+	vector, ok := result.(prometheusmodel.Vector)
+	if !ok {
+		return fmt.Errorf("unexpected result type %s of query", result.Type())
+	}
+	if len(vector) > 0 {
+		return fmt.Errorf("query returned %d unhealthy series", len(vector))
+	}
+	return nil
+}
@@ -94,6 +94,10 @@ func (b *Botanist) generateWantedSecrets(basicAuthAPIServer *secrets.BasicAuth,
 		etcdCertDNSNames = dnsNamesForEtcd(b.Shoot.SeedNamespace)
 	)
 
+	if b.Shoot.WantsHighAvailability {
+		etcdCertDNSNames = append(etcdCertDNSNames, dnsNamesForEtcdPeers(b.Shoot.SeedNamespace, common.EtcdHighAvailabilityMembers)...)
+	}
+
 	if len(certificateAuthorities) != len(wantedCertificateAuthorities) {
 		return nil, fmt.Errorf("missing certificate authorities")
 	}
@@ -277,6 +281,26 @@ func (b *Botanist) generateWantedSecrets(basicAuthAPIServer *secrets.BasicAuth,
 			},
 		},
 
+		// Secret definition for dns-controller-manager
+		&secrets.ControlPlaneSecretConfig{
+			CertificateSecretConfig: &secrets.CertificateSecretConfig{
+				Name: common.DNSControllerManagerDeploymentName,
+
+				CommonName:   "gardener.cloud:system:dns-controller-manager",
+				Organization: nil,
+				DNSNames:     nil,
+				IPAddresses:  nil,
+
+				CertType:  secrets.ClientCert,
+				SigningCA: certificateAuthorities[gardencorev1alpha1.SecretNameCACluster],
+			},
+
+			KubeConfigRequest: &secrets.KubeConfigRequest{
+				ClusterName:  b.Shoot.SeedNamespace,
+				APIServerURL: b.Shoot.ComputeAPIServerURL(true, false),
+			},
+		},
+
 		// Secret definition for kube-proxy
 		&secrets.ControlPlaneSecretConfig{
 			CertificateSecretConfig: &secrets.CertificateSecretConfig{
@@ -990,3 +1014,15 @@ func dnsNamesForEtcd(namespace string) []string {
 	names = append(names, dnsNamesForService(fmt.Sprintf("%s-client", gardencorev1alpha1.StatefulSetNameETCDEvents), namespace)...)
 	return names
 }
+
+// dnsNamesForEtcdPeers returns the DNS names of the members of multi-member etcd clusters which are addressed via the
+// headless peer services.
+func dnsNamesForEtcdPeers(namespace string, members int) []string {
+	var names []string
+	for _, statefulSet := range []string{gardencorev1alpha1.StatefulSetNameETCDMain, gardencorev1alpha1.StatefulSetNameETCDEvents} {
+		for i := 0; i < members; i++ {
+			names = append(names, dnsNamesForService(fmt.Sprintf("%s-%d.%s", statefulSet, i, statefulSet), namespace)...)
+		}
+	}
+	return names
+}
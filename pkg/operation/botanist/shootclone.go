@@ -0,0 +1,90 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/operation/common"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+// CleanupCloneSourceObjects deletes the objects of a cloned Shoot which have been restored from the backup of the Shoot
+// it has been cloned from but which must not be reused by the clone: the Nodes (and their Leases) of the source Shoot
+// and the service account tokens issued for the source Shoot. The tokens are re-issued by the kube-controller-manager.
+// Nothing is done if the worker pools of the clone have already been deployed.
+func (b *Botanist) CleanupCloneSourceObjects(ctx context.Context) error {
+	if err := b.K8sSeedClient.Client().Get(ctx, kutil.Key(b.Shoot.SeedNamespace, b.Shoot.Info.Name), &extensionsv1alpha1.Worker{}); err == nil {
+		return nil
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	clientset := b.K8sShootClient.Kubernetes()
+
+	if err := clientset.CoreV1().Nodes().DeleteCollection(&metav1.DeleteOptions{}, metav1.ListOptions{}); err != nil {
+		return err
+	}
+	if err := clientset.CoordinationV1beta1().Leases(corev1.NamespaceNodeLease).DeleteCollection(&metav1.DeleteOptions{}, metav1.ListOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	secretList, err := clientset.CoreV1().Secrets(metav1.NamespaceAll).List(metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("type", string(corev1.SecretTypeServiceAccountToken)).String(),
+	})
+	if err != nil {
+		return err
+	}
+	for _, secret := range secretList.Items {
+		if err := clientset.CoreV1().Secrets(secret.Namespace).Delete(secret.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// CopyEncryptionConfigurationFromCloneSource copies the etcd encryption configuration of the Shoot a cloned Shoot has
+// been cloned from into the namespace of the clone in the Seed. The secrets restored from the backup of the source
+// Shoot are encrypted with its keys, hence, the API server of the clone must be started with the same configuration.
+// The configuration is taken from the copy in the Garden cluster. Nothing is done if the clone already has an
+// encryption configuration.
+func (b *Botanist) CopyEncryptionConfigurationFromCloneSource(ctx context.Context) error {
+	secret := &corev1.Secret{}
+	if err := b.K8sSeedClient.Client().Get(ctx, kutil.Key(b.Shoot.SeedNamespace, common.EtcdEncryptionSecretName), secret); err == nil {
+		return nil
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	sourceName := b.Shoot.Info.Annotations[common.ShootCloneSource]
+	sourceSecret := &corev1.Secret{}
+	if err := b.K8sGardenClient.Client().Get(ctx, common.GardenEtcdEncryptionSecretKey(b.Shoot.Info.Namespace, sourceName), sourceSecret); err != nil {
+		return fmt.Errorf("could not get the etcd encryption configuration of source shoot %q: %v", sourceName, err)
+	}
+
+	// The checksum annotation is not copied, hence, the configuration is applied in its passive form first and the
+	// secrets of the clone are rewritten with the keys of the configuration before it is activated.
+	secret = &corev1.Secret{ObjectMeta: kutil.ObjectMeta(b.Shoot.SeedNamespace, common.EtcdEncryptionSecretName)}
+	secret.Type = corev1.SecretTypeOpaque
+	secret.Data = sourceSecret.Data
+	return b.K8sSeedClient.Client().Create(ctx, secret)
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"context"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	mockkubernetes "github.com/gardener/gardener/pkg/mock/gardener/kubernetes"
+	"github.com/gardener/gardener/pkg/operation"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Shoot cloning", func() {
+	const (
+		namespace     = "garden-dev"
+		seedNamespace = "shoot--dev--clone"
+		sourceName    = "source"
+	)
+
+	var (
+		ctx  = context.TODO()
+		ctrl *gomock.Controller
+
+		gardenClient client.Client
+		seedClient   client.Client
+		botanist     *Botanist
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		gardenClient = fake.NewFakeClient()
+		seedClient = fake.NewFakeClient()
+
+		k8sGardenClient := mockkubernetes.NewMockInterface(ctrl)
+		k8sGardenClient.EXPECT().Client().Return(gardenClient).AnyTimes()
+		k8sSeedClient := mockkubernetes.NewMockInterface(ctrl)
+		k8sSeedClient.EXPECT().Client().Return(seedClient).AnyTimes()
+
+		botanist = &Botanist{Operation: &operation.Operation{
+			K8sGardenClient: k8sGardenClient,
+			K8sSeedClient:   k8sSeedClient,
+			Shoot: &shootpkg.Shoot{
+				Info: &gardenv1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "clone",
+						Namespace:   namespace,
+						Annotations: map[string]string{common.ShootCloneSource: sourceName},
+					},
+				},
+				SeedNamespace: seedNamespace,
+			},
+		}}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#CopyEncryptionConfigurationFromCloneSource", func() {
+		It("should copy the encryption configuration of the source shoot", func() {
+			data := map[string][]byte{common.EtcdEncryptionSecretFileName: []byte("source-configuration")}
+			Expect(gardenClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: kutil.ObjectMetaFromKey(common.GardenEtcdEncryptionSecretKey(namespace, sourceName)),
+				Data:       data,
+			})).To(Succeed())
+
+			Expect(botanist.CopyEncryptionConfigurationFromCloneSource(ctx)).To(Succeed())
+
+			secret := &corev1.Secret{}
+			Expect(seedClient.Get(ctx, kutil.Key(seedNamespace, common.EtcdEncryptionSecretName), secret)).To(Succeed())
+			Expect(secret.Data).To(Equal(data))
+			Expect(secret.Annotations).NotTo(HaveKey(common.EtcdEncryptionChecksumAnnotationName))
+		})
+
+		It("should keep an existing encryption configuration of the clone", func() {
+			data := map[string][]byte{common.EtcdEncryptionSecretFileName: []byte("clone-configuration")}
+			Expect(seedClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: kutil.ObjectMeta(seedNamespace, common.EtcdEncryptionSecretName),
+				Data:       data,
+			})).To(Succeed())
+
+			Expect(botanist.CopyEncryptionConfigurationFromCloneSource(ctx)).To(Succeed())
+
+			secret := &corev1.Secret{}
+			Expect(seedClient.Get(ctx, kutil.Key(seedNamespace, common.EtcdEncryptionSecretName), secret)).To(Succeed())
+			Expect(secret.Data).To(Equal(data))
+		})
+
+		It("should fail if the source shoot has no encryption configuration", func() {
+			Expect(botanist.CopyEncryptionConfigurationFromCloneSource(ctx)).NotTo(Succeed())
+		})
+	})
+})
@@ -75,7 +75,12 @@ func (b *Botanist) WaitUntilEtcdReady(ctx context.Context) error {
 				continue
 			}
 
-			if statefulSet.Status.ReadyReplicas < 1 {
+			// Multi-member etcd clusters are ready as soon as a majority of their members is ready.
+			replicas := int32(1)
+			if statefulSet.Spec.Replicas != nil && *statefulSet.Spec.Replicas > 0 {
+				replicas = *statefulSet.Spec.Replicas
+			}
+			if statefulSet.Status.ReadyReplicas < replicas/2+1 {
 				bothEtcdStatefulSetsReady = false
 				break
 			}
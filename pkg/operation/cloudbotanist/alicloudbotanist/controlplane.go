@@ -39,6 +39,18 @@ func (b *AlicloudBotanist) GenerateEtcdBackupConfig() (map[string][]byte, error)
 	return secretData, nil
 }
 
+// GetETCDBackupStore returns how the etcd backup-restore tooling accesses the backups in the object store.
+func (b *AlicloudBotanist) GetETCDBackupStore() *common.ETCDBackupStore {
+	return &common.ETCDBackupStore{
+		Provider: "OSS",
+		Env: map[string]string{
+			"ALICLOUD_ENDPOINT":          StorageEndpoint,
+			"ALICLOUD_ACCESS_KEY_ID":     AccessKeyID,
+			"ALICLOUD_ACCESS_KEY_SECRET": AccessKeySecret,
+		},
+	}
+}
+
 // DeployCloudSpecificControlPlane does nothing currently for Alicloud
 func (b *AlicloudBotanist) DeployCloudSpecificControlPlane() error {
 	return nil
@@ -43,6 +43,18 @@ func (b *AWSBotanist) GenerateEtcdBackupConfig() (map[string][]byte, error) {
 	return secretData, nil
 }
 
+// GetETCDBackupStore returns how the etcd backup-restore tooling accesses the backups in the object store.
+func (b *AWSBotanist) GetETCDBackupStore() *common.ETCDBackupStore {
+	return &common.ETCDBackupStore{
+		Provider: "S3",
+		Env: map[string]string{
+			"AWS_REGION":            Region,
+			"AWS_ACCESS_KEY_ID":     AccessKeyID,
+			"AWS_SECRET_ACCESS_KEY": SecretAccessKey,
+		},
+	}
+}
+
 // DeployCloudSpecificControlPlane updates the AWS ELB health check to SSL and deploys the aws-lb-readvertiser.
 // https://github.com/gardener/aws-lb-readvertiser
 func (b *AWSBotanist) DeployCloudSpecificControlPlane() error {
@@ -43,6 +43,17 @@ func (b *AzureBotanist) GenerateEtcdBackupConfig() (map[string][]byte, error) {
 	return secretData, nil
 }
 
+// GetETCDBackupStore returns how the etcd backup-restore tooling accesses the backups in the object store.
+func (b *AzureBotanist) GetETCDBackupStore() *common.ETCDBackupStore {
+	return &common.ETCDBackupStore{
+		Provider: "ABS",
+		Env: map[string]string{
+			"STORAGE_ACCOUNT": "storage-account",
+			"STORAGE_KEY":     "storage-key",
+		},
+	}
+}
+
 // DeployCloudSpecificControlPlane does currently nothing for Azure.
 func (b *AzureBotanist) DeployCloudSpecificControlPlane() error {
 	return nil
@@ -40,6 +40,15 @@ func (b *GCPBotanist) GenerateEtcdBackupConfig() (map[string][]byte, error) {
 	return secretData, nil
 }
 
+// GetETCDBackupStore returns how the etcd backup-restore tooling accesses the backups in the object store.
+func (b *GCPBotanist) GetETCDBackupStore() *common.ETCDBackupStore {
+	return &common.ETCDBackupStore{
+		Provider:           "GCS",
+		CredentialsFileKey: ServiceAccountJSON,
+		CredentialsFileEnv: "GOOGLE_APPLICATION_CREDENTIALS",
+	}
+}
+
 // DeployCloudSpecificControlPlane does currently nothing for GCP.
 func (b *GCPBotanist) DeployCloudSpecificControlPlane() error {
 	return nil
@@ -44,6 +44,20 @@ func (b *OpenStackBotanist) GenerateEtcdBackupConfig() (map[string][]byte, error
 	return secretData, nil
 }
 
+// GetETCDBackupStore returns how the etcd backup-restore tooling accesses the backups in the object store.
+func (b *OpenStackBotanist) GetETCDBackupStore() *common.ETCDBackupStore {
+	return &common.ETCDBackupStore{
+		Provider: "Swift",
+		Env: map[string]string{
+			"OS_AUTH_URL":    AuthURL,
+			"OS_DOMAIN_NAME": DomainName,
+			"OS_USERNAME":    UserName,
+			"OS_PASSWORD":    Password,
+			"OS_TENANT_NAME": TenantName,
+		},
+	}
+}
+
 // DeployCloudSpecificControlPlane does currently nothing for OpenStack.
 func (b *OpenStackBotanist) DeployCloudSpecificControlPlane() error {
 	return nil
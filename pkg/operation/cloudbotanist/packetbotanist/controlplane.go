@@ -14,11 +14,18 @@
 
 package packetbotanist
 
+import "github.com/gardener/gardener/pkg/operation/common"
+
 // GenerateEtcdBackupConfig returns the etcd backup configuration for the etcd Helm chart.
 func (b *PacketBotanist) GenerateEtcdBackupConfig() (map[string][]byte, error) {
 	return map[string][]byte{}, nil
 }
 
+// GetETCDBackupStore returns nil as backups are not supported for Packet.
+func (b *PacketBotanist) GetETCDBackupStore() *common.ETCDBackupStore {
+	return nil
+}
+
 // DeployCloudSpecificControlPlane does any last minute updates
 func (b *PacketBotanist) DeployCloudSpecificControlPlane() error {
 	return nil
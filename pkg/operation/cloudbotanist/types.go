@@ -14,6 +14,8 @@
 
 package cloudbotanist
 
+import "github.com/gardener/gardener/pkg/operation/common"
+
 // CloudBotanist is an interface which must be implemented by cloud-specific Botanists. The Cloud Botanist
 // is responsible for all operations which require IaaS specific knowledge.
 type CloudBotanist interface {
@@ -25,6 +27,7 @@ type CloudBotanist interface {
 
 	// Control Plane
 	GenerateEtcdBackupConfig() (map[string][]byte, error)
+	GetETCDBackupStore() *common.ETCDBackupStore
 	DeployCloudSpecificControlPlane() error
 
 	// Addons
@@ -0,0 +1,118 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// etcdBackupCredentialsDir is the directory into which the credentials file of a backup secret is mounted.
+const etcdBackupCredentialsDir = "/var/etcd-backup"
+
+// ETCDMainVolumeClaimName is the name of the persistent volume claim of the (single) main etcd member.
+var ETCDMainVolumeClaimName = fmt.Sprintf("main-etcd-%s-0", gardencorev1alpha1.StatefulSetNameETCDMain)
+
+// ETCDMainStorePrefix returns the prefix of the snapshots of the main etcd in the object store based on the given
+// backup secret. Backups managed by a `BackupEntry` are stored below the prefix of the entry in the shared bucket
+// of the Seed.
+func ETCDMainStorePrefix(backupSecret *corev1.Secret) string {
+	storePrefix := "etcd-" + EtcdRoleMain
+	if prefix, ok := backupSecret.Data[BackupStorePrefix]; ok && len(prefix) > 0 {
+		return fmt.Sprintf("%s/%s", prefix, storePrefix)
+	}
+	return storePrefix
+}
+
+// ETCDBackupStore describes how the etcd backup-restore tooling accesses the object store containing the backups of
+// a Shoot's etcd. The credentials are taken from the backup secret (see BackupSecretName) in the Shoot namespace.
+type ETCDBackupStore struct {
+	// Provider is the name of the storage provider as understood by etcd-backup-restore (e.g., S3, ABS, GCS).
+	Provider string
+	// Env maps names of environment variables to the keys of the backup secret holding their values.
+	Env map[string]string
+	// CredentialsFileKey is the key of the backup secret which must be mounted as file. Its path is passed in the
+	// environment variable CredentialsFileEnv. It is empty if no file is required.
+	CredentialsFileKey string
+	// CredentialsFileEnv is the name of the environment variable containing the path of the mounted credentials file.
+	CredentialsFileEnv string
+}
+
+// RestoreContainer returns a container with the given <name> running the etcd-backup-restore <image> which restores
+// the latest backup below <storePrefix> into <dataDir>. The credentials and the name of the bucket are read from the
+// secret with the given <secretName>. The volume containing <dataDir> is mounted with <dataVolumeMount>. The volumes
+// required for the credentials are returned and must be added to the pod.
+func (s *ETCDBackupStore) RestoreContainer(name, image, secretName, storePrefix, dataDir string, dataVolumeMount corev1.VolumeMount) (corev1.Container, []corev1.Volume) {
+	var (
+		volumes      []corev1.Volume
+		volumeMounts = []corev1.VolumeMount{dataVolumeMount}
+		env          = []corev1.EnvVar{backupSecretEnvVar(secretName, "STORAGE_CONTAINER", BackupBucketName)}
+	)
+
+	names := make([]string, 0, len(s.Env))
+	for name := range s.Env {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		env = append(env, backupSecretEnvVar(secretName, name, s.Env[name]))
+	}
+
+	if len(s.CredentialsFileKey) > 0 {
+		volumes = append(volumes, corev1.Volume{
+			Name: "etcd-backup",
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+				SecretName: secretName,
+				Items:      []corev1.KeyToPath{{Key: s.CredentialsFileKey, Path: s.CredentialsFileKey}},
+			}},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{Name: "etcd-backup", MountPath: etcdBackupCredentialsDir, ReadOnly: true})
+		env = append(env, corev1.EnvVar{Name: s.CredentialsFileEnv, Value: filepath.Join(etcdBackupCredentialsDir, s.CredentialsFileKey)})
+	}
+
+	return corev1.Container{
+		Name:  name,
+		Image: image,
+		Command: []string{
+			"etcdbrctl",
+			"restore",
+			"--data-dir=" + dataDir,
+			"--snapstore-temp-directory=" + filepath.Join(filepath.Dir(dataDir), "temp"),
+			"--storage-provider=" + s.Provider,
+			"--store-prefix=" + storePrefix,
+			"--store-container=$(STORAGE_CONTAINER)",
+		},
+		Env:                      env,
+		VolumeMounts:             volumeMounts,
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+	}, volumes
+}
+
+func backupSecretEnvVar(secretName, name, key string) corev1.EnvVar {
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+				Key:                  key,
+			},
+		},
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common_test
+
+import (
+	. "github.com/gardener/gardener/pkg/operation/common"
+
+	corev1 "k8s.io/api/core/v1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ETCD backup", func() {
+	Describe("#ETCDMainStorePrefix", func() {
+		It("should return the prefix of Terraform managed backups", func() {
+			secret := &corev1.Secret{Data: map[string][]byte{BackupBucketName: []byte("bucket")}}
+
+			Expect(ETCDMainStorePrefix(secret)).To(Equal("etcd-main"))
+		})
+
+		It("should return the prefix of backups managed by a backup entry", func() {
+			secret := &corev1.Secret{Data: map[string][]byte{
+				BackupBucketName:  []byte("bucket"),
+				BackupStorePrefix: []byte("shoot--foo--bar--12345"),
+			}}
+
+			Expect(ETCDMainStorePrefix(secret)).To(Equal("shoot--foo--bar--12345/etcd-main"))
+		})
+	})
+
+	Describe("#RestoreContainer", func() {
+		It("should read the credentials from the given secret", func() {
+			store := &ETCDBackupStore{
+				Provider:           "GCS",
+				CredentialsFileKey: "serviceaccount.json",
+				CredentialsFileEnv: "GOOGLE_APPLICATION_CREDENTIALS",
+			}
+			dataVolumeMount := corev1.VolumeMount{Name: "data", MountPath: "/var/etcd/data"}
+
+			container, volumes := store.RestoreContainer("restore", "etcdbrctl:1", "source-backup", "etcd-main", "/var/etcd/data/clone.etcd", dataVolumeMount)
+
+			Expect(container.Command).To(ContainElement("--data-dir=/var/etcd/data/clone.etcd"))
+			Expect(container.Command).To(ContainElement("--snapstore-temp-directory=/var/etcd/data/temp"))
+			Expect(container.Command).To(ContainElement("--storage-provider=GCS"))
+			Expect(container.Env[0].ValueFrom.SecretKeyRef.Name).To(Equal("source-backup"))
+			Expect(container.VolumeMounts).To(ConsistOf(dataVolumeMount, corev1.VolumeMount{Name: "etcd-backup", MountPath: "/var/etcd-backup", ReadOnly: true}))
+			Expect(volumes).To(HaveLen(1))
+			Expect(volumes[0].Secret.SecretName).To(Equal("source-backup"))
+		})
+	})
+})
@@ -0,0 +1,149 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// BlockingObject describes an object which prevents the deletion of the namespace it is living in.
+type BlockingObject struct {
+	// APIVersion is the API version of the blocking object.
+	APIVersion string
+	// Kind is the kind of the blocking object.
+	Kind string
+	// Namespace is the namespace of the blocking object.
+	Namespace string
+	// Name is the name of the blocking object.
+	Name string
+	// Reason is a human-readable explanation why the object is blocking.
+	Reason string
+}
+
+// String returns a human-readable representation of the blocking object.
+func (o BlockingObject) String() string {
+	return fmt.Sprintf("%s %s/%s (%s)", o.Kind, o.Namespace, o.Name, o.Reason)
+}
+
+// gardenerFinalizerDomains are the domains of finalizers which are managed by Gardener.
+var gardenerFinalizerDomains = []string{"garden.sapcloud.io", "gardener.cloud"}
+
+// IsGardenerFinalizer returns true if the given <finalizer> is managed by Gardener.
+func IsGardenerFinalizer(finalizer string) bool {
+	if finalizer == gardenv1beta1.GardenerName {
+		return true
+	}
+
+	i := strings.Index(finalizer, "/")
+	if i < 0 {
+		return false
+	}
+
+	domain := finalizer[:i]
+	for _, d := range gardenerFinalizerDomains {
+		if domain == d || strings.HasSuffix(domain, "."+d) {
+			return true
+		}
+	}
+	return false
+}
+
+// FinalizerBlockingObjects lists all objects of the given <kinds> in the given <namespace> and returns those which
+// still carry a Gardener finalizer. Kinds which are not served by the API server are skipped.
+func FinalizerBlockingObjects(ctx context.Context, c client.Client, namespace string, kinds []schema.GroupVersionKind) ([]BlockingObject, error) {
+	var blockingObjects []BlockingObject
+
+	for _, gvk := range kinds {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+
+		if err := c.List(ctx, list, client.InNamespace(namespace)); err != nil {
+			if meta.IsNoMatchError(err) || apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		for _, obj := range list.Items {
+			var finalizers []string
+			for _, finalizer := range obj.GetFinalizers() {
+				if IsGardenerFinalizer(finalizer) {
+					finalizers = append(finalizers, finalizer)
+				}
+			}
+			if len(finalizers) == 0 {
+				continue
+			}
+
+			blockingObjects = append(blockingObjects, BlockingObject{
+				APIVersion: gvk.GroupVersion().String(),
+				Kind:       gvk.Kind,
+				Namespace:  obj.GetNamespace(),
+				Name:       obj.GetName(),
+				Reason:     fmt.Sprintf("carries finalizers %s", strings.Join(finalizers, ", ")),
+			})
+		}
+	}
+
+	return blockingObjects, nil
+}
+
+// CredentialsBlockingObjects returns the secrets in the given <namespace> which are referenced by one of the given
+// <secretBindings> that is still used by one of the given <shoots>.
+func CredentialsBlockingObjects(namespace string, secretBindings []*gardenv1beta1.SecretBinding, shoots []*gardenv1beta1.Shoot) []BlockingObject {
+	shootsPerBinding := make(map[string][]string)
+	for _, shoot := range shoots {
+		key := fmt.Sprintf("%s/%s", shoot.Namespace, shoot.Spec.Cloud.SecretBindingRef.Name)
+		shootsPerBinding[key] = append(shootsPerBinding[key], fmt.Sprintf("%s/%s", shoot.Namespace, shoot.Name))
+	}
+
+	var blockingObjects []BlockingObject
+	for _, binding := range secretBindings {
+		secretNamespace := binding.SecretRef.Namespace
+		if len(secretNamespace) == 0 {
+			secretNamespace = binding.Namespace
+		}
+		if secretNamespace != namespace {
+			continue
+		}
+
+		associatedShoots := shootsPerBinding[fmt.Sprintf("%s/%s", binding.Namespace, binding.Name)]
+		if len(associatedShoots) == 0 {
+			continue
+		}
+		sort.Strings(associatedShoots)
+
+		blockingObjects = append(blockingObjects, BlockingObject{
+			APIVersion: "v1",
+			Kind:       "Secret",
+			Namespace:  secretNamespace,
+			Name:       binding.SecretRef.Name,
+			Reason:     fmt.Sprintf("referenced by SecretBinding %s/%s which is used by Shoots %s", binding.Namespace, binding.Name, strings.Join(associatedShoots, ", ")),
+		})
+	}
+
+	return blockingObjects
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common_test
+
+import (
+	"context"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
+	. "github.com/gardener/gardener/pkg/operation/common"
+
+	"github.com/golang/mock/gomock"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("namespace deletion", func() {
+	DescribeTable("#IsGardenerFinalizer",
+		func(finalizer string, expected bool) {
+			Expect(IsGardenerFinalizer(finalizer)).To(Equal(expected))
+		},
+		Entry("gardener", gardenv1beta1.GardenerName, true),
+		Entry("external gardener", gardenv1beta1.ExternalGardenerName, true),
+		Entry("plant", "core.gardener.cloud/plant", true),
+		Entry("gardener.cloud", "gardener.cloud/foo", true),
+		Entry("kubernetes", "kubernetes", false),
+		Entry("foreign domain", "example.com/gardener", false),
+		Entry("foreign domain with gardener suffix", "notgardener.cloud/foo", false),
+	)
+
+	Describe("#FinalizerBlockingObjects", func() {
+		var (
+			ctrl *gomock.Controller
+			c    *mockclient.MockClient
+			ctx  = context.TODO()
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			c = mockclient.NewMockClient(ctrl)
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should return all objects with Gardener finalizers", func() {
+			secretKind := schema.GroupVersionKind{Version: "v1", Kind: "Secret"}
+
+			c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&unstructured.UnstructuredList{}), gomock.Any()).DoAndReturn(func(_ context.Context, list *unstructured.UnstructuredList, _ ...client.ListOptionFunc) error {
+				Expect(list.GetKind()).To(Equal("SecretList"))
+
+				withFinalizer := unstructured.Unstructured{}
+				withFinalizer.SetNamespace("garden-dev")
+				withFinalizer.SetName("credentials")
+				withFinalizer.SetFinalizers([]string{"foo", gardenv1beta1.ExternalGardenerName})
+
+				withoutFinalizer := unstructured.Unstructured{}
+				withoutFinalizer.SetNamespace("garden-dev")
+				withoutFinalizer.SetName("other")
+				withoutFinalizer.SetFinalizers([]string{"foo"})
+
+				list.Items = []unstructured.Unstructured{withFinalizer, withoutFinalizer}
+				return nil
+			})
+
+			Expect(FinalizerBlockingObjects(ctx, c, "garden-dev", []schema.GroupVersionKind{secretKind})).To(Equal([]BlockingObject{
+				{
+					APIVersion: "v1",
+					Kind:       "Secret",
+					Namespace:  "garden-dev",
+					Name:       "credentials",
+					Reason:     "carries finalizers " + gardenv1beta1.ExternalGardenerName,
+				},
+			}))
+		})
+
+		It("should skip kinds which are not served", func() {
+			c.EXPECT().List(ctx, gomock.Any(), gomock.Any()).Return(&meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: "foo.bar", Kind: "Foo"}})
+
+			Expect(FinalizerBlockingObjects(ctx, c, "garden-dev", []schema.GroupVersionKind{{Group: "foo.bar", Version: "v1", Kind: "Foo"}})).To(BeEmpty())
+		})
+	})
+
+	Describe("#CredentialsBlockingObjects", func() {
+		It("should return secrets referenced by used secret bindings", func() {
+			var (
+				namespace = "garden-dev"
+
+				usedBinding = &gardenv1beta1.SecretBinding{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "garden-other", Name: "used"},
+					SecretRef:  corev1.SecretReference{Namespace: namespace, Name: "credentials"},
+				}
+				unusedBinding = &gardenv1beta1.SecretBinding{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "unused"},
+					SecretRef:  corev1.SecretReference{Name: "other"},
+				}
+				otherNamespaceBinding = &gardenv1beta1.SecretBinding{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "garden-other", Name: "local"},
+					SecretRef:  corev1.SecretReference{Name: "local"},
+				}
+				shoot = func(namespace, name, binding string) *gardenv1beta1.Shoot {
+					return &gardenv1beta1.Shoot{
+						ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+						Spec: gardenv1beta1.ShootSpec{
+							Cloud: gardenv1beta1.Cloud{SecretBindingRef: corev1.LocalObjectReference{Name: binding}},
+						},
+					}
+				}
+			)
+
+			Expect(CredentialsBlockingObjects(
+				namespace,
+				[]*gardenv1beta1.SecretBinding{usedBinding, unusedBinding, otherNamespaceBinding},
+				[]*gardenv1beta1.Shoot{shoot("garden-other", "b", "used"), shoot("garden-other", "a", "used"), shoot("garden-other", "c", "local"), shoot(namespace, "d", "used")},
+			)).To(Equal([]BlockingObject{
+				{
+					APIVersion: "v1",
+					Kind:       "Secret",
+					Namespace:  namespace,
+					Name:       "credentials",
+					Reason:     "referenced by SecretBinding garden-other/used which is used by Shoots garden-other/a, garden-other/b",
+				},
+			}))
+		})
+	})
+})
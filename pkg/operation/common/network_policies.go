@@ -18,6 +18,7 @@ import (
 	"net"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 )
 
 // Private8BitBlock returns a private network (RFC1918) 10.0.0.0/8 IPv4 block
@@ -40,15 +41,42 @@ func CarrierGradeNATBlock() *net.IPNet {
 	return &net.IPNet{IP: net.IP{100, 64, 0, 0}, Mask: net.CIDRMask(10, 32)}
 }
 
+// UniqueLocalIPv6Block returns a Unique Local Address (RFC4193) fc00::/7 IPv6 block
+func UniqueLocalIPv6Block() *net.IPNet {
+	return &net.IPNet{IP: net.ParseIP("fc00::"), Mask: net.CIDRMask(7, 128)}
+}
+
 // AllPrivateNetworkBlocks returns a list of all Private network (RFC1918) and
-// Carrier-grade NAT (RFC6598) IPv4 blocks.
+// Carrier-grade NAT (RFC6598) IPv4 blocks as well as the Unique Local Address (RFC4193) IPv6 block.
 func AllPrivateNetworkBlocks() []net.IPNet {
 	return []net.IPNet{
 		*Private8BitBlock(),
 		*Private12BitBlock(),
 		*Private16BitBlock(),
 		*CarrierGradeNATBlock(),
+		*UniqueLocalIPv6Block(),
+	}
+}
+
+// SeedNetworksCIDRs returns the CIDRs of the node, pod and service networks of a Seed cluster, including the
+// networks of the second IP family if the Seed is dual-stack.
+func SeedNetworksCIDRs(networks gardenv1beta1.SeedNetworks) []gardencorev1alpha1.CIDR {
+	return append([]gardencorev1alpha1.CIDR{networks.Nodes, networks.Pods, networks.Services}, DualStackNetworksCIDRs(networks.DualStack)...)
+}
+
+// DualStackNetworksCIDRs returns the CIDRs of the given dual-stack networks which are set.
+func DualStackNetworksCIDRs(networks *gardencorev1alpha1.DualStackNetworks) []gardencorev1alpha1.CIDR {
+	cidrs := []gardencorev1alpha1.CIDR{}
+	if networks == nil {
+		return cidrs
+	}
+
+	for _, cidr := range []*gardencorev1alpha1.CIDR{networks.Nodes, networks.Pods, networks.Services} {
+		if cidr != nil {
+			cidrs = append(cidrs, *cidr)
+		}
 	}
+	return cidrs
 }
 
 // ToExceptNetworks returns a list of maps with `network` key containing one of `networks`
@@ -63,6 +91,7 @@ func AllPrivateNetworkBlocks() []net.IPNet {
 //		{"network": "172.16.0.0/12", "except": ["172.16.1.0/24"]},
 //		{"network": "192.168.0.0/16", "except": ["192.168.1.0/24"]},
 //		{"network": "100.64.0.0/10", "except": ["100.64.1.0/24"]},
+//		{"network": "fc00::/7", "except": []},
 // ]
 func ToExceptNetworks(networks []net.IPNet, except ...gardencorev1alpha1.CIDR) ([]interface{}, error) {
 	result := []interface{}{}
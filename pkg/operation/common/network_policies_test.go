@@ -18,6 +18,7 @@ import (
 	"net"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	. "github.com/gardener/gardener/pkg/operation/common"
 
 	. "github.com/onsi/ginkgo"
@@ -34,7 +35,29 @@ var _ = Describe("networkpolicies", func() {
 			_, block12, _ := net.ParseCIDR("172.16.0.0/12")
 			_, block16, _ := net.ParseCIDR("192.168.0.0/16")
 			_, carrierGradeBlock, _ := net.ParseCIDR("100.64.0.0/10")
-			Expect(result).To(ConsistOf(*block8, *block12, *block16, *carrierGradeBlock))
+			_, uniqueLocalBlock, _ := net.ParseCIDR("fc00::/7")
+			Expect(result).To(ConsistOf(*block8, *block12, *block16, *carrierGradeBlock, *uniqueLocalBlock))
+		})
+
+	})
+
+	Describe("#SeedNetworksCIDRs", func() {
+
+		It("should return the networks of both IP families", func() {
+			podsV6 := gardencorev1alpha1.CIDR("fd00:10:96::/48")
+			networks := gardenv1beta1.SeedNetworks{
+				Nodes:     "10.250.0.0/16",
+				Pods:      "100.96.0.0/11",
+				Services:  "100.64.0.0/13",
+				DualStack: &gardencorev1alpha1.DualStackNetworks{Pods: &podsV6},
+			}
+
+			Expect(SeedNetworksCIDRs(networks)).To(ConsistOf(
+				gardencorev1alpha1.CIDR("10.250.0.0/16"),
+				gardencorev1alpha1.CIDR("100.96.0.0/11"),
+				gardencorev1alpha1.CIDR("100.64.0.0/13"),
+				podsV6,
+			))
 		})
 
 	})
@@ -43,7 +66,7 @@ var _ = Describe("networkpolicies", func() {
 
 		It("should return correct result", func() {
 
-			result, err := ToExceptNetworks(AllPrivateNetworkBlocks(), "10.10.0.0/24", "172.16.1.0/24", "192.168.1.0/24", "100.64.1.0/24")
+			result, err := ToExceptNetworks(AllPrivateNetworkBlocks(), "10.10.0.0/24", "172.16.1.0/24", "192.168.1.0/24", "100.64.1.0/24", "fd00:10:96::/48")
 			expectedResult := []interface{}{
 				map[string]interface{}{
 					"network": "10.0.0.0/8",
@@ -61,6 +84,10 @@ var _ = Describe("networkpolicies", func() {
 					"network": "100.64.0.0/10",
 					"except":  []gardencorev1alpha1.CIDR{"100.64.1.0/24"},
 				},
+				map[string]interface{}{
+					"network": "fc00::/7",
+					"except":  []gardencorev1alpha1.CIDR{"fd00:10:96::/48"},
+				},
 			}
 
 			Expect(err).NotTo(HaveOccurred())
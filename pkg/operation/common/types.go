@@ -29,6 +29,10 @@ const (
 	// BackupBucketName is a constant for the name of bucket of object storage.
 	BackupBucketName = "bucketName"
 
+	// BackupStorePrefix is a constant for the key in the backup secret holding the prefix of the objects of a Shoot
+	// in a backup bucket shared by multiple Shoots.
+	BackupStorePrefix = "storePrefix"
+
 	// BackupSecretName defines the name of the secret containing the credentials which are required to
 	// authenticate against the respective cloud provider (required to store the backups of Shoot clusters).
 	BackupSecretName = "etcd-backup"
@@ -69,6 +73,11 @@ const (
 	// allow deleting the Shoot (if the annotation is not set any DELETE request will be denied).
 	ConfirmationDeletion = "confirmation.garden.sapcloud.io/deletion"
 
+	// ControlPlaneHighAvailabilityReplicas is the number of replicas of the leader-elected control plane components
+	// (kube-controller-manager, kube-scheduler) of a Shoot with a highly available control plane (one per Seed zone,
+	// like the kube-apiserver).
+	ControlPlaneHighAvailabilityReplicas = KubeAPIServerHighAvailabilityMinReplicas
+
 	// ControllerManagerInternalConfigMapName is the name of the internal config map in which the Gardener controller
 	// manager stores its configuration.
 	ControllerManagerInternalConfigMapName = "gardener-controller-manager-internal-config"
@@ -100,6 +109,10 @@ const (
 	// EtcdRoleEvents is the constant defining the role for etcd storing events in Shoot.
 	EtcdRoleEvents = "events"
 
+	// EtcdHighAvailabilityMembers is the number of members of each etcd cluster of a Shoot with a highly available
+	// control plane. Each member is scheduled into a different zone of the Seed.
+	EtcdHighAvailabilityMembers = 3
+
 	// EtcdEncryptionSecretName is the name of the shoot-specific secret which contains
 	// that shoot's EncryptionConfiguration. The EncryptionConfiguration contains a key
 	// which the shoot's apiserver uses for encrypting selected etcd content.
@@ -191,6 +204,12 @@ const (
 	// GardenRoleBackup is the value of GardenRole key indicating type 'backup'.
 	GardenRoleBackup = "backup"
 
+	// GardenRoleProjectExtensionRole is the value of GardenRole key indicating type 'project-extension-role'.
+	GardenRoleProjectExtensionRole = "project-extension-role"
+
+	// GardenRoleProjectServiceAccount is the value of GardenRole key indicating type 'project-service-account'.
+	GardenRoleProjectServiceAccount = "project-service-account"
+
 	// GardenCreatedBy is the key for an annotation of a Shoot cluster whose value indicates contains the username
 	// of the user that created the resource.
 	GardenCreatedBy = "garden.sapcloud.io/createdBy"
@@ -222,6 +241,10 @@ const (
 	// AWSLBReadvertiserDeploymentName is the name for the aws-lb-readvertiser
 	AWSLBReadvertiserDeploymentName = "aws-lb-readvertiser"
 
+	// KubeAPIServerHighAvailabilityMinReplicas is the minimum number of kube-apiserver replicas of a Shoot with a
+	// highly available control plane (one per Seed zone).
+	KubeAPIServerHighAvailabilityMinReplicas = 3
+
 	// KubeControllerManagerServerName is the name of the kube-controller-manager server.
 	KubeControllerManagerServerName = "kube-controller-manager-server"
 
@@ -240,6 +263,10 @@ const (
 	// CoreDNSDeploymentName is the name of the coredns deployment.
 	CoreDNSDeploymentName = "coredns"
 
+	// DNSControllerManagerDeploymentName is the name of the dns-controller-manager deployment managing the DNS records
+	// for the additional DNS providers of a Shoot.
+	DNSControllerManagerDeploymentName = "dns-controller-manager"
+
 	// VPNShootDeploymentName is the name of the vpn-shoot deployment.
 	VPNShootDeploymentName = "vpn-shoot"
 
@@ -271,6 +298,14 @@ const (
 	// by the Gardener Dashboard.
 	ProjectName = "project.garden.sapcloud.io/name"
 
+	// ProjectExtensionRole is the key of a label on ClusterRoles whose value holds the name of the custom project member
+	// role (without the "extension:" prefix) the rules of the ClusterRole are aggregated to.
+	ProjectExtensionRole = "garden.sapcloud.io/project-extension-role"
+
+	// ProjectServiceAccountName is the key of a label on token and kubeconfig secrets of project service accounts whose
+	// value holds the name of the respective service account.
+	ProjectServiceAccountName = "project.garden.sapcloud.io/service-account"
+
 	// NamespaceProject is they key of a label on namespace whose value holds the project uid.
 	NamespaceProject = "namespace.garden.sapcloud.io/project"
 
@@ -321,6 +356,18 @@ const (
 	// Garden cluster once successfully created.
 	ShootUseAsSeed = "shoot.garden.sapcloud.io/use-as-seed"
 
+	// SeedAgentManaged is a constant for a label on a Seed resource indicating that the shoots, the
+	// ControllerInstallations, and the BackupInfrastructures of this Seed are reconciled by a gardener-seed-agent
+	// running in the Seed cluster instead of the Gardener controller manager.
+	SeedAgentManaged = "seed.garden.sapcloud.io/agent-managed"
+
+	// SeedUserNamePrefix is the prefix of the user names of the seed-scoped identities used by gardener-seed-agents.
+	// The name of the Seed follows the prefix.
+	SeedUserNamePrefix = "garden.sapcloud.io:system:seed:"
+
+	// SeedsGroup is the group of the seed-scoped identities used by gardener-seed-agents.
+	SeedsGroup = "garden.sapcloud.io:system:seeds"
+
 	// ShootStatus is a constant for a label on a Shoot resource indicating that the Shoot's health.
 	// Shoot Care controller and can be used to easily identify Shoot clusters with certain states.
 	ShootStatus = "shoot.garden.sapcloud.io/status"
@@ -356,6 +403,15 @@ const (
 	// ShootOperationReconcile is a constant for an annotation on a Shoot indicating that a Shoot reconciliation shall be triggered.
 	ShootOperationReconcile = "reconcile"
 
+	// ShootCloneSource is a constant for an annotation on a Shoot which contains the name of another Shoot in the same
+	// namespace. The specification of the Shoot is copied from this source Shoot on creation and its main etcd is
+	// initialized with the latest backup of the source Shoot.
+	ShootCloneSource = "shoot.garden.sapcloud.io/clone-source"
+
+	// BackupSecretNameCloneSource is the name of the secret in the namespace of a cloned Shoot containing the
+	// credentials for the backups of the source Shoot.
+	BackupSecretNameCloneSource = "etcd-backup-clone-source"
+
 	// ShootSyncPeriod is a constant for an annotation on a Shoot which may be used to overwrite the global Shoot controller sync period.
 	// The value must be a duration. It can also be used to disable the reconciliation at all by setting it to 0m. Disabling the reconciliation
 	// does only mean that the period reconciliation is disabled. However, when the Gardener is restarted/redeployed or the specification is
@@ -367,6 +423,11 @@ const (
 	// delete)).
 	ShootIgnore = "shoot.garden.sapcloud.io/ignore"
 
+	// ShootHealthChecks is a constant for an annotation on a Shoot which may be used to reference a ConfigMap in the Shoot's
+	// namespace containing additional health checks for the Shoot care controller. Checks in the ConfigMap overwrite the
+	// globally configured checks with the same name.
+	ShootHealthChecks = "shoot.garden.sapcloud.io/health-checks"
+
 	// ShootUID is an annotation key for the shoot namespace in the seed cluster,
 	// which value will be the value of `shoot.status.uid`
 	ShootUID = "shoot.garden.sapcloud.io/uid"
@@ -458,6 +519,9 @@ const (
 	// ETCDImageName is the name of the ETCD image.
 	ETCDImageName = "etcd"
 
+	// ETCDBackupRestoreImageName is the name of the etcd-backup-restore image.
+	ETCDBackupRestoreImageName = "etcd-backup-restore"
+
 	// CSINodeDriverRegistrarImageName is the name of driver registrar - https://github.com/kubernetes-csi/node-driver-registrar
 	CSINodeDriverRegistrarImageName = "csi-node-driver-registrar"
 
@@ -523,6 +587,9 @@ const (
 
 	// VpaExporterImageName is the name of the vpa-exporter image
 	VpaExporterImageName = "vpa-exporter"
+
+	// DNSControllerManagerImageName is the name of the dns-controller-manager image.
+	DNSControllerManagerImageName = "dns-controller-manager"
 )
 
 var (
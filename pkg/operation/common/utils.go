@@ -45,6 +45,8 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -63,11 +65,14 @@ func GetSecretKeysWithPrefix(kind string, m map[string]*corev1.Secret) []string
 }
 
 // ComputeClusterIP parses the provided <cidr> and sets the last byte to the value of <lastByte>.
-// For example, <cidr> = 100.64.0.0/11 and <lastByte> = 10 the result would be 100.64.0.10
+// For example, <cidr> = 100.64.0.0/11 and <lastByte> = 10 the result would be 100.64.0.10, and
+// <cidr> = fd00:10:64::/108 and <lastByte> = 10 the result would be fd00:10:64::a.
 func ComputeClusterIP(cidr gardencorev1alpha1.CIDR, lastByte byte) string {
 	ip, _, _ := net.ParseCIDR(string(cidr))
-	ip = ip.To4()
-	ip[3] = lastByte
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	ip[len(ip)-1] = lastByte
 	return ip.String()
 }
 
@@ -142,6 +147,17 @@ func GenerateBackupNamespaceName(backupInfrastructureName string) string {
 	return fmt.Sprintf("%s--%s", BackupNamespacePrefix, backupInfrastructureName)
 }
 
+// GenerateBackupBucketName returns the name of the BackupBucket shared by all Shoots of the given <seed>.
+func GenerateBackupBucketName(seed *gardenv1beta1.Seed) string {
+	return string(seed.UID)
+}
+
+// GenerateBackupBucketSecretName returns the name of the secret in the garden namespace of the Seed containing the
+// credentials for the BackupBucket with the given <backupBucketName>.
+func GenerateBackupBucketSecretName(backupBucketName string) string {
+	return fmt.Sprintf("backupbucket-%s", backupBucketName)
+}
+
 // IsFollowingNewNamingConvention determines whether the new naming convention followed for shoot resources.
 // TODO: Remove this and use only "--" as separator, once we have all shoots deployed as per new naming conventions.
 func IsFollowingNewNamingConvention(seedNamespace string) bool {
@@ -546,3 +562,23 @@ func GetPersistentVolumeProvider(seed *gardenv1beta1.Seed) string {
 func GardenEtcdEncryptionSecretKey(shootNamespace, shootName string) client.ObjectKey {
 	return kutil.Key(shootNamespace, fmt.Sprintf("%s.%s", shootName, EtcdEncryptionSecretName))
 }
+
+// SeedNameForUser returns the name of the Seed if the given user is a seed-scoped identity used by a
+// gardener-seed-agent.
+func SeedNameForUser(u user.Info) (string, bool) {
+	if u == nil || !strings.HasPrefix(u.GetName(), SeedUserNamePrefix) {
+		return "", false
+	}
+	if !sets.NewString(u.GetGroups()...).Has(SeedsGroup) {
+		return "", false
+	}
+
+	seedName := strings.TrimPrefix(u.GetName(), SeedUserNamePrefix)
+	return seedName, len(seedName) > 0
+}
+
+// SeedAgentLeaseName returns the name of the lease used for the leader election of the gardener-seed-agents of the
+// given Seed.
+func SeedAgentLeaseName(seedName string) string {
+	return fmt.Sprintf("gardener-seed-agent-%s", seedName)
+}
@@ -71,6 +71,12 @@ var _ = Describe("common", func() {
 
 				Expect(result).To(Equal("100.64.0.10"))
 			})
+
+			It("should return an IPv6 cluster IP as string", func() {
+				result := ComputeClusterIP(gardencorev1alpha1.CIDR("fd00:10:64::/108"), 10)
+
+				Expect(result).To(Equal("fd00:10:64::a"))
+			})
 		})
 
 		Describe("#GenerateAddonConfig", func() {
@@ -164,6 +170,17 @@ var _ = Describe("common", func() {
 		})
 	})
 
+	Describe("#GenerateBackupBucketName", func() {
+		It("should use the UID of the Seed as name of the shared bucket", func() {
+			seed := &gardenv1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "aws", UID: types.UID("8a4bd3b2-1e7f-11e9-9d48-8e9bd2a3e4f2")}}
+
+			name := GenerateBackupBucketName(seed)
+
+			Expect(name).To(Equal("8a4bd3b2-1e7f-11e9-9d48-8e9bd2a3e4f2"))
+			Expect(GenerateBackupBucketSecretName(name)).To(Equal("backupbucket-8a4bd3b2-1e7f-11e9-9d48-8e9bd2a3e4f2"))
+		})
+	})
+
 	Describe("#MergeOwnerReferences", func() {
 		It("should merge the new references into the list of existing references", func() {
 			var (
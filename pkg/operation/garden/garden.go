@@ -113,12 +113,6 @@ func DomainIsDefaultDomain(domain string, defaultDomains []*DefaultDomain) *Defa
 // ReadGardenSecrets reads the Kubernetes Secrets from the Garden cluster which are independent of Shoot clusters.
 // The Secret objects are stored on the Controller in order to pass them to created Garden objects later.
 func ReadGardenSecrets(k8sInformers kubeinformers.SharedInformerFactory) (map[string]*corev1.Secret, error) {
-	var (
-		secretsMap                          = make(map[string]*corev1.Secret)
-		numberOfInternalDomainSecrets       = 0
-		numberOfOpenVPNDiffieHellmanSecrets = 0
-	)
-
 	selector, err := labels.Parse(common.GardenRole)
 	if err != nil {
 		return nil, err
@@ -128,6 +122,34 @@ func ReadGardenSecrets(k8sInformers kubeinformers.SharedInformerFactory) (map[st
 		return nil, err
 	}
 
+	return ComputeGardenSecrets(secrets)
+}
+
+// ReadGardenSecretsByName reads the Kubernetes Secrets with the given names from the garden namespace of the Garden
+// cluster. It is used by clients which are not allowed to list the secrets in the garden namespace.
+func ReadGardenSecretsByName(k8sGardenClient kubernetes.Interface, names []string) (map[string]*corev1.Secret, error) {
+	var secrets []*corev1.Secret
+
+	for _, name := range names {
+		secret, err := k8sGardenClient.Kubernetes().CoreV1().Secrets(common.GardenNamespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("could not read secret %s/%s: %v", common.GardenNamespace, name, err)
+		}
+		secrets = append(secrets, secret)
+	}
+
+	return ComputeGardenSecrets(secrets)
+}
+
+// ComputeGardenSecrets computes the map of Kubernetes Secrets which are independent of Shoot clusters based on the
+// given secrets of the garden namespace. Secrets without a Garden role are ignored.
+func ComputeGardenSecrets(secrets []*corev1.Secret) (map[string]*corev1.Secret, error) {
+	var (
+		secretsMap                          = make(map[string]*corev1.Secret)
+		numberOfInternalDomainSecrets       = 0
+		numberOfOpenVPNDiffieHellmanSecrets = 0
+	)
+
 	for _, secret := range secrets {
 		// Retrieving default domain secrets based on all secrets in the Garden namespace which have
 		// a label indicating the Garden role default-domain.
@@ -17,11 +17,14 @@ package garden_test
 import (
 	"testing"
 
+	"github.com/gardener/gardener/pkg/logger"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
 
 func TestGarden(t *testing.T) {
+	logger.NewLogger("info")
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Garden Suite")
 }
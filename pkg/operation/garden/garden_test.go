@@ -137,6 +137,43 @@ var _ = Describe("Garden", func() {
 		})
 	})
 
+	Describe("#ComputeGardenSecrets", func() {
+		newSecret := func(name, role string) *corev1.Secret {
+			return &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   name,
+					Labels: map[string]string{common.GardenRole: role},
+					Annotations: map[string]string{
+						common.DNSProvider: "aws",
+						common.DNSDomain:   name + ".example.com",
+					},
+				},
+			}
+		}
+
+		It("should compute the map of garden secrets", func() {
+			var (
+				internalDomain = newSecret("internal", common.GardenRoleInternalDomain)
+				defaultDomain  = newSecret("default", common.GardenRoleDefaultDomain)
+				other          = newSecret("other", "foo")
+			)
+
+			secrets, err := ComputeGardenSecrets([]*corev1.Secret{internalDomain, defaultDomain, other})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secrets).To(Equal(map[string]*corev1.Secret{
+				common.GardenRoleInternalDomain:                         internalDomain,
+				common.GardenRoleDefaultDomain + "-default.example.com": defaultDomain,
+			}))
+		})
+
+		It("should return an error if there is no internal domain secret", func() {
+			_, err := ComputeGardenSecrets([]*corev1.Secret{newSecret("default", common.GardenRoleDefaultDomain)})
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	var (
 		defaultDomainProvider   = "default-domain-provider"
 		defaultDomainSecretData = map[string][]byte{"default": []byte("domain")}
@@ -119,6 +119,9 @@ func (b *HybridBotanist) generateCoreAddonsChart() (*chartrenderer.RenderedChart
 		clusterAutoscaler = map[string]interface{}{
 			"enabled": b.Shoot.WantsClusterAutoscaler,
 		}
+		dnsControllerManager = map[string]interface{}{
+			"enabled": len(b.Shoot.Info.Spec.DNS.Providers) > 0,
+		}
 		podsecuritypolicies = map[string]interface{}{
 			"allowPrivilegedContainers": *b.Shoot.Info.Spec.Kubernetes.AllowPrivilegedContainers,
 		}
@@ -214,14 +217,15 @@ func (b *HybridBotanist) generateCoreAddonsChart() (*chartrenderer.RenderedChart
 	}
 
 	return b.ChartApplierShoot.Render(filepath.Join(common.ChartPath, "shoot-core"), "shoot-core", metav1.NamespaceSystem, map[string]interface{}{
-		"global":              global,
-		"cluster-autoscaler":  clusterAutoscaler,
-		"podsecuritypolicies": podsecuritypolicies,
-		"coredns":             coreDNS,
-		"kube-proxy":          kubeProxy,
-		"vpn-shoot":           vpnShoot,
-		"calico":              calico,
-		"metrics-server":      metricsServer,
+		"global":                 global,
+		"cluster-autoscaler":     clusterAutoscaler,
+		"dns-controller-manager": dnsControllerManager,
+		"podsecuritypolicies":    podsecuritypolicies,
+		"coredns":                coreDNS,
+		"kube-proxy":             kubeProxy,
+		"vpn-shoot":              vpnShoot,
+		"calico":                 calico,
+		"metrics-server":         metricsServer,
 		"monitoring": map[string]interface{}{
 			"node-exporter":     nodeExporter,
 			"blackbox-exporter": blackboxExporter,
@@ -17,6 +17,7 @@ package hybridbotanist
 import (
 	"context"
 	"fmt"
+	"net"
 	"path/filepath"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
@@ -35,6 +36,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/sets"
 	audit_internal "k8s.io/apiserver/pkg/apis/audit"
 	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
 	auditv1alpha1 "k8s.io/apiserver/pkg/apis/audit/v1alpha1"
@@ -106,14 +108,25 @@ func getResourcesForAPIServer(nodeCount int) (string, string, string, string) {
 	return cpuRequest, memoryRequest, cpuLimit, memoryLimit
 }
 
+// etcdStorageCapacity is the requested size of the volumes of the etcd members.
+const etcdStorageCapacity = "10Gi"
+
 // DeployETCD deploys two etcd clusters via StatefulSets. The first etcd cluster (called 'main') is used for all the
 // data the Shoot Kubernetes cluster needs to store, whereas the second etcd luster (called 'events') is only used to
 // store the events data. The objectstore is also set up to store the backups.
 func (b *HybridBotanist) DeployETCD() error {
-	secretData, err := b.SeedCloudBotanist.GenerateEtcdBackupConfig()
+	// Backups managed by a `BackupEntry` take precedence over the ones managed by the Seed cloud botanist. This way,
+	// the backups of existing Shoots are moved to the backup bucket of the Seed as soon as their entry has been created.
+	secretData, err := b.Botanist.GenerateEtcdBackupConfigFromBackupEntry(context.TODO())
 	if err != nil {
 		return err
 	}
+	if secretData == nil {
+		secretData, err = b.SeedCloudBotanist.GenerateEtcdBackupConfig()
+		if err != nil {
+			return err
+		}
+	}
 
 	// Some cloud botanists do not yet support backup and won't return secret data.
 	if secretData != nil {
@@ -139,7 +152,19 @@ func (b *HybridBotanist) DeployETCD() error {
 			"checksum/secret-etcd-server-tls": b.CheckSums["etcd-server-tls"],
 			"checksum/secret-etcd-client-tls": b.CheckSums["etcd-client-tls"],
 		},
-		"storageCapacity": b.Seed.GetValidVolumeSize("10Gi"),
+		"storageCapacity": b.Seed.GetValidVolumeSize(etcdStorageCapacity),
+	}
+
+	if b.Shoot.WantsHighAvailability {
+		if err := b.checkSeedZonesForHighAvailability(context.TODO()); err != nil {
+			return err
+		}
+
+		etcdConfig["replicas"] = common.EtcdHighAvailabilityMembers
+		etcdConfig["highAvailability"] = map[string]interface{}{
+			"enabled": true,
+			"members": common.EtcdHighAvailabilityMembers,
+		}
 	}
 
 	etcd, err := b.InjectSeedShootImages(etcdConfig, common.ETCDImageName)
@@ -175,6 +200,28 @@ func (b *HybridBotanist) DeployETCD() error {
 	return nil
 }
 
+// checkSeedZonesForHighAvailability checks that the nodes of the Seed are spread across at least as many zones as the
+// etcd clusters of highly available control planes have members. The members must not share a zone, hence, they
+// could not be scheduled otherwise.
+func (b *HybridBotanist) checkSeedZonesForHighAvailability(ctx context.Context) error {
+	nodeList := &corev1.NodeList{}
+	if err := b.K8sSeedClient.Client().List(ctx, nodeList); err != nil {
+		return err
+	}
+
+	zones := sets.NewString()
+	for _, node := range nodeList.Items {
+		if zone, ok := node.Labels[corev1.LabelZoneFailureDomain]; ok {
+			zones.Insert(zone)
+		}
+	}
+
+	if zones.Len() < common.EtcdHighAvailabilityMembers {
+		return fmt.Errorf("highly available control planes require seed nodes in at least %d zones, but seed %q has nodes in %d zone(s) only", common.EtcdHighAvailabilityMembers, b.Seed.Info.Name, zones.Len())
+	}
+	return nil
+}
+
 func (b *HybridBotanist) deployNetworkPolicies(ctx context.Context, denyAll bool) error {
 	var (
 		globalNetworkPoliciesValues = map[string]interface{}{
@@ -212,8 +259,7 @@ func (b *HybridBotanist) deployNetworkPolicies(ctx context.Context, denyAll bool
 		values["clusterNetworks"] = shootNetworkValues
 	}
 
-	seedNetworks := b.Seed.Info.Spec.Networks
-	allCIDRNetworks := append([]gardencorev1alpha1.CIDR{seedNetworks.Nodes, seedNetworks.Pods, seedNetworks.Services}, shootCIDRNetworks...)
+	allCIDRNetworks := append(common.SeedNetworksCIDRs(b.Seed.Info.Spec.Networks), shootCIDRNetworks...)
 	allCIDRNetworks = append(allCIDRNetworks, excludeNets...)
 
 	privateNetworks, err := common.ToExceptNetworks(common.AllPrivateNetworkBlocks(), allCIDRNetworks...)
@@ -223,9 +269,120 @@ func (b *HybridBotanist) deployNetworkPolicies(ctx context.Context, denyAll bool
 	globalNetworkPoliciesValues["privateNetworks"] = privateNetworks
 	values["global-network-policies"] = globalNetworkPoliciesValues
 
+	additionalEgress, err := b.computeAdditionalEgress(ctx, allCIDRNetworks)
+	if err != nil {
+		return err
+	}
+	values["additionalEgress"] = additionalEgress
+
 	return b.ApplyChartSeed(filepath.Join(chartPathControlPlane, "network-policies"), b.Shoot.SeedNamespace, "network-policies", values, nil)
 }
 
+// computeAdditionalEgress computes the chart values for the additional egress destinations of the Shoot's control
+// plane. FQDNs are resolved to their current IP addresses; addresses which cannot be resolved or which belong to
+// any of the <exceptNets> (the Seed's and Shoot's networks as well as the blocked networks of the Seed) are skipped.
+// Configured CIDRs lying completely within one of the <exceptNets> are skipped, too, all others are granted minus
+// the <exceptNets>.
+func (b *HybridBotanist) computeAdditionalEgress(ctx context.Context, exceptNets []gardencorev1alpha1.CIDR) ([]interface{}, error) {
+	result := []interface{}{}
+
+	controlPlane := b.Shoot.Info.Spec.ControlPlane
+	if controlPlane == nil {
+		return result, nil
+	}
+
+	exceptIPNets, err := parseCIDRs(exceptNets)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, destination := range controlPlane.AdditionalEgress {
+		cidrs := []gardencorev1alpha1.CIDR{}
+
+		destinationIPNets, err := parseCIDRs(destination.CIDRs)
+		if err != nil {
+			return nil, err
+		}
+		for i, ipNet := range destinationIPNets {
+			if ipNetsCover(exceptIPNets, ipNet) {
+				b.Logger.Infof("Skipping network %s of additional egress destination %q as it belongs to the seed or shoot networks", destination.CIDRs[i], destination.Name)
+				continue
+			}
+			cidrs = append(cidrs, destination.CIDRs[i])
+		}
+
+		for _, fqdn := range destination.FQDNs {
+			addrs, err := net.DefaultResolver.LookupIPAddr(ctx, fqdn)
+			if err != nil {
+				b.Logger.Errorf("Could not resolve %q of additional egress destination %q: %+v", fqdn, destination.Name, err)
+				continue
+			}
+
+			for _, addr := range addrs {
+				if ipNetsContain(exceptIPNets, addr.IP) {
+					b.Logger.Infof("Skipping address %s of %q for additional egress destination %q as it belongs to the seed or shoot networks", addr.IP, fqdn, destination.Name)
+					continue
+				}
+
+				bits := 32
+				if addr.IP.To4() == nil {
+					bits = 128
+				}
+				cidrs = append(cidrs, gardencorev1alpha1.CIDR((&net.IPNet{IP: addr.IP, Mask: net.CIDRMask(bits, bits)}).String()))
+			}
+		}
+
+		if len(cidrs) == 0 {
+			continue
+		}
+
+		networks, err := common.ExceptNetworks(cidrs, exceptNets...)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, map[string]interface{}{
+			"name":     destination.Name,
+			"networks": networks,
+			"ports":    destination.Ports,
+		})
+	}
+
+	return result, nil
+}
+
+func parseCIDRs(cidrs []gardencorev1alpha1.CIDR) ([]*net.IPNet, error) {
+	ipNets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(string(cidr))
+		if err != nil {
+			return nil, err
+		}
+		ipNets = append(ipNets, ipNet)
+	}
+	return ipNets, nil
+}
+
+func ipNetsContain(ipNets []*net.IPNet, ip net.IP) bool {
+	for _, ipNet := range ipNets {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func ipNetsCover(ipNets []*net.IPNet, other *net.IPNet) bool {
+	otherOnes, otherBits := other.Mask.Size()
+	for _, ipNet := range ipNets {
+		ones, bits := ipNet.Mask.Size()
+		if bits == otherBits && ones <= otherOnes && ipNet.Contains(other.IP) {
+			return true
+		}
+	}
+	return false
+}
+
 // DeployNetworkPolicies creates a network policies in a Shoot cluster's namespace that
 // deny all traffic and allow certain components to use annotations to declare their desire
 // to transmit/receive traffic to/from other Pods/IP addresses.
@@ -283,6 +440,13 @@ func (b *HybridBotanist) DeployKubeAPIServer() error {
 		podAnotationMap["checksum/secret-etcd-encryption"] = b.CheckSums[common.EtcdEncryptionSecretName]
 	}
 
+	if b.Shoot.WantsHighAvailability {
+		defaultValues["highAvailability"] = true
+		defaultValues["replicas"] = common.KubeAPIServerHighAvailabilityMinReplicas
+		defaultValues["minReplicas"] = common.KubeAPIServerHighAvailabilityMinReplicas
+		defaultValues["maxReplicas"] = 2 * common.KubeAPIServerHighAvailabilityMinReplicas
+	}
+
 	if b.ShootedSeed != nil {
 		var (
 			apiServer  = b.ShootedSeed.APIServer
@@ -305,8 +469,8 @@ func (b *HybridBotanist) DeployKubeAPIServer() error {
 		replicas := deployment.Spec.Replicas
 
 		// As kube-apiserver HPA manages the number of replicas, we have to maintain current number of replicas
-		// otherwise keep the value to default
-		if replicas != nil && *replicas > 0 {
+		// otherwise keep the value to default (at least one replica per zone for highly available control planes).
+		if replicas != nil && *replicas > 0 && (!b.Shoot.WantsHighAvailability || *replicas >= common.KubeAPIServerHighAvailabilityMinReplicas) {
 			defaultValues["replicas"] = *replicas
 		}
 		// If the shoot is hibernated then we want to keep the number of replicas (scale down happens later).
@@ -477,6 +641,11 @@ func (b *HybridBotanist) DeployKubeControllerManager() error {
 		"objectCount": b.Shoot.GetNodeCount(),
 	}
 
+	if b.Shoot.WantsHighAvailability {
+		defaultValues["replicas"] = common.ControlPlaneHighAvailabilityReplicas
+		defaultValues["highAvailability"] = true
+	}
+
 	if b.Shoot.IsHibernated {
 		replicaCount, err := common.CurrentReplicaCount(b.K8sSeedClient.Client(), b.Shoot.SeedNamespace, gardencorev1alpha1.DeploymentNameKubeControllerManager)
 		if err != nil {
@@ -522,6 +691,11 @@ func (b *HybridBotanist) DeployKubeScheduler() error {
 		}
 	}
 
+	if b.Shoot.WantsHighAvailability {
+		defaultValues["replicas"] = b.Shoot.GetReplicas(common.ControlPlaneHighAvailabilityReplicas)
+		defaultValues["highAvailability"] = true
+	}
+
 	schedulerConfig := b.Shoot.Info.Spec.Kubernetes.KubeScheduler
 	if schedulerConfig != nil {
 		defaultValues["featureGates"] = schedulerConfig.FeatureGates
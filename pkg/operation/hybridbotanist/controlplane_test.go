@@ -15,8 +15,22 @@
 package hybridbotanist_test
 
 import (
+	"context"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	mockkubernetes "github.com/gardener/gardener/pkg/mock/gardener/kubernetes"
+	"github.com/gardener/gardener/pkg/operation"
 	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	"github.com/gardener/gardener/pkg/operation/seed"
+	"github.com/gardener/gardener/pkg/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
 	auditv1alpha1 "k8s.io/apiserver/pkg/apis/audit/v1alpha1"
@@ -103,5 +117,129 @@ var _ = Describe("controlplane", func() {
 				Expect(ok).To(BeFalse())
 			})
 		})
+
+		Describe("#computeAdditionalEgress", func() {
+			var (
+				exceptNets = []gardencorev1alpha1.CIDR{"10.250.0.0/16", "100.96.0.0/11", "169.254.0.0/16"}
+
+				newHybridBotanist = func(destinations ...gardenv1beta1.EgressDestination) *HybridBotanist {
+					return &HybridBotanist{
+						Operation: &operation.Operation{
+							Logger: logrus.NewEntry(utils.NewNopLogger()),
+							Shoot: &shoot.Shoot{
+								Info: &gardenv1beta1.Shoot{
+									Spec: gardenv1beta1.ShootSpec{
+										ControlPlane: &gardenv1beta1.ControlPlane{AdditionalEgress: destinations},
+									},
+								},
+							},
+						},
+					}
+				}
+			)
+
+			It("should except the seed, shoot and blocked networks", func() {
+				b := newHybridBotanist(gardenv1beta1.EgressDestination{
+					Name:  "foo",
+					CIDRs: []gardencorev1alpha1.CIDR{"10.0.0.0/8"},
+					Ports: []int32{443},
+				})
+
+				values, err := ComputeAdditionalEgress(b, context.TODO(), exceptNets)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values).To(Equal([]interface{}{
+					map[string]interface{}{
+						"name": "foo",
+						"networks": []interface{}{
+							map[string]interface{}{
+								"network": "10.0.0.0/8",
+								"except":  []gardencorev1alpha1.CIDR{"10.250.0.0/16"},
+							},
+						},
+						"ports": []int32{443},
+					},
+				}))
+			})
+
+			It("should skip networks lying completely within the seed, shoot or blocked networks", func() {
+				b := newHybridBotanist(gardenv1beta1.EgressDestination{
+					Name:  "foo",
+					CIDRs: []gardencorev1alpha1.CIDR{"10.250.1.0/24", "100.96.0.1/32", "169.254.169.254/32"},
+				})
+
+				values, err := ComputeAdditionalEgress(b, context.TODO(), exceptNets)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values).To(BeEmpty())
+			})
+		})
+
+		Describe("#checkSeedZonesForHighAvailability", func() {
+			var (
+				ctrl *gomock.Controller
+
+				newNode = func(name, zone string) *corev1.Node {
+					return &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+						Name:   name,
+						Labels: map[string]string{corev1.LabelZoneFailureDomain: zone},
+					}}
+				}
+				newHybridBotanist = func(nodes ...*corev1.Node) *HybridBotanist {
+					seedClient := fake.NewFakeClient()
+					for _, node := range nodes {
+						Expect(seedClient.Create(context.TODO(), node)).To(Succeed())
+					}
+
+					k8sSeedClient := mockkubernetes.NewMockInterface(ctrl)
+					k8sSeedClient.EXPECT().Client().Return(seedClient).AnyTimes()
+
+					return &HybridBotanist{
+						Operation: &operation.Operation{
+							K8sSeedClient: k8sSeedClient,
+							Seed:          &seed.Seed{Info: &gardenv1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed"}}},
+						},
+					}
+				}
+			)
+
+			BeforeEach(func() {
+				ctrl = gomock.NewController(GinkgoT())
+			})
+
+			AfterEach(func() {
+				ctrl.Finish()
+			})
+
+			It("should succeed if the seed nodes are spread across three zones", func() {
+				b := newHybridBotanist(newNode("a", "zone-a"), newNode("b", "zone-b"), newNode("c", "zone-c"), newNode("d", "zone-a"))
+
+				Expect(CheckSeedZonesForHighAvailability(b, context.TODO())).To(Succeed())
+			})
+
+			It("should fail if the seed nodes are spread across less than three zones", func() {
+				b := newHybridBotanist(newNode("a", "zone-a"), newNode("b", "zone-b"), newNode("c", "zone-b"))
+
+				Expect(CheckSeedZonesForHighAvailability(b, context.TODO())).To(MatchError(ContainSubstring("2 zone(s)")))
+			})
+		})
+
+		Describe("#RestoreETCDFromCloneSource", func() {
+			It("should fail for shoots with a highly available control plane", func() {
+				b := &HybridBotanist{
+					Operation: &operation.Operation{
+						Shoot: &shoot.Shoot{Info: &gardenv1beta1.Shoot{
+							Spec: gardenv1beta1.ShootSpec{
+								ControlPlane: &gardenv1beta1.ControlPlane{
+									HighAvailability: &gardenv1beta1.HighAvailability{Enabled: true},
+								},
+							},
+						}},
+					},
+				}
+
+				Expect(b.RestoreETCDFromCloneSource(context.TODO())).To(MatchError(ContainSubstring("highly available control plane")))
+			})
+		})
 	})
 })
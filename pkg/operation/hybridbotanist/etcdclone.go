@@ -0,0 +1,221 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardenv1beta1helper "github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/cloudbotanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	seedpkg "github.com/gardener/gardener/pkg/operation/seed"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/retry"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// etcdCloneRestorePodName is the name of the pod restoring the backup of the source Shoot into the volume of the
+	// main etcd of a cloned Shoot.
+	etcdCloneRestorePodName = "etcd-clone-restore"
+	// etcdCloneRestoreTimeout is the maximum duration of the restoration of the backup of the source Shoot.
+	etcdCloneRestoreTimeout = 30 * time.Minute
+
+	etcdCloneDataDir = "/var/etcd/data"
+)
+
+// RestoreETCDFromCloneSource initializes the volume of the main etcd of a cloned Shoot with the latest backup of the
+// Shoot it has been cloned from. The backup is restored by a pod into the persistent volume claim which is later on
+// used by the etcd stateful set. Nothing is done if the main etcd has already been deployed.
+func (b *HybridBotanist) RestoreETCDFromCloneSource(ctx context.Context) error {
+	// The backup is only restored into the volume of the first member, hence, highly available etcds cannot be cloned.
+	// Such clones are already rejected by the Gardener API server.
+	if gardenv1beta1helper.ShootWantsHighAvailableControlPlane(b.Shoot.Info) {
+		return fmt.Errorf("shoots with a highly available control plane cannot be cloned")
+	}
+
+	if err := b.K8sSeedClient.Client().Get(ctx, kutil.Key(b.Shoot.SeedNamespace, gardencorev1alpha1.StatefulSetNameETCDMain), &appsv1.StatefulSet{}); err == nil {
+		b.Logger.Infof("Main etcd has already been deployed, skipping restoration of the backup of the clone source")
+		return nil
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	sourceName := b.Shoot.Info.Annotations[common.ShootCloneSource]
+	source := &gardenv1beta1.Shoot{}
+	if err := b.K8sGardenClient.Client().Get(ctx, kutil.Key(b.Shoot.Info.Namespace, sourceName), source); err != nil {
+		return fmt.Errorf("could not get source shoot %q: %v", sourceName, err)
+	}
+	if source.Spec.Cloud.Seed == nil || len(source.Status.TechnicalID) == 0 {
+		return fmt.Errorf("source shoot %q has not been created yet", sourceName)
+	}
+
+	// The backups of the source Shoot are stored in the backup infrastructure of its Seed, hence, the store and the
+	// credentials are determined based on the Seed of the source Shoot.
+	sourceSeed, err := seedpkg.NewFromName(b.K8sGardenClient, b.K8sGardenInformers, *source.Spec.Cloud.Seed)
+	if err != nil {
+		return err
+	}
+	sourceOperation := *b.Operation
+	sourceOperation.Seed = sourceSeed
+	sourceSeedCloudBotanist, err := cloudbotanist.New(&sourceOperation, common.CloudPurposeSeed)
+	if err != nil {
+		return err
+	}
+	store := sourceSeedCloudBotanist.GetETCDBackupStore()
+	if store == nil {
+		return fmt.Errorf("cloning is not supported for shoots with backups on %s", sourceSeedCloudBotanist.GetCloudProviderName())
+	}
+
+	sourceSeedClient, err := kubernetes.NewClientFromSecretObject(sourceSeed.Secret, client.Options{
+		Scheme: kubernetes.SeedScheme,
+	})
+	if err != nil {
+		return err
+	}
+	sourceBackupSecret := &corev1.Secret{}
+	if err := sourceSeedClient.Client().Get(ctx, kutil.Key(source.Status.TechnicalID, common.BackupSecretName), sourceBackupSecret); err != nil {
+		return fmt.Errorf("could not get the backup secret of source shoot %q: %v", sourceName, err)
+	}
+
+	backupSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: common.BackupSecretNameCloneSource, Namespace: b.Shoot.SeedNamespace}}
+	if err := kutil.CreateOrUpdate(ctx, b.K8sSeedClient.Client(), backupSecret, func() error {
+		backupSecret.Type = corev1.SecretTypeOpaque
+		backupSecret.Data = sourceBackupSecret.Data
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	volumeClaim := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: common.ETCDMainVolumeClaimName, Namespace: b.Shoot.SeedNamespace}}
+	// The claim is created with the same specification the volume claim template of the etcd stateful set has, so
+	// that the stateful set adopts it.
+	if err := kutil.CreateOrUpdate(ctx, b.K8sSeedClient.Client(), volumeClaim, func() error {
+		if volumeClaim.CreationTimestamp.IsZero() {
+			volumeClaim.Spec = corev1.PersistentVolumeClaimSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceStorage: resource.MustParse(b.Seed.GetValidVolumeSize(etcdStorageCapacity)),
+					},
+				},
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	image, err := b.ImageVector.FindImage(common.ETCDBackupRestoreImageName, imagevector.RuntimeVersion(b.SeedVersion()), imagevector.TargetVersion(b.ShootVersion()))
+	if err != nil {
+		return err
+	}
+
+	pod := newETCDCloneRestorePod(b.Shoot.SeedNamespace, store, common.ETCDMainStorePrefix(sourceBackupSecret), image.String())
+	if err := b.K8sSeedClient.Client().Delete(ctx, pod); client.IgnoreNotFound(err) != nil {
+		return err
+	}
+	if err := b.K8sSeedClient.Client().Create(ctx, pod); err != nil {
+		return err
+	}
+
+	b.Logger.Infof("Restoring the latest backup of source shoot %q", sourceName)
+	if err := retry.UntilTimeout(ctx, botanist.DefaultInterval, etcdCloneRestoreTimeout, func(ctx context.Context) (bool, error) {
+		if err := b.K8sSeedClient.Client().Get(ctx, kutil.Key(pod.Namespace, pod.Name), pod); err != nil {
+			return retry.SevereError(err)
+		}
+		switch pod.Status.Phase {
+		case corev1.PodSucceeded:
+			return retry.Ok()
+		case corev1.PodFailed:
+			return retry.SevereError(fmt.Errorf("restoration of the backup failed: %s %s", pod.Status.Reason, pod.Status.Message))
+		}
+		return retry.MinorError(fmt.Errorf("restoration of the backup has not finished yet (phase %s)", pod.Status.Phase))
+	}); err != nil {
+		return err
+	}
+
+	if err := b.K8sSeedClient.Client().Delete(ctx, pod); client.IgnoreNotFound(err) != nil {
+		return err
+	}
+	return client.IgnoreNotFound(b.K8sSeedClient.Client().Delete(ctx, backupSecret))
+}
+
+// newETCDCloneRestorePod returns a pod which restores the latest backup with the given <storePrefix> from the given
+// <store> into the volume of the main etcd. The credentials are read from the copy of the backup secret of the source
+// Shoot. The backup is restored into a temporary directory first and moved to the data directory of the etcd afterwards
+// so that a failed restoration can be retried.
+func newETCDCloneRestorePod(namespace string, store *common.ETCDBackupStore, storePrefix, image string) *corev1.Pod {
+	var (
+		activeDeadlineSeconds = int64(etcdCloneRestoreTimeout / time.Second)
+		dataVolumeMount       = corev1.VolumeMount{Name: "etcd-data", MountPath: etcdCloneDataDir}
+		restore, volumes      = store.RestoreContainer("restore", image, common.BackupSecretNameCloneSource, storePrefix, etcdCloneDataDir+"/clone.etcd", dataVolumeMount)
+	)
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      etcdCloneRestorePodName,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"app":                              etcdCloneRestorePodName,
+				"networking.gardener.cloud/to-dns": "allowed",
+				"networking.gardener.cloud/to-public-networks":  "allowed",
+				"networking.gardener.cloud/to-private-networks": "allowed",
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:         corev1.RestartPolicyNever,
+			ActiveDeadlineSeconds: &activeDeadlineSeconds,
+			InitContainers: []corev1.Container{
+				{
+					Name:         "cleanup",
+					Image:        image,
+					Command:      []string{"/bin/sh", "-c", fmt.Sprintf("rm -rf %[1]s/clone.etcd %[1]s/temp", etcdCloneDataDir)},
+					VolumeMounts: []corev1.VolumeMount{dataVolumeMount},
+				},
+				restore,
+			},
+			Containers: []corev1.Container{
+				{
+					Name:         "move",
+					Image:        image,
+					Command:      []string{"/bin/sh", "-c", fmt.Sprintf("rm -rf %[1]s/new.etcd %[1]s/temp && mv %[1]s/clone.etcd %[1]s/new.etcd", etcdCloneDataDir)},
+					VolumeMounts: []corev1.VolumeMount{dataVolumeMount},
+				},
+			},
+			Volumes: append([]corev1.Volume{
+				{
+					Name: "etcd-data",
+					VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: common.ETCDMainVolumeClaimName,
+					}},
+				},
+			}, volumes...),
+		},
+	}
+}
@@ -394,9 +394,7 @@ func BootstrapCluster(seed *Seed, secrets map[string]*corev1.Secret, imageVector
 
 	privateNetworks, err := common.ToExceptNetworks(
 		common.AllPrivateNetworkBlocks(),
-		seed.Info.Spec.Networks.Nodes,
-		seed.Info.Spec.Networks.Pods,
-		seed.Info.Spec.Networks.Services)
+		common.SeedNetworksCIDRs(seed.Info.Spec.Networks)...)
 	if err != nil {
 		return err
 	}
@@ -82,6 +82,7 @@ func New(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformer
 
 		IsHibernated:           helper.IsShootHibernated(shoot),
 		WantsClusterAutoscaler: false,
+		WantsHighAvailability:  helper.ShootWantsHighAvailableControlPlane(shoot),
 
 		Extensions: extensions,
 	}
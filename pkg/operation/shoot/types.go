@@ -39,6 +39,7 @@ type Shoot struct {
 
 	WantsClusterAutoscaler bool
 	WantsAlertmanager      bool
+	WantsHighAvailability  bool
 	IgnoreAlerts           bool
 	IsHibernated           bool
 
@@ -43,11 +43,13 @@ func (controllerRegistrationStrategy) PrepareForCreate(ctx context.Context, obj
 	controllerRegistration := obj.(*core.ControllerRegistration)
 
 	controllerRegistration.Generation = 1
+	controllerRegistration.Status = core.ControllerRegistrationStatus{}
 }
 
 func (controllerRegistrationStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
 	newControllerRegistration := obj.(*core.ControllerRegistration)
 	oldControllerRegistration := old.(*core.ControllerRegistration)
+	newControllerRegistration.Status = oldControllerRegistration.Status
 
 	if mustIncreaseGeneration(oldControllerRegistration, newControllerRegistration) {
 		newControllerRegistration.Generation = oldControllerRegistration.Generation + 1
@@ -89,3 +91,20 @@ func (controllerRegistrationStrategy) ValidateUpdate(ctx context.Context, newObj
 func (controllerRegistrationStrategy) AllowUnconditionalUpdate() bool {
 	return false
 }
+
+type controllerRegistrationStatusStrategy struct {
+	controllerRegistrationStrategy
+}
+
+// StatusStrategy defines the storage strategy for the status subresource of ControllerRegistrations.
+var StatusStrategy = controllerRegistrationStatusStrategy{Strategy}
+
+func (controllerRegistrationStatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newControllerRegistration := obj.(*core.ControllerRegistration)
+	oldControllerRegistration := old.(*core.ControllerRegistration)
+	newControllerRegistration.Spec = oldControllerRegistration.Spec
+}
+
+func (controllerRegistrationStatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateControllerRegistrationStatusUpdate(obj.(*core.ControllerRegistration).Status, old.(*core.ControllerRegistration).Status)
+}
@@ -47,6 +47,7 @@ func (p StorageProvider) v1alpha1Storage(restOptionsGetter generic.RESTOptionsGe
 
 	controllerRegistrationStorage := controllerregistrationstore.NewStorage(restOptionsGetter)
 	storage["controllerregistrations"] = controllerRegistrationStorage.ControllerRegistration
+	storage["controllerregistrations/status"] = controllerRegistrationStorage.Status
 
 	controllerInstallationStorage := controllerinstallationstore.NewStorage(restOptionsGetter)
 	storage["controllerinstallations"] = controllerInstallationStorage.ControllerInstallation
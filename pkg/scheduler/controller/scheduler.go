@@ -25,6 +25,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
 	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
@@ -36,8 +37,9 @@ import (
 
 // SchedulerController controls Seeds.
 type SchedulerController struct {
-	k8sGardenClient    kubernetes.Interface
-	k8sGardenInformers gardeninformers.SharedInformerFactory
+	k8sGardenClient        kubernetes.Interface
+	k8sGardenInformers     gardeninformers.SharedInformerFactory
+	k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory
 
 	k8sInformers kubeinformers.SharedInformerFactory
 
@@ -49,37 +51,49 @@ type SchedulerController struct {
 	seedLister gardenlisters.SeedLister
 	seedSynced cache.InformerSynced
 
+	projectSynced cache.InformerSynced
+
 	shootLister gardenlisters.ShootLister
 	shootSynced cache.InformerSynced
 	shootQueue  workqueue.RateLimitingInterface
 
+	controllerInstallationSynced cache.InformerSynced
+	controllerRegistrationSynced cache.InformerSynced
+
 	workerCh               chan int
 	numberOfRunningWorkers int
 }
 
-// NewGardenerScheduler takes a Kubernetes client for the Garden clusters <k8sGardenClient>, a <sharedInformerFactory>, a struct containing the scheduler configuration and a <recorder> for
+// NewGardenerScheduler takes a Kubernetes client for the Garden clusters <k8sGardenClient>, the shared informer factories for the
+// garden and core API groups, a struct containing the scheduler configuration and a <recorder> for
 // event recording. It creates a new NewGardenerScheduler.
-func NewGardenerScheduler(k8sGardenClient kubernetes.Interface, gardenInformerFactory gardeninformers.SharedInformerFactory, config *config.SchedulerConfiguration, recorder record.EventRecorder) *SchedulerController {
+func NewGardenerScheduler(k8sGardenClient kubernetes.Interface, gardenInformerFactory gardeninformers.SharedInformerFactory, gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory, config *config.SchedulerConfiguration, recorder record.EventRecorder) *SchedulerController {
 	var (
 		gardenv1beta1Informer = gardenInformerFactory.Garden().V1beta1()
 
-		seedLister    = gardenv1beta1Informer.Seeds().Lister()
-		shootInformer = gardenv1beta1Informer.Shoots()
-		seedInformer  = gardenv1beta1Informer.Seeds()
-		shootLister   = shootInformer.Lister()
-		shootQueue    = workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(config.RetrySyncPeriod.Duration, 12*time.Hour), "gardener-scheduler")
+		seedLister      = gardenv1beta1Informer.Seeds().Lister()
+		shootInformer   = gardenv1beta1Informer.Shoots()
+		seedInformer    = gardenv1beta1Informer.Seeds()
+		shootLister     = shootInformer.Lister()
+		projectInformer = gardenv1beta1Informer.Projects()
+		shootQueue      = workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(config.RetrySyncPeriod.Duration, 12*time.Hour), "gardener-scheduler")
+
+		gardenCoreV1alpha1Informer     = gardenCoreInformerFactory.Core().V1alpha1()
+		controllerInstallationInformer = gardenCoreV1alpha1Informer.ControllerInstallations()
+		controllerRegistrationInformer = gardenCoreV1alpha1Informer.ControllerRegistrations()
 	)
 
 	schedulerController := &SchedulerController{
-		k8sGardenClient:    k8sGardenClient,
-		k8sGardenInformers: gardenInformerFactory,
-		control:            NewDefaultControl(k8sGardenClient, gardenInformerFactory, recorder, config, shootLister, seedLister),
-		config:             config,
-		recorder:           recorder,
-		seedLister:         seedLister,
-		shootQueue:         shootQueue,
-		shootLister:        shootLister,
-		workerCh:           make(chan int),
+		k8sGardenClient:        k8sGardenClient,
+		k8sGardenInformers:     gardenInformerFactory,
+		k8sGardenCoreInformers: gardenCoreInformerFactory,
+		control:                NewDefaultControl(k8sGardenClient, gardenInformerFactory, recorder, config, shootLister, seedLister, projectInformer.Lister(), controllerInstallationInformer.Lister(), controllerRegistrationInformer.Lister()),
+		config:                 config,
+		recorder:               recorder,
+		seedLister:             seedLister,
+		shootQueue:             shootQueue,
+		shootLister:            shootLister,
+		workerCh:               make(chan int),
 	}
 
 	shootInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -88,17 +102,21 @@ func NewGardenerScheduler(k8sGardenClient kubernetes.Interface, gardenInformerFa
 	})
 	schedulerController.seedSynced = seedInformer.Informer().HasSynced
 	schedulerController.shootSynced = shootInformer.Informer().HasSynced
+	schedulerController.projectSynced = projectInformer.Informer().HasSynced
+	schedulerController.controllerInstallationSynced = controllerInstallationInformer.Informer().HasSynced
+	schedulerController.controllerRegistrationSynced = controllerRegistrationInformer.Informer().HasSynced
 
 	return schedulerController
 }
 
 // Run runs the SchedulerController until the given stop channel can be read from.
-func (c *SchedulerController) Run(ctx context.Context, k8sGardenInformers gardeninformers.SharedInformerFactory) {
+func (c *SchedulerController) Run(ctx context.Context, k8sGardenInformers gardeninformers.SharedInformerFactory, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory) {
 	var waitGroup sync.WaitGroup
 
 	k8sGardenInformers.Start(ctx.Done())
+	k8sGardenCoreInformers.Start(ctx.Done())
 
-	if !cache.WaitForCacheSync(ctx.Done(), c.seedSynced, c.shootSynced) {
+	if !cache.WaitForCacheSync(ctx.Done(), c.seedSynced, c.shootSynced, c.projectSynced, c.controllerInstallationSynced, c.controllerRegistrationSynced) {
 		logger.Logger.Error("Timed out waiting for caches to sync")
 		return
 	}
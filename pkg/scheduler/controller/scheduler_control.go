@@ -20,6 +20,7 @@ import (
 	"fmt"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -30,12 +31,15 @@ import (
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	gardenhelper "github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	gardencorelisters "github.com/gardener/gardener/pkg/client/core/listers/core/v1alpha1"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
 	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/scheduler/apis/config"
 	schedulerutils "github.com/gardener/gardener/pkg/scheduler/utils"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -99,8 +103,8 @@ type SchedulerInterface interface {
 
 // NewDefaultControl returns a new instance of the default implementation SchedulerInterface that
 // implements the documented semantics for Scheduling.
-func NewDefaultControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, recorder record.EventRecorder, config *config.SchedulerConfiguration, shootLister gardenlisters.ShootLister, seedLister gardenlisters.SeedLister) SchedulerInterface {
-	return &defaultControl{k8sGardenClient, k8sGardenInformers, recorder, config, shootLister, seedLister}
+func NewDefaultControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, recorder record.EventRecorder, config *config.SchedulerConfiguration, shootLister gardenlisters.ShootLister, seedLister gardenlisters.SeedLister, projectLister gardenlisters.ProjectLister, controllerInstallationLister gardencorelisters.ControllerInstallationLister, controllerRegistrationLister gardencorelisters.ControllerRegistrationLister) SchedulerInterface {
+	return &defaultControl{k8sGardenClient, k8sGardenInformers, recorder, config, shootLister, seedLister, projectLister, controllerInstallationLister, controllerRegistrationLister}
 }
 
 type defaultControl struct {
@@ -110,6 +114,10 @@ type defaultControl struct {
 	config             *config.SchedulerConfiguration
 	shootLister        gardenlisters.ShootLister
 	seedLister         gardenlisters.SeedLister
+	projectLister      gardenlisters.ProjectLister
+
+	controllerInstallationLister gardencorelisters.ControllerInstallationLister
+	controllerRegistrationLister gardencorelisters.ControllerRegistrationLister
 }
 
 type executeSchedulingRequest = func(context.Context, *gardenv1beta1.Shoot) error
@@ -130,7 +138,7 @@ func (c *defaultControl) ScheduleShoot(ctx context.Context, obj *gardenv1beta1.S
 	schedulerLogger.Infof("[SCHEDULING SHOOT] using %s strategy", c.config.Strategy)
 
 	// If no Seed is referenced, we try to determine an adequate one.
-	seed, err := determineSeed(shoot, c.seedLister, c.shootLister, c.config.Strategy)
+	seed, err := determineSeed(shoot, c.seedLister, c.shootLister, c.projectLister, c.controllerInstallationLister, c.controllerRegistrationLister, c.config.Strategy)
 	if err != nil {
 		c.reportFailedScheduling(shoot, err)
 		return err
@@ -163,7 +171,7 @@ func (c *defaultControl) ScheduleShoot(ctx context.Context, obj *gardenv1beta1.S
 }
 
 // determineSeed returns an appropriate Seed cluster (or nil).
-func determineSeed(shoot *gardenv1beta1.Shoot, seedLister gardenlisters.SeedLister, shootLister gardenlisters.ShootLister, strategy config.CandidateDeterminationStrategy) (*gardenv1beta1.Seed, error) {
+func determineSeed(shoot *gardenv1beta1.Shoot, seedLister gardenlisters.SeedLister, shootLister gardenlisters.ShootLister, projectLister gardenlisters.ProjectLister, controllerInstallationLister gardencorelisters.ControllerInstallationLister, controllerRegistrationLister gardencorelisters.ControllerRegistrationLister, strategy config.CandidateDeterminationStrategy) (*gardenv1beta1.Seed, error) {
 	seedList, err := seedLister.List(labels.Everything())
 	if err != nil {
 		return nil, err
@@ -172,11 +180,45 @@ func determineSeed(shoot *gardenv1beta1.Shoot, seedLister gardenlisters.SeedList
 	if err != nil {
 		return nil, err
 	}
+	controllerInstallationList, err := controllerInstallationLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	controllerRegistrationList, err := controllerRegistrationLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	unhealthySeeds, err := determineSeedsWithUnhealthyExtensions(shoot, controllerInstallationList, controllerRegistrationList)
+	if err != nil {
+		return nil, err
+	}
+	allowedSeeds, err := determineAllowedSeeds(shoot, projectLister)
+	if err != nil {
+		return nil, err
+	}
+
+	return determineBestSeedCandidate(shoot, shootList, seedList, unhealthySeeds, allowedSeeds, strategy)
+}
+
+// determineAllowedSeeds returns the seeds the policy of the Shoot's project allows it to be scheduled to. An empty
+// result means that the seeds are not restricted, this is also the case if the Shoot does not belong to a project.
+func determineAllowedSeeds(shoot *gardenv1beta1.Shoot, projectLister gardenlisters.ProjectLister) (sets.String, error) {
+	project, err := common.ProjectForNamespace(projectLister, shoot.Namespace)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return sets.NewString(), nil
+		}
+		return nil, err
+	}
 
-	return determineBestSeedCandidate(shoot, shootList, seedList, strategy)
+	if project.Spec.Policy == nil {
+		return sets.NewString(), nil
+	}
+	return sets.NewString(project.Spec.Policy.AllowedSeeds...), nil
 }
 
-func determineBestSeedCandidate(shoot *gardenv1beta1.Shoot, shootList []*gardenv1beta1.Shoot, seedList []*gardenv1beta1.Seed, strategy config.CandidateDeterminationStrategy) (*gardenv1beta1.Seed, error) {
+func determineBestSeedCandidate(shoot *gardenv1beta1.Shoot, shootList []*gardenv1beta1.Shoot, seedList []*gardenv1beta1.Seed, unhealthySeeds, allowedSeeds sets.String, strategy config.CandidateDeterminationStrategy) (*gardenv1beta1.Seed, error) {
 	// Map seeds to number of managed shoots.
 	var (
 		seedUsage  = generateSeedUsageMap(shootList)
@@ -197,6 +239,21 @@ func determineBestSeedCandidate(shoot *gardenv1beta1.Shoot, shootList []*gardenv
 		return nil, errors.New(message)
 	}
 
+	if allowedSeeds.Len() > 0 {
+		old := candidates
+		candidates = nil
+
+		for _, seed := range old {
+			if allowedSeeds.Has(seed.Name) {
+				candidates = append(candidates, seed)
+			}
+		}
+
+		if candidates == nil {
+			return nil, fmt.Errorf("found %d possible seed cluster(s), however none is allowed by the policy of the shoot's project (allowed seeds: %v)", len(old), allowedSeeds.List())
+		}
+	}
+
 	old := candidates
 	candidates = nil
 
@@ -210,6 +267,19 @@ func determineBestSeedCandidate(shoot *gardenv1beta1.Shoot, shootList []*gardenv
 		return nil, errors.New("found %d possible seed cluster(s), however none have a disjoint network")
 	}
 
+	old = candidates
+	candidates = nil
+
+	for _, seed := range old {
+		if !unhealthySeeds.Has(seed.Name) {
+			candidates = append(candidates, seed)
+		}
+	}
+
+	if candidates == nil {
+		return nil, fmt.Errorf("found %d possible seed cluster(s), however the extension controllers required by the shoot are unhealthy on all of them", len(old))
+	}
+
 	var (
 		bestCandidate *gardenv1beta1.Seed
 		min           *int
@@ -266,6 +336,51 @@ func determineCandidatesWithMinimalDistanceStrategy(seeds []*gardenv1beta1.Seed,
 	return candidates
 }
 
+// determineSeedsWithUnhealthyExtensions returns the names of the seeds on which a controller installation is marked as
+// unhealthy while its registration supports the infrastructure provider or one of the extensions of the given shoot.
+func determineSeedsWithUnhealthyExtensions(shoot *gardenv1beta1.Shoot, controllerInstallationList []*gardencorev1alpha1.ControllerInstallation, controllerRegistrationList []*gardencorev1alpha1.ControllerRegistration) (sets.String, error) {
+	cloudProvider, err := gardenhelper.DetermineCloudProviderInShoot(shoot.Spec.Cloud)
+	if err != nil {
+		return nil, err
+	}
+
+	requiredExtensions := map[string]sets.String{
+		extensionsv1alpha1.InfrastructureResource: sets.NewString(string(cloudProvider)),
+		extensionsv1alpha1.ControlPlaneResource:   sets.NewString(string(cloudProvider)),
+		extensionsv1alpha1.WorkerResource:         sets.NewString(string(cloudProvider)),
+		extensionsv1alpha1.ExtensionResource:      sets.NewString(),
+	}
+	for _, extension := range shoot.Spec.Extensions {
+		requiredExtensions[extensionsv1alpha1.ExtensionResource].Insert(extension.Type)
+	}
+
+	controllerRegistrations := make(map[string]*gardencorev1alpha1.ControllerRegistration, len(controllerRegistrationList))
+	for _, controllerRegistration := range controllerRegistrationList {
+		controllerRegistrations[controllerRegistration.Name] = controllerRegistration
+	}
+
+	unhealthySeeds := sets.NewString()
+	for _, controllerInstallation := range controllerInstallationList {
+		if gardencorev1alpha1helper.IsControllerInstallationHealthy(*controllerInstallation) {
+			continue
+		}
+
+		controllerRegistration, ok := controllerRegistrations[controllerInstallation.Spec.RegistrationRef.Name]
+		if !ok {
+			continue
+		}
+
+		for _, resource := range controllerRegistration.Spec.Resources {
+			if requiredExtensions[resource.Kind].Has(resource.Type) {
+				unhealthySeeds.Insert(controllerInstallation.Spec.SeedRef.Name)
+				break
+			}
+		}
+	}
+
+	return unhealthySeeds, nil
+}
+
 func generateSeedUsageMap(shootList []*gardenv1beta1.Shoot) map[string]int {
 	m := map[string]int{}
 
@@ -19,11 +19,13 @@ import (
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
 	"github.com/gardener/gardener/pkg/scheduler/apis/config"
@@ -33,10 +35,11 @@ var _ = Describe("Scheduler_Control", func() {
 	var (
 		ctrl *gomock.Controller
 
-		gardenInformerFactory  gardeninformers.SharedInformerFactory
-		seed                   gardenv1beta1.Seed
-		shoot                  gardenv1beta1.Shoot
-		schedulerConfiguration config.SchedulerConfiguration
+		gardenInformerFactory     gardeninformers.SharedInformerFactory
+		gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory
+		seed                      gardenv1beta1.Seed
+		shoot                     gardenv1beta1.Shoot
+		schedulerConfiguration    config.SchedulerConfiguration
 
 		cloudProfileName = "cloudprofile-1"
 		seedName         = "seed-1"
@@ -116,6 +119,7 @@ var _ = Describe("Scheduler_Control", func() {
 			shoot = *shootBase.DeepCopy()
 			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
 			gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
 			// no seed referenced
 			shoot.Spec.Cloud.Seed = nil
 		})
@@ -125,7 +129,61 @@ var _ = Describe("Scheduler_Control", func() {
 		It("should find a seed cluster 1) 'Same Region' seed determination strategy 2) referencing the same profile 3) same  region 4) indicating availability", func() {
 			gardenInformerFactory.Garden().V1beta1().Seeds().Informer().GetStore().Add(&seed)
 
-			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), schedulerConfiguration.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), gardenInformerFactory.Garden().V1beta1().Projects().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerInstallations().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Strategy)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
+		It("should not find a seed cluster on which a required extension controller is unhealthy", func() {
+			gardenInformerFactory.Garden().V1beta1().Seeds().Informer().GetStore().Add(&seed)
+			gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Informer().GetStore().Add(&gardencorev1alpha1.ControllerRegistration{
+				ObjectMeta: metav1.ObjectMeta{Name: "provider-aws"},
+				Spec: gardencorev1alpha1.ControllerRegistrationSpec{
+					Resources: []gardencorev1alpha1.ControllerResource{{Kind: "Infrastructure", Type: "aws"}},
+				},
+			})
+			gardenCoreInformerFactory.Core().V1alpha1().ControllerInstallations().Informer().GetStore().Add(&gardencorev1alpha1.ControllerInstallation{
+				ObjectMeta: metav1.ObjectMeta{Name: "provider-aws-seed-1"},
+				Spec: gardencorev1alpha1.ControllerInstallationSpec{
+					RegistrationRef: corev1.ObjectReference{Name: "provider-aws"},
+					SeedRef:         corev1.ObjectReference{Name: seedName},
+				},
+				Status: gardencorev1alpha1.ControllerInstallationStatus{
+					Conditions: []gardencorev1alpha1.Condition{
+						{Type: gardencorev1alpha1.ControllerInstallationHealthy, Status: gardencorev1alpha1.ConditionFalse},
+					},
+				},
+			})
+
+			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), gardenInformerFactory.Garden().V1beta1().Projects().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerInstallations().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Strategy)
+
+			Expect(err).To(HaveOccurred())
+			Expect(bestSeed).To(BeNil())
+		})
+
+		It("should ignore unhealthy extension controllers which are not required by the shoot", func() {
+			gardenInformerFactory.Garden().V1beta1().Seeds().Informer().GetStore().Add(&seed)
+			gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Informer().GetStore().Add(&gardencorev1alpha1.ControllerRegistration{
+				ObjectMeta: metav1.ObjectMeta{Name: "provider-gcp"},
+				Spec: gardencorev1alpha1.ControllerRegistrationSpec{
+					Resources: []gardencorev1alpha1.ControllerResource{{Kind: "Infrastructure", Type: "gcp"}},
+				},
+			})
+			gardenCoreInformerFactory.Core().V1alpha1().ControllerInstallations().Informer().GetStore().Add(&gardencorev1alpha1.ControllerInstallation{
+				ObjectMeta: metav1.ObjectMeta{Name: "provider-gcp-seed-1"},
+				Spec: gardencorev1alpha1.ControllerInstallationSpec{
+					RegistrationRef: corev1.ObjectReference{Name: "provider-gcp"},
+					SeedRef:         corev1.ObjectReference{Name: seedName},
+				},
+				Status: gardencorev1alpha1.ControllerInstallationStatus{
+					Conditions: []gardencorev1alpha1.Condition{
+						{Type: gardencorev1alpha1.ControllerInstallationHealthy, Status: gardencorev1alpha1.ConditionFalse},
+					},
+				},
+			})
+
+			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), gardenInformerFactory.Garden().V1beta1().Projects().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerInstallations().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Strategy)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(seed.Name))
@@ -145,20 +203,63 @@ var _ = Describe("Scheduler_Control", func() {
 
 			gardenInformerFactory.Garden().V1beta1().Shoots().Informer().GetStore().Add(&secondShoot)
 
-			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), schedulerConfiguration.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), gardenInformerFactory.Garden().V1beta1().Projects().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerInstallations().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Strategy)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
 		})
 
+		It("should only find seed clusters allowed by the policy of the shoot's project", func() {
+			secondSeed := seedBase
+			secondSeed.Name = "seed-2"
+
+			gardenInformerFactory.Garden().V1beta1().Seeds().Informer().GetStore().Add(&seed)
+			gardenInformerFactory.Garden().V1beta1().Seeds().Informer().GetStore().Add(&secondSeed)
+			gardenInformerFactory.Garden().V1beta1().Projects().Informer().GetStore().Add(&gardenv1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "project"},
+				Spec: gardenv1beta1.ProjectSpec{
+					Namespace: &shoot.Namespace,
+					Policy:    &gardenv1beta1.ProjectPolicy{AllowedSeeds: []string{seedName}},
+				},
+			})
+
+			secondShoot := shootBase
+			secondShoot.Name = "shoot-2"
+			// first seed references more shoots than seed-2, but seed-2 is not allowed by the policy
+			secondShoot.Spec.Cloud.Seed = &seed.Name
+
+			gardenInformerFactory.Garden().V1beta1().Shoots().Informer().GetStore().Add(&secondShoot)
+
+			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), gardenInformerFactory.Garden().V1beta1().Projects().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerInstallations().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Strategy)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
 		// FAIL
 
+		It("should fail because no seed cluster is allowed by the policy of the shoot's project", func() {
+			gardenInformerFactory.Garden().V1beta1().Seeds().Informer().GetStore().Add(&seed)
+			gardenInformerFactory.Garden().V1beta1().Projects().Informer().GetStore().Add(&gardenv1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "project"},
+				Spec: gardenv1beta1.ProjectSpec{
+					Namespace: &shoot.Namespace,
+					Policy:    &gardenv1beta1.ProjectPolicy{AllowedSeeds: []string{"other-seed"}},
+				},
+			})
+
+			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), gardenInformerFactory.Garden().V1beta1().Projects().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerInstallations().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Strategy)
+
+			Expect(err).To(HaveOccurred())
+			Expect(bestSeed).To(BeNil())
+		})
+
 		It("should fail because it cannot find a seed cluster  1) 'Same Region' seed determination strategy 2) region that no seed supports", func() {
 			shoot.Spec.Cloud.Region = "another-region"
 
 			gardenInformerFactory.Garden().V1beta1().Seeds().Informer().GetStore().Add(&seed)
 
-			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), schedulerConfiguration.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), gardenInformerFactory.Garden().V1beta1().Projects().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerInstallations().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Strategy)
 
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
@@ -171,6 +272,7 @@ var _ = Describe("Scheduler_Control", func() {
 			shoot = *shootBase.DeepCopy()
 			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
 			gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
 			// no seed referenced
 			shoot.Spec.Cloud.Seed = nil
 			schedulerConfiguration.Strategy = config.MinimalDistance
@@ -179,7 +281,7 @@ var _ = Describe("Scheduler_Control", func() {
 		It("should find a seed cluster 1) referencing the same profile 2) same  region 3) indicating availability", func() {
 			gardenInformerFactory.Garden().V1beta1().Seeds().Informer().GetStore().Add(&seed)
 
-			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), schedulerConfiguration.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), gardenInformerFactory.Garden().V1beta1().Projects().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerInstallations().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Strategy)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(seedName))
@@ -191,7 +293,7 @@ var _ = Describe("Scheduler_Control", func() {
 
 			gardenInformerFactory.Garden().V1beta1().Seeds().Informer().GetStore().Add(&seed)
 
-			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), schedulerConfiguration.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), gardenInformerFactory.Garden().V1beta1().Projects().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerInstallations().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Strategy)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(seedName))
@@ -219,7 +321,7 @@ var _ = Describe("Scheduler_Control", func() {
 			anotherRegion := "europe-west3"
 			shoot.Spec.Cloud.Region = anotherRegion
 
-			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), schedulerConfiguration.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), gardenInformerFactory.Garden().V1beta1().Projects().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerInstallations().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Strategy)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
@@ -241,7 +343,7 @@ var _ = Describe("Scheduler_Control", func() {
 
 			gardenInformerFactory.Garden().V1beta1().Shoots().Informer().GetStore().Add(&secondShoot)
 
-			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), schedulerConfiguration.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), gardenInformerFactory.Garden().V1beta1().Projects().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerInstallations().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Strategy)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
@@ -254,6 +356,7 @@ var _ = Describe("Scheduler_Control", func() {
 			shoot = *shootBase.DeepCopy()
 			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
 			gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
 			// no seed referenced
 			shoot.Spec.Cloud.Seed = nil
 			schedulerConfiguration.Strategy = config.Default
@@ -262,7 +365,7 @@ var _ = Describe("Scheduler_Control", func() {
 		It("should find a seed cluster 1) referencing the same profile 2) same  region 3) indicating availability", func() {
 			gardenInformerFactory.Garden().V1beta1().Seeds().Informer().GetStore().Add(&seed)
 
-			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), schedulerConfiguration.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), gardenInformerFactory.Garden().V1beta1().Projects().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerInstallations().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Strategy)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(seedName))
@@ -281,7 +384,7 @@ var _ = Describe("Scheduler_Control", func() {
 
 			gardenInformerFactory.Garden().V1beta1().Shoots().Informer().GetStore().Add(&secondShoot)
 
-			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), schedulerConfiguration.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), gardenInformerFactory.Garden().V1beta1().Projects().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerInstallations().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Strategy)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
@@ -298,7 +401,21 @@ var _ = Describe("Scheduler_Control", func() {
 
 			gardenInformerFactory.Garden().V1beta1().Seeds().Informer().GetStore().Add(&seed)
 
-			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), schedulerConfiguration.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), gardenInformerFactory.Garden().V1beta1().Projects().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerInstallations().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Strategy)
+
+			Expect(err).To(HaveOccurred())
+			Expect(bestSeed).To(BeNil())
+		})
+
+		It("should fail because it cannot find a seed cluster due to dual-stack network disjointedness", func() {
+			seedPodsV6 := gardencorev1alpha1.CIDR("fd00:10:96::/48")
+			seed.Spec.Networks.DualStack = &gardencorev1alpha1.DualStackNetworks{Pods: &seedPodsV6}
+			shootPodsV6 := gardencorev1alpha1.CIDR("fd00:10:96:1::/64")
+			shoot.Spec.Cloud.AWS.Networks.Pods = &shootPodsV6
+
+			gardenInformerFactory.Garden().V1beta1().Seeds().Informer().GetStore().Add(&seed)
+
+			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), gardenInformerFactory.Garden().V1beta1().Projects().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerInstallations().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Strategy)
 
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
@@ -309,7 +426,7 @@ var _ = Describe("Scheduler_Control", func() {
 
 			gardenInformerFactory.Garden().V1beta1().Seeds().Informer().GetStore().Add(&seed)
 
-			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), schedulerConfiguration.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), gardenInformerFactory.Garden().V1beta1().Projects().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerInstallations().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Strategy)
 
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
@@ -320,7 +437,7 @@ var _ = Describe("Scheduler_Control", func() {
 
 			gardenInformerFactory.Garden().V1beta1().Seeds().Informer().GetStore().Add(&seed)
 
-			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), schedulerConfiguration.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), gardenInformerFactory.Garden().V1beta1().Projects().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerInstallations().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Strategy)
 
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
@@ -336,7 +453,7 @@ var _ = Describe("Scheduler_Control", func() {
 
 			gardenInformerFactory.Garden().V1beta1().Seeds().Informer().GetStore().Add(&seed)
 
-			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), schedulerConfiguration.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), gardenInformerFactory.Garden().V1beta1().Projects().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerInstallations().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Strategy)
 
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
@@ -347,7 +464,7 @@ var _ = Describe("Scheduler_Control", func() {
 
 			gardenInformerFactory.Garden().V1beta1().Seeds().Informer().GetStore().Add(&seed)
 
-			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), schedulerConfiguration.Strategy)
+			bestSeed, err := determineSeed(&shoot, gardenInformerFactory.Garden().V1beta1().Seeds().Lister(), gardenInformerFactory.Garden().V1beta1().Shoots().Lister(), gardenInformerFactory.Garden().V1beta1().Projects().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerInstallations().Lister(), gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations().Lister(), schedulerConfiguration.Strategy)
 
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
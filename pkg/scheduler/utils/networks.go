@@ -22,7 +22,8 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
-// ValidateNetworkDisjointedness validates that the given <seedNetworks> and <k8sNetworks> are disjoint.
+// ValidateNetworkDisjointedness validates that the given <seedNetworks> and <k8sNetworks> are disjoint. The networks of
+// both IP families of dual-stack clusters are considered.
 func ValidateNetworkDisjointedness(seedNetworks gardenv1beta1.SeedNetworks, k8sNetworks gardencorev1alpha1.K8SNetworks, fldPath *field.Path) field.ErrorList {
 	var (
 		allErrs = field.ErrorList{}
@@ -30,10 +31,20 @@ func ValidateNetworkDisjointedness(seedNetworks gardenv1beta1.SeedNetworks, k8sN
 		pathNodes    = fldPath.Child("nodes")
 		pathServices = fldPath.Child("services")
 		pathPods     = fldPath.Child("pods")
+
+		seedNodes    = []gardencorev1alpha1.CIDR{seedNetworks.Nodes}
+		seedServices = []gardencorev1alpha1.CIDR{seedNetworks.Services}
+		seedPods     = []gardencorev1alpha1.CIDR{seedNetworks.Pods}
 	)
 
+	if dualStack := seedNetworks.DualStack; dualStack != nil {
+		seedNodes = appendCIDR(seedNodes, dualStack.Nodes)
+		seedServices = appendCIDR(seedServices, dualStack.Services)
+		seedPods = appendCIDR(seedPods, dualStack.Pods)
+	}
+
 	if nodes := k8sNetworks.Nodes; nodes != nil {
-		if networksIntersect(seedNetworks.Nodes, *nodes) {
+		if networksIntersect(*nodes, seedNodes...) {
 			allErrs = append(allErrs, field.Invalid(pathNodes, *nodes, "shoot node network intersects with seed node network"))
 		}
 	} else {
@@ -41,7 +52,7 @@ func ValidateNetworkDisjointedness(seedNetworks gardenv1beta1.SeedNetworks, k8sN
 	}
 
 	if services := k8sNetworks.Services; services != nil {
-		if networksIntersect(seedNetworks.Services, *services) {
+		if networksIntersect(*services, seedServices...) {
 			allErrs = append(allErrs, field.Invalid(pathServices, *services, "shoot service network intersects with seed service network"))
 		}
 	} else {
@@ -49,7 +60,7 @@ func ValidateNetworkDisjointedness(seedNetworks gardenv1beta1.SeedNetworks, k8sN
 	}
 
 	if pods := k8sNetworks.Pods; pods != nil {
-		if networksIntersect(seedNetworks.Pods, *pods) {
+		if networksIntersect(*pods, seedPods...) {
 			allErrs = append(allErrs, field.Invalid(pathPods, *pods, "shoot pod network intersects with seed pod network"))
 		}
 	} else {
@@ -59,8 +70,25 @@ func ValidateNetworkDisjointedness(seedNetworks gardenv1beta1.SeedNetworks, k8sN
 	return allErrs
 }
 
-func networksIntersect(cidr1, cidr2 gardencorev1alpha1.CIDR) bool {
-	_, net1, err1 := net.ParseCIDR(string(cidr1))
-	_, net2, err2 := net.ParseCIDR(string(cidr2))
-	return err1 != nil || err2 != nil || net2.Contains(net1.IP) || net1.Contains(net2.IP)
+func appendCIDR(cidrs []gardencorev1alpha1.CIDR, cidr *gardencorev1alpha1.CIDR) []gardencorev1alpha1.CIDR {
+	if cidr == nil {
+		return cidrs
+	}
+	return append(cidrs, *cidr)
+}
+
+// networksIntersect returns true if <cidr> intersects with any of the <others>. Networks of different IP families
+// never intersect.
+func networksIntersect(cidr gardencorev1alpha1.CIDR, others ...gardencorev1alpha1.CIDR) bool {
+	_, net1, err := net.ParseCIDR(string(cidr))
+	if err != nil {
+		return true
+	}
+	for _, other := range others {
+		_, net2, err := net.ParseCIDR(string(other))
+		if err != nil || net2.Contains(net1.IP) || net1.Contains(net2.IP) {
+			return true
+		}
+	}
+	return false
 }
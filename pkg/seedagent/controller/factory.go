@@ -0,0 +1,185 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	backupinfrastructurecontroller "github.com/gardener/gardener/pkg/controllermanager/controller/backupinfrastructure"
+	controllerinstallationcontroller "github.com/gardener/gardener/pkg/controllermanager/controller/controllerinstallation"
+	seedcontroller "github.com/gardener/gardener/pkg/controllermanager/controller/seed"
+	shootcontroller "github.com/gardener/gardener/pkg/controllermanager/controller/shoot"
+	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
+	gardenmetrics "github.com/gardener/gardener/pkg/controllermanager/metrics"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/operation/garden"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/version"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+)
+
+// SeedAgentControllerFactory contains information relevant to the controllers which are run by the gardener-seed-agent
+// for a single Seed.
+type SeedAgentControllerFactory struct {
+	cfg                    *config.ControllerManagerConfiguration
+	identity               *gardenv1beta1.Gardener
+	gardenNamespace        string
+	k8sGardenClient        kubernetes.Interface
+	k8sGardenInformers     gardeninformers.SharedInformerFactory
+	k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory
+	k8sInformers           kubeinformers.SharedInformerFactory
+	recorder               record.EventRecorder
+}
+
+// NewSeedAgentControllerFactory creates a new factory for the controllers run by the gardener-seed-agent.
+func NewSeedAgentControllerFactory(k8sGardenClient kubernetes.Interface, gardenInformerFactory gardeninformers.SharedInformerFactory, gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory, kubeInformerFactory kubeinformers.SharedInformerFactory, cfg *config.ControllerManagerConfiguration, identity *gardenv1beta1.Gardener, gardenNamespace string, recorder record.EventRecorder) *SeedAgentControllerFactory {
+	return &SeedAgentControllerFactory{
+		cfg:                    cfg,
+		identity:               identity,
+		gardenNamespace:        gardenNamespace,
+		k8sGardenClient:        k8sGardenClient,
+		k8sGardenInformers:     gardenInformerFactory,
+		k8sGardenCoreInformers: gardenCoreInformerFactory,
+		k8sInformers:           kubeInformerFactory,
+		recorder:               recorder,
+	}
+}
+
+// Run claims the Seed of the agent and starts all the controllers which are responsible for objects belonging to it.
+func (f *SeedAgentControllerFactory) Run(ctx context.Context) {
+	var (
+		seedName = f.cfg.SeedAgent.SeedName
+
+		cloudProfileInformer           = f.k8sGardenInformers.Garden().V1beta1().CloudProfiles().Informer()
+		secretBindingInformer          = f.k8sGardenInformers.Garden().V1beta1().SecretBindings().Informer()
+		quotaInformer                  = f.k8sGardenInformers.Garden().V1beta1().Quotas().Informer()
+		projectInformer                = f.k8sGardenInformers.Garden().V1beta1().Projects().Informer()
+		seedInformer                   = f.k8sGardenInformers.Garden().V1beta1().Seeds().Informer()
+		shootInformer                  = f.k8sGardenInformers.Garden().V1beta1().Shoots().Informer()
+		backupInfrastructureInformer   = f.k8sGardenInformers.Garden().V1beta1().BackupInfrastructures().Informer()
+		controllerRegistrationInformer = f.k8sGardenCoreInformers.Core().V1alpha1().ControllerRegistrations().Informer()
+		controllerInstallationInformer = f.k8sGardenCoreInformers.Core().V1alpha1().ControllerInstallations().Informer()
+
+		namespaceInformer = f.k8sInformers.Core().V1().Namespaces().Informer()
+	)
+
+	f.k8sGardenInformers.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), cloudProfileInformer.HasSynced, secretBindingInformer.HasSynced, quotaInformer.HasSynced, projectInformer.HasSynced, seedInformer.HasSynced, shootInformer.HasSynced, backupInfrastructureInformer.HasSynced) {
+		panic("Timed out waiting for Garden caches to sync")
+	}
+
+	f.k8sGardenCoreInformers.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), controllerRegistrationInformer.HasSynced, controllerInstallationInformer.HasSynced) {
+		panic("Timed out waiting for Garden core caches to sync")
+	}
+
+	f.k8sInformers.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), namespaceInformer.HasSynced) {
+		panic("Timed out waiting for Kube caches to sync")
+	}
+
+	seed, err := f.k8sGardenInformers.Garden().V1beta1().Seeds().Lister().Get(seedName)
+	runtime.Must(err)
+
+	// The seed authorizer only allows to read the Seed secret by name, hence, the secret informer is restricted to it.
+	seedSecretInformers := kubeinformers.NewSharedInformerFactoryWithOptions(f.k8sGardenClient.Kubernetes(), 0,
+		kubeinformers.WithNamespace(seed.Spec.SecretRef.Namespace),
+		kubeinformers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.FieldSelector = fields.OneTermEqualSelector("metadata.name", seed.Spec.SecretRef.Name).String()
+		}),
+	)
+	seedSecretInformer := seedSecretInformers.Core().V1().Secrets().Informer()
+
+	seedSecretInformers.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), seedSecretInformer.HasSynced) {
+		panic("Timed out waiting for Seed secret cache to sync")
+	}
+
+	runtime.Must(claimSeed(f.k8sGardenClient, seedName))
+	logger.Logger.Infof("Successfully claimed Seed %q, it is now reconciled by this gardener-seed-agent.", seedName)
+
+	secrets, err := garden.ReadGardenSecretsByName(f.k8sGardenClient, f.cfg.SeedAgent.GardenSecretNames)
+	runtime.Must(err)
+
+	imageVector, err := imagevector.ReadGlobalImageVectorWithEnvOverride(filepath.Join(common.ChartPath, "images.yaml"))
+	runtime.Must(err)
+
+	gardenNamespace := &corev1.Namespace{}
+	runtime.Must(f.k8sGardenClient.Client().Get(context.TODO(), kutil.Key(common.GardenNamespace), gardenNamespace))
+
+	// Initialize the workqueue metrics collection.
+	gardenmetrics.RegisterWorkqueMetrics()
+
+	var (
+		shootController                  = shootcontroller.NewShootController(f.k8sGardenClient, f.k8sGardenInformers, f.k8sGardenCoreInformers, f.k8sInformers, f.cfg, f.identity, f.gardenNamespace, secrets, imageVector, f.recorder, nil)
+		seedController                   = seedcontroller.NewSeedController(f.k8sGardenClient, f.k8sGardenInformers, seedSecretInformers, secrets, imageVector, f.identity, f.cfg, f.recorder)
+		backupInfrastructureController   = backupinfrastructurecontroller.NewBackupInfrastructureController(f.k8sGardenClient, f.k8sGardenInformers, f.cfg, f.identity, f.gardenNamespace, secrets, imageVector, f.recorder)
+		controllerInstallationController = controllerinstallationcontroller.NewController(f.k8sGardenClient, f.k8sGardenInformers, f.k8sGardenCoreInformers, f.cfg, f.recorder, gardenNamespace)
+	)
+
+	// Initialize the Controller metrics collection.
+	gardenmetrics.RegisterControllerMetrics(shootController, seedController, backupInfrastructureController)
+
+	// Shoot maintenance, quota and hibernation remain with the Gardener controller manager, hence no workers are started.
+	go shootController.Run(ctx, f.cfg.Controllers.Shoot.ConcurrentSyncs, f.cfg.Controllers.ShootCare.ConcurrentSyncs, 0, 0, 0)
+	go seedController.Run(ctx, f.cfg.Controllers.Seed.ConcurrentSyncs)
+	go backupInfrastructureController.Run(ctx, f.cfg.Controllers.BackupInfrastructure.ConcurrentSyncs, backupinfrastructurecontroller.VerificationWorkers(f.cfg))
+	go controllerInstallationController.Run(ctx, f.cfg.Controllers.ControllerInstallation.ConcurrentSyncs)
+
+	logger.Logger.Infof("Gardener seed agent (version %s) for Seed %q initialized.", version.Get().GitVersion, seedName)
+
+	// Shutdown handling
+	<-ctx.Done()
+
+	logger.Logger.Infof("I have received a stop signal and will no longer watch events of the Garden API group.")
+	logger.Logger.Infof("Bye Bye!")
+}
+
+// claimSeed labels the Seed with the given name so that the Gardener controller manager stops reconciling the objects
+// belonging to it.
+func claimSeed(k8sGardenClient kubernetes.Interface, seedName string) error {
+	seed, err := k8sGardenClient.Garden().GardenV1beta1().Seeds().Get(seedName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("could not get Seed %q: %v", seedName, err)
+	}
+	if controllerutils.IsSeedAgentManaged(seed) {
+		return nil
+	}
+
+	seed = seed.DeepCopy()
+	if seed.Labels == nil {
+		seed.Labels = map[string]string{}
+	}
+	seed.Labels[common.SeedAgentManaged] = "true"
+
+	_, err = k8sGardenClient.Garden().GardenV1beta1().Seeds().Update(seed)
+	return err
+}
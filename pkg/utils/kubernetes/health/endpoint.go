@@ -0,0 +1,123 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// nonPublicNetworks contains the networks of the IANA IPv4 and IPv6 special-purpose address registries which are
+// not globally reachable, plus the networks which embed IPv4 addresses (NAT64, 6to4, Teredo) and could hence be used to
+// reach internal IPv4 addresses. IPv4-mapped IPv6 addresses are covered by the IPv4 networks.
+var nonPublicNetworks = mustParseCIDRs(
+	"0.0.0.0/8",       // "this" network (RFC1122)
+	"10.0.0.0/8",      // private network (RFC1918)
+	"100.64.0.0/10",   // carrier-grade NAT (RFC6598), also contains metadata services like 100.100.100.200
+	"127.0.0.0/8",     // loopback (RFC1122)
+	"169.254.0.0/16",  // link-local (RFC3927), also contains metadata services like 169.254.169.254
+	"172.16.0.0/12",   // private network (RFC1918)
+	"192.0.0.0/24",    // IETF protocol assignments (RFC6890)
+	"192.0.2.0/24",    // documentation, TEST-NET-1 (RFC5737)
+	"192.88.99.0/24",  // 6to4 relay anycast (RFC7526)
+	"192.168.0.0/16",  // private network (RFC1918)
+	"198.18.0.0/15",   // benchmarking (RFC2544)
+	"198.51.100.0/24", // documentation, TEST-NET-2 (RFC5737)
+	"203.0.113.0/24",  // documentation, TEST-NET-3 (RFC5737)
+	"224.0.0.0/4",     // multicast (RFC5771)
+	"240.0.0.0/4",     // reserved (RFC1112), also contains the limited broadcast address (RFC919)
+	"::/128",          // unspecified address (RFC4291)
+	"::1/128",         // loopback (RFC4291)
+	"64:ff9b::/96",    // IPv4/IPv6 translation, NAT64 (RFC6052)
+	"64:ff9b:1::/48",  // local-use IPv4/IPv6 translation (RFC8215)
+	"100::/64",        // discard-only (RFC6666)
+	"2001::/23",       // IETF protocol assignments (RFC2928), also contains Teredo 2001::/32 (RFC4380)
+	"2001:db8::/32",   // documentation (RFC3849)
+	"2002::/16",       // 6to4 (RFC3056)
+	"3fff::/20",       // documentation (RFC9637)
+	"5f00::/16",       // segment routing SIDs (RFC9602)
+	"fc00::/7",        // unique local addresses (RFC4193), also contains metadata services like fd00:ec2::254
+	"fe80::/10",       // link-local (RFC4291)
+	"ff00::/8",        // multicast (RFC4291)
+)
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	out := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		out = append(out, ipNet)
+	}
+	return out
+}
+
+// IsPublicIP returns true if the given IP address is a public address, i.e. it belongs neither to a private,
+// loopback, link-local, multicast or otherwise reserved network. In particular, metadata services of cloud
+// providers are not public.
+func IsPublicIP(ip net.IP) bool {
+	for _, ipNet := range nonPublicNetworks {
+		if ipNet.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// publicAddressesOnly is a dialer control function which refuses connections to non-public addresses. As it is
+// invoked for the resolved address of every connection (including redirects), it cannot be tricked by DNS names
+// which resolve to internal addresses.
+func publicAddressesOnly(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !IsPublicIP(ip) {
+		return fmt.Errorf("connections to non-public address %s are not allowed", host)
+	}
+	return nil
+}
+
+// CheckPublicHTTPEndpoint sends a GET request to the given URL and checks the status code of the response with
+// CheckHTTPStatusCode. Only endpoints with public addresses may be probed (see IsPublicIP), hence, it is safe to use
+// it for URLs provided by end-users.
+func CheckPublicHTTPEndpoint(ctx context.Context, url string, timeout time.Duration, expectedStatusCodes []int) error {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	transport := &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: timeout,
+			Control: publicAddressesOnly,
+		}).DialContext,
+		TLSHandshakeTimeout: timeout,
+	}
+	defer transport.CloseIdleConnections()
+
+	resp, err := (&http.Client{Timeout: timeout, Transport: transport}).Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return CheckHTTPStatusCode(resp.StatusCode, expectedStatusCodes)
+}
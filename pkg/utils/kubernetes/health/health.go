@@ -152,6 +152,25 @@ func CheckStatefulSet(statefulSet *appsv1.StatefulSet) error {
 	return nil
 }
 
+// CheckStatefulSetQuorum checks whether the given StatefulSet running a quorum-based cluster (e.g., etcd) is healthy.
+// A StatefulSet is considered healthy if its controller observed its current revision and if a majority of its
+// desired replicas is ready.
+func CheckStatefulSetQuorum(statefulSet *appsv1.StatefulSet) error {
+	if statefulSet.Status.ObservedGeneration < statefulSet.Generation {
+		return fmt.Errorf("observed generation outdated (%d/%d)", statefulSet.Status.ObservedGeneration, statefulSet.Generation)
+	}
+
+	replicas := int32(1)
+	if statefulSet.Spec.Replicas != nil {
+		replicas = *statefulSet.Spec.Replicas
+	}
+
+	if quorum := replicas/2 + 1; statefulSet.Status.ReadyReplicas < quorum {
+		return fmt.Errorf("quorum lost, not enough ready replicas (%d/%d, quorum %d)", statefulSet.Status.ReadyReplicas, replicas, quorum)
+	}
+	return nil
+}
+
 func daemonSetMaxUnavailable(daemonSet *appsv1.DaemonSet) int32 {
 	if daemonSet.Status.DesiredNumberScheduled == 0 || daemonSet.Spec.UpdateStrategy.Type != appsv1.RollingUpdateDaemonSetStrategyType {
 		return 0
@@ -349,6 +368,21 @@ func CheckExtensionObject(obj extensionsv1alpha1.Object) error {
 	return nil
 }
 
+// CheckHTTPStatusCode checks whether the given status code of an HTTP response is one of the expected status codes.
+// If no expected status codes are given then only 200 (OK) is considered healthy.
+func CheckHTTPStatusCode(statusCode int, expectedStatusCodes []int) error {
+	if len(expectedStatusCodes) == 0 {
+		expectedStatusCodes = []int{http.StatusOK}
+	}
+
+	for _, expected := range expectedStatusCodes {
+		if statusCode == expected {
+			return nil
+		}
+	}
+	return fmt.Errorf("unexpected status code %d (expected one of %v)", statusCode, expectedStatusCodes)
+}
+
 // Now determines the current time.
 var Now = time.Now
 
@@ -15,7 +15,12 @@
 package health_test
 
 import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 
@@ -124,6 +129,33 @@ var _ = Describe("health", func() {
 		)
 	})
 
+	Context("CheckStatefulSetQuorum", func() {
+		DescribeTable("statefulsets",
+			func(statefulSet *appsv1.StatefulSet, matcher types.GomegaMatcher) {
+				err := health.CheckStatefulSetQuorum(statefulSet)
+				Expect(err).To(matcher)
+			},
+			Entry("healthy", &appsv1.StatefulSet{
+				Spec:   appsv1.StatefulSetSpec{Replicas: replicas(3)},
+				Status: appsv1.StatefulSetStatus{ReadyReplicas: 3},
+			}, BeNil()),
+			Entry("healthy with nil replicas", &appsv1.StatefulSet{
+				Status: appsv1.StatefulSetStatus{ReadyReplicas: 1},
+			}, BeNil()),
+			Entry("healthy with quorum", &appsv1.StatefulSet{
+				Spec:   appsv1.StatefulSetSpec{Replicas: replicas(3)},
+				Status: appsv1.StatefulSetStatus{ReadyReplicas: 2},
+			}, BeNil()),
+			Entry("not observed at latest version", &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+			}, HaveOccurred()),
+			Entry("quorum lost", &appsv1.StatefulSet{
+				Spec:   appsv1.StatefulSetSpec{Replicas: replicas(3)},
+				Status: appsv1.StatefulSetStatus{ReadyReplicas: 1},
+			}, HaveOccurred()),
+		)
+	})
+
 	Context("CheckDaemonSet", func() {
 		oneUnavailable := intstr.FromInt(1)
 		DescribeTable("daemonsets",
@@ -370,4 +402,56 @@ var _ = Describe("health", func() {
 				HaveOccurred()),
 		)
 	})
+
+	Context("CheckHTTPStatusCode", func() {
+		DescribeTable("status codes",
+			func(statusCode int, expectedStatusCodes []int, matcher types.GomegaMatcher) {
+				err := health.CheckHTTPStatusCode(statusCode, expectedStatusCodes)
+				Expect(err).To(matcher)
+			},
+			Entry("ok without expected status codes", 200, nil, BeNil()),
+			Entry("not ok without expected status codes", 204, nil, HaveOccurred()),
+			Entry("expected status code", 204, []int{200, 204}, BeNil()),
+			Entry("unexpected status code", 503, []int{200, 204}, HaveOccurred()),
+		)
+	})
+
+	Context("IsPublicIP", func() {
+		DescribeTable("addresses",
+			func(ip string, public bool) {
+				Expect(health.IsPublicIP(net.ParseIP(ip))).To(Equal(public))
+			},
+			Entry("public IPv4 address", "8.8.8.8", true),
+			Entry("public IPv6 address", "2001:4860:4860::8888", true),
+			Entry("private IPv4 address", "10.250.0.1", false),
+			Entry("loopback address", "127.0.0.1", false),
+			Entry("metadata service", "169.254.169.254", false),
+			Entry("carrier-grade NAT address", "100.100.100.200", false),
+			Entry("IPv4-mapped private address", "::ffff:192.168.0.1", false),
+			Entry("IPv6 loopback address", "::1", false),
+			Entry("IPv6 unique local address", "fd00:ec2::254", false),
+			Entry("IPv6 link-local address", "fe80::1", false),
+			Entry("IETF protocol assignment", "192.0.0.8", false),
+			Entry("benchmarking address", "198.19.0.1", false),
+			Entry("IPv4 documentation address", "203.0.113.1", false),
+			Entry("NAT64 address of a private address", "64:ff9b::a00:1", false),
+			Entry("discard-only address", "100::1", false),
+			Entry("Teredo address", "2001:0:4136:e378::1", false),
+			Entry("IPv6 documentation address", "2001:db8::1", false),
+			Entry("6to4 address of a private address", "2002:a00:1::1", false),
+		)
+	})
+
+	Context("CheckPublicHTTPEndpoint", func() {
+		It("should refuse to probe non-public endpoints", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			err := health.CheckPublicHTTPEndpoint(context.TODO(), server.URL, time.Second, nil)
+
+			Expect(err).To(MatchError(ContainSubstring("non-public address 127.0.0.1")))
+		})
+	})
 })
@@ -24,6 +24,8 @@ import (
 
 	"k8s.io/apimachinery/pkg/runtime"
 
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
 	"github.com/golang/mock/gomock"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -757,4 +759,43 @@ var _ = Describe("kubernetes", func() {
 		Entry("< 63 chars", "foo", "foo"),
 		Entry("= 63 chars", strings.Repeat("a", 63), strings.Repeat("a", 63)),
 		Entry("> 63 chars", strings.Repeat("a", 64), strings.Repeat("a", 63)))
+
+	Describe("#shootConditionsEqual", func() {
+		var (
+			shootWithAvailability = func(downtime time.Duration, percentage string) *gardenv1beta1.Shoot {
+				return &gardenv1beta1.Shoot{
+					Status: gardenv1beta1.ShootStatus{
+						Availability: []gardenv1beta1.ConditionAvailability{{
+							Type:       "APIServerAvailable",
+							Window:     metav1.Duration{Duration: time.Hour},
+							Downtime:   metav1.Duration{Duration: downtime},
+							Percentage: percentage,
+						}},
+					},
+				}
+			}
+		)
+
+		It("should ignore changed availability figures", func() {
+			Expect(shootConditionsEqual(shootWithAvailability(time.Minute, "98.333"), shootWithAvailability(2*time.Minute, "96.667"))).To(BeTrue())
+		})
+
+		It("should detect changed availability windows", func() {
+			updated := shootWithAvailability(time.Minute, "98.333")
+			updated.Status.Availability[0].Window.Duration = 2 * time.Hour
+
+			Expect(shootConditionsEqual(shootWithAvailability(time.Minute, "98.333"), updated)).To(BeFalse())
+		})
+
+		It("should detect missing availabilities", func() {
+			Expect(shootConditionsEqual(&gardenv1beta1.Shoot{}, shootWithAvailability(0, "100.000"))).To(BeFalse())
+		})
+
+		It("should detect changed conditions", func() {
+			updated := shootWithAvailability(time.Minute, "98.333")
+			updated.Status.Conditions = []gardencorev1alpha1.Condition{{Type: "APIServerAvailable", Status: gardencorev1alpha1.ConditionFalse}}
+
+			Expect(shootConditionsEqual(shootWithAvailability(time.Minute, "98.333"), updated)).To(BeFalse())
+		})
+	})
 })
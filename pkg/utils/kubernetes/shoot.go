@@ -118,12 +118,31 @@ func TryUpdateShootLabels(g garden.Interface, backoff wait.Backoff, meta metav1.
 // It retries with the given <backoff> characteristics as long as it gets Conflict errors.
 // The transformation function is applied to the current state of the Shoot object. If the transformation
 // yields a semantically equal Shoot (regarding conditions), no update is done and the operation returns normally.
+// The availability is not compared by its figures which change with every computation, i.e. it is only updated
+// together with the conditions or the condition history.
 func TryUpdateShootConditions(g garden.Interface, backoff wait.Backoff, meta metav1.ObjectMeta, transform func(*gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error)) (*gardenv1beta1.Shoot, error) {
 	return tryUpdateShoot(g, backoff, meta, transform, func(g garden.Interface, shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
 		return g.GardenV1beta1().Shoots(shoot.Namespace).UpdateStatus(shoot)
-	}, func(cur, updated *gardenv1beta1.Shoot) bool {
-		return equality.Semantic.DeepEqual(cur.Status.Conditions, updated.Status.Conditions)
-	})
+	}, shootConditionsEqual)
+}
+
+func shootConditionsEqual(cur, updated *gardenv1beta1.Shoot) bool {
+	return equality.Semantic.DeepEqual(cur.Status.Conditions, updated.Status.Conditions) &&
+		equality.Semantic.DeepEqual(cur.Status.ConditionHistory, updated.Status.ConditionHistory) &&
+		availabilityShapeEqual(cur.Status.Availability, updated.Status.Availability)
+}
+
+// availabilityShapeEqual compares the given availabilities only by their condition types and windows.
+func availabilityShapeEqual(cur, updated []gardenv1beta1.ConditionAvailability) bool {
+	if len(cur) != len(updated) {
+		return false
+	}
+	for i := range cur {
+		if cur[i].Type != updated[i].Type || cur[i].Window.Duration != updated[i].Window.Duration {
+			return false
+		}
+	}
+	return true
 }
 
 // TryUpdateShootAnnotations tries to update the annotations of the shoot matching the given <meta>.
@@ -32,6 +32,8 @@ type CIDR interface {
 	GetIPNet() *net.IPNet
 	// Parse checks if CIDR parses
 	Parse() bool
+	// ValidateDifferentIPFamily returns errors if any of others belongs to the same IP family.
+	ValidateDifferentIPFamily(others ...CIDR) field.ErrorList
 	// ValidateNotSubset returns errors if subsets is a subset.
 	ValidateNotSubset(subsets ...CIDR) field.ErrorList
 	// ValidateParse returns errors CIDR can't be parsed.
@@ -85,6 +87,22 @@ func (c *cidrPath) ValidateNotSubset(subsets ...CIDR) field.ErrorList {
 	return allErrs
 }
 
+func (c *cidrPath) ValidateDifferentIPFamily(others ...CIDR) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if c.ParseError != nil {
+		return allErrs
+	}
+	for _, other := range others {
+		if other == nil || c == other || !other.Parse() {
+			continue
+		}
+		if isIPv6(c.net) == isIPv6(other.GetIPNet()) {
+			allErrs = append(allErrs, field.Invalid(other.GetFieldPath(), other.GetCIDR(), fmt.Sprintf("must not belong to the same IP family as %q (%q)", c.fieldPath.String(), c.cidr)))
+		}
+	}
+	return allErrs
+}
+
 func (c *cidrPath) ValidateParse() field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -110,3 +128,7 @@ func (c *cidrPath) GetFieldPath() *field.Path {
 func (c *cidrPath) GetCIDR() gardencore.CIDR {
 	return c.cidr
 }
+
+func isIPv6(ipNet *net.IPNet) bool {
+	return ipNet.IP.To4() == nil
+}
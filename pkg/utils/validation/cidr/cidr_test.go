@@ -112,6 +112,13 @@ var _ = Describe("cidr", func() {
 			Expect(cdr.ValidateNotSubset(other)).To(BeEmpty())
 		})
 
+		It("should not be a subset of another IP family", func() {
+			cdr := NewCIDR(gardencore.CIDR("::/0"), path)
+			other := NewCIDR(validGardenCIDR, path)
+
+			Expect(cdr.ValidateNotSubset(other)).To(BeEmpty())
+		})
+
 		It("should ignore nil values", func() {
 			cdr := NewCIDR(validGardenCIDR, path)
 
@@ -140,6 +147,42 @@ var _ = Describe("cidr", func() {
 		})
 	})
 
+	Context("ValidateDifferentIPFamily", func() {
+		It("should belong to a different IP family", func() {
+			cdr := NewCIDR(validGardenCIDR, path)
+			other := NewCIDR(gardencore.CIDR("2001:db8::/64"), path)
+
+			Expect(cdr.ValidateDifferentIPFamily(other)).To(BeEmpty())
+		})
+
+		It("should ignore nil values", func() {
+			cdr := NewCIDR(validGardenCIDR, path)
+
+			Expect(cdr.ValidateDifferentIPFamily(nil)).To(BeEmpty())
+		})
+
+		It("should ignore when parse error", func() {
+			cdr := NewCIDR(invalidGardenCIDR, path)
+			other := NewCIDR(gardencore.CIDR("2.2.2.2/32"), path)
+
+			Expect(cdr.ValidateDifferentIPFamily(other)).To(BeEmpty())
+		})
+
+		It("should return an error for the same IP family", func() {
+			cdr := NewCIDR(gardencore.CIDR("2001:db8::/64"), path)
+			badCIDR := gardencore.CIDR("fd00::/8")
+			badPath := field.NewPath("bad")
+			other := NewCIDR(badCIDR, badPath)
+
+			Expect(cdr.ValidateDifferentIPFamily(other)).To(ConsistOfFields(Fields{
+				"Type":     Equal(field.ErrorTypeInvalid),
+				"Field":    Equal(badPath.String()),
+				"BadValue": Equal(badCIDR),
+				"Detail":   Equal(`must not belong to the same IP family as "foo" ("2001:db8::/64")`),
+			}))
+		})
+	})
+
 	Context("ValidateParse", func() {
 		It("should parse without errors", func() {
 			cdr := NewCIDR(validGardenCIDR, path)
@@ -29,6 +29,7 @@ import (
 	"github.com/gardener/gardener/plugin/pkg/utils"
 
 	rbacv1 "k8s.io/api/rbac/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/admission"
@@ -41,6 +42,8 @@ import (
 const (
 	// PluginName is the name of this admission plugin.
 	PluginName = "ResourceReferenceManager"
+	// ProjectVerbManagePolicy is the verb on projects which is required to set or change the policy of a project.
+	ProjectVerbManagePolicy = "manage-policy"
 )
 
 // Register registers a plugin.
@@ -228,6 +231,10 @@ func (r *ReferenceManager) Admit(a admission.Attributes, o admission.ObjectInter
 		if !ok {
 			return apierrors.NewBadRequest("could not convert resource into Project object")
 		}
+		// The policy restricts the project members, hence, they must not be able to change it themselves.
+		if err := r.ensureProjectPolicyChangeIsAllowed(a, project); err != nil {
+			return admission.NewForbidden(a, err)
+		}
 		if utils.SkipVerification(operation, project.ObjectMeta) {
 			return nil
 		}
@@ -254,15 +261,7 @@ func (r *ReferenceManager) Admit(a admission.Attributes, o admission.ObjectInter
 		}
 
 		if project.Spec.Owner != nil {
-			ownerPartOfMember := false
-			for _, member := range project.Spec.Members {
-				if member == *project.Spec.Owner {
-					ownerPartOfMember = true
-				}
-			}
-			if !ownerPartOfMember {
-				project.Spec.Members = append(project.Spec.Members, *project.Spec.Owner)
-			}
+			ensureOwnerIsAdmin(project)
 		}
 	}
 
@@ -272,6 +271,59 @@ func (r *ReferenceManager) Admit(a admission.Attributes, o admission.ObjectInter
 	return nil
 }
 
+// ensureOwnerIsAdmin makes sure that the owner of the given project is a project member with the "admin" role.
+func ensureOwnerIsAdmin(project *garden.Project) {
+	owner := *project.Spec.Owner
+
+	for i, member := range project.Spec.ProjectMembers {
+		if member.Subject != owner {
+			continue
+		}
+		for _, role := range member.Roles {
+			if role == garden.ProjectMemberAdmin {
+				return
+			}
+		}
+		project.Spec.ProjectMembers[i].Roles = append(project.Spec.ProjectMembers[i].Roles, garden.ProjectMemberAdmin)
+		return
+	}
+
+	project.Spec.ProjectMembers = append(project.Spec.ProjectMembers, garden.ProjectMember{
+		Subject: owner,
+		Roles:   []string{garden.ProjectMemberAdmin},
+	})
+}
+
+// ensureProjectPolicyChangeIsAllowed ensures that the policy of the given project is only set or changed by users
+// which are allowed to manage project policies.
+func (r *ReferenceManager) ensureProjectPolicyChangeIsAllowed(attributes admission.Attributes, project *garden.Project) error {
+	var oldPolicy *garden.ProjectPolicy
+	if attributes.GetOperation() == admission.Update {
+		oldProject, ok := attributes.GetOldObject().(*garden.Project)
+		if !ok {
+			return errors.New("could not convert old resource into Project object")
+		}
+		oldPolicy = oldProject.Spec.Policy
+	}
+	if apiequality.Semantic.DeepEqual(project.Spec.Policy, oldPolicy) {
+		return nil
+	}
+
+	managePolicyAttributes := authorizer.AttributesRecord{
+		User:            attributes.GetUserInfo(),
+		Verb:            ProjectVerbManagePolicy,
+		APIGroup:        gardenv1beta1.SchemeGroupVersion.Group,
+		APIVersion:      gardenv1beta1.SchemeGroupVersion.Version,
+		Resource:        "projects",
+		Name:            project.Name,
+		ResourceRequest: true,
+	}
+	if decision, _, _ := r.authorizer.Authorize(managePolicyAttributes); decision != authorizer.DecisionAllow {
+		return fmt.Errorf("user is not allowed to change the policy of project %q (requires verb %q on projects)", project.Name, ProjectVerbManagePolicy)
+	}
+	return nil
+}
+
 func (r *ReferenceManager) ensureSecretBindingReferences(attributes admission.Attributes, binding *garden.SecretBinding) error {
 	readAttributes := authorizer.AttributesRecord{
 		User:            attributes.GetUserInfo(),
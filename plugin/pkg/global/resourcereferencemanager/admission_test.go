@@ -58,7 +58,8 @@ var _ = Describe("resourcereferencemanager", func() {
 			gardenInformerFactory gardeninformers.SharedInformerFactory
 			fakeAuthorizer        fakeAuthorizerType
 
-			shoot garden.Shoot
+			shoot   garden.Shoot
+			project garden.Project
 
 			namespace        = "default"
 			cloudProfileName = "profile-1"
@@ -137,7 +138,7 @@ var _ = Describe("resourcereferencemanager", func() {
 					},
 				},
 			}
-			project = garden.Project{
+			projectBase = garden.Project{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: projectName,
 				},
@@ -190,6 +191,7 @@ var _ = Describe("resourcereferencemanager", func() {
 			MissingSecretWait = 0
 
 			shoot = shootBase
+			project = *projectBase.DeepCopy()
 		})
 
 		Context("tests for SecretBinding objects", func() {
@@ -502,7 +504,7 @@ var _ = Describe("resourcereferencemanager", func() {
 				}))
 			})
 
-			It("should add the owner to members", func() {
+			It("should add the owner as admin to the project members", func() {
 				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
 
 				attrs := admission.NewAttributesRecord(&project, nil, garden.Kind("Project").WithVersion("version"), project.Namespace, project.Name, garden.Resource("projects").WithVersion("version"), "", admission.Create, false, defaultUserInfo)
@@ -510,11 +512,104 @@ var _ = Describe("resourcereferencemanager", func() {
 				err := admissionHandler.Admit(attrs, nil)
 
 				Expect(err).NotTo(HaveOccurred())
-				Expect(project.Spec.Members).To(ContainElement(Equal(rbacv1.Subject{
+				Expect(project.Spec.Members).To(BeEmpty())
+				Expect(project.Spec.ProjectMembers).To(ContainElement(Equal(garden.ProjectMember{
+					Subject: rbacv1.Subject{
+						APIGroup: "rbac.authorization.k8s.io",
+						Kind:     rbacv1.UserKind,
+						Name:     defaultUserName,
+					},
+					Roles: []string{garden.ProjectMemberAdmin},
+				})))
+			})
+
+			It("should add the admin role to the owner in the project members", func() {
+				owner := rbacv1.Subject{
 					APIGroup: "rbac.authorization.k8s.io",
 					Kind:     rbacv1.UserKind,
 					Name:     defaultUserName,
-				})))
+				}
+				project.Spec.ProjectMembers = []garden.ProjectMember{
+					{Subject: owner, Roles: []string{garden.ProjectMemberViewer}},
+				}
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+
+				attrs := admission.NewAttributesRecord(&project, nil, garden.Kind("Project").WithVersion("version"), project.Namespace, project.Name, garden.Resource("projects").WithVersion("version"), "", admission.Create, false, defaultUserInfo)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(project.Spec.Members).To(BeEmpty())
+				Expect(project.Spec.ProjectMembers).To(Equal([]garden.ProjectMember{
+					{Subject: owner, Roles: []string{garden.ProjectMemberViewer, garden.ProjectMemberAdmin}},
+				}))
+			})
+
+			It("should append the owner as admin to the existing project members", func() {
+				member := garden.ProjectMember{
+					Subject: rbacv1.Subject{APIGroup: "rbac.authorization.k8s.io", Kind: rbacv1.UserKind, Name: "alice.doe@example.com"},
+					Roles:   []string{garden.ProjectMemberShootOperator},
+				}
+				project.Spec.ProjectMembers = []garden.ProjectMember{member}
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+
+				attrs := admission.NewAttributesRecord(&project, nil, garden.Kind("Project").WithVersion("version"), project.Namespace, project.Name, garden.Resource("projects").WithVersion("version"), "", admission.Create, false, defaultUserInfo)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(project.Spec.Members).To(BeEmpty())
+				Expect(project.Spec.ProjectMembers).To(Equal([]garden.ProjectMember{
+					member,
+					{
+						Subject: rbacv1.Subject{APIGroup: "rbac.authorization.k8s.io", Kind: rbacv1.UserKind, Name: defaultUserName},
+						Roles:   []string{garden.ProjectMemberAdmin},
+					},
+				}))
+			})
+
+			It("should reject because the user is not allowed to set the policy", func() {
+				project.Spec.Policy = &garden.ProjectPolicy{AllowedRegions: []string{"eu-west-1"}}
+
+				attrs := admission.NewAttributesRecord(&project, nil, garden.Kind("Project").WithVersion("version"), project.Namespace, project.Name, garden.Resource("projects").WithVersion("version"), "", admission.Create, false, defaultUserInfo)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should accept because the user is allowed to set the policy", func() {
+				project.Spec.Policy = &garden.ProjectPolicy{AllowedRegions: []string{"eu-west-1"}}
+
+				attrs := admission.NewAttributesRecord(&project, nil, garden.Kind("Project").WithVersion("version"), project.Namespace, project.Name, garden.Resource("projects").WithVersion("version"), "", admission.Create, false, &user.DefaultInfo{Name: "allowed-user"})
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject because the user is not allowed to change the policy", func() {
+				project.Spec.Policy = &garden.ProjectPolicy{AllowedRegions: []string{"eu-west-1"}}
+				oldProject := project.DeepCopy()
+				project.Spec.Policy.AllowedRegions = nil
+
+				attrs := admission.NewAttributesRecord(&project, oldProject, garden.Kind("Project").WithVersion("version"), project.Namespace, project.Name, garden.Resource("projects").WithVersion("version"), "", admission.Update, false, defaultUserInfo)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should accept updates which do not change the policy", func() {
+				project.Spec.Policy = &garden.ProjectPolicy{AllowedRegions: []string{"eu-west-1"}}
+				oldProject := project.DeepCopy()
+				project.Spec.Description = &projectName
+
+				attrs := admission.NewAttributesRecord(&project, oldProject, garden.Kind("Project").WithVersion("version"), project.Namespace, project.Name, garden.Resource("projects").WithVersion("version"), "", admission.Update, false, defaultUserInfo)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
 			})
 		})
 	})
@@ -0,0 +1,117 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seedrestriction
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/admission"
+)
+
+const (
+	// PluginName is the name of this admission plugin.
+	PluginName = "SeedRestriction"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// SeedRestriction contains the admission handler.
+type SeedRestriction struct {
+	*admission.Handler
+}
+
+// New creates a new SeedRestriction admission plugin.
+func New() (*SeedRestriction, error) {
+	return &SeedRestriction{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}, nil
+}
+
+// Validate restricts the seed-scoped identities used by gardener-seed-agents to the objects of their own Seed, similar
+// to the NodeRestriction admission plugin of Kubernetes. The seed authorizer only knows the objects from its caches,
+// hence, it cannot prevent that an agent moves a Shoot, BackupInfrastructure, or ControllerInstallation to another Seed
+// or creates a BackupInfrastructure for another Seed.
+func (s *SeedRestriction) Validate(a admission.Attributes, o admission.ObjectInterfaces) error {
+	seedName, ok := common.SeedNameForUser(a.GetUserInfo())
+	if !ok {
+		return nil
+	}
+
+	switch a.GetKind().GroupKind() {
+	case garden.Kind("Shoot"):
+		return s.validateSeedNames(a, seedName, func(obj interface{}) (string, bool) {
+			shoot, ok := obj.(*garden.Shoot)
+			if !ok {
+				return "", false
+			}
+			if shoot.Spec.Cloud.Seed == nil {
+				return "", true
+			}
+			return *shoot.Spec.Cloud.Seed, true
+		})
+
+	case garden.Kind("BackupInfrastructure"):
+		return s.validateSeedNames(a, seedName, func(obj interface{}) (string, bool) {
+			backupInfrastructure, ok := obj.(*garden.BackupInfrastructure)
+			if !ok {
+				return "", false
+			}
+			return backupInfrastructure.Spec.Seed, true
+		})
+
+	case core.Kind("ControllerInstallation"):
+		return s.validateSeedNames(a, seedName, func(obj interface{}) (string, bool) {
+			controllerInstallation, ok := obj.(*core.ControllerInstallation)
+			if !ok {
+				return "", false
+			}
+			return controllerInstallation.Spec.SeedRef.Name, true
+		})
+	}
+
+	return nil
+}
+
+// validateSeedNames forbids the request if the new object or, for updates, the old object does not belong to the Seed
+// with the given name.
+func (s *SeedRestriction) validateSeedNames(a admission.Attributes, seedName string, seedNameOf func(interface{}) (string, bool)) error {
+	objects := []interface{}{a.GetObject()}
+	if a.GetOperation() == admission.Update {
+		objects = append(objects, a.GetOldObject())
+	}
+
+	for _, obj := range objects {
+		objSeedName, ok := seedNameOf(obj)
+		if !ok {
+			return apierrors.NewBadRequest(fmt.Sprintf("could not convert resource into %s object", a.GetKind().Kind))
+		}
+		if objSeedName != seedName {
+			return admission.NewForbidden(a, fmt.Errorf("seed %q cannot modify %s objects of seed %q", seedName, a.GetKind().Kind, objSeedName))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seedrestriction_test
+
+import (
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/plugin/pkg/global/seedrestriction"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("seedrestriction", func() {
+	Describe("#Validate", func() {
+		var (
+			admissionHandler *SeedRestriction
+
+			seedName  = "seed-1"
+			otherSeed = "seed-2"
+
+			seedUser = &user.DefaultInfo{
+				Name:   common.SeedUserNamePrefix + seedName,
+				Groups: []string{common.SeedsGroup},
+			}
+			otherUser = &user.DefaultInfo{Name: "foo"}
+
+			newShoot = func(seed string) *garden.Shoot {
+				return &garden.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-my-project"},
+					Spec:       garden.ShootSpec{Cloud: garden.Cloud{Seed: &seed}},
+				}
+			}
+			newBackupInfrastructure = func(seed string) *garden.BackupInfrastructure {
+				return &garden.BackupInfrastructure{
+					ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "garden-my-project"},
+					Spec:       garden.BackupInfrastructureSpec{Seed: seed},
+				}
+			}
+			newControllerInstallation = func(seed string) *core.ControllerInstallation {
+				return &core.ControllerInstallation{
+					ObjectMeta: metav1.ObjectMeta{Name: "installation"},
+					Spec:       core.ControllerInstallationSpec{SeedRef: corev1.ObjectReference{Name: seed}},
+				}
+			}
+		)
+
+		BeforeEach(func() {
+			admissionHandler, _ = New()
+		})
+
+		validate := func(kind schema.GroupKind, resource schema.GroupResource, obj, oldObj runtime.Object, operation admission.Operation, userInfo user.Info) error {
+			attrs := admission.NewAttributesRecord(obj, oldObj, kind.WithVersion("version"), "garden-my-project", "name", resource.WithVersion("version"), "", operation, false, userInfo)
+			return admissionHandler.Validate(attrs, nil)
+		}
+
+		var (
+			shootKind                      = garden.Kind("Shoot")
+			shootResource                  = garden.Resource("shoots")
+			backupInfrastructureKind       = garden.Kind("BackupInfrastructure")
+			backupInfrastructureResource   = garden.Resource("backupinfrastructures")
+			controllerInstallationKind     = core.Kind("ControllerInstallation")
+			controllerInstallationResource = core.Resource("controllerinstallations")
+		)
+
+		It("should allow seeds to update their own shoots", func() {
+			Expect(validate(shootKind, shootResource, newShoot(seedName), newShoot(seedName), admission.Update, seedUser)).To(Succeed())
+		})
+
+		It("should forbid seeds to change the seed of their shoots", func() {
+			err := validate(shootKind, shootResource, newShoot(otherSeed), newShoot(seedName), admission.Update, seedUser)
+
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		})
+
+		It("should forbid seeds to update shoots of other seeds", func() {
+			err := validate(shootKind, shootResource, newShoot(seedName), newShoot(otherSeed), admission.Update, seedUser)
+
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		})
+
+		It("should allow other users to change the seed of shoots", func() {
+			Expect(validate(shootKind, shootResource, newShoot(otherSeed), newShoot(seedName), admission.Update, otherUser)).To(Succeed())
+		})
+
+		It("should allow seeds to create backup infrastructures for themselves", func() {
+			Expect(validate(backupInfrastructureKind, backupInfrastructureResource, newBackupInfrastructure(seedName), nil, admission.Create, seedUser)).To(Succeed())
+		})
+
+		It("should forbid seeds to create backup infrastructures for other seeds", func() {
+			err := validate(backupInfrastructureKind, backupInfrastructureResource, newBackupInfrastructure(otherSeed), nil, admission.Create, seedUser)
+
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		})
+
+		It("should forbid seeds to change the seed of controller installations", func() {
+			err := validate(controllerInstallationKind, controllerInstallationResource, newControllerInstallation(otherSeed), newControllerInstallation(seedName), admission.Update, seedUser)
+
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		})
+	})
+})
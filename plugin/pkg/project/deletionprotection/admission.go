@@ -0,0 +1,194 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deletionprotection
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	informers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	listers "github.com/gardener/gardener/pkg/client/garden/listers/garden/internalversion"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/admission"
+)
+
+const (
+	// PluginName is the name of this admission plugin.
+	PluginName = "ProjectDeletionProtection"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// DeletionProtection contains listers and admission handler.
+type DeletionProtection struct {
+	*admission.Handler
+	projectLister       listers.ProjectLister
+	secretBindingLister listers.SecretBindingLister
+	shootLister         listers.ShootLister
+	readyFunc           admission.ReadyFunc
+}
+
+var (
+	_ = admissioninitializer.WantsInternalGardenInformerFactory(&DeletionProtection{})
+
+	readyFuncs = []admission.ReadyFunc{}
+)
+
+// New creates a new DeletionProtection admission plugin.
+func New() (*DeletionProtection, error) {
+	return &DeletionProtection{
+		Handler: admission.NewHandler(admission.Delete),
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (d *DeletionProtection) AssignReadyFunc(f admission.ReadyFunc) {
+	d.readyFunc = f
+	d.SetReadyFunc(f)
+}
+
+// SetInternalGardenInformerFactory gets Lister from SharedInformerFactory.
+func (d *DeletionProtection) SetInternalGardenInformerFactory(f informers.SharedInformerFactory) {
+	projectInformer := f.Garden().InternalVersion().Projects()
+	d.projectLister = projectInformer.Lister()
+
+	secretBindingInformer := f.Garden().InternalVersion().SecretBindings()
+	d.secretBindingLister = secretBindingInformer.Lister()
+
+	shootInformer := f.Garden().InternalVersion().Shoots()
+	d.shootLister = shootInformer.Lister()
+
+	readyFuncs = append(readyFuncs, projectInformer.Informer().HasSynced, secretBindingInformer.Informer().HasSynced, shootInformer.Informer().HasSynced)
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (d *DeletionProtection) ValidateInitialization() error {
+	if d.projectLister == nil {
+		return errors.New("missing project lister")
+	}
+	if d.secretBindingLister == nil {
+		return errors.New("missing secret binding lister")
+	}
+	if d.shootLister == nil {
+		return errors.New("missing shoot lister")
+	}
+	return nil
+}
+
+// Validate rejects the deletion of Projects whose namespace still contains cloud provider credentials that are
+// used by Shoots in other namespaces. Such credentials would be deleted together with the project namespace even
+// though the namespace deletion webhook only considers objects living in the namespace itself.
+func (d *DeletionProtection) Validate(a admission.Attributes, o admission.ObjectInterfaces) error {
+	// Ignore all kinds other than Project
+	if a.GetKind().GroupKind() != garden.Kind("Project") {
+		return nil
+	}
+
+	// Wait until the caches have been synced
+	if d.readyFunc == nil {
+		d.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	if !d.WaitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+
+	var projects []*garden.Project
+
+	// DELETECOLLECTION requests are handed to admission plugins with an empty name, see the deletionconfirmation
+	// admission plugin for details.
+	if a.GetName() == "" {
+		list, err := d.projectLister.List(labels.Everything())
+		if err != nil {
+			return apierrors.NewInternalError(err)
+		}
+		projects = list
+	} else {
+		project, err := d.projectLister.Get(a.GetName())
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return apierrors.NewInternalError(err)
+		}
+		projects = append(projects, project)
+	}
+
+	secretBindings, err := d.secretBindingLister.List(labels.Everything())
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	shoots, err := d.shootLister.List(labels.Everything())
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+
+	// The credentials are only compared by the references between the objects, hence, it is sufficient to convert
+	// these references to call the common helper which is shared with the namespace deletion webhook.
+	var externalShoots []*gardenv1beta1.Shoot
+	for _, shoot := range shoots {
+		externalShoots = append(externalShoots, &gardenv1beta1.Shoot{
+			ObjectMeta: shoot.ObjectMeta,
+			Spec:       gardenv1beta1.ShootSpec{Cloud: gardenv1beta1.Cloud{SecretBindingRef: shoot.Spec.Cloud.SecretBindingRef}},
+		})
+	}
+
+	for _, project := range projects {
+		if project.Spec.Namespace == nil {
+			continue
+		}
+		namespace := *project.Spec.Namespace
+
+		// Credentials used by Shoots of the project namespace itself do not need to be considered, the namespace
+		// deletion is blocked by these Shoots anyway.
+		var foreignSecretBindings []*gardenv1beta1.SecretBinding
+		for _, secretBinding := range secretBindings {
+			if secretBinding.Namespace != namespace {
+				foreignSecretBindings = append(foreignSecretBindings, &gardenv1beta1.SecretBinding{ObjectMeta: secretBinding.ObjectMeta, SecretRef: secretBinding.SecretRef})
+			}
+		}
+
+		if blockingObjects := common.CredentialsBlockingObjects(namespace, foreignSecretBindings, externalShoots); len(blockingObjects) > 0 {
+			var descriptions []string
+			for _, obj := range blockingObjects {
+				descriptions = append(descriptions, obj.String())
+			}
+			sort.Strings(descriptions)
+			return admission.NewForbidden(a, fmt.Errorf("namespace %q of project %q still contains credentials used by Shoots of other namespaces: %s", namespace, project.Name, strings.Join(descriptions, "; ")))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deletionprotection_test
+
+import (
+	"github.com/gardener/gardener/pkg/apis/garden"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	. "github.com/gardener/gardener/plugin/pkg/project/deletionprotection"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("deletionprotection", func() {
+	Describe("#Validate", func() {
+		var (
+			admissionHandler      *DeletionProtection
+			gardenInformerFactory gardeninformers.SharedInformerFactory
+
+			namespaceName = "garden-my-project"
+
+			project = garden.Project{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-project",
+				},
+				Spec: garden.ProjectSpec{
+					Namespace: &namespaceName,
+				},
+			}
+			secretBinding = garden.SecretBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foreign-binding",
+					Namespace: "garden-other-project",
+				},
+				SecretRef: corev1.SecretReference{
+					Name:      "credentials",
+					Namespace: namespaceName,
+				},
+			}
+			shoot = garden.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot",
+					Namespace: "garden-other-project",
+				},
+				Spec: garden.ShootSpec{
+					Cloud: garden.Cloud{
+						SecretBindingRef: corev1.LocalObjectReference{Name: "foreign-binding"},
+					},
+				},
+			}
+		)
+
+		BeforeEach(func() {
+			admissionHandler, _ = New()
+			admissionHandler.AssignReadyFunc(func() bool { return true })
+			gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
+
+			gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+			gardenInformerFactory.Garden().InternalVersion().SecretBindings().Informer().GetStore().Add(&secretBinding)
+		})
+
+		validate := func(name string) error {
+			attrs := admission.NewAttributesRecord(nil, nil, garden.Kind("Project").WithVersion("version"), "", name, garden.Resource("projects").WithVersion("version"), "", admission.Delete, false, nil)
+			return admissionHandler.Validate(attrs, nil)
+		}
+
+		It("should allow the deletion if the credentials are not used", func() {
+			Expect(validate(project.Name)).To(Succeed())
+		})
+
+		It("should allow the deletion of unknown projects", func() {
+			gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(&shoot)
+
+			Expect(validate("unknown")).To(Succeed())
+		})
+
+		It("should allow the deletion if the credentials are only used within the project namespace", func() {
+			localBinding := secretBinding.DeepCopy()
+			localBinding.Namespace = namespaceName
+			localShoot := shoot.DeepCopy()
+			localShoot.Namespace = namespaceName
+			gardenInformerFactory.Garden().InternalVersion().SecretBindings().Informer().GetStore().Update(localBinding)
+			gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(localShoot)
+
+			Expect(validate(project.Name)).To(Succeed())
+		})
+
+		It("should forbid the deletion if the credentials are used by shoots of other namespaces", func() {
+			gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(&shoot)
+
+			err := validate(project.Name)
+
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("Secret garden-my-project/credentials (referenced by SecretBinding garden-other-project/foreign-binding which is used by Shoots garden-other-project/shoot)"))
+		})
+
+		It("should forbid deleting all projects if the credentials are used by shoots of other namespaces", func() {
+			gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(&shoot)
+
+			Expect(apierrors.IsForbidden(validate(""))).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,240 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloning
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/apis/garden/helper"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	informers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	listers "github.com/gardener/gardener/pkg/client/garden/listers/garden/internalversion"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/admission"
+)
+
+const (
+	// PluginName is the name of this admission plugin.
+	PluginName = "ShootCloning"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// Cloning contains listers and admission handler.
+type Cloning struct {
+	*admission.Handler
+	shootLister listers.ShootLister
+	readyFunc   admission.ReadyFunc
+}
+
+var (
+	_ = admissioninitializer.WantsInternalGardenInformerFactory(&Cloning{})
+
+	readyFuncs = []admission.ReadyFunc{}
+)
+
+// New creates a new Cloning admission plugin.
+func New() (*Cloning, error) {
+	return &Cloning{
+		Handler: admission.NewHandler(admission.Create),
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (c *Cloning) AssignReadyFunc(f admission.ReadyFunc) {
+	c.readyFunc = f
+	c.SetReadyFunc(f)
+}
+
+// SetInternalGardenInformerFactory gets Lister from SharedInformerFactory.
+func (c *Cloning) SetInternalGardenInformerFactory(f informers.SharedInformerFactory) {
+	shootInformer := f.Garden().InternalVersion().Shoots()
+	c.shootLister = shootInformer.Lister()
+
+	readyFuncs = append(readyFuncs, shootInformer.Informer().HasSynced)
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (c *Cloning) ValidateInitialization() error {
+	if c.shootLister == nil {
+		return errors.New("missing shoot lister")
+	}
+	return nil
+}
+
+// Admit copies the specification of the source Shoot into Shoots which are created as clones of another Shoot. Only
+// the fields explicitly given in the new Shoot are kept.
+func (c *Cloning) Admit(a admission.Attributes, o admission.ObjectInterfaces) error {
+	// Wait until the caches have been synced
+	if c.readyFunc == nil {
+		c.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	if !c.WaitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+
+	// Ignore all kinds other than Shoot
+	if a.GetKind().GroupKind() != garden.Kind("Shoot") {
+		return nil
+	}
+
+	// Ignore updates to shoot status or other subresources
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*garden.Shoot)
+	if !ok {
+		return apierrors.NewInternalError(errors.New("could not convert resource into Shoot object"))
+	}
+
+	sourceName, ok := shoot.Annotations[common.ShootCloneSource]
+	if !ok {
+		return nil
+	}
+
+	source, err := c.shootLister.Shoots(shoot.Namespace).Get(sourceName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return admission.NewForbidden(a, fmt.Errorf("source shoot %q does not exist", sourceName))
+		}
+		return apierrors.NewInternalError(fmt.Errorf("could not get source shoot %q: %v", sourceName, err))
+	}
+	if source.DeletionTimestamp != nil {
+		return admission.NewForbidden(a, fmt.Errorf("source shoot %q is being deleted", sourceName))
+	}
+	if len(source.Status.TechnicalID) == 0 {
+		return admission.NewForbidden(a, fmt.Errorf("source shoot %q has not been created yet", sourceName))
+	}
+
+	spec, err := CloneSpec(&source.Spec, &shoot.Spec)
+	if err != nil {
+		return admission.NewForbidden(a, err)
+	}
+	// Only the first member of a highly available main etcd could be initialized with the backup of the source Shoot,
+	// the other members would start with empty data directories.
+	if controlPlane := spec.ControlPlane; controlPlane != nil && controlPlane.HighAvailability != nil && controlPlane.HighAvailability.Enabled {
+		return admission.NewForbidden(a, fmt.Errorf("shoots with a highly available control plane cannot be cloned, but source shoot %q has one", sourceName))
+	}
+	shoot.Spec = *spec
+	return nil
+}
+
+// CloneSpec returns a copy of the specification of the <source> Shoot overridden by the fields given in the
+// specification of the <clone>. The region, the seed, the DNS domain, the hibernation settings as well as the
+// networks and zones of the cloud provider can be overridden. The DNS domain is never copied as it must be unique.
+func CloneSpec(source, clone *garden.ShootSpec) (*garden.ShootSpec, error) {
+	spec := source.DeepCopy()
+
+	if len(clone.Cloud.Region) > 0 {
+		spec.Cloud.Region = clone.Cloud.Region
+		if clone.Cloud.Region != source.Cloud.Region {
+			// The seed of the source is most likely not located in the new region, hence, it has to be determined again.
+			spec.Cloud.Seed = nil
+		}
+	}
+	if clone.Cloud.Seed != nil {
+		spec.Cloud.Seed = clone.Cloud.Seed
+	}
+	spec.DNS.Domain = clone.DNS.Domain
+	spec.Hibernation = clone.Hibernation
+
+	sourceProvider, err := helper.DetermineCloudProviderInShoot(source.Cloud)
+	if err != nil {
+		return nil, err
+	}
+	if cloneProvider, err := helper.DetermineCloudProviderInShoot(clone.Cloud); err == nil && cloneProvider != sourceProvider {
+		return nil, fmt.Errorf("the cloud provider %q of the clone must match the cloud provider %q of the source shoot", cloneProvider, sourceProvider)
+	}
+
+	switch sourceProvider {
+	case garden.CloudProviderAWS:
+		if clone.Cloud.AWS != nil {
+			if !isZero(clone.Cloud.AWS.Networks) {
+				spec.Cloud.AWS.Networks = clone.Cloud.AWS.Networks
+			}
+			if len(clone.Cloud.AWS.Zones) > 0 {
+				spec.Cloud.AWS.Zones = clone.Cloud.AWS.Zones
+			}
+		}
+	case garden.CloudProviderAzure:
+		if clone.Cloud.Azure != nil {
+			if !isZero(clone.Cloud.Azure.Networks) {
+				spec.Cloud.Azure.Networks = clone.Cloud.Azure.Networks
+			}
+		}
+	case garden.CloudProviderGCP:
+		if clone.Cloud.GCP != nil {
+			if !isZero(clone.Cloud.GCP.Networks) {
+				spec.Cloud.GCP.Networks = clone.Cloud.GCP.Networks
+			}
+			if len(clone.Cloud.GCP.Zones) > 0 {
+				spec.Cloud.GCP.Zones = clone.Cloud.GCP.Zones
+			}
+		}
+	case garden.CloudProviderOpenStack:
+		if clone.Cloud.OpenStack != nil {
+			if !isZero(clone.Cloud.OpenStack.Networks) {
+				spec.Cloud.OpenStack.Networks = clone.Cloud.OpenStack.Networks
+			}
+			if len(clone.Cloud.OpenStack.Zones) > 0 {
+				spec.Cloud.OpenStack.Zones = clone.Cloud.OpenStack.Zones
+			}
+		}
+	case garden.CloudProviderAlicloud:
+		if clone.Cloud.Alicloud != nil {
+			if !isZero(clone.Cloud.Alicloud.Networks) {
+				spec.Cloud.Alicloud.Networks = clone.Cloud.Alicloud.Networks
+			}
+			if len(clone.Cloud.Alicloud.Zones) > 0 {
+				spec.Cloud.Alicloud.Zones = clone.Cloud.Alicloud.Zones
+			}
+		}
+	case garden.CloudProviderPacket:
+		if clone.Cloud.Packet != nil {
+			if !isZero(clone.Cloud.Packet.Networks) {
+				spec.Cloud.Packet.Networks = clone.Cloud.Packet.Networks
+			}
+			if len(clone.Cloud.Packet.Zones) > 0 {
+				spec.Cloud.Packet.Zones = clone.Cloud.Packet.Zones
+			}
+		}
+	}
+
+	return spec, nil
+}
+
+func isZero(obj interface{}) bool {
+	return apiequality.Semantic.DeepEqual(obj, reflect.Zero(reflect.TypeOf(obj)).Interface())
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloning_test
+
+import (
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/cloning"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("cloning", func() {
+	Describe("#Admit", func() {
+		var (
+			admissionHandler      *Cloning
+			gardenInformerFactory gardeninformers.SharedInformerFactory
+			source                garden.Shoot
+			shoot                 garden.Shoot
+
+			namespaceName = "garden-my-project"
+			seedName      = "aws-eu1"
+			domain        = "source.my-project.example.com"
+
+			sourceBase = garden.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "source",
+					Namespace: namespaceName,
+				},
+				Spec: garden.ShootSpec{
+					Cloud: garden.Cloud{
+						Profile: "aws",
+						Region:  "eu-west-1",
+						Seed:    &seedName,
+						AWS: &garden.AWSCloud{
+							Networks: garden.AWSNetworks{
+								K8SNetworks: gardencore.K8SNetworks{
+									Nodes: cidr("10.250.0.0/16"),
+								},
+							},
+							Workers: []garden.AWSWorker{
+								{Worker: garden.Worker{Name: "worker", AutoScalerMin: 1, AutoScalerMax: 3}},
+							},
+							Zones: []string{"eu-west-1a"},
+						},
+					},
+					DNS: garden.DNS{
+						Domain: &domain,
+					},
+					Kubernetes: garden.Kubernetes{
+						Version: "1.15.2",
+					},
+				},
+				Status: garden.ShootStatus{
+					TechnicalID: "shoot--my-project--source",
+				},
+			}
+			shootBase = garden.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "clone",
+					Namespace:   namespaceName,
+					Annotations: map[string]string{common.ShootCloneSource: "source"},
+				},
+			}
+		)
+
+		BeforeEach(func() {
+			source = *sourceBase.DeepCopy()
+			shoot = *shootBase.DeepCopy()
+
+			admissionHandler, _ = New()
+			admissionHandler.AssignReadyFunc(func() bool { return true })
+			gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
+		})
+
+		admit := func(shoot *garden.Shoot) error {
+			gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(&source)
+
+			attrs := admission.NewAttributesRecord(shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+			return admissionHandler.Admit(attrs, nil)
+		}
+
+		It("should not touch shoots which are no clones", func() {
+			shoot.Annotations = nil
+
+			Expect(admit(&shoot)).To(Succeed())
+			Expect(shoot.Spec).To(Equal(garden.ShootSpec{}))
+		})
+
+		It("should copy the specification of the source shoot", func() {
+			Expect(admit(&shoot)).To(Succeed())
+
+			expectedSpec := source.Spec.DeepCopy()
+			expectedSpec.DNS.Domain = nil
+			Expect(shoot.Spec).To(Equal(*expectedSpec))
+		})
+
+		It("should keep the overridden region, networks and zones", func() {
+			shoot.Spec.Cloud.Region = "eu-central-1"
+			shoot.Spec.Cloud.AWS = &garden.AWSCloud{
+				Networks: garden.AWSNetworks{
+					K8SNetworks: gardencore.K8SNetworks{
+						Nodes: cidr("10.251.0.0/16"),
+					},
+				},
+				Zones: []string{"eu-central-1a"},
+			}
+
+			Expect(admit(&shoot)).To(Succeed())
+
+			Expect(shoot.Spec.Cloud.Region).To(Equal("eu-central-1"))
+			Expect(shoot.Spec.Cloud.Seed).To(BeNil())
+			Expect(shoot.Spec.Cloud.AWS.Networks.Nodes).To(Equal(cidr("10.251.0.0/16")))
+			Expect(shoot.Spec.Cloud.AWS.Zones).To(ConsistOf("eu-central-1a"))
+			Expect(shoot.Spec.Cloud.AWS.Workers).To(Equal(source.Spec.Cloud.AWS.Workers))
+		})
+
+		It("should forbid cloning shoots of another cloud provider", func() {
+			shoot.Spec.Cloud.GCP = &garden.GCPCloud{}
+
+			err := admit(&shoot)
+
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		})
+
+		It("should forbid cloning a non-existing shoot", func() {
+			shoot.Annotations[common.ShootCloneSource] = "foo"
+
+			err := admit(&shoot)
+
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		})
+
+		It("should forbid cloning a shoot which has not been created yet", func() {
+			source.Status.TechnicalID = ""
+
+			err := admit(&shoot)
+
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		})
+
+		It("should forbid cloning a shoot with a highly available control plane", func() {
+			source.Spec.ControlPlane = &garden.ControlPlane{
+				HighAvailability: &garden.HighAvailability{Enabled: true},
+			}
+
+			err := admit(&shoot)
+
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("highly available control plane"))
+		})
+	})
+})
+
+func cidr(value string) *gardencore.CIDR {
+	c := gardencore.CIDR(value)
+	return &c
+}
@@ -15,10 +15,12 @@
 package dns
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/gardener/gardener/pkg/apis/garden"
 	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
@@ -27,16 +29,25 @@ import (
 	"github.com/gardener/gardener/pkg/operation/common"
 	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apiserver/pkg/admission"
 	kubeinformers "k8s.io/client-go/informers"
 	kubecorev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/klog"
 )
 
 const (
 	// PluginName is the name of this admission plugin.
 	PluginName = "ShootDNS"
+
+	// zoneListTimeout is the maximum duration for listing the hosted zones of a DNS provider.
+	zoneListTimeout = 10 * time.Second
+
+	// AuditAnnotationHostedZoneCheck is the key of the audit annotation reporting that the hosted zone check has been
+	// skipped for the domain of a Shoot.
+	AuditAnnotationHostedZoneCheck = "shootdns.admission.gardener.cloud/hosted-zone-check"
 )
 
 // Register registers a plugin.
@@ -49,9 +60,11 @@ func Register(plugins *admission.Plugins) {
 // DNS contains listers and and admission handler.
 type DNS struct {
 	*admission.Handler
-	secretLister  kubecorev1listers.SecretLister
-	projectLister gardenlisters.ProjectLister
-	readyFunc     admission.ReadyFunc
+	secretLister        kubecorev1listers.SecretLister
+	projectLister       gardenlisters.ProjectLister
+	secretBindingLister gardenlisters.SecretBindingLister
+	zoneListers         map[string]ZoneLister
+	readyFunc           admission.ReadyFunc
 }
 
 var (
@@ -64,10 +77,17 @@ var (
 // New creates a new DNS admission plugin.
 func New() (*DNS, error) {
 	return &DNS{
-		Handler: admission.NewHandler(admission.Create),
+		Handler:     admission.NewHandler(admission.Create),
+		zoneListers: DefaultZoneListers,
 	}, nil
 }
 
+// SetZoneListers sets the ZoneListers (keyed by DNS provider type) used to check whether a hosted zone for the domain
+// of a Shoot exists in the account of its DNS provider.
+func (d *DNS) SetZoneListers(zoneListers map[string]ZoneLister) {
+	d.zoneListers = zoneListers
+}
+
 // AssignReadyFunc assigns the ready function to the admission handler.
 func (d *DNS) AssignReadyFunc(f admission.ReadyFunc) {
 	d.readyFunc = f
@@ -79,7 +99,10 @@ func (d *DNS) SetInternalGardenInformerFactory(f gardeninformers.SharedInformerF
 	projectInformer := f.Garden().InternalVersion().Projects()
 	d.projectLister = projectInformer.Lister()
 
-	readyFuncs = append(readyFuncs, projectInformer.Informer().HasSynced)
+	secretBindingInformer := f.Garden().InternalVersion().SecretBindings()
+	d.secretBindingLister = secretBindingInformer.Lister()
+
+	readyFuncs = append(readyFuncs, projectInformer.Informer().HasSynced, secretBindingInformer.Informer().HasSynced)
 }
 
 // SetKubeInformerFactory gets Lister from SharedInformerFactory.
@@ -98,10 +121,14 @@ func (d *DNS) ValidateInitialization() error {
 	if d.projectLister == nil {
 		return errors.New("missing project lister")
 	}
+	if d.secretBindingLister == nil {
+		return errors.New("missing secret binding lister")
+	}
 	return nil
 }
 
-// Admit tries to determine a DNS hosted zone for the Shoot's external domain.
+// Admit tries to determine a DNS hosted zone for the Shoot's external domain. Domains which would shadow the internal or
+// a default domain are rejected, as well as custom domains without a hosted zone in the account of the referenced DNS provider.
 func (d *DNS) Admit(a admission.Attributes, o admission.ObjectInterfaces) error {
 	// Wait until the caches have been synced
 	if d.readyFunc == nil {
@@ -140,9 +167,109 @@ func (d *DNS) Admit(a admission.Attributes, o admission.ObjectInterfaces) error
 		return apierrors.NewBadRequest(fmt.Sprintf("shoot domain field .spec.dns.domain must be set if provider != %s", garden.DNSUnmanaged))
 	}
 
+	if err := checkDomainDoesNotShadowGardenDomains(*shoot.Spec.DNS.Domain, d.secretLister); err != nil {
+		return admission.NewForbidden(a, err)
+	}
+
+	if err := d.checkHostedZoneExists(a, shoot); err != nil {
+		return admission.NewForbidden(a, err)
+	}
+
 	return nil
 }
 
+// checkDomainDoesNotShadowGardenDomains checks that the given Shoot domain is neither the internal domain, a parent
+// or a subdomain of it, nor a default domain or a parent of it. Subdomains of default domains are allowed.
+func checkDomainDoesNotShadowGardenDomains(shootDomain string, secretLister kubecorev1listers.SecretLister) error {
+	for _, role := range []string{common.GardenRoleInternalDomain, common.GardenRoleDefaultDomain} {
+		selector, err := labels.Parse(fmt.Sprintf("%s=%s", common.GardenRole, role))
+		if err != nil {
+			return err
+		}
+		secrets, err := secretLister.Secrets(common.GardenNamespace).List(selector)
+		if err != nil {
+			return err
+		}
+
+		for _, secret := range secrets {
+			_, domain, err := common.GetDomainInfoFromAnnotations(secret.Annotations)
+			if err != nil {
+				continue
+			}
+
+			if isSameOrSubdomain(domain, shootDomain) || (role == common.GardenRoleInternalDomain && isSameOrSubdomain(shootDomain, domain)) {
+				return fmt.Errorf("domain %q must not shadow the %s %q", shootDomain, strings.Replace(role, "-", " ", -1), domain)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkHostedZoneExists checks that a hosted zone for the custom domain of the given Shoot (i.e., for the domain itself
+// or one of its parent domains) exists in the account of its DNS provider. It does not check that the zone is delegated
+// from its parent zone, hence, the domain might still not resolve. Shoots using a default domain are not checked. For
+// DNS providers without a ZoneLister the check is skipped, which is logged and reported as audit annotation.
+func (d *DNS) checkHostedZoneExists(a admission.Attributes, shoot *garden.Shoot) error {
+	provider := shoot.Spec.DNS.Provider
+	if provider == nil {
+		return nil
+	}
+	zoneLister, ok := d.zoneListers[*provider]
+	if !ok {
+		message := fmt.Sprintf("skipped for domain %q as the hosted zones of DNS provider type %q cannot be listed", *shoot.Spec.DNS.Domain, *provider)
+		klog.Infof("Hosted zone check of shoot %s/%s %s", shoot.Namespace, shoot.Name, message)
+		if err := a.AddAnnotation(AuditAnnotationHostedZoneCheck, message); err != nil {
+			klog.Warningf("Could not add audit annotation %q: %v", AuditAnnotationHostedZoneCheck, err)
+		}
+		return nil
+	}
+
+	secret, err := d.getDNSSecret(shoot)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), zoneListTimeout)
+	defer cancel()
+
+	zones, err := zoneLister.ListZones(ctx, secret.Data)
+	if err != nil {
+		return fmt.Errorf("could not list the hosted zones of DNS provider %q with secret %s/%s: %v", *provider, secret.Namespace, secret.Name, err)
+	}
+	if _, ok := findZone(*shoot.Spec.DNS.Domain, zones); !ok {
+		return fmt.Errorf("there is no hosted zone for domain %q in the account of DNS provider %q accessible with secret %s/%s", *shoot.Spec.DNS.Domain, *provider, secret.Namespace, secret.Name)
+	}
+
+	return nil
+}
+
+// getDNSSecret returns the secret containing the credentials for the DNS provider of the given Shoot. It is either the
+// secret referenced in `.spec.dns.secretName` or the cloud provider secret of the Shoot.
+func (d *DNS) getDNSSecret(shoot *garden.Shoot) (*corev1.Secret, error) {
+	if name := shoot.Spec.DNS.SecretName; name != nil {
+		secret, err := d.secretLister.Secrets(shoot.Namespace).Get(*name)
+		if err != nil {
+			return nil, fmt.Errorf("could not get DNS secret %q: %v", *name, err)
+		}
+		return secret, nil
+	}
+
+	binding, err := d.secretBindingLister.SecretBindings(shoot.Namespace).Get(shoot.Spec.Cloud.SecretBindingRef.Name)
+	if err != nil {
+		return nil, fmt.Errorf("could not get secret binding %q: %v", shoot.Spec.Cloud.SecretBindingRef.Name, err)
+	}
+	namespace := binding.SecretRef.Namespace
+	if len(namespace) == 0 {
+		namespace = binding.Namespace
+	}
+	secret, err := d.secretLister.Secrets(namespace).Get(binding.SecretRef.Name)
+	if err != nil {
+		return nil, fmt.Errorf("could not get secret %s/%s referenced by secret binding %q: %v", namespace, binding.SecretRef.Name, binding.Name, err)
+	}
+	return secret, nil
+}
+
 // assignDefaultDomainIfNeeded generates a domain <shoot-name>.<project-name>.<default-domain>
 // and sets it in the shoot resource in the `spec.dns.domain` field.
 // If for any reason no domain can be generated, no domain is assigned to the Shoot.
@@ -15,6 +15,8 @@
 package dns_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"github.com/gardener/gardener/pkg/apis/garden"
@@ -71,6 +73,21 @@ var _ = Describe("dns", func() {
 				},
 			}
 
+			customProvider       = "custom-dns"
+			internalDomainSecret = corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "secret-internal",
+					Namespace: common.GardenNamespace,
+					Labels: map[string]string{
+						common.GardenRole: common.GardenRoleInternalDomain,
+					},
+					Annotations: map[string]string{
+						common.DNSDomain:   "internal.example.org",
+						common.DNSProvider: defaultDomainProvider,
+					},
+				},
+			}
+
 			shootBase = garden.Shoot{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      shootName,
@@ -90,7 +107,7 @@ var _ = Describe("dns", func() {
 
 			shootBase.Spec.DNS.Domain = nil
 			shootBase.Spec.DNS.Provider = &provider
-			shoot = shootBase
+			shoot = *shootBase.DeepCopy()
 		})
 
 		It("should do nothing because the shoot specifies the 'unmanaged' dns provider", func() {
@@ -153,6 +170,164 @@ var _ = Describe("dns", func() {
 
 				Expect(err).To(MatchError(apierrors.NewBadRequest("shoot domain field .spec.dns.domain must be set if provider != unmanaged")))
 			})
+
+			It("should reject because the shoot domain shadows the default domain", func() {
+				shoot.Spec.DNS.Domain = &domain
+
+				kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&defaultDomainSecret)
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should reject because the shoot domain is a subdomain of the internal domain", func() {
+				shootDomain := "my-shoot.internal.example.org"
+				shoot.Spec.DNS.Domain = &shootDomain
+				shoot.Spec.DNS.Provider = &customProvider
+
+				kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&internalDomainSecret)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+		})
+
+		Context("custom domain", func() {
+			var (
+				zoneLister *fakeZoneLister
+
+				shootDomain = "my-shoot.example.org"
+				dnsSecret   = corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "dns-secret",
+						Namespace: namespace,
+					},
+					Data: map[string][]byte{"key": []byte("dns")},
+				}
+				cloudSecret = corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "cloud-secret",
+						Namespace: namespace,
+					},
+					Data: map[string][]byte{"key": []byte("cloud")},
+				}
+				secretBinding = garden.SecretBinding{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "binding",
+						Namespace: namespace,
+					},
+					SecretRef: corev1.SecretReference{
+						Name: cloudSecret.Name,
+					},
+				}
+			)
+
+			BeforeEach(func() {
+				zoneLister = &fakeZoneLister{zones: []string{"example.org."}}
+				admissionHandler.SetZoneListers(map[string]ZoneLister{customProvider: zoneLister})
+
+				shoot.Spec.DNS.Domain = &shootDomain
+				shoot.Spec.DNS.Provider = &customProvider
+				shoot.Spec.DNS.SecretName = &dnsSecret.Name
+				shoot.Spec.Cloud.SecretBindingRef.Name = secretBinding.Name
+
+				kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&dnsSecret)
+				kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&cloudSecret)
+				gardenInformerFactory.Garden().InternalVersion().SecretBindings().Informer().GetStore().Add(&secretBinding)
+			})
+
+			It("should pass because a hosted zone for the domain exists", func() {
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(zoneLister.credentials).To(Equal(dnsSecret.Data))
+			})
+
+			It("should use the cloud provider secret if no DNS secret is referenced", func() {
+				shoot.Spec.DNS.SecretName = nil
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(zoneLister.credentials).To(Equal(cloudSecret.Data))
+			})
+
+			It("should pass without checking the zones but report it because the provider has no zone lister", func() {
+				otherProvider := "other-provider"
+				shoot.Spec.DNS.Provider = &otherProvider
+				attrs := &annotatingAttributes{Attributes: admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)}
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(zoneLister.credentials).To(BeNil())
+				Expect(attrs.annotations).To(HaveKeyWithValue(AuditAnnotationHostedZoneCheck, ContainSubstring("skipped")))
+			})
+
+			It("should reject because there is no hosted zone for the domain", func() {
+				zoneLister.zones = []string{"example.com", "other.example.org"}
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should reject because the zones cannot be listed", func() {
+				zoneLister.err = errors.New("invalid credentials")
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should reject because the DNS secret does not exist", func() {
+				missing := "missing"
+				shoot.Spec.DNS.SecretName = &missing
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
 		})
 	})
 })
+
+type fakeZoneLister struct {
+	zones       []string
+	err         error
+	credentials map[string][]byte
+}
+
+func (f *fakeZoneLister) ListZones(_ context.Context, credentials map[string][]byte) ([]string, error) {
+	f.credentials = credentials
+	return f.zones, f.err
+}
+
+type annotatingAttributes struct {
+	admission.Attributes
+	annotations map[string]string
+}
+
+func (a *annotatingAttributes) AddAnnotation(key, value string) error {
+	if a.annotations == nil {
+		a.annotations = map[string]string{}
+	}
+	a.annotations[key] = value
+	return a.Attributes.AddAnnotation(key, value)
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dns
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+// route53Region is the region used for the Route53 API which is a global service.
+const route53Region = "us-east-1"
+
+// listRoute53Zones lists the public hosted zones of the AWS account the given credentials belong to. Both the keys of
+// the DNS provider secrets and the ones of the cloud provider secrets are supported.
+func listRoute53Zones(ctx context.Context, data map[string][]byte) ([]string, error) {
+	accessKeyID, err := credentialValue(data, "AWS_ACCESS_KEY_ID", "accessKeyID")
+	if err != nil {
+		return nil, err
+	}
+	secretAccessKey, err := credentialValue(data, "AWS_SECRET_ACCESS_KEY", "secretAccessKey")
+	if err != nil {
+		return nil, err
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials(accessKeyID, secretAccessKey, ""),
+		Region:      aws.String(route53Region),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var zones []string
+	if err := route53.New(sess).ListHostedZonesPagesWithContext(ctx, &route53.ListHostedZonesInput{}, func(out *route53.ListHostedZonesOutput, _ bool) bool {
+		for _, zone := range out.HostedZones {
+			if zone.Config != nil && aws.BoolValue(zone.Config.PrivateZone) {
+				continue
+			}
+			zones = append(zones, aws.StringValue(zone.Name))
+		}
+		return true
+	}); err != nil {
+		return nil, err
+	}
+
+	return zones, nil
+}
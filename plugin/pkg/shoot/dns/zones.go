@@ -0,0 +1,79 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ZoneLister lists the public hosted zones which can be managed with the credentials of a DNS provider.
+type ZoneLister interface {
+	// ListZones returns the domains of all public hosted zones which are accessible with the given credentials.
+	ListZones(ctx context.Context, credentials map[string][]byte) ([]string, error)
+}
+
+// ZoneListerFunc is a function that implements ZoneLister.
+type ZoneListerFunc func(ctx context.Context, credentials map[string][]byte) ([]string, error)
+
+// ListZones implements ZoneLister.
+func (f ZoneListerFunc) ListZones(ctx context.Context, credentials map[string][]byte) ([]string, error) {
+	return f(ctx, credentials)
+}
+
+// DefaultZoneListers maps DNS provider types to the ZoneListers used by the admission plugin. For providers without a
+// ZoneLister the hosted zone check is skipped, which is logged and reported as audit annotation.
+var DefaultZoneListers = map[string]ZoneLister{
+	"aws-route53": ZoneListerFunc(listRoute53Zones),
+}
+
+// findZone returns the most specific zone out of <zones> serving the given <domain>, i.e. the longest zone which is
+// equal to the domain or a parent domain of it.
+func findZone(domain string, zones []string) (string, bool) {
+	var (
+		found bool
+		match string
+	)
+
+	domain = normalizeDomain(domain)
+	for _, zone := range zones {
+		zone = normalizeDomain(zone)
+		if (domain == zone || strings.HasSuffix(domain, "."+zone)) && len(zone) > len(match) {
+			found, match = true, zone
+		}
+	}
+
+	return match, found
+}
+
+// isSameOrSubdomain returns true if <domain> is equal to <parent> or a subdomain of it.
+func isSameOrSubdomain(domain, parent string) bool {
+	domain, parent = normalizeDomain(domain), normalizeDomain(parent)
+	return domain == parent || strings.HasSuffix(domain, "."+parent)
+}
+
+func normalizeDomain(domain string) string {
+	return strings.ToLower(strings.TrimSuffix(domain, "."))
+}
+
+func credentialValue(credentials map[string][]byte, keys ...string) (string, error) {
+	for _, key := range keys {
+		if value, ok := credentials[key]; ok && len(value) > 0 {
+			return string(value), nil
+		}
+	}
+	return "", fmt.Errorf("credentials do not contain any of the keys %s", strings.Join(keys, ", "))
+}
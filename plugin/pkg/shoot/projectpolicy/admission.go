@@ -0,0 +1,309 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projectpolicy
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/apis/garden/helper"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	"github.com/gardener/gardener/pkg/client/garden/clientset/internalversion"
+	informers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	listers "github.com/gardener/gardener/pkg/client/garden/listers/garden/internalversion"
+	"github.com/gardener/gardener/pkg/utils"
+	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/admission"
+)
+
+const (
+	// PluginName is the name of this admission plugin.
+	PluginName = "ShootProjectPolicy"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// ProjectPolicy contains listers and admission handler.
+type ProjectPolicy struct {
+	*admission.Handler
+	gardenClient  internalversion.Interface
+	projectLister listers.ProjectLister
+	readyFunc     admission.ReadyFunc
+}
+
+var (
+	_ = admissioninitializer.WantsInternalGardenInformerFactory(&ProjectPolicy{})
+	_ = admissioninitializer.WantsInternalGardenClientset(&ProjectPolicy{})
+
+	readyFuncs = []admission.ReadyFunc{}
+)
+
+// New creates a new ProjectPolicy admission plugin.
+func New() (*ProjectPolicy, error) {
+	return &ProjectPolicy{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (p *ProjectPolicy) AssignReadyFunc(f admission.ReadyFunc) {
+	p.readyFunc = f
+	p.SetReadyFunc(f)
+}
+
+// SetInternalGardenInformerFactory gets Lister from SharedInformerFactory.
+func (p *ProjectPolicy) SetInternalGardenInformerFactory(f informers.SharedInformerFactory) {
+	projectInformer := f.Garden().InternalVersion().Projects()
+	p.projectLister = projectInformer.Lister()
+
+	readyFuncs = append(readyFuncs, projectInformer.Informer().HasSynced)
+}
+
+// SetInternalGardenClientset gets the clientset from the Kubernetes client.
+func (p *ProjectPolicy) SetInternalGardenClientset(c internalversion.Interface) {
+	p.gardenClient = c
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (p *ProjectPolicy) ValidateInitialization() error {
+	if p.gardenClient == nil {
+		return errors.New("missing garden client")
+	}
+	if p.projectLister == nil {
+		return errors.New("missing project lister")
+	}
+	return nil
+}
+
+// Admit checks that Shoots comply with the policy of their project.
+func (p *ProjectPolicy) Admit(a admission.Attributes, o admission.ObjectInterfaces) error {
+	// Wait until the caches have been synced
+	if p.readyFunc == nil {
+		p.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	if !p.WaitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+
+	// Ignore all kinds other than Shoot
+	if a.GetKind().GroupKind() != garden.Kind("Shoot") {
+		return nil
+	}
+
+	// Ignore updates to shoot status or other subresources
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*garden.Shoot)
+	if !ok {
+		return apierrors.NewInternalError(errors.New("could not convert resource into Shoot object"))
+	}
+
+	// Pass if the shoot is intended to get deleted
+	if shoot.DeletionTimestamp != nil {
+		return nil
+	}
+
+	project, err := admissionutils.GetProject(shoot.Namespace, p.projectLister)
+	if err != nil {
+		return apierrors.NewBadRequest(fmt.Sprintf("could not find referenced project: %+v", err.Error()))
+	}
+
+	policy := project.Spec.Policy
+	if policy == nil {
+		return nil
+	}
+
+	// Only fields which have been changed are checked against the policy in order to not block updates of existing
+	// Shoots when the policy is tightened later.
+	var oldShoot *garden.Shoot
+	if a.GetOperation() == admission.Update {
+		oldShoot, ok = a.GetOldObject().(*garden.Shoot)
+		if !ok {
+			return apierrors.NewInternalError(errors.New("could not convert old resource into Shoot object"))
+		}
+	}
+
+	if oldShoot == nil && policy.MaxShoots != nil {
+		// The shoots are listed from the storage instead of the cache as the cache might not contain the most recently
+		// created shoots yet.
+		shoots, err := p.gardenClient.Garden().Shoots(shoot.Namespace).List(metav1.ListOptions{})
+		if err != nil {
+			return apierrors.NewInternalError(err)
+		}
+		// Shoots which are already being deleted do not count against the limit.
+		numberOfShoots := 0
+		for _, s := range shoots.Items {
+			if s.DeletionTimestamp == nil {
+				numberOfShoots++
+			}
+		}
+		if numberOfShoots >= int(*policy.MaxShoots) {
+			return admission.NewForbidden(a, fmt.Errorf("the project %q must not have more than %d shoots", project.Name, *policy.MaxShoots))
+		}
+	}
+
+	allErrs, err := validateShoot(policy, shoot, oldShoot)
+	if err != nil {
+		return apierrors.NewBadRequest(err.Error())
+	}
+	if len(allErrs) > 0 {
+		return admission.NewForbidden(a, fmt.Errorf("the shoot does not comply with the policy of project %q: %+v", project.Name, allErrs))
+	}
+
+	return nil
+}
+
+func validateShoot(policy *garden.ProjectPolicy, shoot, oldShoot *garden.Shoot) (field.ErrorList, error) {
+	var (
+		allErrs   = field.ErrorList{}
+		cloudPath = field.NewPath("spec", "cloud")
+		isCreate  = oldShoot == nil
+	)
+
+	if len(policy.AllowedCloudProfiles) > 0 && (isCreate || shoot.Spec.Cloud.Profile != oldShoot.Spec.Cloud.Profile) {
+		if !sets.NewString(policy.AllowedCloudProfiles...).Has(shoot.Spec.Cloud.Profile) {
+			allErrs = append(allErrs, field.NotSupported(cloudPath.Child("profile"), shoot.Spec.Cloud.Profile, policy.AllowedCloudProfiles))
+		}
+	}
+
+	if len(policy.AllowedRegions) > 0 && (isCreate || shoot.Spec.Cloud.Region != oldShoot.Spec.Cloud.Region) {
+		if !sets.NewString(policy.AllowedRegions...).Has(shoot.Spec.Cloud.Region) {
+			allErrs = append(allErrs, field.NotSupported(cloudPath.Child("region"), shoot.Spec.Cloud.Region, policy.AllowedRegions))
+		}
+	}
+
+	// Shoots without seed are scheduled later on to one of the allowed seeds by the scheduler. Its update of the seed
+	// is checked here as well.
+	if seed := shoot.Spec.Cloud.Seed; len(policy.AllowedSeeds) > 0 && seed != nil && (isCreate || !apiequality.Semantic.DeepEqual(seed, oldShoot.Spec.Cloud.Seed)) {
+		if !sets.NewString(policy.AllowedSeeds...).Has(*seed) {
+			allErrs = append(allErrs, field.NotSupported(cloudPath.Child("seed"), *seed, policy.AllowedSeeds))
+		}
+	}
+
+	if constraint := policy.AllowedKubernetesVersions; constraint != nil && (isCreate || shoot.Spec.Kubernetes.Version != oldShoot.Spec.Kubernetes.Version) {
+		allowed, err := utils.CheckVersionMeetsConstraint(shoot.Spec.Kubernetes.Version, *constraint)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "kubernetes", "version"), fmt.Sprintf("version %s does not meet the constraint %q", shoot.Spec.Kubernetes.Version, *constraint)))
+		}
+	}
+
+	if isCreate || !apiequality.Semantic.DeepEqual(shoot.Labels, oldShoot.Labels) {
+		for _, key := range policy.RequiredLabels {
+			if _, ok := shoot.Labels[key]; !ok {
+				allErrs = append(allErrs, field.Required(field.NewPath("metadata", "labels").Key(key), "label is required by the project policy"))
+			}
+		}
+	}
+
+	if isCreate || !apiequality.Semantic.DeepEqual(shoot.Annotations, oldShoot.Annotations) {
+		for _, key := range policy.RequiredAnnotations {
+			if _, ok := shoot.Annotations[key]; !ok {
+				allErrs = append(allErrs, field.Required(field.NewPath("metadata", "annotations").Key(key), "annotation is required by the project policy"))
+			}
+		}
+	}
+
+	if maxWorkerPoolSize := policy.MaxWorkerPoolSize; maxWorkerPoolSize != nil {
+		workers, err := getShootWorkers(shoot)
+		if err != nil {
+			return nil, err
+		}
+
+		oldWorkers := map[string]garden.Worker{}
+		if !isCreate {
+			old, err := getShootWorkers(oldShoot)
+			if err != nil {
+				return nil, err
+			}
+			for _, worker := range old {
+				oldWorkers[worker.Name] = worker
+			}
+		}
+
+		for i, worker := range workers {
+			if oldWorker, ok := oldWorkers[worker.Name]; ok && oldWorker.AutoScalerMax == worker.AutoScalerMax {
+				continue
+			}
+			if worker.AutoScalerMax > int(*maxWorkerPoolSize) {
+				allErrs = append(allErrs, field.Invalid(cloudPath.Child("workers").Index(i).Child("autoScalerMax"), worker.AutoScalerMax, fmt.Sprintf("must not be greater than %d", *maxWorkerPoolSize)))
+			}
+		}
+	}
+
+	return allErrs, nil
+}
+
+func getShootWorkers(shoot *garden.Shoot) ([]garden.Worker, error) {
+	cloudProvider, err := helper.DetermineCloudProviderInShoot(shoot.Spec.Cloud)
+	if err != nil {
+		return nil, err
+	}
+
+	var workers []garden.Worker
+
+	switch cloudProvider {
+	case garden.CloudProviderAWS:
+		for _, worker := range shoot.Spec.Cloud.AWS.Workers {
+			workers = append(workers, worker.Worker)
+		}
+	case garden.CloudProviderAzure:
+		for _, worker := range shoot.Spec.Cloud.Azure.Workers {
+			workers = append(workers, worker.Worker)
+		}
+	case garden.CloudProviderGCP:
+		for _, worker := range shoot.Spec.Cloud.GCP.Workers {
+			workers = append(workers, worker.Worker)
+		}
+	case garden.CloudProviderOpenStack:
+		for _, worker := range shoot.Spec.Cloud.OpenStack.Workers {
+			workers = append(workers, worker.Worker)
+		}
+	case garden.CloudProviderAlicloud:
+		for _, worker := range shoot.Spec.Cloud.Alicloud.Workers {
+			workers = append(workers, worker.Worker)
+		}
+	case garden.CloudProviderPacket:
+		for _, worker := range shoot.Spec.Cloud.Packet.Workers {
+			workers = append(workers, worker.Worker)
+		}
+	}
+
+	return workers, nil
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projectpolicy_test
+
+import (
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/client/garden/clientset/internalversion/fake"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/projectpolicy"
+	"github.com/gardener/gardener/test"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/admission"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("projectpolicy", func() {
+	Describe("#Admit", func() {
+		var (
+			admissionHandler      *ProjectPolicy
+			gardenInformerFactory gardeninformers.SharedInformerFactory
+			project               garden.Project
+			shoot                 garden.Shoot
+
+			namespaceName = "garden-my-project"
+			seedName      = "seed"
+
+			maxShoots         = int32(1)
+			maxWorkerPoolSize = int32(3)
+
+			projectBase = garden.Project{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-project",
+				},
+				Spec: garden.ProjectSpec{
+					Namespace: &namespaceName,
+					Policy: &garden.ProjectPolicy{
+						MaxShoots:                 &maxShoots,
+						AllowedCloudProfiles:      []string{"aws"},
+						AllowedRegions:            []string{"eu-west-1"},
+						AllowedSeeds:              []string{seedName},
+						AllowedKubernetesVersions: test.MakeStrPointer(">= 1.14, < 1.16"),
+						RequiredLabels:            []string{"cost-center"},
+						RequiredAnnotations:       []string{"example.com/owner"},
+						MaxWorkerPoolSize:         &maxWorkerPoolSize,
+					},
+				},
+			}
+			shootBase = garden.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "shoot",
+					Namespace:   namespaceName,
+					Labels:      map[string]string{"cost-center": "123"},
+					Annotations: map[string]string{"example.com/owner": "john.doe@example.com"},
+				},
+				Spec: garden.ShootSpec{
+					Cloud: garden.Cloud{
+						Profile: "aws",
+						Region:  "eu-west-1",
+						Seed:    &seedName,
+						AWS: &garden.AWSCloud{
+							Workers: []garden.AWSWorker{
+								{Worker: garden.Worker{Name: "worker", AutoScalerMin: 1, AutoScalerMax: 3}},
+							},
+						},
+					},
+					Kubernetes: garden.Kubernetes{
+						Version: "1.15.2",
+					},
+				},
+			}
+		)
+
+		BeforeEach(func() {
+			project = *projectBase.DeepCopy()
+			shoot = *shootBase.DeepCopy()
+
+			admissionHandler, _ = New()
+			admissionHandler.AssignReadyFunc(func() bool { return true })
+			gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
+			admissionHandler.SetInternalGardenClientset(fake.NewSimpleClientset())
+		})
+
+		admit := func(shoot, oldShoot *garden.Shoot, operation admission.Operation) error {
+			gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+
+			var old runtime.Object
+			if oldShoot != nil {
+				old = oldShoot
+			}
+			attrs := admission.NewAttributesRecord(shoot, old, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", operation, false, nil)
+			return admissionHandler.Admit(attrs, nil)
+		}
+
+		It("should allow shoots complying with the policy", func() {
+			Expect(admit(&shoot, nil, admission.Create)).To(Succeed())
+		})
+
+		It("should allow shoots in projects without policy", func() {
+			project.Spec.Policy = nil
+			shoot.Labels = nil
+
+			Expect(admit(&shoot, nil, admission.Create)).To(Succeed())
+		})
+
+		It("should forbid creating more shoots than allowed", func() {
+			existingShoot := shootBase.DeepCopy()
+			existingShoot.Name = "existing"
+			admissionHandler.SetInternalGardenClientset(fake.NewSimpleClientset(existingShoot))
+
+			err := admit(&shoot, nil, admission.Create)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must not have more than 1 shoots"))
+		})
+
+		It("should not count shoots which are already being deleted", func() {
+			existingShoot := shootBase.DeepCopy()
+			existingShoot.Name = "existing"
+			now := metav1.Now()
+			existingShoot.DeletionTimestamp = &now
+			admissionHandler.SetInternalGardenClientset(fake.NewSimpleClientset(existingShoot))
+
+			Expect(admit(&shoot, nil, admission.Create)).To(Succeed())
+		})
+
+		It("should forbid shoots violating the policy", func() {
+			seed := "other-seed"
+			shoot.Labels = nil
+			shoot.Annotations = nil
+			shoot.Spec.Cloud.Profile = "gcp"
+			shoot.Spec.Cloud.Region = "us-east-1"
+			shoot.Spec.Cloud.Seed = &seed
+			shoot.Spec.Kubernetes.Version = "1.13.4"
+			shoot.Spec.Cloud.AWS.Workers[0].AutoScalerMax = 4
+
+			err := admit(&shoot, nil, admission.Create)
+
+			Expect(err).To(HaveOccurred())
+			for _, path := range []string{"spec.cloud.profile", "spec.cloud.region", "spec.cloud.seed", "spec.kubernetes.version", "metadata.labels[cost-center]", "metadata.annotations[example.com/owner]", "spec.cloud.workers[0].autoScalerMax"} {
+				Expect(err.Error()).To(ContainSubstring(path))
+			}
+		})
+
+		It("should not count shoots which are being deleted", func() {
+			now := metav1.Now()
+			existingShoot := shootBase.DeepCopy()
+			existingShoot.Name = "existing"
+			existingShoot.DeletionTimestamp = &now
+			gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(existingShoot)
+
+			Expect(admit(&shoot, nil, admission.Create)).To(Succeed())
+		})
+
+		It("should allow shoots without seed if the seeds are restricted", func() {
+			shoot.Spec.Cloud.Seed = nil
+
+			Expect(admit(&shoot, nil, admission.Create)).To(Succeed())
+		})
+
+		It("should check the seed assigned by the scheduler", func() {
+			oldShoot := shoot.DeepCopy()
+			oldShoot.Spec.Cloud.Seed = nil
+			seed := "other-seed"
+			shoot.Spec.Cloud.Seed = &seed
+
+			err := admit(&shoot, oldShoot, admission.Update)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.cloud.seed"))
+		})
+
+		It("should not check unchanged fields of existing shoots", func() {
+			gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(&shoot)
+			shoot.Spec.Kubernetes.Version = "1.13.4"
+			shoot.Spec.Cloud.AWS.Workers[0].AutoScalerMax = 4
+			oldShoot := shoot.DeepCopy()
+
+			Expect(admit(&shoot, oldShoot, admission.Update)).To(Succeed())
+		})
+
+		It("should check changed fields of existing shoots", func() {
+			gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(&shoot)
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.Kubernetes.Version = "1.16.0"
+
+			err := admit(&shoot, oldShoot, admission.Update)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.kubernetes.version"))
+		})
+	})
+})
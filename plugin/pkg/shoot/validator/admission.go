@@ -314,6 +314,10 @@ func (v *ValidateShoot) Admit(a admission.Attributes, o admission.ObjectInterfac
 		allErrs = validateAlicloud(validationContext)
 	}
 
+	if seed != nil && shoot.Spec.ControlPlane != nil {
+		allErrs = append(allErrs, admissionutils.ValidateEgressDisjointedness(seed.Spec.Networks, seed.Spec.BlockCIDRs, shoot.Spec.ControlPlane.AdditionalEgress, field.NewPath("spec", "controlPlane", "additionalEgress"))...)
+	}
+
 	dnsErrors, err := validateDNSDomainUniqueness(v.shootLister, shoot.Name, shoot.Spec.DNS)
 	if err != nil {
 		return apierrors.NewInternalError(err)
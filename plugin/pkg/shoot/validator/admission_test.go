@@ -632,6 +632,25 @@ var _ = Describe("validator", func() {
 				Expect(apierrors.IsForbidden(err)).To(BeTrue())
 			})
 
+			It("should reject because an additional egress destination intersects with the blocked seed networks", func() {
+				seed.Spec.BlockCIDRs = []gardencore.CIDR{"169.254.169.254/32"}
+				shoot.Spec.ControlPlane = &garden.ControlPlane{
+					AdditionalEgress: []garden.EgressDestination{
+						{Name: "metadata", CIDRs: []gardencore.CIDR{"169.254.0.0/16"}},
+					},
+				}
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
 			It("should reject due to an invalid dns provider", func() {
 				provider := "some-provider"
 				shoot.Spec.DNS.Provider = &provider
@@ -108,4 +108,145 @@ var _ = Describe("utils", func() {
 			}))))
 		})
 	})
+
+	Describe("#ValidateNetworkDisjointedness (dual-stack seed)", func() {
+		var (
+			seedPodsV6     = gardencore.CIDR("fd00:10:96::/48")
+			seedServicesV6 = gardencore.CIDR("fd00:10:64::/108")
+
+			seedNetworks = garden.SeedNetworks{
+				Pods:     gardencore.CIDR("10.241.128.0/17"),
+				Services: gardencore.CIDR("10.241.0.0/17"),
+				Nodes:    gardencore.CIDR("10.240.0.0/16"),
+				DualStack: &gardencore.DualStackNetworks{
+					Pods:     &seedPodsV6,
+					Services: &seedServicesV6,
+				},
+			}
+
+			nodesCIDR = gardencore.CIDR("10.241.0.0/16")
+		)
+
+		It("should pass the validation", func() {
+			var (
+				podsV6     = gardencore.CIDR("fd00:20:96::/48")
+				servicesV6 = gardencore.CIDR("fd00:20:64::/108")
+
+				k8sNetworks = gardencore.K8SNetworks{
+					Pods:     &podsV6,
+					Services: &servicesV6,
+					Nodes:    &nodesCIDR,
+				}
+			)
+
+			errorList := ValidateNetworkDisjointedness(seedNetworks, k8sNetworks, field.NewPath(""))
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should fail due to disjointedness", func() {
+			var (
+				podsV6 = gardencore.CIDR("fd00:10:96:1::/64")
+
+				k8sNetworks = gardencore.K8SNetworks{
+					Pods:     &podsV6,
+					Services: &seedServicesV6,
+					Nodes:    &nodesCIDR,
+				}
+			)
+
+			errorList := ValidateNetworkDisjointedness(seedNetworks, k8sNetworks, field.NewPath(""))
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("[].pods"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("[].services"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateEgressDisjointedness", func() {
+		var (
+			seedNetworks = garden.SeedNetworks{
+				Nodes:    gardencore.CIDR("10.250.0.0/16"),
+				Pods:     gardencore.CIDR("100.96.0.0/11"),
+				Services: gardencore.CIDR("100.64.0.0/13"),
+			}
+			blockCIDRs = []gardencore.CIDR{"169.254.0.0/16", "10.0.0.0/24"}
+		)
+
+		It("should pass the validation", func() {
+			destinations := []garden.EgressDestination{
+				{Name: "foo", CIDRs: []gardencore.CIDR{"10.1.0.0/16"}, FQDNs: []string{"foo.example.com"}},
+			}
+
+			errorList := ValidateEgressDisjointedness(seedNetworks, blockCIDRs, destinations, field.NewPath("egress"))
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should fail due to intersecting networks", func() {
+			destinations := []garden.EgressDestination{
+				{Name: "foo", CIDRs: []gardencore.CIDR{"10.1.0.0/16", "169.254.169.254/32"}},
+				{Name: "bar", CIDRs: []gardencore.CIDR{"10.0.0.0/8"}},
+			}
+
+			errorList := ValidateEgressDisjointedness(seedNetworks, blockCIDRs, destinations, field.NewPath("egress"))
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("egress[0].cidrs[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("egress[1].cidrs[0]"),
+				})),
+			))
+		})
+
+		It("should fail due to networks intersecting with the seed networks", func() {
+			destinations := []garden.EgressDestination{
+				{Name: "foo", CIDRs: []gardencore.CIDR{"10.250.1.0/24", "100.64.0.0/10"}},
+				{Name: "bar", CIDRs: []gardencore.CIDR{"100.100.0.1/32"}},
+			}
+
+			errorList := ValidateEgressDisjointedness(seedNetworks, blockCIDRs, destinations, field.NewPath("egress"))
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("egress[0].cidrs[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("egress[0].cidrs[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("egress[1].cidrs[0]"),
+				})),
+			))
+		})
+
+		It("should fail due to networks intersecting with the dual-stack seed networks", func() {
+			pods := gardencore.CIDR("fd00:10:1::/48")
+			dualStackSeedNetworks := seedNetworks
+			dualStackSeedNetworks.DualStack = &gardencore.DualStackNetworks{Pods: &pods}
+			destinations := []garden.EgressDestination{
+				{Name: "foo", CIDRs: []gardencore.CIDR{"fd00:10:1:2::/64", "fd00:20::/64"}},
+			}
+
+			errorList := ValidateEgressDisjointedness(dualStackSeedNetworks, blockCIDRs, destinations, field.NewPath("egress"))
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("egress[0].cidrs[0]"),
+				})),
+			))
+		})
+	})
 })
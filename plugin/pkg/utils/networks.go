@@ -15,6 +15,7 @@
 package utils
 
 import (
+	"fmt"
 	"net"
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
@@ -22,7 +23,8 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
-// ValidateNetworkDisjointedness validates that the given <seedNetworks> and <k8sNetworks> are disjoint.
+// ValidateNetworkDisjointedness validates that the given <seedNetworks> and <k8sNetworks> are disjoint. The networks of
+// both IP families of dual-stack clusters are considered.
 func ValidateNetworkDisjointedness(seedNetworks garden.SeedNetworks, k8sNetworks gardencore.K8SNetworks, fldPath *field.Path) field.ErrorList {
 	var (
 		allErrs = field.ErrorList{}
@@ -30,10 +32,20 @@ func ValidateNetworkDisjointedness(seedNetworks garden.SeedNetworks, k8sNetworks
 		pathNodes    = fldPath.Child("nodes")
 		pathServices = fldPath.Child("services")
 		pathPods     = fldPath.Child("pods")
+
+		seedNodes    = []gardencore.CIDR{seedNetworks.Nodes}
+		seedServices = []gardencore.CIDR{seedNetworks.Services}
+		seedPods     = []gardencore.CIDR{seedNetworks.Pods}
 	)
 
+	if dualStack := seedNetworks.DualStack; dualStack != nil {
+		seedNodes = appendCIDR(seedNodes, dualStack.Nodes)
+		seedServices = appendCIDR(seedServices, dualStack.Services)
+		seedPods = appendCIDR(seedPods, dualStack.Pods)
+	}
+
 	if nodes := k8sNetworks.Nodes; nodes != nil {
-		if networksIntersect(seedNetworks.Nodes, *nodes) {
+		if networksIntersect(*nodes, seedNodes...) {
 			allErrs = append(allErrs, field.Invalid(pathNodes, *nodes, "shoot node network intersects with seed node network"))
 		}
 	} else {
@@ -41,7 +53,7 @@ func ValidateNetworkDisjointedness(seedNetworks garden.SeedNetworks, k8sNetworks
 	}
 
 	if services := k8sNetworks.Services; services != nil {
-		if networksIntersect(seedNetworks.Services, *services) {
+		if networksIntersect(*services, seedServices...) {
 			allErrs = append(allErrs, field.Invalid(pathServices, *services, "shoot service network intersects with seed service network"))
 		}
 	} else {
@@ -49,7 +61,7 @@ func ValidateNetworkDisjointedness(seedNetworks garden.SeedNetworks, k8sNetworks
 	}
 
 	if pods := k8sNetworks.Pods; pods != nil {
-		if networksIntersect(seedNetworks.Pods, *pods) {
+		if networksIntersect(*pods, seedPods...) {
 			allErrs = append(allErrs, field.Invalid(pathPods, *pods, "shoot pod network intersects with seed pod network"))
 		}
 	} else {
@@ -59,8 +71,67 @@ func ValidateNetworkDisjointedness(seedNetworks garden.SeedNetworks, k8sNetworks
 	return allErrs
 }
 
-func networksIntersect(cidr1, cidr2 gardencore.CIDR) bool {
-	_, net1, err1 := net.ParseCIDR(string(cidr1))
-	_, net2, err2 := net.ParseCIDR(string(cidr2))
-	return err1 != nil || err2 != nil || net2.Contains(net1.IP) || net1.Contains(net2.IP)
+// ValidateEgressDisjointedness validates that the CIDRs of the given egress <destinations> do not intersect with
+// the networks of the seed (of both IP families of dual-stack seeds) or any of the <blockCIDRs> of the seed.
+func ValidateEgressDisjointedness(seedNetworks garden.SeedNetworks, blockCIDRs []gardencore.CIDR, destinations []garden.EgressDestination, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+
+		seedCIDRs = []gardencore.CIDR{seedNetworks.Nodes, seedNetworks.Pods, seedNetworks.Services}
+	)
+
+	if dualStack := seedNetworks.DualStack; dualStack != nil {
+		seedCIDRs = appendCIDR(seedCIDRs, dualStack.Nodes)
+		seedCIDRs = appendCIDR(seedCIDRs, dualStack.Pods)
+		seedCIDRs = appendCIDR(seedCIDRs, dualStack.Services)
+	}
+
+	for i, destination := range destinations {
+		for j, cidr := range destination.CIDRs {
+			idxPath := fldPath.Index(i).Child("cidrs").Index(j)
+
+			if intersecting, ok := firstIntersectingNetwork(cidr, seedCIDRs...); ok {
+				allErrs = append(allErrs, field.Invalid(idxPath, cidr, fmt.Sprintf("egress destination intersects with seed network %s", intersecting)))
+				continue
+			}
+			if intersecting, ok := firstIntersectingNetwork(cidr, blockCIDRs...); ok {
+				allErrs = append(allErrs, field.Invalid(idxPath, cidr, fmt.Sprintf("egress destination intersects with blocked seed network %s", intersecting)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// firstIntersectingNetwork returns the first of the <others> which intersects with <cidr>.
+func firstIntersectingNetwork(cidr gardencore.CIDR, others ...gardencore.CIDR) (gardencore.CIDR, bool) {
+	for _, other := range others {
+		if networksIntersect(cidr, other) {
+			return other, true
+		}
+	}
+	return "", false
+}
+
+func appendCIDR(cidrs []gardencore.CIDR, cidr *gardencore.CIDR) []gardencore.CIDR {
+	if cidr == nil {
+		return cidrs
+	}
+	return append(cidrs, *cidr)
+}
+
+// networksIntersect returns true if <cidr> intersects with any of the <others>. Networks of different IP families
+// never intersect.
+func networksIntersect(cidr gardencore.CIDR, others ...gardencore.CIDR) bool {
+	_, net1, err := net.ParseCIDR(string(cidr))
+	if err != nil {
+		return true
+	}
+	for _, other := range others {
+		_, net2, err := net.ParseCIDR(string(other))
+		if err != nil || net2.Contains(net1.IP) || net1.Contains(net2.IP) {
+			return true
+		}
+	}
+	return false
 }